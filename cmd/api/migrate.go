@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/app"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	infraLogger "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+)
+
+const migrateUsage = `usage: pr-reviewer-service migrate <command>
+
+commands:
+  up          apply all pending migrations
+  down [N]    roll back the last N migrations (default 1)
+  status      show current and expected schema version`
+
+var errMigrateUsage = errors.New(migrateUsage)
+
+// runMigrate выполняет подкоманду migrate над хранилищем из конфигурации
+func runMigrate(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+
+	steps := 1
+	switch args[0] {
+	case "up", "status":
+		if len(args) > 1 {
+			return errMigrateUsage
+		}
+	case "down":
+		if len(args) > 2 {
+			return errMigrateUsage
+		}
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid number of steps: %s", args[1])
+			}
+			steps = n
+		}
+	default:
+		return errMigrateUsage
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	log := infraLogger.NewSlogLogger(infraLogger.Config{
+		Level:  cfg.Logger.Level,
+		Format: cfg.Logger.Format,
+	})
+
+	storage, err := app.NewStorage(cfg, log)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck // Закрытие базы при выходе из команды
+	defer storage.Close()
+
+	migrator, err := app.NewMigrator(storage)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck // Закрытие мигратора при выходе из команды
+	defer migrator.Close()
+
+	switch args[0] {
+	case "up":
+		err = migrator.Up()
+	case "down":
+		err = migrator.Down(steps)
+	}
+	if err != nil {
+		return err
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "storage: %s\nversion: %d\nexpected: %d\ndirty: %t\n",
+		storage.Driver, status.Version, status.Expected, status.Dirty)
+	return err
+}
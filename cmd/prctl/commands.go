@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/client"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// Статусы импорта команды
+const (
+	importCreated = "created"
+	importExists  = "exists"
+	importFailed  = "failed"
+)
+
+// cli зависимости команд
+type cli struct {
+	client *client.Client
+	out    *printer
+	stdin  io.Reader
+}
+
+// command команда prctl. name - слова команды через пробел, например "team create"
+type command struct {
+	name string
+	run  func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands = []command{
+	{name: "team create", run: runTeamCreate},
+	{name: "team import", run: runTeamImport},
+	{name: "team get", run: runTeamGet},
+	{name: "user activate", run: runUserActivate},
+	{name: "user deactivate", run: runUserDeactivate},
+	{name: "user reviews", run: runUserReviews},
+	{name: "pr reassign", run: runPRReassign},
+	{name: "pr merge", run: runPRMerge},
+	{name: "stats", run: runStats},
+}
+
+// importResult итог импорта одной команды
+type importResult struct {
+	TeamName string `json:"team_name"`
+	Status   string `json:"status"`
+	Members  int    `json:"members"`
+	Error    string `json:"error,omitempty"`
+}
+
+// memberList значение флага -member в формате <user_id>:<username>, флаг можно повторять
+type memberList []dto.TeamMemberRequest
+
+func (m *memberList) String() string {
+	return ""
+}
+
+func (m *memberList) Set(value string) error {
+	userID, username, ok := strings.Cut(value, ":")
+	if !ok || userID == "" || username == "" {
+		return fmt.Errorf("expected <user_id>:<username>, got %q", value)
+	}
+	*m = append(*m, dto.TeamMemberRequest{UserID: userID, Username: username, IsActive: true})
+	return nil
+}
+
+// optionalInt значение числового флага, который можно не задавать
+type optionalInt struct {
+	value *int
+}
+
+func (o *optionalInt) String() string {
+	if o.value == nil {
+		return ""
+	}
+	return strconv.Itoa(*o.value)
+}
+
+func (o *optionalInt) Set(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid number %q", value)
+	}
+	o.value = &n
+	return nil
+}
+
+func runTeamCreate(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("team create")
+	var members memberList
+	var minReviewers, maxReviewers optionalInt
+	fs.Var(&members, "member", "")
+	strategy := fs.String("strategy", "", "")
+	fs.Var(&minReviewers, "min", "")
+	fs.Var(&maxReviewers, "max", "")
+
+	positional, err := parseArgs(fs, args, "<team_name>")
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return usageErrorf("team create: at least one -member is required")
+	}
+
+	team, err := c.client.CreateTeam(ctx, dto.CreateTeamRequest{
+		TeamName:         positional[0],
+		Members:          members,
+		ReviewerStrategy: *strategy,
+		MinReviewers:     minReviewers.value,
+		MaxReviewers:     maxReviewers.value,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.out.team(team)
+}
+
+// runTeamImport создает команды из файла. Уже существующие команды пропускаются,
+// ошибки API по отдельным командам не прерывают импорт остальных
+func runTeamImport(ctx context.Context, c *cli, args []string) error {
+	positional, err := parseArgs(newFlagSet("team import"), args, "<file>")
+	if err != nil {
+		return err
+	}
+
+	teams, err := c.readTeams(positional[0])
+	if err != nil {
+		return err
+	}
+
+	results := make([]importResult, 0, len(teams))
+	failed := 0
+	for _, req := range teams {
+		result := importResult{TeamName: req.TeamName, Members: len(req.Members)}
+
+		_, err := c.client.CreateTeam(ctx, req)
+		switch {
+		case err == nil:
+			result.Status = importCreated
+		case client.IsAPIError(err, presenter.ErrorCodeTeamExists):
+			result.Status = importExists
+		default:
+			// Сервис недоступен: остальные команды упадут так же
+			var apiErr *client.APIError
+			if !errors.As(err, &apiErr) {
+				return err
+			}
+			result.Status = importFailed
+			result.Error = err.Error()
+			failed++
+		}
+
+		results = append(results, result)
+	}
+
+	if err := c.out.importResults(results); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d teams failed to import", failed, len(teams))
+	}
+	return nil
+}
+
+// readTeams читает команды из файла: JSON массив запросов /team/add или один запрос
+func (c *cli) readTeams(path string) ([]dto.CreateTeamRequest, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(c.stdin)
+	} else {
+		data, err = os.ReadFile(path) //nolint:gosec // Путь задает оператор
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read teams: %w", err)
+	}
+
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("{")) {
+		var team dto.CreateTeamRequest
+		if err := json.Unmarshal(data, &team); err != nil {
+			return nil, fmt.Errorf("failed to parse teams: %w", err)
+		}
+		return []dto.CreateTeamRequest{team}, nil
+	}
+
+	var teams []dto.CreateTeamRequest
+	if err := json.Unmarshal(data, &teams); err != nil {
+		return nil, fmt.Errorf("failed to parse teams: %w", err)
+	}
+	return teams, nil
+}
+
+func runTeamGet(ctx context.Context, c *cli, args []string) error {
+	positional, err := parseArgs(newFlagSet("team get"), args, "<team_name>")
+	if err != nil {
+		return err
+	}
+
+	team, err := c.client.GetTeam(ctx, positional[0])
+	if err != nil {
+		return err
+	}
+
+	return c.out.team(team)
+}
+
+func runUserActivate(ctx context.Context, c *cli, args []string) error {
+	positional, err := parseArgs(newFlagSet("user activate"), args, "<user_id>")
+	if err != nil {
+		return err
+	}
+
+	activity, err := c.client.SetUserActive(ctx, dto.SetUserActiveRequest{UserID: positional[0], IsActive: true})
+	if err != nil {
+		return err
+	}
+
+	return c.out.userActivity(activity)
+}
+
+func runUserDeactivate(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("user deactivate")
+	skipReassign := fs.Bool("skip-reassign", false, "")
+
+	positional, err := parseArgs(fs, args, "<user_id>")
+	if err != nil {
+		return err
+	}
+
+	activity, err := c.client.SetUserActive(ctx, dto.SetUserActiveRequest{
+		UserID:       positional[0],
+		IsActive:     false,
+		SkipReassign: *skipReassign,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.out.userActivity(activity)
+}
+
+func runUserReviews(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("user reviews")
+	awaiting := fs.Bool("awaiting", false, "")
+
+	positional, err := parseArgs(fs, args, "<user_id>")
+	if err != nil {
+		return err
+	}
+
+	reviews, err := c.client.GetUserReviews(ctx, positional[0], *awaiting)
+	if err != nil {
+		return err
+	}
+
+	return c.out.userReviews(reviews)
+}
+
+func runPRReassign(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("pr reassign")
+	newUserID := fs.String("to", "", "")
+
+	positional, err := parseArgs(fs, args, "<pr_id>", "<old_user_id>")
+	if err != nil {
+		return err
+	}
+
+	reassignment, err := c.client.ReassignReviewer(ctx, dto.ReassignReviewerRequest{
+		PullRequestID: positional[0],
+		OldUserID:     positional[1],
+		NewUserID:     *newUserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.out.reassignment(positional[1], reassignment)
+}
+
+func runPRMerge(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("pr merge")
+	force := fs.Bool("force", false, "")
+
+	positional, err := parseArgs(fs, args, "<pr_id>")
+	if err != nil {
+		return err
+	}
+
+	pr, err := c.client.MergePR(ctx, dto.MergePRRequest{PullRequestID: positional[0], Force: *force})
+	if err != nil {
+		return err
+	}
+
+	return c.out.pullRequest(pr)
+}
+
+func runStats(ctx context.Context, c *cli, args []string) error {
+	fs := newFlagSet("stats")
+	teamName := fs.String("team", "", "")
+
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	stats, err := c.client.GetStatistics(ctx, *teamName)
+	if err != nil {
+		return err
+	}
+
+	return c.out.statistics(stats)
+}
@@ -0,0 +1,178 @@
+// prctl административная утилита сервиса. Работает через HTTP API,
+// поэтому действия проходят те же проверки прав, аудит и события, что и запросы клиентов
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/client"
+)
+
+const (
+	defaultURL     = "http://localhost:8080"
+	defaultTimeout = 30 * time.Second
+
+	exitError = 1
+	exitUsage = 2
+)
+
+const usage = `usage: prctl [flags] <command> [args]
+
+commands:
+  team create <team_name> -member <user_id>:<username> [-member ...] [-strategy <name>] [-min N] [-max N]
+                                        create a team
+  team import <file>                    create teams from a JSON array of /team/add requests ("-" reads stdin);
+                                        existing teams are skipped
+  team get <team_name>                  show a team with its members
+  user activate <user_id>               activate a user
+  user deactivate [-skip-reassign] <user_id>
+                                        deactivate a user and reassign their open reviews
+  user reviews [-awaiting] <user_id>    list pull requests the user reviews
+  pr reassign [-to <user_id>] <pr_id> <old_user_id>
+                                        replace a reviewer of a pull request
+  pr merge [-force] <pr_id>             merge a pull request
+  stats [-team <team_name>]             show assignment statistics
+
+flags:
+  -url string        service address (env PRCTL_URL, default ` + defaultURL + `)
+  -token string      API token (env PRCTL_TOKEN)
+  -o string          output format: table or json (default "table")
+  -timeout duration  request timeout (default 30s)`
+
+// usageError ошибка в аргументах командной строки
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usageErrorf(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout)
+
+	var usageErr *usageError
+	switch {
+	case err == nil:
+		return
+	case errors.Is(err, flag.ErrHelp):
+		//nolint:gosec
+		_, _ = fmt.Fprintln(os.Stdout, usage)
+		return
+	case errors.As(err, &usageErr):
+		//nolint:gosec
+		_, _ = fmt.Fprintf(os.Stderr, "prctl: %s\n\n%s\n", err, usage)
+		stop()
+		//nolint:gocritic // Намеренное завершение программы
+		os.Exit(exitUsage)
+	default:
+		//nolint:gosec
+		_, _ = fmt.Fprintf(os.Stderr, "prctl: %s\n", err)
+		stop()
+		os.Exit(exitError)
+	}
+}
+
+// run разбирает глобальные флаги и выполняет команду
+func run(ctx context.Context, args []string, stdin io.Reader, out io.Writer) error {
+	fs := newFlagSet("prctl")
+	baseURL := fs.String("url", envOr("PRCTL_URL", defaultURL), "")
+	token := fs.String("token", os.Getenv("PRCTL_TOKEN"), "")
+	format := fs.String("o", formatTable, "")
+	timeout := fs.Duration("timeout", defaultTimeout, "")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageErrorf("%s", err)
+	}
+
+	if *format != formatTable && *format != formatJSON {
+		return usageErrorf("unknown output format %q", *format)
+	}
+
+	cmd, cmdArgs, err := findCommand(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	c := &cli{
+		client: client.New(*baseURL, *token, &http.Client{Timeout: *timeout}),
+		out:    &printer{out: out, format: *format},
+		stdin:  stdin,
+	}
+	return cmd.run(ctx, c, cmdArgs)
+}
+
+// findCommand находит команду по первым словам аргументов
+func findCommand(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return command{}, nil, usageErrorf("command is required")
+	}
+
+	for _, cmd := range commands {
+		words := strings.Fields(cmd.name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == cmd.name {
+			return cmd, args[len(words):], nil
+		}
+	}
+
+	return command{}, nil, usageErrorf("unknown command %q", strings.Join(args, " "))
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	// Ошибки и справку печатает main вместе с общим usage
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// parseArgs разбирает флаги команды, разрешая им стоять и после позиционных аргументов.
+// Возвращает позиционные аргументы, их должно быть ровно len(names)
+func parseArgs(fs *flag.FlagSet, args []string, names ...string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageErrorf("%s: %s", fs.Name(), err)
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) != len(names) {
+		return nil, usageErrorf("%s: expected arguments: %s", fs.Name(), strings.Join(names, " "))
+	}
+
+	return positional, nil
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
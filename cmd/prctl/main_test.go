@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+func runCommand(t *testing.T, handler http.HandlerFunc, stdin string, args ...string) (string, error) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	var out bytes.Buffer
+	err := run(context.Background(), append([]string{"-url", server.URL}, args...), strings.NewReader(stdin), &out)
+	return out.String(), err
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "no command", args: nil},
+		{name: "unknown command", args: []string{"team", "delete", "backend"}},
+		{name: "unknown output format", args: []string{"-o", "yaml", "stats"}},
+		{name: "missing argument", args: []string{"pr", "merge"}},
+		{name: "extra argument", args: []string{"user", "activate", "u1", "u2"}},
+		{name: "unknown flag", args: []string{"pr", "merge", "-now", "pr-1"}},
+		{name: "team without members", args: []string{"team", "create", "backend"}},
+		{name: "invalid member", args: []string{"team", "create", "backend", "-member", "u1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runCommand(t, func(_ http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}, "", tt.args...)
+
+			var usageErr *usageError
+			if !errors.As(err, &usageErr) {
+				t.Errorf("expected usage error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+	_, err := runCommand(t, nil, "", "pr", "merge", "-h")
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Errorf("expected flag.ErrHelp, got %v", err)
+	}
+}
+
+func TestRun_PRMerge(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var req dto.MergePRRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if r.URL.Path != "/pullRequest/merge" || req.PullRequestID != "pr-1" || !req.Force {
+			t.Errorf("unexpected request %s %+v", r.URL.Path, req)
+		}
+		presenter.RespondPullRequest(w, http.StatusOK, &dto.PullRequestDTO{
+			PullRequestID:     req.PullRequestID,
+			PullRequestName:   "Add search",
+			AuthorID:          "u1",
+			Status:            "MERGED",
+			AssignedReviewers: []string{"u2", "u3"},
+			MergeForced:       true,
+		})
+	}
+
+	// Флаг команды можно указать и после позиционного аргумента
+	out, err := runCommand(t, handler, "", "pr", "merge", "pr-1", "-force")
+	if err != nil {
+		t.Fatalf("pr merge failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got %q", out)
+	}
+	for _, want := range []string{"pr-1", "Add search", "MERGED", "u2,u3", "true"} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("expected %q in row %q", want, lines[1])
+		}
+	}
+}
+
+func TestRun_StatsJSON(t *testing.T) {
+	stats := dto.StatisticsDTO{
+		PRStats:   dto.PRStatsDTO{Total: 3, Open: 2, Merged: 1},
+		UserStats: []dto.UserStatsDTO{{UserID: "u1", TotalReviews: 2, ActiveReviews: 1}},
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("team_name"); got != "backend" {
+			t.Errorf("expected team_name=backend, got %q", got)
+		}
+		presenter.RespondStatistics(w, http.StatusOK, &stats)
+	}
+
+	out, err := runCommand(t, handler, "", "-o", "json", "stats", "-team", "backend")
+	if err != nil {
+		t.Fatalf("stats failed: %v", err)
+	}
+
+	var got dto.StatisticsDTO
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", out, err)
+	}
+	if got.PRStats != stats.PRStats || len(got.UserStats) != 1 || got.UserStats[0] != stats.UserStats[0] {
+		t.Errorf("expected %+v, got %+v", stats, got)
+	}
+}
+
+func TestRun_TeamImport(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var req dto.CreateTeamRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		switch req.TeamName {
+		case "backend":
+			presenter.RespondTeam(w, http.StatusCreated, &dto.TeamDTO{TeamName: req.TeamName})
+		case "frontend":
+			presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeTeamExists, "frontend already exists")
+		default:
+			presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "members array is required")
+		}
+	}
+	teams := `[
+		{"team_name": "backend", "members": [{"user_id": "u1", "username": "Alice", "is_active": true}]},
+		{"team_name": "frontend", "members": [{"user_id": "u2", "username": "Bob", "is_active": true}]},
+		{"team_name": "empty", "members": []}
+	]`
+
+	out, err := runCommand(t, handler, teams, "-o", "json", "team", "import", "-")
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 teams failed") {
+		t.Errorf("expected import to report one failure, got %v", err)
+	}
+
+	var results []importResult
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", out, err)
+	}
+
+	want := []string{importCreated, importExists, importFailed}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %+v", len(want), results)
+	}
+	for i, status := range want {
+		if results[i].Status != status {
+			t.Errorf("team %s: expected status %s, got %s", results[i].TeamName, status, results[i].Status)
+		}
+	}
+	if results[2].Error == "" {
+		t.Error("expected error message for failed team")
+	}
+}
+
+func TestRun_TeamImportSingleObject(t *testing.T) {
+	var created []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var req dto.CreateTeamRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		created = append(created, req.TeamName)
+		presenter.RespondTeam(w, http.StatusCreated, &dto.TeamDTO{TeamName: req.TeamName})
+	}
+
+	_, err := runCommand(t, handler, `{"team_name": "backend", "members": [{"user_id": "u1", "username": "Alice"}]}`,
+		"team", "import", "-")
+	if err != nil {
+		t.Fatalf("team import failed: %v", err)
+	}
+	if len(created) != 1 || created[0] != "backend" {
+		t.Errorf("expected backend to be created, got %v", created)
+	}
+}
+
+func TestRun_APIError(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		presenter.RespondError(w, http.StatusNotFound, presenter.ErrorCodeNotFound, "user not found")
+	}
+
+	_, err := runCommand(t, handler, "", "user", "deactivate", "-skip-reassign", "u404")
+
+	var usageErr *usageError
+	if err == nil || errors.As(err, &usageErr) {
+		t.Fatalf("expected API error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), presenter.ErrorCodeNotFound) {
+		t.Errorf("expected error code in message, got %q", err.Error())
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/client"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// Форматы вывода
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// emptyCell значение пустой ячейки таблицы
+const emptyCell = "-"
+
+// printer печатает результаты команд таблицей или JSON. В JSON результаты
+// печатаются в тех же структурах, что возвращает API
+type printer struct {
+	out    io.Writer
+	format string
+}
+
+// table пишет строки таблицы, колонки разделяются табуляцией. Пустая строка отделяет таблицы
+type table struct {
+	w *tabwriter.Writer
+}
+
+func (t table) row(cells ...string) {
+	//nolint:gosec // Ошибка записи вернется из Flush
+	_, _ = fmt.Fprintln(t.w, strings.Join(cells, "\t"))
+}
+
+// print печатает v в JSON или заполняет таблицу функцией fill
+func (p *printer) print(v interface{}, fill func(t table)) error {
+	if p.format == formatJSON {
+		encoder := json.NewEncoder(p.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	fill(table{w: w})
+	return w.Flush()
+}
+
+func (p *printer) team(team *dto.TeamDTO) error {
+	return p.print(team, func(t table) {
+		t.row("TEAM", "STRATEGY", "MIN_REVIEWERS", "MAX_REVIEWERS", "FALLBACK_TEAMS")
+		t.row(
+			team.TeamName,
+			orEmpty(team.ReviewerStrategy),
+			strconv.Itoa(team.MinReviewers),
+			strconv.Itoa(team.MaxReviewers),
+			orEmpty(strings.Join(team.FallbackTeams, ",")),
+		)
+		t.row()
+		t.row("USER_ID", "USERNAME", "ACTIVE")
+		for _, member := range team.Members {
+			t.row(member.UserID, member.Username, strconv.FormatBool(member.IsActive))
+		}
+	})
+}
+
+func (p *printer) importResults(results []importResult) error {
+	return p.print(results, func(t table) {
+		t.row("TEAM", "STATUS", "MEMBERS", "ERROR")
+		for _, result := range results {
+			t.row(result.TeamName, result.Status, strconv.Itoa(result.Members), orEmpty(result.Error))
+		}
+	})
+}
+
+func (p *printer) userActivity(activity *client.UserActivity) error {
+	return p.print(activity, func(t table) {
+		t.row("USER_ID", "USERNAME", "TEAM", "ACTIVE")
+		t.row(activity.User.UserID, activity.User.Username, activity.User.TeamName, strconv.FormatBool(activity.User.IsActive))
+
+		if len(activity.Reassignments) == 0 {
+			return
+		}
+		t.row()
+		t.row("PULL_REQUEST_ID", "OLD_REVIEWER", "NEW_REVIEWER")
+		for _, reassignment := range activity.Reassignments {
+			newUserID := emptyCell
+			if reassignment.NewUserID != nil {
+				newUserID = *reassignment.NewUserID
+			}
+			t.row(reassignment.PullRequestID, reassignment.OldUserID, newUserID)
+		}
+	})
+}
+
+func (p *printer) userReviews(reviews *client.UserReviews) error {
+	return p.print(reviews, func(t table) {
+		t.row("PULL_REQUEST_ID", "NAME", "AUTHOR", "STATUS")
+		for _, pr := range reviews.PullRequests {
+			t.row(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status)
+		}
+	})
+}
+
+func (p *printer) reassignment(oldUserID string, reassignment *client.Reassignment) error {
+	return p.print(reassignment, func(t table) {
+		pr := reassignment.PullRequest
+		t.row("PULL_REQUEST_ID", "OLD_REVIEWER", "NEW_REVIEWER", "REVIEWERS")
+		t.row(pr.PullRequestID, oldUserID, reassignment.ReplacedBy, orEmpty(strings.Join(pr.AssignedReviewers, ",")))
+	})
+}
+
+func (p *printer) pullRequest(pr *dto.PullRequestDTO) error {
+	return p.print(pr, func(t table) {
+		mergedAt := emptyCell
+		if pr.MergedAt != nil {
+			mergedAt = pr.MergedAt.Format(time.RFC3339)
+		}
+		t.row("PULL_REQUEST_ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS", "MERGED_AT", "FORCED")
+		t.row(
+			pr.PullRequestID,
+			pr.PullRequestName,
+			pr.AuthorID,
+			pr.Status,
+			orEmpty(strings.Join(pr.AssignedReviewers, ",")),
+			mergedAt,
+			strconv.FormatBool(pr.MergeForced),
+		)
+	})
+}
+
+func (p *printer) statistics(stats *dto.StatisticsDTO) error {
+	return p.print(stats, func(t table) {
+		t.row("TOTAL", "DRAFT", "OPEN", "MERGED", "CLOSED")
+		t.row(
+			strconv.Itoa(stats.PRStats.Total),
+			strconv.Itoa(stats.PRStats.Draft),
+			strconv.Itoa(stats.PRStats.Open),
+			strconv.Itoa(stats.PRStats.Merged),
+			strconv.Itoa(stats.PRStats.Closed),
+		)
+
+		if len(stats.UserStats) == 0 {
+			return
+		}
+		t.row()
+		t.row("USER_ID", "TOTAL_REVIEWS", "ACTIVE_REVIEWS")
+		for _, user := range stats.UserStats {
+			t.row(user.UserID, strconv.Itoa(user.TotalReviews), strconv.Itoa(user.ActiveReviews))
+		}
+	})
+}
+
+func orEmpty(value string) string {
+	if value == "" {
+		return emptyCell
+	}
+	return value
+}
@@ -3,38 +3,55 @@ package app
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 	"time"
 
 	httpDelivery "github.com/exPriceD/pr-reviewer-service/internal/delivery/http"
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/handler"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/middleware"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
-	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/auth"
 	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
-	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database"
-	prRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/pull_request"
-	teamRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/team"
-	userRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/user"
 	infraLogger "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/metrics"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/notifier"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/ratelimit"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/tracing"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 )
 
 // App содержит все зависимости приложения
 type App struct {
-	Config    *config.Config
-	Logger    logger.Logger
-	DB        *database.PostgresDB
-	TxManager transaction.Manager
-
-	// Repositories
-	UserRepository        *userRepo.Repository
-	TeamRepository        *teamRepo.Repository
-	PullRequestRepository *prRepo.Repository
+	Config *config.Config
+	Logger logger.Logger
+	// Storage репозитории выбранного хранилища (storage.driver)
+	Storage *Storage
+	// Metrics реестр метрик Prometheus
+	Metrics *metrics.Metrics
 
 	// Use Cases
-	UserUseCase        *usecase.UserUseCase
-	TeamUseCase        *usecase.TeamUseCase
-	PullRequestUseCase *usecase.PullRequestUseCase
-	StatisticsUseCase  *usecase.StatisticsUseCase
+	UserUseCase         *usecase.UserUseCase
+	TeamUseCase         *usecase.TeamUseCase
+	PullRequestUseCase  *usecase.PullRequestUseCase
+	StatisticsUseCase   *usecase.StatisticsUseCase
+	WebhookUseCase      *usecase.WebhookUseCase
+	SubscriptionUseCase *usecase.SubscriptionUseCase
+	AuthUseCase         *usecase.AuthUseCase
+	AuditUseCase        *usecase.AuditUseCase
+	HealthUseCase       *usecase.HealthUseCase
+	IdempotencyUseCase  *usecase.IdempotencyUseCase // nil, если Idempotency-Key не обрабатывается
+
+	// Фоновая публикация событий из outbox и доставка подписчикам
+	EventPublisher *notifier.InProcessPublisher
+	OutboxRelay    *usecase.OutboxRelay
+	EventDeliverer *usecase.EventDeliverer
+	stopWorkers    context.CancelFunc
+	workers        sync.WaitGroup
+
+	// shutdownTracing выгружает накопленные спаны при остановке
+	shutdownTracing func(ctx context.Context) error
 
 	// HTTP Server
 	HTTPServer *httpDelivery.Server
@@ -58,35 +75,91 @@ func Build() (*App, error) {
 		"log_level", cfg.Logger.Level,
 	)
 
-	log.Info("Connecting to database",
-		"host", cfg.Database.Host,
-		"port", cfg.Database.Port,
-		"database", cfg.Database.DBName,
-	)
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure tracing: %w", err)
+	}
+	if cfg.Tracing.Enabled {
+		log.Info("Tracing enabled",
+			"exporter", cfg.Tracing.Exporter,
+			"sample_ratio", cfg.Tracing.SampleRatio,
+		)
+	}
 
-	db, err := database.NewPostgresDB(cfg.Database)
+	storage, err := NewStorage(cfg, log)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database: %w", err)
+		_ = shutdownTracing(context.Background())
+		return nil, err
+	}
+	// release освобождает соединения с базой и трассировку, если сборка приложения не удалась
+	release := func() {
+		_ = storage.Close()
+		_ = shutdownTracing(context.Background())
+	}
+
+	if err := PrepareSchema(storage, cfg.Storage, log); err != nil {
+		release()
+		return nil, fmt.Errorf("failed to prepare database schema: %w", err)
 	}
 
-	log.Info("Successfully connected to database")
+	log.Info("Repositories initialized", "storage", cfg.Storage.Driver)
 
-	txManager := database.NewTransactionManager(db)
+	appMetrics := metrics.New()
+	if cfg.Metrics.Enabled {
+		if err := InstrumentStorage(appMetrics, storage, cfg.Storage.Driver); err != nil {
+			release()
+			return nil, fmt.Errorf("failed to register metrics: %w", err)
+		}
+		log.Info("Metrics enabled", "path", "/metrics")
+	}
+
+	reviewerSelector := usecase.NewReviewerSelector(
+		storage.UserRepository,
+		storage.PullRequestRepository,
+		storage.TeamRepository,
+		usecase.NewReviewerStrategies(storage.ReviewerCursorRepository),
+		entity.ReviewerStrategyName(cfg.Reviewer.Strategy),
+	)
+
+	eventOutbox := usecase.NewEventOutbox(storage.OutboxRepository)
+	auditTrail := usecase.NewAuditTrail(storage.AuditRepository, AuditContext)
 
-	log.Info("Transaction Manager initialized")
+	userUseCase := usecase.NewUserUseCase(storage.TxManager, storage.UserRepository, storage.PullRequestRepository, storage.ReviewerHistoryRepository, reviewerSelector, eventOutbox, auditTrail, log)
+	teamUseCase := usecase.NewTeamUseCase(storage.TxManager, storage.TeamRepository, storage.UserRepository, storage.PullRequestRepository, storage.ReviewerHistoryRepository, reviewerSelector, eventOutbox, auditTrail, log)
+	pullRequestUseCase := usecase.NewPullRequestUseCase(storage.TxManager, storage.PullRequestRepository, storage.ReviewerHistoryRepository, storage.UserRepository, storage.TeamRepository, reviewerSelector, eventOutbox, auditTrail, appMetrics, log)
+	statisticsUseCase := usecase.NewStatisticsUseCase(storage.PullRequestRepository, storage.ReviewerHistoryRepository, storage.UserRepository, log)
+	webhookUseCase := usecase.NewWebhookUseCase(storage.TxManager, storage.WebhookRepository, storage.UserRepository, pullRequestUseCase, auditTrail, log)
+	subscriptionUseCase := usecase.NewSubscriptionUseCase(storage.TxManager, storage.SubscriptionRepository, storage.EventDeliveryRepository, auditTrail, log)
+	auditUseCase := usecase.NewAuditUseCase(storage.AuditRepository, log)
 
-	userRepository := userRepo.NewRepository(db.DB(), db.Getter())
-	teamRepository := teamRepo.NewRepository(db.DB(), db.Getter())
-	pullRequestRepository := prRepo.NewRepository(db.DB(), db.Getter())
+	readinessChecks, err := NewReadinessChecks(storage)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to configure readiness checks: %w", err)
+	}
+	healthUseCase := usecase.NewHealthUseCase(readinessChecks, time.Duration(cfg.Server.ReadinessTimeout)*time.Second, log)
 
-	log.Info("Repositories initialized")
+	tokenVerifier, err := NewTokenVerifier(cfg.Auth)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to configure jwt verification: %w", err)
+	}
+	authUseCase := usecase.NewAuthUseCase(storage.TxManager, storage.APITokenRepository, tokenVerifier, usecase.AuthSettings{
+		BootstrapToken: cfg.Auth.BootstrapToken,
+	}, auditTrail, log)
 
-	reviewerSelector := usecase.NewReviewerSelector(userRepository, pullRequestRepository)
+	eventNotifier := usecase.NewEventNotifier(storage.SubscriptionRepository, storage.EventDeliveryRepository, log)
+	eventPublisher := notifier.NewInProcessPublisher(eventNotifier.Publish)
+	outboxRelay := usecase.NewOutboxRelay(storage.TxManager, storage.OutboxRepository, eventPublisher, NewOutboxRelaySettings(cfg.Outbox), log)
 
-	userUseCase := usecase.NewUserUseCase(userRepository, pullRequestRepository, log)
-	teamUseCase := usecase.NewTeamUseCase(txManager, teamRepository, userRepository, log)
-	pullRequestUseCase := usecase.NewPullRequestUseCase(txManager, pullRequestRepository, userRepository, reviewerSelector, log)
-	statisticsUseCase := usecase.NewStatisticsUseCase(pullRequestRepository, userRepository, log)
+	eventDeliverer := usecase.NewEventDeliverer(
+		storage.TxManager,
+		storage.SubscriptionRepository,
+		storage.EventDeliveryRepository,
+		notifier.NewHTTPSender(&http.Client{}),
+		NewEventDeliverySettings(cfg.Notifications),
+		log,
+	)
 
 	log.Info("Use Cases initialized")
 
@@ -94,33 +167,204 @@ func Build() (*App, error) {
 	userHandler := handler.NewUserHandler(userUseCase)
 	pullRequestHandler := handler.NewPullRequestHandler(pullRequestUseCase)
 	statisticsHandler := handler.NewStatisticsHandler(statisticsUseCase)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase, handler.WebhookSecrets{
+		GitHub: cfg.Webhooks.GitHubSecret,
+		GitLab: cfg.Webhooks.GitLabSecret,
+	})
+	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionUseCase)
+	authHandler := handler.NewAuthHandler(authUseCase)
+	auditHandler := handler.NewAuditHandler(auditUseCase)
+	healthHandler := handler.NewHealthHandler(healthUseCase)
+
+	var routerMetrics httpDelivery.Metrics
+	if cfg.Metrics.Enabled {
+		routerMetrics = appMetrics
+	}
+
+	var rateLimiter middleware.RateLimiter
+	var authFailureLimiter middleware.AuthFailureLimiter
+	if cfg.RateLimit.Enabled {
+		rateLimiter = NewRateLimiter(cfg.RateLimit)
+		authFailureLimiter = NewAuthFailureLimiter(cfg.RateLimit)
+		log.Info("Rate limiting enabled",
+			"rps", cfg.RateLimit.Default.RPS,
+			"burst", cfg.RateLimit.Default.Burst,
+			"routes", len(cfg.RateLimit.Routes),
+			"auth_failures_rps", cfg.RateLimit.AuthFailures.RPS,
+			"auth_failures_burst", cfg.RateLimit.AuthFailures.Burst,
+		)
+	}
 
-	router := httpDelivery.NewRouter(teamHandler, userHandler, pullRequestHandler, statisticsHandler, log, int64(cfg.Server.MaxBodySize))
+	var idempotencyUseCase *usecase.IdempotencyUseCase
+	var idempotencyStore middleware.IdempotencyStore
+	if cfg.Idempotency.Enabled {
+		idempotencyUseCase = usecase.NewIdempotencyUseCase(storage.IdempotencyRepository, NewIdempotencySettings(cfg.Idempotency), log)
+		idempotencyStore = idempotencyUseCase
+		log.Info("Idempotency keys enabled",
+			"ttl", time.Duration(cfg.Idempotency.TTL)*time.Second,
+			"lease", time.Duration(cfg.Idempotency.Lease)*time.Second,
+		)
+	}
+
+	var authenticator middleware.Authenticator
+	if cfg.Auth.Enabled {
+		authenticator = authUseCase
+		log.Info("Authentication enabled", "jwt", tokenVerifier != nil, "bootstrap_token", cfg.Auth.BootstrapToken != "")
+	} else {
+		log.Warn("Authentication disabled, all endpoints are public")
+	}
+
+	router := httpDelivery.NewRouter(
+		teamHandler,
+		userHandler,
+		pullRequestHandler,
+		statisticsHandler,
+		webhookHandler,
+		subscriptionHandler,
+		authHandler,
+		auditHandler,
+		healthHandler,
+		authenticator,
+		routerMetrics,
+		rateLimiter,
+		authFailureLimiter,
+		idempotencyStore,
+		log,
+		int64(cfg.Server.MaxBodySize),
+	)
 	chiRouter := router.Setup()
 
 	httpServer := httpDelivery.NewServer(cfg.Server, chiRouter)
 	log.Info("HTTP Server initialized", "address", httpServer.Address())
 
 	return &App{
-		Config:                cfg,
-		Logger:                log,
-		DB:                    db,
-		TxManager:             txManager,
-		UserRepository:        userRepository,
-		TeamRepository:        teamRepository,
-		PullRequestRepository: pullRequestRepository,
-		UserUseCase:           userUseCase,
-		TeamUseCase:           teamUseCase,
-		PullRequestUseCase:    pullRequestUseCase,
-		StatisticsUseCase:     statisticsUseCase,
-		HTTPServer:            httpServer,
+		Config:              cfg,
+		Logger:              log,
+		Storage:             storage,
+		Metrics:             appMetrics,
+		UserUseCase:         userUseCase,
+		TeamUseCase:         teamUseCase,
+		PullRequestUseCase:  pullRequestUseCase,
+		StatisticsUseCase:   statisticsUseCase,
+		WebhookUseCase:      webhookUseCase,
+		SubscriptionUseCase: subscriptionUseCase,
+		AuthUseCase:         authUseCase,
+		AuditUseCase:        auditUseCase,
+		HealthUseCase:       healthUseCase,
+		IdempotencyUseCase:  idempotencyUseCase,
+		EventPublisher:      eventPublisher,
+		OutboxRelay:         outboxRelay,
+		EventDeliverer:      eventDeliverer,
+		HTTPServer:          httpServer,
+		shutdownTracing:     shutdownTracing,
 	}, nil
 }
 
+// InstrumentStorage подключает к метрикам пул соединений базы, длительность транзакций
+// и бизнес-метрики из репозиториев. Менеджер транзакций хранилища заменяется измеряющим,
+// поэтому вызывать до создания use case
+func InstrumentStorage(m *metrics.Metrics, storage *Storage, driver string) error {
+	if storage.DB != nil {
+		if err := m.RegisterDB(storage.DB, driver); err != nil {
+			return err
+		}
+	}
+	if err := m.RegisterBusinessMetrics(storage.PullRequestRepository, storage.TeamRepository); err != nil {
+		return err
+	}
+	storage.TxManager = m.InstrumentTransactions(storage.TxManager)
+	return nil
+}
+
+// NewEventDeliverySettings переводит конфигурацию доставки событий в настройки EventDeliverer
+func NewEventDeliverySettings(cfg config.NotificationsConfig) usecase.EventDeliverySettings {
+	return usecase.EventDeliverySettings{
+		PollInterval:   time.Duration(cfg.PollInterval) * time.Second,
+		RequestTimeout: time.Duration(cfg.RequestTimeout) * time.Second,
+		BatchSize:      cfg.BatchSize,
+		Retry: entity.RetryPolicy{
+			MaxAttempts:    cfg.MaxAttempts,
+			InitialBackoff: time.Duration(cfg.InitialBackoff) * time.Second,
+			MaxBackoff:     time.Duration(cfg.MaxBackoff) * time.Second,
+		},
+	}
+}
+
+// NewOutboxRelaySettings переводит конфигурацию outbox в настройки OutboxRelay
+func NewOutboxRelaySettings(cfg config.OutboxConfig) usecase.OutboxRelaySettings {
+	return usecase.OutboxRelaySettings{
+		PollInterval: time.Duration(cfg.PollInterval) * time.Second,
+		BatchSize:    cfg.BatchSize,
+	}
+}
+
+// NewIdempotencySettings переводит конфигурацию Idempotency-Key в настройки IdempotencyUseCase
+func NewIdempotencySettings(cfg config.IdempotencyConfig) usecase.IdempotencySettings {
+	return usecase.IdempotencySettings{
+		TTL:             time.Duration(cfg.TTL) * time.Second,
+		Lease:           time.Duration(cfg.Lease) * time.Second,
+		CleanupInterval: time.Duration(cfg.CleanupInterval) * time.Second,
+	}
+}
+
+// NewRateLimiter переводит конфигурацию лимитов в ограничитель частоты запросов
+func NewRateLimiter(cfg config.RateLimitConfig) *ratelimit.Limiter {
+	routes := make(map[string]ratelimit.Limit, len(cfg.Routes))
+	for route, limit := range cfg.Routes {
+		routes[route] = ratelimit.Limit{Rate: limit.RPS, Burst: limit.Burst}
+	}
+	return ratelimit.New(ratelimit.Limit{Rate: cfg.Default.RPS, Burst: cfg.Default.Burst}, routes)
+}
+
+// NewAuthFailureLimiter переводит лимит неудачных попыток аутентификации в отдельный ограничитель,
+// чтобы они не делили корзину с обычными запросами клиента
+func NewAuthFailureLimiter(cfg config.RateLimitConfig) *ratelimit.Limiter {
+	return ratelimit.New(ratelimit.Limit{Rate: cfg.AuthFailures.RPS, Burst: cfg.AuthFailures.Burst}, nil)
+}
+
+// NewTokenVerifier создает проверку JWT по конфигурации аутентификации.
+// Возвращает nil, если ключ не задан: тогда принимаются только статические токены
+func NewTokenVerifier(cfg config.AuthConfig) (usecase.TokenVerifier, error) {
+	jwtCfg := auth.JWTConfig{
+		Secret:   []byte(cfg.JWTSecret),
+		Issuer:   cfg.JWTIssuer,
+		Audience: cfg.JWTAudience,
+	}
+
+	if cfg.JWTPublicKeyFile != "" {
+		publicKey, err := auth.LoadRSAPublicKey(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		jwtCfg.PublicKey = publicKey
+	}
+
+	if len(jwtCfg.Secret) == 0 && jwtCfg.PublicKey == nil {
+		return nil, nil
+	}
+
+	return auth.NewJWTVerifier(jwtCfg)
+}
+
+// AuditContext берет автора изменения и request ID для журнала аудита из контекста запроса
+func AuditContext(ctx context.Context) (string, string) {
+	return infraLogger.GetUserID(ctx), infraLogger.GetRequestID(ctx)
+}
+
 // Shutdown корректно завершает работу приложения
 func (a *App) Shutdown() error {
 	a.Logger.Info("Shutting down application...")
 
+	// Сначала /readyz начинает отвечать 503, и балансировщик выводит экземпляр из ротации.
+	// Сервер тем временем продолжает обслуживать запросы, уже направленные на него
+	if a.HealthUseCase != nil {
+		a.HealthUseCase.StartDraining()
+		if drainDelay := time.Duration(a.Config.Server.DrainDelay) * time.Second; drainDelay > 0 {
+			a.Logger.Info("Draining traffic before shutdown", "delay", drainDelay)
+			<-time.After(drainDelay)
+		}
+	}
+
 	if a.HTTPServer != nil {
 		shutdownTimeout := time.Duration(a.Config.Server.ShutdownTimeout) * time.Second
 		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
@@ -133,7 +377,24 @@ func (a *App) Shutdown() error {
 		a.Logger.Info("HTTP Server stopped")
 	}
 
-	if err := a.DB.Close(); err != nil {
+	// Фоновые процессы останавливаются после HTTP сервера, чтобы события последних запросов
+	// остались в outbox, и до закрытия БД, которая им нужна
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+		a.workers.Wait()
+		a.Logger.Info("Background workers stopped")
+	}
+
+	if a.shutdownTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(a.Config.Server.ShutdownTimeout)*time.Second)
+		defer cancel()
+
+		if err := a.shutdownTracing(ctx); err != nil {
+			a.Logger.Error("Error flushing traces", "error", err)
+		}
+	}
+
+	if err := a.Storage.Close(); err != nil {
 		a.Logger.Error("Error closing database connection", "error", err)
 		return err
 	}
@@ -148,6 +409,27 @@ func (a *App) Start() error {
 		return fmt.Errorf("HTTP server is not initialized")
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopWorkers = cancel
+	if a.OutboxRelay != nil {
+		a.runWorker(ctx, a.OutboxRelay.Run)
+	}
+	if a.EventDeliverer != nil {
+		a.runWorker(ctx, a.EventDeliverer.Run)
+	}
+	if a.IdempotencyUseCase != nil {
+		a.runWorker(ctx, a.IdempotencyUseCase.Run)
+	}
+
 	a.Logger.Info("Starting HTTP server", "address", a.HTTPServer.Address())
 	return a.HTTPServer.Start()
 }
+
+// runWorker запускает фоновый процесс, которого Shutdown дождется после отмены ctx
+func (a *App) runWorker(ctx context.Context, run func(ctx context.Context)) {
+	a.workers.Add(1)
+	go func() {
+		defer a.workers.Done()
+		run(ctx)
+	}()
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/migration"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/health"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+)
+
+// NewReadinessChecks создает проверки хранилища для /readyz. Пул проверяется первым,
+// пока остальные проверки не заняли соединение. Хранилищу в памяти проверять нечего
+func NewReadinessChecks(storage *Storage) ([]usecase.HealthCheck, error) {
+	if storage.DB == nil {
+		return nil, nil
+	}
+
+	source, err := migrationsFS(storage.Driver)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := migration.ExpectedVersion(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return []usecase.HealthCheck{
+		health.NewPoolCheck(storage.DB),
+		health.NewDatabaseCheck(storage.DB),
+		health.NewSchemaCheck(storage.DB, expected),
+	}, nil
+}
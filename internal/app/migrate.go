@@ -0,0 +1,73 @@
+package app
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/migration"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/sqlite"
+	"github.com/exPriceD/pr-reviewer-service/migrations"
+)
+
+// NewMigrator создает мигратор схемы хранилища. У хранилища в памяти схемы нет
+func NewMigrator(storage *Storage) (*migration.Migrator, error) {
+	source, err := migrationsFS(storage.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	switch storage.Driver {
+	case config.StorageDriverPostgres:
+		return migration.NewPostgres(storage.DB, source)
+	default:
+		return migration.NewSQLite(storage.DB, source)
+	}
+}
+
+// migrationsFS возвращает миграции, встроенные в бинарник для драйвера хранилища
+func migrationsFS(driver string) (fs.FS, error) {
+	switch driver {
+	case config.StorageDriverPostgres:
+		return migrations.FS, nil
+	case config.StorageDriverSQLite:
+		return sqlite.Migrations(), nil
+	default:
+		return nil, fmt.Errorf("storage driver %s has no schema migrations", driver)
+	}
+}
+
+// PrepareSchema применяет миграции, если включен storage.auto_migrate, и проверяет,
+// что версия схемы совпадает с ожидаемой бинарником
+func PrepareSchema(storage *Storage, cfg config.StorageConfig, log logger.Logger) error {
+	if storage.Driver == config.StorageDriverMemory {
+		return nil
+	}
+
+	migrator, err := NewMigrator(storage)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck // Ошибка освобождения соединения мигратора не влияет на результат
+	defer migrator.Close()
+
+	if cfg.AutoMigrate {
+		log.Info("Applying database migrations")
+		if err := migrator.Up(); err != nil {
+			return err
+		}
+	}
+
+	if err := migrator.Check(); err != nil {
+		return fmt.Errorf("%w (run \"migrate up\" or enable storage.auto_migrate)", err)
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Database schema is up to date", "version", status.Version)
+	return nil
+}
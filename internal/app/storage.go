@@ -0,0 +1,150 @@
+package app
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database"
+	apiTokenRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/api_token"
+	auditRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/audit"
+	eventDeliveryRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/event_delivery"
+	idempotencyRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/idempotency"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/memory"
+	outboxRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/outbox"
+	prRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/pull_request"
+	reviewerCursorRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/reviewer_cursor"
+	reviewerHistoryRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/reviewer_history"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/sqlite"
+	subscriptionRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/subscription"
+	teamRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/team"
+	userRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/user"
+	webhookRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/webhook"
+)
+
+// Storage репозитории и менеджер транзакций выбранного хранилища
+type Storage struct {
+	Driver    string  // storage.driver
+	DB        *sql.DB // nil для хранилища в памяти
+	TxManager transaction.Manager
+
+	UserRepository            repository.UserRepository
+	TeamRepository            repository.TeamRepository
+	PullRequestRepository     repository.PullRequestRepository
+	ReviewerCursorRepository  repository.ReviewerCursorRepository
+	WebhookRepository         repository.WebhookRepository
+	SubscriptionRepository    repository.SubscriptionRepository
+	EventDeliveryRepository   repository.EventDeliveryRepository
+	OutboxRepository          repository.OutboxRepository
+	APITokenRepository        repository.APITokenRepository
+	AuditRepository           repository.AuditRepository
+	ReviewerHistoryRepository repository.ReviewerHistoryRepository
+	IdempotencyRepository     repository.IdempotencyRepository
+}
+
+// NewStorage создает хранилище по storage.driver
+func NewStorage(cfg *config.Config, log logger.Logger) (*Storage, error) {
+	switch cfg.Storage.Driver {
+	case config.StorageDriverMemory:
+		log.Warn("Using in-memory storage, data will be lost on restart")
+		return NewMemoryStorage(memory.NewStore()), nil
+	case config.StorageDriverPostgres:
+		log.Info("Connecting to database",
+			"host", cfg.Database.Host,
+			"port", cfg.Database.Port,
+			"database", cfg.Database.DBName,
+		)
+
+		db, err := database.NewPostgresDB(cfg.Database)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+
+		log.Info("Successfully connected to database")
+		return NewPostgresStorage(db), nil
+	case config.StorageDriverSQLite:
+		log.Info("Opening SQLite database", "path", cfg.SQLite.Path)
+
+		db, err := sqlite.NewSQLiteDB(cfg.SQLite)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+		}
+
+		return NewSQLiteStorage(db), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.Storage.Driver)
+	}
+}
+
+// NewPostgresStorage создает репозитории поверх подключения к PostgreSQL
+func NewPostgresStorage(db *database.PostgresDB) *Storage {
+	return &Storage{
+		Driver:                    config.StorageDriverPostgres,
+		DB:                        db.DB(),
+		TxManager:                 database.NewTransactionManager(db),
+		UserRepository:            userRepo.NewRepository(db.DB(), db.Getter()),
+		TeamRepository:            teamRepo.NewRepository(db.DB(), db.Getter()),
+		PullRequestRepository:     prRepo.NewRepository(db.DB(), db.Getter()),
+		ReviewerCursorRepository:  reviewerCursorRepo.NewRepository(db.DB(), db.Getter()),
+		WebhookRepository:         webhookRepo.NewRepository(db.DB(), db.Getter()),
+		SubscriptionRepository:    subscriptionRepo.NewRepository(db.DB(), db.Getter()),
+		EventDeliveryRepository:   eventDeliveryRepo.NewRepository(db.DB(), db.Getter()),
+		OutboxRepository:          outboxRepo.NewRepository(db.DB(), db.Getter()),
+		APITokenRepository:        apiTokenRepo.NewRepository(db.DB(), db.Getter()),
+		AuditRepository:           auditRepo.NewRepository(db.DB(), db.Getter()),
+		ReviewerHistoryRepository: reviewerHistoryRepo.NewRepository(db.DB(), db.Getter()),
+		IdempotencyRepository:     idempotencyRepo.NewRepository(db.DB(), db.Getter()),
+	}
+}
+
+// NewSQLiteStorage создает репозитории поверх базы SQLite
+func NewSQLiteStorage(db *sqlite.SQLiteDB) *Storage {
+	return &Storage{
+		Driver:                    config.StorageDriverSQLite,
+		DB:                        db.DB(),
+		TxManager:                 sqlite.NewTransactionManager(db),
+		UserRepository:            sqlite.NewUserRepository(db),
+		TeamRepository:            sqlite.NewTeamRepository(db),
+		PullRequestRepository:     sqlite.NewPullRequestRepository(db),
+		ReviewerCursorRepository:  sqlite.NewReviewerCursorRepository(db),
+		WebhookRepository:         sqlite.NewWebhookRepository(db),
+		SubscriptionRepository:    sqlite.NewSubscriptionRepository(db),
+		EventDeliveryRepository:   sqlite.NewEventDeliveryRepository(db),
+		OutboxRepository:          sqlite.NewOutboxRepository(db),
+		APITokenRepository:        sqlite.NewAPITokenRepository(db),
+		AuditRepository:           sqlite.NewAuditRepository(db),
+		ReviewerHistoryRepository: sqlite.NewReviewerHistoryRepository(db),
+		IdempotencyRepository:     sqlite.NewIdempotencyRepository(db),
+	}
+}
+
+// NewMemoryStorage создает репозитории поверх хранилища в памяти
+func NewMemoryStorage(store *memory.Store) *Storage {
+	return &Storage{
+		Driver:                    config.StorageDriverMemory,
+		TxManager:                 memory.NewTransactionManager(store),
+		UserRepository:            memory.NewUserRepository(store),
+		TeamRepository:            memory.NewTeamRepository(store),
+		PullRequestRepository:     memory.NewPullRequestRepository(store),
+		ReviewerCursorRepository:  memory.NewReviewerCursorRepository(store),
+		WebhookRepository:         memory.NewWebhookRepository(store),
+		SubscriptionRepository:    memory.NewSubscriptionRepository(store),
+		EventDeliveryRepository:   memory.NewEventDeliveryRepository(store),
+		OutboxRepository:          memory.NewOutboxRepository(store),
+		APITokenRepository:        memory.NewAPITokenRepository(store),
+		AuditRepository:           memory.NewAuditRepository(store),
+		ReviewerHistoryRepository: memory.NewReviewerHistoryRepository(store),
+		IdempotencyRepository:     memory.NewIdempotencyRepository(store),
+	}
+}
+
+// Close закрывает подключение к базе данных, если оно есть
+func (s *Storage) Close() error {
+	if s.DB == nil {
+		return nil
+	}
+	return s.DB.Close()
+}
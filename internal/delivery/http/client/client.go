@@ -0,0 +1,238 @@
+// Package client HTTP клиент API сервиса. Запросы и ответы описаны типами dto,
+// ошибки API возвращаются как *APIError с кодом из presenter
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// maxErrorBodySize сколько байт ответа с ошибкой читается. Ошибки валидации со списком details бывают длинными
+const maxErrorBodySize = 64 << 10
+
+// APIError ошибка, которую вернул сервис
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    []string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.Code, e.Message)
+	if len(e.Details) > 0 {
+		msg += " (" + strings.Join(e.Details, "; ") + ")"
+	}
+	return msg
+}
+
+// IsAPIError сообщает, вернул ли сервис ошибку с кодом code
+func IsAPIError(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// UserActivity ответ POST /users/setIsActive
+type UserActivity struct {
+	User          dto.UserDTO                   `json:"user"`
+	Reassignments []dto.ReviewerReassignmentDTO `json:"reassignments"`
+}
+
+// UserReviews ответ GET /users/getReview
+type UserReviews struct {
+	UserID       string                    `json:"user_id"`
+	PullRequests []dto.PullRequestShortDTO `json:"pull_requests"`
+}
+
+// Reassignment ответ POST /pullRequest/reassign
+type Reassignment struct {
+	PullRequest dto.PullRequestDTO `json:"pr"`
+	ReplacedBy  string             `json:"replaced_by"`
+}
+
+// Client клиент API сервиса
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// New создает новый Client. Пустой token отправляет запросы без аутентификации
+func New(baseURL, token string, httpClient *http.Client) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// CreateTeam создает команду с участниками
+func (c *Client) CreateTeam(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamDTO, error) {
+	var resp struct {
+		Team dto.TeamDTO `json:"team"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/team/add", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Team, nil
+}
+
+// GetTeam возвращает команду с участниками
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*dto.TeamDTO, error) {
+	var resp struct {
+		Team dto.TeamDTO `json:"team"`
+	}
+	query := url.Values{"team_name": {teamName}}
+	if err := c.do(ctx, http.MethodGet, "/team/get", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Team, nil
+}
+
+// SetUserActive меняет активность пользователя
+func (c *Client) SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*UserActivity, error) {
+	var resp UserActivity
+	if err := c.do(ctx, http.MethodPost, "/users/setIsActive", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetUserReviews возвращает PR, где пользователь назначен ревьювером.
+// awaitingOnly оставляет только PR, ожидающие его ревью
+func (c *Client) GetUserReviews(ctx context.Context, userID string, awaitingOnly bool) (*UserReviews, error) {
+	query := url.Values{"user_id": {userID}}
+	if awaitingOnly {
+		query.Set("awaiting", strconv.FormatBool(awaitingOnly))
+	}
+
+	var resp UserReviews
+	if err := c.do(ctx, http.MethodGet, "/users/getReview", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ReassignReviewer заменяет ревьювера PR
+func (c *Client) ReassignReviewer(ctx context.Context, req dto.ReassignReviewerRequest) (*Reassignment, error) {
+	var resp Reassignment
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/reassign", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MergePR мержит PR
+func (c *Client) MergePR(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
+	var resp struct {
+		PullRequest dto.PullRequestDTO `json:"pr"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/pullRequest/merge", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PullRequest, nil
+}
+
+// GetStatistics возвращает статистику. Если teamName задан, добавляется статистика участников команды
+func (c *Client) GetStatistics(ctx context.Context, teamName string) (*dto.StatisticsDTO, error) {
+	var query url.Values
+	if teamName != "" {
+		query = url.Values{"team_name": {teamName}}
+	}
+
+	var resp dto.StatisticsDTO
+	if err := c.do(ctx, http.MethodGet, "/statistics", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// do отправляет запрос и декодирует успешный ответ в out
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	target := c.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	// Вызывающий код с трассировкой продолжает свою трассировку в сервисе
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return decodeError(resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// decodeError разбирает ошибку в формате API. Ответ в другом формате (например, от прокси) попадает в Message как есть
+func decodeError(resp *http.Response) error {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
+		return fmt.Errorf("failed to read error response: %w", err)
+	}
+
+	var errResp presenter.ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Code != "" {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Code:       errResp.Error.Code,
+			Message:    errResp.Error.Message,
+			Details:    errResp.Error.Details,
+		}
+	}
+
+	message := string(bytes.TrimSpace(body))
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Code:       strconv.Itoa(resp.StatusCode),
+		Message:    message,
+	}
+}
@@ -0,0 +1,189 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/client"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// newTestClient поднимает сервер с handler и возвращает клиент к нему.
+// Ответы в handler собираются функциями presenter, как в настоящих обработчиках
+func newTestClient(t *testing.T, token string, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return client.New(server.URL+"/", token, &http.Client{Timeout: 5 * time.Second})
+}
+
+func TestClient_CreateTeam(t *testing.T) {
+	c := newTestClient(t, "secret", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/team/add" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("expected bearer token, got %q", got)
+		}
+
+		var req dto.CreateTeamRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.TeamName != "backend" || len(req.Members) != 1 {
+			t.Errorf("unexpected request body %+v", req)
+		}
+
+		presenter.RespondTeam(w, http.StatusCreated, &dto.TeamDTO{
+			TeamName: req.TeamName,
+			Members:  []dto.TeamMemberDTO{{UserID: "u1", Username: "Alice", IsActive: true}},
+		})
+	})
+
+	team, err := c.CreateTeam(context.Background(), dto.CreateTeamRequest{
+		TeamName: "backend",
+		Members:  []dto.TeamMemberRequest{{UserID: "u1", Username: "Alice", IsActive: true}},
+	})
+	if err != nil {
+		t.Fatalf("CreateTeam failed: %v", err)
+	}
+	if team.TeamName != "backend" || len(team.Members) != 1 {
+		t.Errorf("unexpected team %+v", team)
+	}
+}
+
+func TestClient_APIError(t *testing.T) {
+	c := newTestClient(t, "", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("expected no authorization header, got %q", got)
+		}
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeTeamExists, "backend already exists")
+	})
+
+	_, err := c.CreateTeam(context.Background(), dto.CreateTeamRequest{TeamName: "backend"})
+	if !client.IsAPIError(err, presenter.ErrorCodeTeamExists) {
+		t.Fatalf("expected TEAM_EXISTS error, got %v", err)
+	}
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "backend already exists" {
+		t.Errorf("unexpected error %#v", err)
+	}
+}
+
+func TestClient_NonAPIError(t *testing.T) {
+	c := newTestClient(t, "", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	})
+
+	_, err := c.GetStatistics(context.Background(), "")
+
+	if !client.IsAPIError(err, "502") {
+		t.Fatalf("expected error with status code, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "upstream unavailable") {
+		t.Errorf("expected response body in error, got %q", err.Error())
+	}
+}
+
+func TestClient_QueryParameters(t *testing.T) {
+	tests := []struct {
+		name      string
+		call      func(c *client.Client) error
+		wantPath  string
+		wantQuery string
+	}{
+		{
+			name: "user reviews",
+			call: func(c *client.Client) error {
+				_, err := c.GetUserReviews(context.Background(), "u 1", false)
+				return err
+			},
+			wantPath:  "/users/getReview",
+			wantQuery: "user_id=u+1",
+		},
+		{
+			name: "awaiting user reviews",
+			call: func(c *client.Client) error {
+				_, err := c.GetUserReviews(context.Background(), "u1", true)
+				return err
+			},
+			wantPath:  "/users/getReview",
+			wantQuery: "awaiting=true&user_id=u1",
+		},
+		{
+			name: "team statistics",
+			call: func(c *client.Client) error {
+				_, err := c.GetStatistics(context.Background(), "backend")
+				return err
+			},
+			wantPath:  "/statistics",
+			wantQuery: "team_name=backend",
+		},
+		{
+			name: "all statistics",
+			call: func(c *client.Client) error {
+				_, err := c.GetStatistics(context.Background(), "")
+				return err
+			},
+			wantPath:  "/statistics",
+			wantQuery: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, "", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("expected GET, got %s", r.Method)
+				}
+				if r.URL.Path != tt.wantPath || r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("expected %s?%s, got %s?%s", tt.wantPath, tt.wantQuery, r.URL.Path, r.URL.RawQuery)
+				}
+				presenter.RespondJSON(w, http.StatusOK, map[string]interface{}{})
+			})
+
+			if err := tt.call(c); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_SetUserActive(t *testing.T) {
+	newUserID := "u3"
+	c := newTestClient(t, "", func(w http.ResponseWriter, r *http.Request) {
+		var req dto.SetUserActiveRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.IsActive || !req.SkipReassign {
+			t.Errorf("unexpected request body %+v", req)
+		}
+
+		presenter.RespondUserActivity(w, http.StatusOK,
+			&dto.UserDTO{UserID: req.UserID, Username: "Bob", TeamName: "backend"},
+			[]dto.ReviewerReassignmentDTO{{PullRequestID: "pr-1", OldUserID: req.UserID, NewUserID: &newUserID}},
+		)
+	})
+
+	activity, err := c.SetUserActive(context.Background(), dto.SetUserActiveRequest{UserID: "u2", SkipReassign: true})
+	if err != nil {
+		t.Fatalf("SetUserActive failed: %v", err)
+	}
+	if activity.User.UserID != "u2" || activity.User.IsActive {
+		t.Errorf("unexpected user %+v", activity.User)
+	}
+	if len(activity.Reassignments) != 1 || *activity.Reassignments[0].NewUserID != newUserID {
+		t.Errorf("unexpected reassignments %+v", activity.Reassignments)
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/validator"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// AuditHandler обработчик журнала аудита
+type AuditHandler struct {
+	auditUseCase AuditUseCase
+}
+
+// AuditUseCase интерфейс use case для журнала аудита (локальный для handler)
+type AuditUseCase interface {
+	ListAuditEntries(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error)
+}
+
+// NewAuditHandler создает новый AuditHandler
+func NewAuditHandler(auditUseCase AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		auditUseCase: auditUseCase,
+	}
+}
+
+// ListAuditEntries обрабатывает GET /audit?entity_type=&entity_id=&actor=&from=&to=&cursor=&limit=
+func (h *AuditHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := dto.ListAuditRequest{
+		EntityType: query.Get("entity_type"),
+		EntityID:   query.Get("entity_id"),
+		Actor:      query.Get("actor"),
+		From:       query.Get("from"),
+		To:         query.Get("to"),
+		Cursor:     query.Get("cursor"),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil {
+			presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "limit must be an integer")
+			return
+		}
+		req.Limit = parsed
+	}
+
+	if validationErrors := validator.ValidateListAuditRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	page, err := h.auditUseCase.ListAuditEntries(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondAuditPage(w, http.StatusOK, page)
+}
+
+// RegisterRoutes регистрирует маршруты журнала аудита
+func (h *AuditHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/audit", h.ListAuditEntries)
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+type mockAuditUseCase struct {
+	listAuditEntries func(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error)
+}
+
+func (m *mockAuditUseCase) ListAuditEntries(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error) {
+	if m.listAuditEntries != nil {
+		return m.listAuditEntries(ctx, req)
+	}
+	return nil, nil
+}
+
+func TestAuditHandler_ListAuditEntries(t *testing.T) {
+	nextCursor := "Nw"
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(t *testing.T) *mockAuditUseCase
+		wantStatus     int
+		wantEntries    int
+		wantNextCursor *string
+	}{
+		{
+			name:  "success with filters",
+			query: "?entity_type=pull_request&entity_id=pr-1&actor=u1&from=2025-01-01T00:00:00Z&cursor=OA&limit=1",
+			setupMock: func(t *testing.T) *mockAuditUseCase {
+				return &mockAuditUseCase{
+					listAuditEntries: func(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error) {
+						want := dto.ListAuditRequest{
+							EntityType: "pull_request",
+							EntityID:   "pr-1",
+							Actor:      "u1",
+							From:       "2025-01-01T00:00:00Z",
+							Cursor:     "OA",
+							Limit:      1,
+						}
+						if req != want {
+							t.Errorf("expected request %+v, got %+v", want, req)
+						}
+						return &dto.AuditPageDTO{
+							Entries: []dto.AuditEntryDTO{{
+								EntryID:    "a1",
+								Action:     "pr.created",
+								EntityType: "pull_request",
+								EntityID:   "pr-1",
+								Actor:      "u1",
+								After:      json.RawMessage(`{"pull_request_id":"pr-1"}`),
+								OccurredAt: time.Now(),
+							}},
+							NextCursor: &nextCursor,
+						}, nil
+					},
+				}
+			},
+			wantStatus:     http.StatusOK,
+			wantEntries:    1,
+			wantNextCursor: &nextCursor,
+		},
+		{
+			name:  "empty page",
+			query: "",
+			setupMock: func(t *testing.T) *mockAuditUseCase {
+				return &mockAuditUseCase{
+					listAuditEntries: func(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error) {
+						return &dto.AuditPageDTO{}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:  "limit is not a number",
+			query: "?limit=ten",
+			setupMock: func(t *testing.T) *mockAuditUseCase {
+				return &mockAuditUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "unknown entity type",
+			query: "?entity_type=repository",
+			setupMock: func(t *testing.T) *mockAuditUseCase {
+				return &mockAuditUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "malformed cursor",
+			query: "?cursor=zzz",
+			setupMock: func(t *testing.T) *mockAuditUseCase {
+				return &mockAuditUseCase{
+					listAuditEntries: func(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error) {
+						return nil, fmt.Errorf("%w: malformed cursor", usecase.ErrInvalidAuditQuery)
+					},
+				}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewAuditHandler(tt.setupMock(t))
+
+			req := httptest.NewRequest(http.MethodGet, "/audit"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ListAuditEntries(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp struct {
+				Entries    []dto.AuditEntryDTO `json:"entries"`
+				NextCursor *string             `json:"next_cursor"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Entries == nil || len(resp.Entries) != tt.wantEntries {
+				t.Errorf("expected %d entries, got %v", tt.wantEntries, resp.Entries)
+			}
+			if (resp.NextCursor == nil) != (tt.wantNextCursor == nil) ||
+				(resp.NextCursor != nil && *resp.NextCursor != *tt.wantNextCursor) {
+				t.Errorf("expected next_cursor %v, got %v", tt.wantNextCursor, resp.NextCursor)
+			}
+		})
+	}
+}
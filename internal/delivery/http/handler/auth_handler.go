@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/validator"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// AuthHandler обработчик статических API токенов и информации о субъекте запроса
+type AuthHandler struct {
+	authUseCase AuthUseCase
+}
+
+// AuthUseCase интерфейс use case для API токенов (локальный для handler)
+type AuthUseCase interface {
+	CreateAPIToken(ctx context.Context, req dto.CreateAPITokenRequest) (*dto.APITokenDTO, error)
+	ListAPITokens(ctx context.Context) ([]dto.APITokenDTO, error)
+	RevokeAPIToken(ctx context.Context, tokenID string) (*dto.APITokenDTO, error)
+}
+
+// NewAuthHandler создает новый AuthHandler
+func NewAuthHandler(authUseCase AuthUseCase) *AuthHandler {
+	return &AuthHandler{
+		authUseCase: authUseCase,
+	}
+}
+
+// CreateAPIToken обрабатывает POST /auth/tokens/create
+func (h *AuthHandler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateAPITokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateCreateAPITokenRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	token, err := h.authUseCase.CreateAPIToken(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondAPIToken(w, http.StatusCreated, token)
+}
+
+// ListAPITokens обрабатывает GET /auth/tokens/list
+func (h *AuthHandler) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := h.authUseCase.ListAPITokens(r.Context())
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondAPITokens(w, http.StatusOK, tokens)
+}
+
+// RevokeAPIToken обрабатывает POST /auth/tokens/revoke
+func (h *AuthHandler) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req dto.RevokeAPITokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateRevokeAPITokenRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	token, err := h.authUseCase.RevokeAPIToken(r.Context(), req.TokenID)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondAPIToken(w, http.StatusOK, token)
+}
+
+// WhoAmI обрабатывает GET /auth/whoami
+func (h *AuthHandler) WhoAmI(w http.ResponseWriter, r *http.Request) {
+	principal, ok := logger.GetPrincipal(r.Context())
+	if !ok {
+		presenter.RespondError(w, http.StatusUnauthorized, presenter.ErrorCodeUnauthorized, "authentication required")
+		return
+	}
+
+	presenter.RespondPrincipal(w, http.StatusOK, dto.ToPrincipalDTO(principal))
+}
+
+// RegisterRoutes регистрирует маршруты для API токенов
+func (h *AuthHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/auth/tokens/create", h.CreateAPIToken)
+	r.Get("/auth/tokens/list", h.ListAPITokens)
+	r.Post("/auth/tokens/revoke", h.RevokeAPIToken)
+	r.Get("/auth/whoami", h.WhoAmI)
+}
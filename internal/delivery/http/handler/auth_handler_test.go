@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+type mockAuthUseCase struct {
+	createAPIToken func(ctx context.Context, req dto.CreateAPITokenRequest) (*dto.APITokenDTO, error)
+	listAPITokens  func(ctx context.Context) ([]dto.APITokenDTO, error)
+	revokeAPIToken func(ctx context.Context, tokenID string) (*dto.APITokenDTO, error)
+}
+
+func (m *mockAuthUseCase) CreateAPIToken(ctx context.Context, req dto.CreateAPITokenRequest) (*dto.APITokenDTO, error) {
+	if m.createAPIToken != nil {
+		return m.createAPIToken(ctx, req)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthUseCase) ListAPITokens(ctx context.Context) ([]dto.APITokenDTO, error) {
+	if m.listAPITokens != nil {
+		return m.listAPITokens(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockAuthUseCase) RevokeAPIToken(ctx context.Context, tokenID string) (*dto.APITokenDTO, error) {
+	if m.revokeAPIToken != nil {
+		return m.revokeAPIToken(ctx, tokenID)
+	}
+	return nil, nil
+}
+
+func testPrincipal(id string, role entity.Role) *entity.Principal {
+	principal, err := entity.NewPrincipal(id, role)
+	if err != nil {
+		panic(err)
+	}
+	return principal
+}
+
+func TestAuthHandler_CreateAPIToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       interface{}
+		setupMock  func() *mockAuthUseCase
+		wantStatus int
+		wantToken  string
+	}{
+		{
+			name: "success",
+			body: dto.CreateAPITokenRequest{Subject: "ci-bot", Role: "service"},
+			setupMock: func() *mockAuthUseCase {
+				return &mockAuthUseCase{
+					createAPIToken: func(ctx context.Context, req dto.CreateAPITokenRequest) (*dto.APITokenDTO, error) {
+						return &dto.APITokenDTO{
+							TokenID:   "t1",
+							Subject:   req.Subject,
+							Role:      req.Role,
+							Token:     "prt_plain",
+							CreatedAt: time.Now(),
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+			wantToken:  "prt_plain",
+		},
+		{
+			name: "invalid role",
+			body: dto.CreateAPITokenRequest{Subject: "ci-bot", Role: "root"},
+			setupMock: func() *mockAuthUseCase {
+				return &mockAuthUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid body",
+			body: "not-json",
+			setupMock: func() *mockAuthUseCase {
+				return &mockAuthUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewAuthHandler(tt.setupMock())
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/auth/tokens/create", bytes.NewReader(bodyBytes))
+			w := httptest.NewRecorder()
+
+			handler.CreateAPIToken(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantToken != "" {
+				var resp struct {
+					Token dto.APITokenDTO `json:"token"`
+				}
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.Token.Token != tt.wantToken {
+					t.Errorf("expected plain token %q, got %q", tt.wantToken, resp.Token.Token)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_RevokeAPIToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       dto.RevokeAPITokenRequest
+		setupMock  func() *mockAuthUseCase
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: dto.RevokeAPITokenRequest{TokenID: "t1"},
+			setupMock: func() *mockAuthUseCase {
+				return &mockAuthUseCase{
+					revokeAPIToken: func(ctx context.Context, tokenID string) (*dto.APITokenDTO, error) {
+						revokedAt := time.Now()
+						return &dto.APITokenDTO{TokenID: tokenID, RevokedAt: &revokedAt}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "missing token_id",
+			body: dto.RevokeAPITokenRequest{},
+			setupMock: func() *mockAuthUseCase {
+				return &mockAuthUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not found",
+			body: dto.RevokeAPITokenRequest{TokenID: "missing"},
+			setupMock: func() *mockAuthUseCase {
+				return &mockAuthUseCase{
+					revokeAPIToken: func(ctx context.Context, tokenID string) (*dto.APITokenDTO, error) {
+						return nil, usecase.ErrAPITokenNotFound
+					},
+				}
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewAuthHandler(tt.setupMock())
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/auth/tokens/revoke", bytes.NewReader(bodyBytes))
+			w := httptest.NewRecorder()
+
+			handler.RevokeAPIToken(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_WhoAmI(t *testing.T) {
+	handler := NewAuthHandler(&mockAuthUseCase{})
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/whoami", nil)
+	w := httptest.NewRecorder()
+	handler.WhoAmI(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 without principal, got %d", w.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/auth/whoami", nil)
+	req = req.WithContext(logger.WithPrincipal(req.Context(), testPrincipal("u1", entity.RoleMember)))
+	w = httptest.NewRecorder()
+	handler.WhoAmI(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	var resp struct {
+		Principal dto.PrincipalDTO `json:"principal"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Principal.ID != "u1" || resp.Principal.Role != "member" {
+		t.Errorf("expected u1/member, got %+v", resp.Principal)
+	}
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// HealthHandler обработчик проб живости и готовности
+type HealthHandler struct {
+	healthUseCase HealthUseCase
+}
+
+// HealthUseCase интерфейс use case для проб (локальный для handler)
+type HealthUseCase interface {
+	Liveness() *dto.HealthDTO
+	Readiness(ctx context.Context) *dto.HealthDTO
+}
+
+// NewHealthHandler создает новый HealthHandler
+func NewHealthHandler(healthUseCase HealthUseCase) *HealthHandler {
+	return &HealthHandler{
+		healthUseCase: healthUseCase,
+	}
+}
+
+// Liveness обрабатывает GET /livez
+func (h *HealthHandler) Liveness(w http.ResponseWriter, _ *http.Request) {
+	presenter.RespondHealth(w, h.healthUseCase.Liveness())
+}
+
+// Readiness обрабатывает GET /readyz. Отвечает 503, если зависимость недоступна
+// или сервис останавливается
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	presenter.RespondHealth(w, h.healthUseCase.Readiness(r.Context()))
+}
+
+// RegisterRoutes регистрирует маршруты проб
+func (h *HealthHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/livez", h.Liveness)
+	r.Get("/readyz", h.Readiness)
+}
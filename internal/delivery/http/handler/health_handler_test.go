@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+type mockHealthUseCase struct {
+	readiness *dto.HealthDTO
+}
+
+func (m *mockHealthUseCase) Liveness() *dto.HealthDTO {
+	return &dto.HealthDTO{Status: dto.HealthStatusOK}
+}
+
+func (m *mockHealthUseCase) Readiness(context.Context) *dto.HealthDTO {
+	return m.readiness
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		readiness  *dto.HealthDTO
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "liveness",
+			path:       "/livez",
+			wantStatus: http.StatusOK,
+			wantBody:   dto.HealthStatusOK,
+		},
+		{
+			name: "ready",
+			path: "/readyz",
+			readiness: &dto.HealthDTO{
+				Status: dto.HealthStatusOK,
+				Checks: map[string]dto.HealthCheckDTO{"database": {Status: dto.HealthStatusOK}},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   dto.HealthStatusOK,
+		},
+		{
+			name: "not ready",
+			path: "/readyz",
+			readiness: &dto.HealthDTO{
+				Status: dto.HealthStatusFail,
+				Checks: map[string]dto.HealthCheckDTO{"database": {Status: dto.HealthStatusFail, Error: "connection refused"}},
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   dto.HealthStatusFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := chi.NewRouter()
+			NewHealthHandler(&mockHealthUseCase{readiness: tt.readiness}).RegisterRoutes(r)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+
+			var body dto.HealthDTO
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.Status != tt.wantBody {
+				t.Errorf("expected body status %q, got %q", tt.wantBody, body.Status)
+			}
+		})
+	}
+}
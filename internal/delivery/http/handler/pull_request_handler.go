@@ -3,12 +3,17 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/validator"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
@@ -20,8 +25,15 @@ type PullRequestHandler struct {
 // PullRequestUseCase интерфейс use case для Pull Requests (локальный для handler)
 type PullRequestUseCase interface {
 	CreatePR(ctx context.Context, req dto.CreatePRRequest) (*dto.PullRequestDTO, error)
-	MergePR(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	MergePR(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error)
+	MarkReady(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	ClosePR(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	ReopenPR(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
 	ReassignReviewer(ctx context.Context, req dto.ReassignReviewerRequest) (*dto.PullRequestDTO, string, error)
+	AddReviewer(ctx context.Context, req dto.AddReviewerRequest) (*dto.PullRequestDTO, error)
+	SubmitReview(ctx context.Context, req dto.SubmitReviewRequest) (*dto.PullRequestDTO, error)
+	RemoveReviewer(ctx context.Context, req dto.RemoveReviewerRequest) (*dto.PullRequestDTO, error)
+	GetReviewerHistory(ctx context.Context, prID string) ([]dto.ReviewerHistoryEntryDTO, error)
 }
 
 // NewPullRequestHandler создает новый PullRequestHandler
@@ -67,7 +79,91 @@ func (h *PullRequestHandler) MergePR(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pr, err := h.prUseCase.MergePR(r.Context(), req.PullRequestID)
+	// Мерж в обход политики доступен только администратору. Без аутентификации субъекта в контексте нет
+	if principal, ok := logger.GetPrincipal(r.Context()); req.Force && ok && !principal.HasRole(entity.RoleAdmin) {
+		presenter.RespondError(w, http.StatusForbidden, presenter.ErrorCodeForbidden, "forced merge requires admin role")
+		return
+	}
+
+	req.ManagerID = managerID(r)
+
+	pr, err := h.prUseCase.MergePR(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		var blocked *usecase.MergeBlockedError
+		if errors.As(err, &blocked) {
+			presenter.RespondErrorWithDetails(w, statusCode, code, message, blocked.Conditions)
+			return
+		}
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondPullRequest(w, http.StatusOK, pr)
+}
+
+// SubmitReview обрабатывает POST /pullRequest/review
+func (h *PullRequestHandler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	var req dto.SubmitReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateSubmitReviewRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	// Ревью оставляет только сам ревьювер, от чужого имени - только администратор
+	if principal, ok := logger.GetPrincipal(r.Context()); ok && !principal.HasRole(entity.RoleAdmin) && principal.ID() != req.UserID {
+		presenter.RespondError(w, http.StatusForbidden, presenter.ErrorCodeForbidden, "review can be submitted only by the reviewer")
+		return
+	}
+
+	pr, err := h.prUseCase.SubmitReview(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondPullRequest(w, http.StatusOK, pr)
+}
+
+// MarkReady обрабатывает POST /pullRequest/ready
+func (h *PullRequestHandler) MarkReady(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.prUseCase.MarkReady)
+}
+
+// ClosePR обрабатывает POST /pullRequest/close
+func (h *PullRequestHandler) ClosePR(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.prUseCase.ClosePR)
+}
+
+// ReopenPR обрабатывает POST /pullRequest/reopen
+func (h *PullRequestHandler) ReopenPR(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.prUseCase.ReopenPR)
+}
+
+// changeStatus разбирает запрос смены статуса PR и применяет переход
+func (h *PullRequestHandler) changeStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	transition func(ctx context.Context, prID string) (*dto.PullRequestDTO, error),
+) {
+	var req dto.ChangePRStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateChangePRStatusRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	pr, err := transition(r.Context(), req.PullRequestID)
 	if err != nil {
 		statusCode, code, message := presenter.MapUseCaseError(err)
 		presenter.RespondError(w, statusCode, code, message)
@@ -89,6 +185,7 @@ func (h *PullRequestHandler) ReassignReviewer(w http.ResponseWriter, r *http.Req
 		validator.RespondValidationErrors(w, validationErrors)
 		return
 	}
+	req.ActorID = actorID(r)
 
 	pr, replacedBy, err := h.prUseCase.ReassignReviewer(r.Context(), req)
 	if err != nil {
@@ -100,9 +197,91 @@ func (h *PullRequestHandler) ReassignReviewer(w http.ResponseWriter, r *http.Req
 	presenter.RespondPullRequestReassign(w, http.StatusOK, pr, replacedBy)
 }
 
+// AddReviewer обрабатывает POST /pullRequest/addReviewer
+func (h *PullRequestHandler) AddReviewer(w http.ResponseWriter, r *http.Request) {
+	var req dto.AddReviewerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateAddReviewerRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+	req.ActorID = actorID(r)
+
+	pr, err := h.prUseCase.AddReviewer(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondPullRequest(w, http.StatusOK, pr)
+}
+
+// RemoveReviewer обрабатывает POST /pullRequest/removeReviewer
+func (h *PullRequestHandler) RemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	var req dto.RemoveReviewerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateRemoveReviewerRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+	req.ActorID = actorID(r)
+
+	pr, err := h.prUseCase.RemoveReviewer(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondPullRequest(w, http.StatusOK, pr)
+}
+
+// GetReviewerHistory обрабатывает GET /pullRequest/history?pull_request_id=
+func (h *PullRequestHandler) GetReviewerHistory(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+	if strings.TrimSpace(prID) == "" {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "pull_request_id parameter is required")
+		return
+	}
+
+	history, err := h.prUseCase.GetReviewerHistory(r.Context(), prID)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondReviewerHistory(w, http.StatusOK, prID, history)
+}
+
+// actorID возвращает ID аутентифицированного субъекта, кроме администратора: use case
+// проверяет по нему, что автор не меняет ревьюверов своего PR
+func actorID(r *http.Request) string {
+	if principal, ok := logger.GetPrincipal(r.Context()); ok && !principal.HasRole(entity.RoleAdmin) {
+		return principal.ID()
+	}
+	return ""
+}
+
 // RegisterRoutes регистрирует маршруты для Pull Requests
 func (h *PullRequestHandler) RegisterRoutes(r chi.Router) {
 	r.Post("/pullRequest/create", h.CreatePR)
 	r.Post("/pullRequest/merge", h.MergePR)
+	r.Post("/pullRequest/ready", h.MarkReady)
+	r.Post("/pullRequest/close", h.ClosePR)
+	r.Post("/pullRequest/reopen", h.ReopenPR)
 	r.Post("/pullRequest/reassign", h.ReassignReviewer)
+	r.Post("/pullRequest/addReviewer", h.AddReviewer)
+	r.Post("/pullRequest/removeReviewer", h.RemoveReviewer)
+	r.Post("/pullRequest/review", h.SubmitReview)
+	r.Get("/pullRequest/history", h.GetReviewerHistory)
 }
@@ -6,31 +6,69 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
 type mockPullRequestUseCase struct {
 	createPR         func(ctx context.Context, req dto.CreatePRRequest) (*dto.PullRequestDTO, error)
-	mergePR          func(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	mergePR          func(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error)
+	markReady        func(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	closePR          func(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	reopenPR         func(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
 	reassignReviewer func(ctx context.Context, req dto.ReassignReviewerRequest) (*dto.PullRequestDTO, string, error)
+	addReviewer      func(ctx context.Context, req dto.AddReviewerRequest) (*dto.PullRequestDTO, error)
+	submitReview     func(ctx context.Context, req dto.SubmitReviewRequest) (*dto.PullRequestDTO, error)
+	removeReviewer   func(ctx context.Context, req dto.RemoveReviewerRequest) (*dto.PullRequestDTO, error)
+	reviewerHistory  func(ctx context.Context, prID string) ([]dto.ReviewerHistoryEntryDTO, error)
 }
 
 func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, req dto.CreatePRRequest) (*dto.PullRequestDTO, error) {
 	return m.createPR(ctx, req)
 }
 
-func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
-	return m.mergePR(ctx, prID)
+func (m *mockPullRequestUseCase) MergePR(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
+	return m.mergePR(ctx, req)
+}
+
+func (m *mockPullRequestUseCase) MarkReady(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+	return m.markReady(ctx, prID)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+	return m.closePR(ctx, prID)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+	return m.reopenPR(ctx, prID)
 }
 
 func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, req dto.ReassignReviewerRequest) (*dto.PullRequestDTO, string, error) {
 	return m.reassignReviewer(ctx, req)
 }
 
+func (m *mockPullRequestUseCase) AddReviewer(ctx context.Context, req dto.AddReviewerRequest) (*dto.PullRequestDTO, error) {
+	return m.addReviewer(ctx, req)
+}
+
+func (m *mockPullRequestUseCase) SubmitReview(ctx context.Context, req dto.SubmitReviewRequest) (*dto.PullRequestDTO, error) {
+	return m.submitReview(ctx, req)
+}
+
+func (m *mockPullRequestUseCase) RemoveReviewer(ctx context.Context, req dto.RemoveReviewerRequest) (*dto.PullRequestDTO, error) {
+	return m.removeReviewer(ctx, req)
+}
+
+func (m *mockPullRequestUseCase) GetReviewerHistory(ctx context.Context, prID string) ([]dto.ReviewerHistoryEntryDTO, error) {
+	return m.reviewerHistory(ctx, prID)
+}
+
 func TestPullRequestHandler_CreatePR(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -120,10 +158,12 @@ func TestPullRequestHandler_CreatePR(t *testing.T) {
 
 func TestPullRequestHandler_MergePR(t *testing.T) {
 	tests := []struct {
-		name       string
-		body       dto.MergePRRequest
-		setupMock  func() *mockPullRequestUseCase
-		wantStatus int
+		name        string
+		body        dto.MergePRRequest
+		principal   *entity.Principal
+		setupMock   func() *mockPullRequestUseCase
+		wantStatus  int
+		wantDetails []string
 	}{
 		{
 			name: "success",
@@ -132,9 +172,9 @@ func TestPullRequestHandler_MergePR(t *testing.T) {
 			},
 			setupMock: func() *mockPullRequestUseCase {
 				return &mockPullRequestUseCase{
-					mergePR: func(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+					mergePR: func(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
 						return &dto.PullRequestDTO{
-							PullRequestID: prID,
+							PullRequestID: req.PullRequestID,
 							Status:        string(entity.PRStatusMerged),
 						}, nil
 					},
@@ -157,13 +197,74 @@ func TestPullRequestHandler_MergePR(t *testing.T) {
 			},
 			setupMock: func() *mockPullRequestUseCase {
 				return &mockPullRequestUseCase{
-					mergePR: func(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+					mergePR: func(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
 						return nil, usecase.ErrPRNotFound
 					},
 				}
 			},
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name: "merge blocked lists unmet conditions",
+			body: dto.MergePRRequest{
+				PullRequestID: "pr-1",
+			},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					mergePR: func(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
+						return nil, &usecase.MergeBlockedError{Conditions: []string{"2 approvals required, got 0"}}
+					},
+				}
+			},
+			wantStatus:  http.StatusConflict,
+			wantDetails: []string{"2 approvals required, got 0"},
+		},
+		{
+			name: "forced merge by team lead is forbidden",
+			body: dto.MergePRRequest{
+				PullRequestID: "pr-1",
+				Force:         true,
+			},
+			principal: testPrincipal("lead", entity.RoleTeamLead),
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "team lead merges PR of another team",
+			body: dto.MergePRRequest{
+				PullRequestID: "pr-1",
+			},
+			principal: testPrincipal("lead", entity.RoleTeamLead),
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					mergePR: func(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
+						if req.ManagerID != "lead" {
+							t.Errorf("expected manager lead, got %q", req.ManagerID)
+						}
+						return nil, usecase.ErrForbidden
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "forced merge by admin",
+			body: dto.MergePRRequest{
+				PullRequestID: "pr-1",
+				Force:         true,
+			},
+			principal: testPrincipal("root", entity.RoleAdmin),
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					mergePR: func(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
+						return &dto.PullRequestDTO{PullRequestID: req.PullRequestID, Status: string(entity.PRStatusMerged), MergeForced: true}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,11 +275,92 @@ func TestPullRequestHandler_MergePR(t *testing.T) {
 			bodyBytes, _ := json.Marshal(tt.body)
 			req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", bytes.NewReader(bodyBytes))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.principal != nil {
+				req = req.WithContext(logger.WithPrincipal(req.Context(), tt.principal))
+			}
 
 			w := httptest.NewRecorder()
 
 			handler.MergePR(w, req)
 
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantDetails != nil {
+				var resp presenter.ErrorResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.Error.Code != presenter.ErrorCodeMergeBlocked || !reflect.DeepEqual(resp.Error.Details, tt.wantDetails) {
+					t.Errorf("unexpected error response: %+v", resp.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestPullRequestHandler_ChangeStatus(t *testing.T) {
+	closed := func(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+		return &dto.PullRequestDTO{PullRequestID: prID, Status: string(entity.PRStatusClosed)}, nil
+	}
+
+	tests := []struct {
+		name       string
+		body       dto.ChangePRStatusRequest
+		mockUC     *mockPullRequestUseCase
+		call       func(*PullRequestHandler) http.HandlerFunc
+		wantStatus int
+	}{
+		{
+			name:       "close success",
+			body:       dto.ChangePRStatusRequest{PullRequestID: "pr-1"},
+			mockUC:     &mockPullRequestUseCase{closePR: closed},
+			call:       func(h *PullRequestHandler) http.HandlerFunc { return h.ClosePR },
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing pull_request_id",
+			body:       dto.ChangePRStatusRequest{},
+			mockUC:     &mockPullRequestUseCase{},
+			call:       func(h *PullRequestHandler) http.HandlerFunc { return h.ReopenPR },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "ready on non-draft",
+			body: dto.ChangePRStatusRequest{PullRequestID: "pr-1"},
+			mockUC: &mockPullRequestUseCase{
+				markReady: func(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+					return nil, usecase.ErrInvalidStatusTransition
+				},
+			},
+			call:       func(h *PullRequestHandler) http.HandlerFunc { return h.MarkReady },
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "reopen merged",
+			body: dto.ChangePRStatusRequest{PullRequestID: "pr-1"},
+			mockUC: &mockPullRequestUseCase{
+				reopenPR: func(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
+					return nil, usecase.ErrPRAlreadyMerged
+				},
+			},
+			call:       func(h *PullRequestHandler) http.HandlerFunc { return h.ReopenPR },
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewPullRequestHandler(tt.mockUC)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/close", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			tt.call(handler)(w, req)
+
 			if w.Code != tt.wantStatus {
 				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
 			}
@@ -190,6 +372,7 @@ func TestPullRequestHandler_ReassignReviewer(t *testing.T) {
 	tests := []struct {
 		name       string
 		body       dto.ReassignReviewerRequest
+		principal  *entity.Principal
 		setupMock  func() *mockPullRequestUseCase
 		wantStatus int
 	}{
@@ -234,6 +417,44 @@ func TestPullRequestHandler_ReassignReviewer(t *testing.T) {
 			},
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name: "author reassigns reviewer of own PR",
+			body: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+			},
+			principal: testPrincipal("author-1", entity.RoleMember),
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					reassignReviewer: func(ctx context.Context, req dto.ReassignReviewerRequest) (*dto.PullRequestDTO, string, error) {
+						if req.ActorID != "author-1" {
+							t.Errorf("expected actor author-1, got %q", req.ActorID)
+						}
+						return nil, "", usecase.ErrForbidden
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "admin reassigns without author check",
+			body: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+			},
+			principal: testPrincipal("root", entity.RoleAdmin),
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					reassignReviewer: func(ctx context.Context, req dto.ReassignReviewerRequest) (*dto.PullRequestDTO, string, error) {
+						if req.ActorID != "" {
+							t.Errorf("expected empty actor for admin, got %q", req.ActorID)
+						}
+						return &dto.PullRequestDTO{PullRequestID: req.PullRequestID, Status: string(entity.PRStatusOpen)}, "reviewer-2", nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -244,6 +465,9 @@ func TestPullRequestHandler_ReassignReviewer(t *testing.T) {
 			bodyBytes, _ := json.Marshal(tt.body)
 			req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", bytes.NewReader(bodyBytes))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.principal != nil {
+				req = req.WithContext(logger.WithPrincipal(req.Context(), tt.principal))
+			}
 
 			w := httptest.NewRecorder()
 
@@ -255,3 +479,304 @@ func TestPullRequestHandler_ReassignReviewer(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestHandler_AddReviewer(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       dto.AddReviewerRequest
+		setupMock  func() *mockPullRequestUseCase
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-3"},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					addReviewer: func(ctx context.Context, req dto.AddReviewerRequest) (*dto.PullRequestDTO, error) {
+						return &dto.PullRequestDTO{
+							PullRequestID:     req.PullRequestID,
+							Status:            string(entity.PRStatusOpen),
+							AssignedReviewers: []string{req.UserID},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "validation error",
+			body: dto.AddReviewerRequest{PullRequestID: "pr-1"},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "too many reviewers",
+			body: dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-3"},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					addReviewer: func(ctx context.Context, req dto.AddReviewerRequest) (*dto.PullRequestDTO, error) {
+						return nil, usecase.ErrTooManyReviewers
+					},
+				}
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewPullRequestHandler(tt.setupMock())
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/addReviewer", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			handler.AddReviewer(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestPullRequestHandler_RemoveReviewer(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       dto.RemoveReviewerRequest
+		setupMock  func() *mockPullRequestUseCase
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					removeReviewer: func(ctx context.Context, req dto.RemoveReviewerRequest) (*dto.PullRequestDTO, error) {
+						return &dto.PullRequestDTO{
+							PullRequestID:     req.PullRequestID,
+							Status:            string(entity.PRStatusOpen),
+							AssignedReviewers: []string{},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "validation error",
+			body: dto.RemoveReviewerRequest{UserID: "reviewer-1"},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "merged PR",
+			body: dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"},
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					removeReviewer: func(ctx context.Context, req dto.RemoveReviewerRequest) (*dto.PullRequestDTO, error) {
+						return nil, usecase.ErrPRAlreadyMerged
+					},
+				}
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewPullRequestHandler(tt.setupMock())
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/removeReviewer", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+
+			handler.RemoveReviewer(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestPullRequestHandler_SubmitReview(t *testing.T) {
+	approve := &mockPullRequestUseCase{
+		submitReview: func(ctx context.Context, req dto.SubmitReviewRequest) (*dto.PullRequestDTO, error) {
+			return &dto.PullRequestDTO{PullRequestID: req.PullRequestID, ReviewDecision: "APPROVED"}, nil
+		},
+	}
+
+	tests := []struct {
+		name       string
+		body       dto.SubmitReviewRequest
+		principal  *entity.Principal
+		mockUC     *mockPullRequestUseCase
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			mockUC: &mockPullRequestUseCase{
+				submitReview: func(ctx context.Context, req dto.SubmitReviewRequest) (*dto.PullRequestDTO, error) {
+					return &dto.PullRequestDTO{PullRequestID: req.PullRequestID, ReviewDecision: "APPROVED"}, nil
+				},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid state",
+			body:       dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "PENDING"},
+			mockUC:     &mockPullRequestUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "reviewer not assigned",
+			body: dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "stranger", State: "COMMENTED"},
+			mockUC: &mockPullRequestUseCase{
+				submitReview: func(ctx context.Context, req dto.SubmitReviewRequest) (*dto.PullRequestDTO, error) {
+					return nil, usecase.ErrReviewerNotAssigned
+				},
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "reviewer submits own review",
+			body:       dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			principal:  testPrincipal("reviewer-1", entity.RoleMember),
+			mockUC:     approve,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "member submits review for another user",
+			body:       dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			principal:  testPrincipal("reviewer-2", entity.RoleMember),
+			mockUC:     &mockPullRequestUseCase{},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "team lead submits review for another user",
+			body:       dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			principal:  testPrincipal("lead", entity.RoleTeamLead),
+			mockUC:     &mockPullRequestUseCase{},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "admin submits review for reviewer",
+			body:       dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			principal:  testPrincipal("root", entity.RoleAdmin),
+			mockUC:     approve,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewPullRequestHandler(tt.mockUC)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/review", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.principal != nil {
+				req = req.WithContext(logger.WithPrincipal(req.Context(), tt.principal))
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.SubmitReview(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestPullRequestHandler_GetReviewerHistory(t *testing.T) {
+	tests := []struct {
+		name        string
+		prID        string
+		setupMock   func() *mockPullRequestUseCase
+		wantStatus  int
+		wantHistory int
+	}{
+		{
+			name: "success",
+			prID: "pr-1",
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					reviewerHistory: func(ctx context.Context, prID string) ([]dto.ReviewerHistoryEntryDTO, error) {
+						return []dto.ReviewerHistoryEntryDTO{
+							{UserID: "u2", Action: string(entity.ReviewerAssigned), Reason: string(entity.ReviewerReasonAuto)},
+							{UserID: "u2", Action: string(entity.ReviewerUnassigned), Reason: string(entity.ReviewerReasonReassign)},
+							{UserID: "u3", Action: string(entity.ReviewerAssigned), Reason: string(entity.ReviewerReasonReassign)},
+						}, nil
+					},
+				}
+			},
+			wantStatus:  http.StatusOK,
+			wantHistory: 3,
+		},
+		{
+			name: "missing pull_request_id parameter",
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "use case error - PR not found",
+			prID: "pr-unknown",
+			setupMock: func() *mockPullRequestUseCase {
+				return &mockPullRequestUseCase{
+					reviewerHistory: func(ctx context.Context, prID string) ([]dto.ReviewerHistoryEntryDTO, error) {
+						return nil, usecase.ErrPRNotFound
+					},
+				}
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewPullRequestHandler(tt.setupMock())
+
+			req := httptest.NewRequest(http.MethodGet, "/pullRequest/history", nil)
+			if tt.prID != "" {
+				q := req.URL.Query()
+				q.Add("pull_request_id", tt.prID)
+				req.URL.RawQuery = q.Encode()
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.GetReviewerHistory(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+
+			if tt.wantStatus == http.StatusOK {
+				var resp struct {
+					PullRequestID string                        `json:"pull_request_id"`
+					History       []dto.ReviewerHistoryEntryDTO `json:"history"`
+				}
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.PullRequestID != tt.prID || len(resp.History) != tt.wantHistory {
+					t.Errorf("expected %d history entries for %s, got %+v", tt.wantHistory, tt.prID, resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/validator"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// SubscriptionHandler обработчик подписок на события
+type SubscriptionHandler struct {
+	subscriptionUseCase SubscriptionUseCase
+}
+
+// SubscriptionUseCase интерфейс use case для подписок (локальный для handler)
+type SubscriptionUseCase interface {
+	CreateSubscription(ctx context.Context, req dto.CreateSubscriptionRequest) (*dto.SubscriptionDTO, error)
+	GetSubscription(ctx context.Context, subscriptionID string) (*dto.SubscriptionDTO, error)
+	ListSubscriptions(ctx context.Context) ([]dto.SubscriptionDTO, error)
+	UpdateSubscription(ctx context.Context, req dto.UpdateSubscriptionRequest) (*dto.SubscriptionDTO, error)
+	DeleteSubscription(ctx context.Context, subscriptionID string) error
+	GetDeadLetters(ctx context.Context, subscriptionID string) ([]dto.DeadLetterDTO, error)
+}
+
+// NewSubscriptionHandler создает новый SubscriptionHandler
+func NewSubscriptionHandler(subscriptionUseCase SubscriptionUseCase) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		subscriptionUseCase: subscriptionUseCase,
+	}
+}
+
+// CreateSubscription обрабатывает POST /subscriptions/create
+func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateCreateSubscriptionRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	subscription, err := h.subscriptionUseCase.CreateSubscription(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondSubscription(w, http.StatusCreated, subscription)
+}
+
+// GetSubscription обрабатывает GET /subscriptions/get?subscription_id=
+func (h *SubscriptionHandler) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := r.URL.Query().Get("subscription_id")
+	if strings.TrimSpace(subscriptionID) == "" {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "subscription_id parameter is required")
+		return
+	}
+
+	subscription, err := h.subscriptionUseCase.GetSubscription(r.Context(), subscriptionID)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondSubscription(w, http.StatusOK, subscription)
+}
+
+// ListSubscriptions обрабатывает GET /subscriptions/list
+func (h *SubscriptionHandler) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subscriptions, err := h.subscriptionUseCase.ListSubscriptions(r.Context())
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondSubscriptions(w, http.StatusOK, subscriptions)
+}
+
+// UpdateSubscription обрабатывает POST /subscriptions/update
+func (h *SubscriptionHandler) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateUpdateSubscriptionRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	subscription, err := h.subscriptionUseCase.UpdateSubscription(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondSubscription(w, http.StatusOK, subscription)
+}
+
+// DeleteSubscription обрабатывает POST /subscriptions/delete
+func (h *SubscriptionHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	var req dto.DeleteSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateDeleteSubscriptionRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	if err := h.subscriptionUseCase.DeleteSubscription(r.Context(), req.SubscriptionID); err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetDeadLetters обрабатывает GET /subscriptions/deadLetters?subscription_id=
+func (h *SubscriptionHandler) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := r.URL.Query().Get("subscription_id")
+	if strings.TrimSpace(subscriptionID) == "" {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "subscription_id parameter is required")
+		return
+	}
+
+	deadLetters, err := h.subscriptionUseCase.GetDeadLetters(r.Context(), subscriptionID)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondDeadLetters(w, http.StatusOK, subscriptionID, deadLetters)
+}
+
+// RegisterRoutes регистрирует маршруты для подписок
+func (h *SubscriptionHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/subscriptions/create", h.CreateSubscription)
+	r.Get("/subscriptions/list", h.ListSubscriptions)
+	r.Get("/subscriptions/get", h.GetSubscription)
+	r.Post("/subscriptions/update", h.UpdateSubscription)
+	r.Post("/subscriptions/delete", h.DeleteSubscription)
+	r.Get("/subscriptions/deadLetters", h.GetDeadLetters)
+}
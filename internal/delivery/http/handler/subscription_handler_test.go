@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+type mockSubscriptionUseCase struct {
+	createSubscription func(ctx context.Context, req dto.CreateSubscriptionRequest) (*dto.SubscriptionDTO, error)
+	getSubscription    func(ctx context.Context, subscriptionID string) (*dto.SubscriptionDTO, error)
+	listSubscriptions  func(ctx context.Context) ([]dto.SubscriptionDTO, error)
+	updateSubscription func(ctx context.Context, req dto.UpdateSubscriptionRequest) (*dto.SubscriptionDTO, error)
+	deleteSubscription func(ctx context.Context, subscriptionID string) error
+	getDeadLetters     func(ctx context.Context, subscriptionID string) ([]dto.DeadLetterDTO, error)
+}
+
+func (m *mockSubscriptionUseCase) CreateSubscription(ctx context.Context, req dto.CreateSubscriptionRequest) (*dto.SubscriptionDTO, error) {
+	return m.createSubscription(ctx, req)
+}
+
+func (m *mockSubscriptionUseCase) GetSubscription(ctx context.Context, subscriptionID string) (*dto.SubscriptionDTO, error) {
+	return m.getSubscription(ctx, subscriptionID)
+}
+
+func (m *mockSubscriptionUseCase) ListSubscriptions(ctx context.Context) ([]dto.SubscriptionDTO, error) {
+	return m.listSubscriptions(ctx)
+}
+
+func (m *mockSubscriptionUseCase) UpdateSubscription(ctx context.Context, req dto.UpdateSubscriptionRequest) (*dto.SubscriptionDTO, error) {
+	return m.updateSubscription(ctx, req)
+}
+
+func (m *mockSubscriptionUseCase) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	return m.deleteSubscription(ctx, subscriptionID)
+}
+
+func (m *mockSubscriptionUseCase) GetDeadLetters(ctx context.Context, subscriptionID string) ([]dto.DeadLetterDTO, error) {
+	return m.getDeadLetters(ctx, subscriptionID)
+}
+
+func TestSubscriptionHandler_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		useCaseErr error
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			body:       `{"url":"https://hooks.example.com/pr","event_types":["pr.merged"]}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "invalid url",
+			body:       `{"url":"hooks.example.com","event_types":["pr.merged"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown event type",
+			body:       `{"url":"https://hooks.example.com/pr","event_types":["pr.opened"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "short secret",
+			body:       `{"url":"https://hooks.example.com/pr","secret":"short","event_types":["pr.merged"]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid body",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "use case rejects subscription",
+			body:       `{"url":"https://hooks.example.com/pr","event_types":["pr.merged"]}`,
+			useCaseErr: usecase.ErrInvalidSubscription,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockSubscriptionUseCase{
+				createSubscription: func(ctx context.Context, req dto.CreateSubscriptionRequest) (*dto.SubscriptionDTO, error) {
+					if tt.useCaseErr != nil {
+						return nil, tt.useCaseErr
+					}
+					return &dto.SubscriptionDTO{
+						SubscriptionID: "sub-1",
+						URL:            req.URL,
+						EventTypes:     req.EventTypes,
+						IsActive:       true,
+						Secret:         "generated-secret-value",
+					}, nil
+				},
+			}
+			handler := NewSubscriptionHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/subscriptions/create", bytes.NewReader([]byte(tt.body)))
+			w := httptest.NewRecorder()
+
+			handler.CreateSubscription(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("CreateSubscription() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var resp struct {
+				Subscription dto.SubscriptionDTO `json:"subscription"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Subscription.Secret == "" {
+				t.Error("expected secret to be returned on create")
+			}
+		})
+	}
+}
+
+func TestSubscriptionHandler_GetDeadLetters(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		useCaseErr error
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			query:      "?subscription_id=sub-1",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing subscription_id",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "subscription not found",
+			query:      "?subscription_id=ghost",
+			useCaseErr: usecase.ErrSubscriptionNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockSubscriptionUseCase{
+				getDeadLetters: func(ctx context.Context, subscriptionID string) ([]dto.DeadLetterDTO, error) {
+					if tt.useCaseErr != nil {
+						return nil, tt.useCaseErr
+					}
+					return []dto.DeadLetterDTO{{DeliveryID: "delivery-1", EventType: "pr.merged", Attempts: 8}}, nil
+				},
+			}
+			handler := NewSubscriptionHandler(mock)
+
+			req := httptest.NewRequest(http.MethodGet, "/subscriptions/deadLetters"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.GetDeadLetters(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("GetDeadLetters() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestSubscriptionHandler_DeleteSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		useCaseErr error
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			body:       `{"subscription_id":"sub-1"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "missing subscription_id",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "subscription not found",
+			body:       `{"subscription_id":"ghost"}`,
+			useCaseErr: usecase.ErrSubscriptionNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockSubscriptionUseCase{
+				deleteSubscription: func(ctx context.Context, subscriptionID string) error {
+					return tt.useCaseErr
+				},
+			}
+			handler := NewSubscriptionHandler(mock)
+
+			req := httptest.NewRequest(http.MethodPost, "/subscriptions/delete", bytes.NewReader([]byte(tt.body)))
+			w := httptest.NewRecorder()
+
+			handler.DeleteSubscription(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("DeleteSubscription() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
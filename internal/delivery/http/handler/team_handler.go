@@ -10,6 +10,8 @@ import (
 
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/validator"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
@@ -22,7 +24,8 @@ type TeamHandler struct {
 type TeamUseCase interface {
 	CreateTeam(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamDTO, error)
 	GetTeam(ctx context.Context, teamName string) (*dto.TeamDTO, error)
-	DeactivateTeamMembers(ctx context.Context, teamName string) (*dto.TeamDTO, error)
+	UpdateTeam(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error)
+	DeactivateTeamMembers(ctx context.Context, req dto.DeactivateTeamMembersRequest) (*dto.TeamDTO, []dto.ReviewerReassignmentDTO, error)
 }
 
 // NewTeamHandler создает новый TeamHandler
@@ -45,6 +48,8 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.ManagerID = managerID(r)
+
 	team, err := h.teamUseCase.CreateTeam(r.Context(), req)
 	if err != nil {
 		statusCode, code, message := presenter.MapUseCaseError(err)
@@ -73,6 +78,31 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	presenter.RespondSuccess(w, http.StatusOK, team)
 }
 
+// UpdateTeam обрабатывает POST /team/update
+func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateUpdateTeamRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	req.ManagerID = managerID(r)
+
+	team, err := h.teamUseCase.UpdateTeam(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondTeam(w, http.StatusOK, team)
+}
+
 // DeactivateTeamMembers обрабатывает POST /team/deactivateMembers
 func (h *TeamHandler) DeactivateTeamMembers(w http.ResponseWriter, r *http.Request) {
 	var req dto.DeactivateTeamMembersRequest
@@ -86,19 +116,31 @@ func (h *TeamHandler) DeactivateTeamMembers(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	team, err := h.teamUseCase.DeactivateTeamMembers(r.Context(), req.TeamName)
+	req.ManagerID = managerID(r)
+
+	team, reassignments, err := h.teamUseCase.DeactivateTeamMembers(r.Context(), req)
 	if err != nil {
 		statusCode, code, message := presenter.MapUseCaseError(err)
 		presenter.RespondError(w, statusCode, code, message)
 		return
 	}
 
-	presenter.RespondTeam(w, http.StatusOK, team)
+	presenter.RespondTeamDeactivation(w, http.StatusOK, team, reassignments)
+}
+
+// managerID возвращает ID аутентифицированного руководителя команды: его действия use case
+// ограничивает своей командой. Для администратора и запросов без субъекта пусто
+func managerID(r *http.Request) string {
+	if principal, ok := logger.GetPrincipal(r.Context()); ok && principal.HasRole(entity.RoleTeamLead) {
+		return principal.ID()
+	}
+	return ""
 }
 
 // RegisterRoutes регистрирует маршруты для команд
 func (h *TeamHandler) RegisterRoutes(r chi.Router) {
 	r.Post("/team/add", h.CreateTeam)
 	r.Get("/team/get", h.GetTeam)
+	r.Post("/team/update", h.UpdateTeam)
 	r.Post("/team/deactivateMembers", h.DeactivateTeamMembers)
 }
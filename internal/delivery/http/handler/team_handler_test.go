@@ -8,6 +8,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
@@ -15,7 +17,8 @@ import (
 type mockTeamUseCase struct {
 	createTeam            func(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamDTO, error)
 	getTeam               func(ctx context.Context, teamName string) (*dto.TeamDTO, error)
-	deactivateTeamMembers func(ctx context.Context, teamName string) (*dto.TeamDTO, error)
+	updateTeam            func(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error)
+	deactivateTeamMembers func(ctx context.Context, req dto.DeactivateTeamMembersRequest) (*dto.TeamDTO, []dto.ReviewerReassignmentDTO, error)
 }
 
 func (m *mockTeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamDTO, error) {
@@ -26,8 +29,12 @@ func (m *mockTeamUseCase) GetTeam(ctx context.Context, teamName string) (*dto.Te
 	return m.getTeam(ctx, teamName)
 }
 
-func (m *mockTeamUseCase) DeactivateTeamMembers(ctx context.Context, teamName string) (*dto.TeamDTO, error) {
-	return m.deactivateTeamMembers(ctx, teamName)
+func (m *mockTeamUseCase) UpdateTeam(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error) {
+	return m.updateTeam(ctx, req)
+}
+
+func (m *mockTeamUseCase) DeactivateTeamMembers(ctx context.Context, req dto.DeactivateTeamMembersRequest) (*dto.TeamDTO, []dto.ReviewerReassignmentDTO, error) {
+	return m.deactivateTeamMembers(ctx, req)
 }
 
 func TestTeamHandler_CreateTeam(t *testing.T) {
@@ -191,10 +198,119 @@ func TestTeamHandler_GetTeam(t *testing.T) {
 	}
 }
 
+func TestTeamHandler_UpdateTeam(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name       string
+		body       dto.UpdateTeamRequest
+		principal  *entity.Principal
+		setupMock  func() *mockTeamUseCase
+		wantStatus int
+	}{
+		{
+			name: "success",
+			body: dto.UpdateTeamRequest{TeamName: "team-1", MinReviewers: intPtr(1), MaxReviewers: intPtr(3)},
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{
+					updateTeam: func(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error) {
+						return &dto.TeamDTO{
+							TeamName:     req.TeamName,
+							MinReviewers: *req.MinReviewers,
+							MaxReviewers: *req.MaxReviewers,
+							Members:      []dto.TeamMemberDTO{},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "validation error - nothing to update",
+			body: dto.UpdateTeamRequest{TeamName: "team-1"},
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "validation error - max_reviewers out of range",
+			body: dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(0)},
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "use case error - limits conflict with current",
+			body: dto.UpdateTeamRequest{TeamName: "team-1", MinReviewers: intPtr(5)},
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{
+					updateTeam: func(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error) {
+						return nil, usecase.ErrInvalidReviewerLimits
+					},
+				}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "use case error - team not found",
+			body: dto.UpdateTeamRequest{TeamName: "nonexistent", MaxReviewers: intPtr(1)},
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{
+					updateTeam: func(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error) {
+						return nil, usecase.ErrTeamNotFound
+					},
+				}
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:      "team lead of another team is forbidden",
+			body:      dto.UpdateTeamRequest{TeamName: "team-2", MaxReviewers: intPtr(1)},
+			principal: testPrincipal("lead", entity.RoleTeamLead),
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{
+					updateTeam: func(ctx context.Context, req dto.UpdateTeamRequest) (*dto.TeamDTO, error) {
+						if req.ManagerID != "lead" {
+							t.Errorf("expected manager lead, got %q", req.ManagerID)
+						}
+						return nil, usecase.ErrForbidden
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := tt.setupMock()
+			handler := NewTeamHandler(mockUseCase)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/team/update", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.principal != nil {
+				req = req.WithContext(logger.WithPrincipal(req.Context(), tt.principal))
+			}
+
+			w := httptest.NewRecorder()
+
+			handler.UpdateTeam(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
 func TestTeamHandler_DeactivateTeamMembers(t *testing.T) {
 	tests := []struct {
 		name       string
 		body       dto.DeactivateTeamMembersRequest
+		principal  *entity.Principal
 		setupMock  func() *mockTeamUseCase
 		wantStatus int
 	}{
@@ -205,13 +321,16 @@ func TestTeamHandler_DeactivateTeamMembers(t *testing.T) {
 			},
 			setupMock: func() *mockTeamUseCase {
 				return &mockTeamUseCase{
-					deactivateTeamMembers: func(ctx context.Context, teamName string) (*dto.TeamDTO, error) {
+					deactivateTeamMembers: func(ctx context.Context, req dto.DeactivateTeamMembersRequest) (*dto.TeamDTO, []dto.ReviewerReassignmentDTO, error) {
+						newUserID := "user-3"
 						return &dto.TeamDTO{
-							TeamName: teamName,
+							TeamName: req.TeamName,
 							Members: []dto.TeamMemberDTO{
 								{UserID: "user-1", Username: "User 1", IsActive: false},
 								{UserID: "user-2", Username: "User 2", IsActive: false},
 							},
+						}, []dto.ReviewerReassignmentDTO{
+							{PullRequestID: "pr-1", OldUserID: "user-1", NewUserID: &newUserID},
 						}, nil
 					},
 				}
@@ -243,13 +362,29 @@ func TestTeamHandler_DeactivateTeamMembers(t *testing.T) {
 			},
 			setupMock: func() *mockTeamUseCase {
 				return &mockTeamUseCase{
-					deactivateTeamMembers: func(ctx context.Context, teamName string) (*dto.TeamDTO, error) {
-						return nil, usecase.ErrTeamNotFound
+					deactivateTeamMembers: func(ctx context.Context, req dto.DeactivateTeamMembersRequest) (*dto.TeamDTO, []dto.ReviewerReassignmentDTO, error) {
+						return nil, nil, usecase.ErrTeamNotFound
 					},
 				}
 			},
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name:      "team lead is limited to own team",
+			body:      dto.DeactivateTeamMembersRequest{TeamName: "team-2"},
+			principal: testPrincipal("lead", entity.RoleTeamLead),
+			setupMock: func() *mockTeamUseCase {
+				return &mockTeamUseCase{
+					deactivateTeamMembers: func(ctx context.Context, req dto.DeactivateTeamMembersRequest) (*dto.TeamDTO, []dto.ReviewerReassignmentDTO, error) {
+						if req.ManagerID != "lead" {
+							t.Errorf("expected manager lead, got %q", req.ManagerID)
+						}
+						return nil, nil, usecase.ErrForbidden
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -260,6 +395,9 @@ func TestTeamHandler_DeactivateTeamMembers(t *testing.T) {
 			bodyBytes, _ := json.Marshal(tt.body)
 			req := httptest.NewRequest(http.MethodPost, "/team/deactivateMembers", bytes.NewReader(bodyBytes))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.principal != nil {
+				req = req.WithContext(logger.WithPrincipal(req.Context(), tt.principal))
+			}
 
 			w := httptest.NewRecorder()
 
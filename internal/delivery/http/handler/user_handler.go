@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
@@ -20,8 +21,8 @@ type UserHandler struct {
 
 // UserUseCase интерфейс use case для пользователей (локальный для handler)
 type UserUseCase interface {
-	SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error)
-	GetUserReviews(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error)
+	SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error)
+	GetUserReviews(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error)
 }
 
 // NewUserHandler создает новый UserHandler
@@ -44,17 +45,19 @@ func (h *UserHandler) SetUserActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.userUseCase.SetUserActive(r.Context(), req)
+	req.ManagerID = managerID(r)
+
+	user, reassignments, err := h.userUseCase.SetUserActive(r.Context(), req)
 	if err != nil {
 		statusCode, code, message := presenter.MapUseCaseError(err)
 		presenter.RespondError(w, statusCode, code, message)
 		return
 	}
 
-	presenter.RespondUser(w, http.StatusOK, user)
+	presenter.RespondUserActivity(w, http.StatusOK, user, reassignments)
 }
 
-// GetUserReviews обрабатывает GET /users/getReview?user_id=
+// GetUserReviews обрабатывает GET /users/getReview?user_id=&awaiting=
 func (h *UserHandler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 	userID := r.URL.Query().Get("user_id")
 	if strings.TrimSpace(userID) == "" {
@@ -62,7 +65,17 @@ func (h *UserHandler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	prs, err := h.userUseCase.GetUserReviews(r.Context(), userID)
+	awaitingOnly := false
+	if awaiting := r.URL.Query().Get("awaiting"); awaiting != "" {
+		parsed, err := strconv.ParseBool(awaiting)
+		if err != nil {
+			presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "awaiting must be a boolean")
+			return
+		}
+		awaitingOnly = parsed
+	}
+
+	prs, err := h.userUseCase.GetUserReviews(r.Context(), userID, awaitingOnly)
 	if err != nil {
 		statusCode, code, message := presenter.MapUseCaseError(err)
 		presenter.RespondError(w, statusCode, code, message)
@@ -9,27 +9,29 @@ import (
 	"testing"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
 type mockUserUseCase struct {
-	setUserActive  func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error)
-	getUserReviews func(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error)
+	setUserActive  func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error)
+	getUserReviews func(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error)
 }
 
-func (m *mockUserUseCase) SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error) {
+func (m *mockUserUseCase) SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
 	return m.setUserActive(ctx, req)
 }
 
-func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error) {
-	return m.getUserReviews(ctx, userID)
+func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error) {
+	return m.getUserReviews(ctx, userID, awaitingOnly)
 }
 
 func TestUserHandler_SetUserActive(t *testing.T) {
 	tests := []struct {
 		name       string
 		body       dto.SetUserActiveRequest
+		principal  *entity.Principal
 		setupMock  func() *mockUserUseCase
 		wantStatus int
 	}{
@@ -41,13 +43,13 @@ func TestUserHandler_SetUserActive(t *testing.T) {
 			},
 			setupMock: func() *mockUserUseCase {
 				return &mockUserUseCase{
-					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error) {
+					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
 						return &dto.UserDTO{
 							UserID:   req.UserID,
 							Username: "User 1",
 							TeamName: "team-1",
 							IsActive: req.IsActive,
-						}, nil
+						}, nil, nil
 					},
 				}
 			},
@@ -61,13 +63,13 @@ func TestUserHandler_SetUserActive(t *testing.T) {
 			},
 			setupMock: func() *mockUserUseCase {
 				return &mockUserUseCase{
-					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error) {
+					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
 						return &dto.UserDTO{
 							UserID:   req.UserID,
 							Username: "User 1",
 							TeamName: "team-1",
 							IsActive: req.IsActive,
-						}, nil
+						}, nil, nil
 					},
 				}
 			},
@@ -100,13 +102,45 @@ func TestUserHandler_SetUserActive(t *testing.T) {
 			},
 			setupMock: func() *mockUserUseCase {
 				return &mockUserUseCase{
-					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error) {
-						return nil, usecase.ErrUserNotFound
+					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
+						return nil, nil, usecase.ErrUserNotFound
 					},
 				}
 			},
 			wantStatus: http.StatusNotFound,
 		},
+		{
+			name:      "team lead is limited to own team",
+			body:      dto.SetUserActiveRequest{UserID: "user-1", IsActive: false},
+			principal: testPrincipal("lead", entity.RoleTeamLead),
+			setupMock: func() *mockUserUseCase {
+				return &mockUserUseCase{
+					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
+						if req.ManagerID != "lead" {
+							t.Errorf("expected manager lead, got %q", req.ManagerID)
+						}
+						return nil, nil, usecase.ErrForbidden
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:      "admin is not limited to team",
+			body:      dto.SetUserActiveRequest{UserID: "user-1", IsActive: true},
+			principal: testPrincipal("root", entity.RoleAdmin),
+			setupMock: func() *mockUserUseCase {
+				return &mockUserUseCase{
+					setUserActive: func(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
+						if req.ManagerID != "" {
+							t.Errorf("expected empty manager for admin, got %q", req.ManagerID)
+						}
+						return &dto.UserDTO{UserID: req.UserID, IsActive: req.IsActive}, nil, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -117,6 +151,9 @@ func TestUserHandler_SetUserActive(t *testing.T) {
 			bodyBytes, _ := json.Marshal(tt.body)
 			req := httptest.NewRequest(http.MethodPost, "/users/setIsActive", bytes.NewReader(bodyBytes))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.principal != nil {
+				req = req.WithContext(logger.WithPrincipal(req.Context(), tt.principal))
+			}
 
 			w := httptest.NewRecorder()
 
@@ -133,6 +170,7 @@ func TestUserHandler_GetUserReviews(t *testing.T) {
 	tests := []struct {
 		name       string
 		userID     string
+		awaiting   string
 		setupMock  func() *mockUserUseCase
 		wantStatus int
 	}{
@@ -141,7 +179,7 @@ func TestUserHandler_GetUserReviews(t *testing.T) {
 			userID: "user-1",
 			setupMock: func() *mockUserUseCase {
 				return &mockUserUseCase{
-					getUserReviews: func(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error) {
+					getUserReviews: func(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error) {
 						return []dto.PullRequestShortDTO{
 							{
 								PullRequestID:   "pr-1",
@@ -166,13 +204,38 @@ func TestUserHandler_GetUserReviews(t *testing.T) {
 			userID: "user-1",
 			setupMock: func() *mockUserUseCase {
 				return &mockUserUseCase{
-					getUserReviews: func(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error) {
+					getUserReviews: func(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error) {
 						return []dto.PullRequestShortDTO{}, nil
 					},
 				}
 			},
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:     "awaiting filter passed to use case",
+			userID:   "user-1",
+			awaiting: "true",
+			setupMock: func() *mockUserUseCase {
+				return &mockUserUseCase{
+					getUserReviews: func(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error) {
+						if !awaitingOnly {
+							return nil, usecase.ErrUserNotFound
+						}
+						return []dto.PullRequestShortDTO{}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:     "invalid awaiting parameter",
+			userID:   "user-1",
+			awaiting: "maybe",
+			setupMock: func() *mockUserUseCase {
+				return &mockUserUseCase{}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:   "missing user_id parameter",
 			userID: "",
@@ -186,7 +249,7 @@ func TestUserHandler_GetUserReviews(t *testing.T) {
 			userID: "nonexistent",
 			setupMock: func() *mockUserUseCase {
 				return &mockUserUseCase{
-					getUserReviews: func(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error) {
+					getUserReviews: func(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error) {
 						return nil, usecase.ErrUserNotFound
 					},
 				}
@@ -204,6 +267,9 @@ func TestUserHandler_GetUserReviews(t *testing.T) {
 			if tt.userID != "" {
 				q := req.URL.Query()
 				q.Add("user_id", tt.userID)
+				if tt.awaiting != "" {
+					q.Add("awaiting", tt.awaiting)
+				}
 				req.URL.RawQuery = q.Encode()
 			}
 
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/validator"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+const (
+	githubPullRequestEvent = "pull_request"
+	gitlabMergeRequestHook = "Merge Request Hook"
+)
+
+// WebhookHandler обработчик вебхуков git-хостингов
+type WebhookHandler struct {
+	webhookUseCase WebhookUseCase
+	secrets        WebhookSecrets
+}
+
+// WebhookUseCase интерфейс use case для вебхуков (локальный для handler)
+type WebhookUseCase interface {
+	HandlePullRequestEvent(ctx context.Context, event dto.PullRequestEvent) (*dto.WebhookResultDTO, error)
+	LinkGitLogin(ctx context.Context, req dto.LinkGitLoginRequest) (*dto.GitLoginDTO, error)
+}
+
+// WebhookSecrets секреты вебхуков. Вебхук git-хостинга с пустым секретом отключен
+type WebhookSecrets struct {
+	GitHub string
+	GitLab string
+}
+
+// NewWebhookHandler создает новый WebhookHandler
+func NewWebhookHandler(webhookUseCase WebhookUseCase, secrets WebhookSecrets) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+		secrets:        secrets,
+	}
+}
+
+// GitHub обрабатывает POST /webhooks/github
+func (h *WebhookHandler) GitHub(w http.ResponseWriter, r *http.Request) {
+	if h.secrets.GitHub == "" {
+		presenter.RespondError(w, http.StatusNotFound, presenter.ErrorCodeNotFound, "github webhook is not configured")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if !verifyGitHubSignature(h.secrets.GitHub, body, r.Header.Get("X-Hub-Signature-256")) {
+		presenter.RespondError(w, http.StatusUnauthorized, presenter.ErrorCodeInvalidSignature, "invalid webhook signature")
+		return
+	}
+
+	deliveryID := r.Header.Get("X-GitHub-Delivery")
+	if deliveryID == "" {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "X-GitHub-Delivery header is required")
+		return
+	}
+
+	if eventName := r.Header.Get("X-GitHub-Event"); eventName != githubPullRequestEvent {
+		respondWebhookIgnored(w, deliveryID, fmt.Sprintf("unsupported event %q", eventName))
+		return
+	}
+
+	var payload githubPullRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	action, ok := githubAction(payload)
+	if !ok {
+		respondWebhookIgnored(w, deliveryID, fmt.Sprintf("unsupported action %q", payload.Action))
+		return
+	}
+
+	h.handleEvent(w, r, dto.PullRequestEvent{
+		Provider:    string(entity.GitProviderGitHub),
+		DeliveryID:  deliveryID,
+		Action:      action,
+		Repository:  payload.Repository.FullName,
+		Number:      payload.Number,
+		Title:       payload.PullRequest.Title,
+		AuthorLogin: payload.PullRequest.User.Login,
+		Draft:       payload.PullRequest.Draft,
+	})
+}
+
+// GitLab обрабатывает POST /webhooks/gitlab
+func (h *WebhookHandler) GitLab(w http.ResponseWriter, r *http.Request) {
+	if h.secrets.GitLab == "" {
+		presenter.RespondError(w, http.StatusNotFound, presenter.ErrorCodeNotFound, "gitlab webhook is not configured")
+		return
+	}
+
+	if !verifyGitLabToken(h.secrets.GitLab, r.Header.Get("X-Gitlab-Token")) {
+		presenter.RespondError(w, http.StatusUnauthorized, presenter.ErrorCodeInvalidSignature, "invalid webhook token")
+		return
+	}
+
+	deliveryID := r.Header.Get("X-Gitlab-Event-UUID")
+	if deliveryID == "" {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "X-Gitlab-Event-UUID header is required")
+		return
+	}
+
+	if eventName := r.Header.Get("X-Gitlab-Event"); eventName != gitlabMergeRequestHook {
+		respondWebhookIgnored(w, deliveryID, fmt.Sprintf("unsupported event %q", eventName))
+		return
+	}
+
+	var payload gitlabMergeRequestPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	action, ok := gitlabAction(payload)
+	if !ok {
+		respondWebhookIgnored(w, deliveryID, fmt.Sprintf("unsupported action %q", payload.ObjectAttributes.Action))
+		return
+	}
+
+	h.handleEvent(w, r, dto.PullRequestEvent{
+		Provider:    string(entity.GitProviderGitLab),
+		DeliveryID:  deliveryID,
+		Action:      action,
+		Repository:  payload.Project.PathWithNamespace,
+		Number:      payload.ObjectAttributes.IID,
+		Title:       payload.ObjectAttributes.Title,
+		AuthorLogin: payload.User.Username,
+		Draft:       payload.ObjectAttributes.Draft,
+	})
+}
+
+func (h *WebhookHandler) handleEvent(w http.ResponseWriter, r *http.Request, event dto.PullRequestEvent) {
+	result, err := h.webhookUseCase.HandlePullRequestEvent(r.Context(), event)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondWebhookResult(w, http.StatusOK, result)
+}
+
+// respondWebhookIgnored отвечает 200 на событие, которое сервис не обрабатывает, чтобы git-хостинг его не повторял
+func respondWebhookIgnored(w http.ResponseWriter, deliveryID, reason string) {
+	presenter.RespondWebhookResult(w, http.StatusOK, &dto.WebhookResultDTO{
+		DeliveryID: deliveryID,
+		Status:     dto.WebhookStatusIgnored,
+		Reason:     reason,
+	})
+}
+
+// LinkGitLogin обрабатывает POST /webhooks/linkLogin
+func (h *WebhookHandler) LinkGitLogin(w http.ResponseWriter, r *http.Request) {
+	var req dto.LinkGitLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateLinkGitLoginRequest(req); len(validationErrors) > 0 {
+		validator.RespondValidationErrors(w, validationErrors)
+		return
+	}
+
+	login, err := h.webhookUseCase.LinkGitLogin(r.Context(), req)
+	if err != nil {
+		statusCode, code, message := presenter.MapUseCaseError(err)
+		presenter.RespondError(w, statusCode, code, message)
+		return
+	}
+
+	presenter.RespondGitLogin(w, http.StatusOK, login)
+}
+
+// RegisterRoutes регистрирует маршруты для вебхуков
+func (h *WebhookHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/webhooks/github", h.GitHub)
+	r.Post("/webhooks/gitlab", h.GitLab)
+	r.Post("/webhooks/linkLogin", h.LinkGitLogin)
+}
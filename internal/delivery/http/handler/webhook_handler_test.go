@@ -0,0 +1,398 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+const (
+	testGitHubSecret = "github-secret"
+	testGitLabSecret = "gitlab-secret"
+)
+
+type mockWebhookUseCase struct {
+	handlePullRequestEvent func(ctx context.Context, event dto.PullRequestEvent) (*dto.WebhookResultDTO, error)
+	linkGitLogin           func(ctx context.Context, req dto.LinkGitLoginRequest) (*dto.GitLoginDTO, error)
+}
+
+func (m *mockWebhookUseCase) HandlePullRequestEvent(ctx context.Context, event dto.PullRequestEvent) (*dto.WebhookResultDTO, error) {
+	return m.handlePullRequestEvent(ctx, event)
+}
+
+func (m *mockWebhookUseCase) LinkGitLogin(ctx context.Context, req dto.LinkGitLoginRequest) (*dto.GitLoginDTO, error) {
+	return m.linkGitLogin(ctx, req)
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+	return data
+}
+
+func signGitHubPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookHandler_GitHub(t *testing.T) {
+	tests := []struct {
+		name          string
+		fixture       string
+		event         string
+		deliveryID    string
+		signature     func(body []byte) string
+		useCaseErr    error
+		wantStatus    int
+		wantResult    string
+		expectedEvent *dto.PullRequestEvent
+	}{
+		{
+			name:       "opened event creates PR",
+			fixture:    "github_pull_request_opened.json",
+			event:      "pull_request",
+			deliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+			signature:  func(body []byte) string { return signGitHubPayload(testGitHubSecret, body) },
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusProcessed,
+			expectedEvent: &dto.PullRequestEvent{
+				Provider:    "github",
+				DeliveryID:  "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+				Action:      dto.PullRequestEventOpened,
+				Repository:  "acme/backend",
+				Number:      42,
+				Title:       "Add webhook ingestion (part 1)",
+				AuthorLogin: "Octocat",
+			},
+		},
+		{
+			name:       "closed with merged flag merges PR",
+			fixture:    "github_pull_request_closed_merged.json",
+			event:      "pull_request",
+			deliveryID: "9a1f4c70-cc80-11e3-8d3a-f1b2c3d4e5f6",
+			signature:  func(body []byte) string { return signGitHubPayload(testGitHubSecret, body) },
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusProcessed,
+			expectedEvent: &dto.PullRequestEvent{
+				Provider:    "github",
+				DeliveryID:  "9a1f4c70-cc80-11e3-8d3a-f1b2c3d4e5f6",
+				Action:      dto.PullRequestEventMerged,
+				Repository:  "acme/backend",
+				Number:      42,
+				Title:       "Add webhook ingestion (part 1)",
+				AuthorLogin: "Octocat",
+			},
+		},
+		{
+			name:       "unsupported action is ignored",
+			fixture:    "github_pull_request_labeled.json",
+			event:      "pull_request",
+			deliveryID: "d-1",
+			signature:  func(body []byte) string { return signGitHubPayload(testGitHubSecret, body) },
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusIgnored,
+		},
+		{
+			name:       "other event is ignored",
+			fixture:    "github_pull_request_opened.json",
+			event:      "push",
+			deliveryID: "d-1",
+			signature:  func(body []byte) string { return signGitHubPayload(testGitHubSecret, body) },
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusIgnored,
+		},
+		{
+			name:       "wrong secret",
+			fixture:    "github_pull_request_opened.json",
+			event:      "pull_request",
+			deliveryID: "d-1",
+			signature:  func(body []byte) string { return signGitHubPayload("other-secret", body) },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing signature",
+			fixture:    "github_pull_request_opened.json",
+			event:      "pull_request",
+			deliveryID: "d-1",
+			signature:  func(body []byte) string { return "" },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing delivery id",
+			fixture:    "github_pull_request_opened.json",
+			event:      "pull_request",
+			signature:  func(body []byte) string { return signGitHubPayload(testGitHubSecret, body) },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "use case error",
+			fixture:    "github_pull_request_opened.json",
+			event:      "pull_request",
+			deliveryID: "d-1",
+			signature:  func(body []byte) string { return signGitHubPayload(testGitHubSecret, body) },
+			useCaseErr: errors.New("db error"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *dto.PullRequestEvent
+			mock := &mockWebhookUseCase{
+				handlePullRequestEvent: func(ctx context.Context, event dto.PullRequestEvent) (*dto.WebhookResultDTO, error) {
+					received = &event
+					if tt.useCaseErr != nil {
+						return nil, tt.useCaseErr
+					}
+					return &dto.WebhookResultDTO{DeliveryID: event.DeliveryID, Status: dto.WebhookStatusProcessed}, nil
+				},
+			}
+			handler := NewWebhookHandler(mock, WebhookSecrets{GitHub: testGitHubSecret})
+
+			body := readFixture(t, tt.fixture)
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+			req.Header.Set("X-GitHub-Event", tt.event)
+			req.Header.Set("X-GitHub-Delivery", tt.deliveryID)
+			req.Header.Set("X-Hub-Signature-256", tt.signature(body))
+			w := httptest.NewRecorder()
+
+			handler.GitHub(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("GitHub() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantResult != "" {
+				var result dto.WebhookResultDTO
+				if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if result.Status != tt.wantResult {
+					t.Errorf("expected status %s, got %s", tt.wantResult, result.Status)
+				}
+			}
+			if tt.expectedEvent != nil && !reflect.DeepEqual(received, tt.expectedEvent) {
+				t.Errorf("expected event %+v, got %+v", tt.expectedEvent, received)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_GitLab(t *testing.T) {
+	tests := []struct {
+		name          string
+		fixture       string
+		event         string
+		token         string
+		deliveryID    string
+		wantStatus    int
+		wantResult    string
+		expectedEvent *dto.PullRequestEvent
+	}{
+		{
+			name:       "open event creates draft PR",
+			fixture:    "gitlab_merge_request_open.json",
+			event:      "Merge Request Hook",
+			token:      testGitLabSecret,
+			deliveryID: "f2a1c1a6-6c07-4b1e-9c43-5e3a0b1d2c3e",
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusProcessed,
+			expectedEvent: &dto.PullRequestEvent{
+				Provider:    "gitlab",
+				DeliveryID:  "f2a1c1a6-6c07-4b1e-9c43-5e3a0b1d2c3e",
+				Action:      dto.PullRequestEventOpened,
+				Repository:  "acme/platform/api",
+				Number:      7,
+				Title:       "Draft: Retry outbound deliveries",
+				AuthorLogin: "jane.doe",
+				Draft:       true,
+			},
+		},
+		{
+			name:       "draft removal marks PR ready",
+			fixture:    "gitlab_merge_request_ready.json",
+			event:      "Merge Request Hook",
+			token:      testGitLabSecret,
+			deliveryID: "d-2",
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusProcessed,
+			expectedEvent: &dto.PullRequestEvent{
+				Provider:    "gitlab",
+				DeliveryID:  "d-2",
+				Action:      dto.PullRequestEventReady,
+				Repository:  "acme/platform/api",
+				Number:      7,
+				Title:       "Retry outbound deliveries",
+				AuthorLogin: "jane.doe",
+			},
+		},
+		{
+			name:       "merge event merges PR",
+			fixture:    "gitlab_merge_request_merge.json",
+			event:      "Merge Request Hook",
+			token:      testGitLabSecret,
+			deliveryID: "d-3",
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusProcessed,
+			expectedEvent: &dto.PullRequestEvent{
+				Provider:    "gitlab",
+				DeliveryID:  "d-3",
+				Action:      dto.PullRequestEventMerged,
+				Repository:  "acme/platform/api",
+				Number:      7,
+				Title:       "Retry outbound deliveries",
+				AuthorLogin: "release-bot",
+			},
+		},
+		{
+			name:       "other event is ignored",
+			fixture:    "gitlab_merge_request_open.json",
+			event:      "Push Hook",
+			token:      testGitLabSecret,
+			deliveryID: "d-4",
+			wantStatus: http.StatusOK,
+			wantResult: dto.WebhookStatusIgnored,
+		},
+		{
+			name:       "wrong token",
+			fixture:    "gitlab_merge_request_open.json",
+			event:      "Merge Request Hook",
+			token:      "other-secret",
+			deliveryID: "d-5",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing delivery id",
+			fixture:    "gitlab_merge_request_open.json",
+			event:      "Merge Request Hook",
+			token:      testGitLabSecret,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *dto.PullRequestEvent
+			mock := &mockWebhookUseCase{
+				handlePullRequestEvent: func(ctx context.Context, event dto.PullRequestEvent) (*dto.WebhookResultDTO, error) {
+					received = &event
+					return &dto.WebhookResultDTO{DeliveryID: event.DeliveryID, Status: dto.WebhookStatusProcessed}, nil
+				},
+			}
+			handler := NewWebhookHandler(mock, WebhookSecrets{GitLab: testGitLabSecret})
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", bytes.NewReader(readFixture(t, tt.fixture)))
+			req.Header.Set("X-Gitlab-Event", tt.event)
+			req.Header.Set("X-Gitlab-Token", tt.token)
+			req.Header.Set("X-Gitlab-Event-UUID", tt.deliveryID)
+			w := httptest.NewRecorder()
+
+			handler.GitLab(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("GitLab() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantResult != "" {
+				var result dto.WebhookResultDTO
+				if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if result.Status != tt.wantResult {
+					t.Errorf("expected status %s, got %s", tt.wantResult, result.Status)
+				}
+			}
+			if tt.expectedEvent != nil && !reflect.DeepEqual(received, tt.expectedEvent) {
+				t.Errorf("expected event %+v, got %+v", tt.expectedEvent, received)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_NotConfigured(t *testing.T) {
+	handler := NewWebhookHandler(&mockWebhookUseCase{}, WebhookSecrets{})
+
+	for path, serve := range map[string]http.HandlerFunc{
+		"/webhooks/github": handler.GitHub,
+		"/webhooks/gitlab": handler.GitLab,
+	} {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+
+		serve(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s status = %v, want %v", path, w.Code, http.StatusNotFound)
+		}
+	}
+}
+
+func TestWebhookHandler_LinkGitLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		useCaseErr error
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			body:       `{"provider":"github","login":"octocat","user_id":"u1"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid provider",
+			body:       `{"provider":"svn","login":"octocat","user_id":"u1"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid body",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "user not found",
+			body:       `{"provider":"gitlab","login":"octocat","user_id":"ghost"}`,
+			useCaseErr: usecase.ErrUserNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockWebhookUseCase{
+				linkGitLogin: func(ctx context.Context, req dto.LinkGitLoginRequest) (*dto.GitLoginDTO, error) {
+					if tt.useCaseErr != nil {
+						return nil, tt.useCaseErr
+					}
+					return &dto.GitLoginDTO{Provider: req.Provider, Login: req.Login, UserID: req.UserID}, nil
+				},
+			}
+			handler := NewWebhookHandler(mock, WebhookSecrets{})
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/linkLogin", bytes.NewReader([]byte(tt.body)))
+			w := httptest.NewRecorder()
+
+			handler.LinkGitLogin(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("LinkGitLogin() status = %v, want %v, body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// githubPullRequestPayload поля события pull_request GitHub, которые нужны сервису
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int64  `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// gitlabMergeRequestPayload поля события Merge Request Hook GitLab, которые нужны сервису.
+// user - инициатор события, для action=open это автор MR
+type gitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int64  `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+		Draft  bool   `json:"draft"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+// githubAction сопоставляет действие GitHub действию сервиса. closed с merged=true - это мерж
+func githubAction(payload githubPullRequestPayload) (dto.PullRequestEventAction, bool) {
+	switch payload.Action {
+	case "opened":
+		return dto.PullRequestEventOpened, true
+	case "ready_for_review":
+		return dto.PullRequestEventReady, true
+	case "closed":
+		if payload.PullRequest.Merged {
+			return dto.PullRequestEventMerged, true
+		}
+		return dto.PullRequestEventClosed, true
+	case "reopened":
+		return dto.PullRequestEventReopened, true
+	default:
+		return "", false
+	}
+}
+
+// gitlabAction сопоставляет действие GitLab действию сервиса.
+// update, снимающий признак draft, переводит MR из черновика
+func gitlabAction(payload gitlabMergeRequestPayload) (dto.PullRequestEventAction, bool) {
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		return dto.PullRequestEventOpened, true
+	case "update":
+		if draft := payload.Changes.Draft; draft != nil && draft.Previous && !draft.Current {
+			return dto.PullRequestEventReady, true
+		}
+		return "", false
+	case "close":
+		return dto.PullRequestEventClosed, true
+	case "merge":
+		return dto.PullRequestEventMerged, true
+	case "reopen":
+		return dto.PullRequestEventReopened, true
+	default:
+		return "", false
+	}
+}
+
+// verifyGitHubSignature проверяет заголовок X-Hub-Signature-256: sha256=<hex HMAC-SHA256 тела>
+func verifyGitHubSignature(secret string, body []byte, signature string) bool {
+	hexDigest, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+
+	received, err := hex.DecodeString(hexDigest)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(received, mac.Sum(nil))
+}
+
+// verifyGitLabToken проверяет заголовок X-Gitlab-Token. GitLab не подписывает тело,
+// а передает секрет вебхука как есть, поэтому он сравнивается за постоянное время
+func verifyGitLabToken(secret, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+)
+
+// Authenticator определяет субъекта по значению Bearer токена
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*entity.Principal, error)
+}
+
+// Authenticate middleware проверяет заголовок Authorization: Bearer <token> и кладет субъекта
+// в контекст под ключом logger.UserIDKey. Пути из publicPaths пропускаются без токена
+func Authenticate(authenticator Authenticator, publicPaths []string) func(http.Handler) http.Handler {
+	public := pathSet(publicPaths)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if public[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, ok := bearerToken(r.Header.Get("Authorization"))
+			if !ok {
+				respondUnauthorized(w, http.StatusUnauthorized, presenter.ErrorCodeUnauthorized, "bearer token required")
+				return
+			}
+
+			principal, err := authenticator.Authenticate(r.Context(), token)
+			if err != nil {
+				statusCode, code, message := presenter.MapUseCaseError(err)
+				respondUnauthorized(w, statusCode, code, message)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(logger.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// Authorize middleware проверяет, что роль субъекта разрешена для пути запроса.
+// Путь, которого нет в permissions, доступен только администратору
+func Authorize(permissions map[string][]entity.Role, publicPaths []string) func(http.Handler) http.Handler {
+	public := pathSet(publicPaths)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if public[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, ok := logger.GetPrincipal(r.Context())
+			if !ok {
+				respondUnauthorized(w, http.StatusUnauthorized, presenter.ErrorCodeUnauthorized, "authentication required")
+				return
+			}
+
+			roles, known := permissions[r.URL.Path]
+			if !known {
+				roles = []entity.Role{entity.RoleAdmin}
+			}
+
+			if !principal.HasRole(roles...) {
+				presenter.RespondError(w, http.StatusForbidden, presenter.ErrorCodeForbidden, "insufficient permissions")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerToken извлекает токен из значения заголовка Authorization. Схема нечувствительна к регистру
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func respondUnauthorized(w http.ResponseWriter, statusCode int, code, message string) {
+	if statusCode == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="pr-reviewer-service"`)
+	}
+	presenter.RespondError(w, statusCode, code, message)
+}
+
+func pathSet(paths []string) map[string]bool {
+	set := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		set[path] = true
+	}
+	return set
+}
@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	domainlogger "github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// Заголовки идемпотентных запросов
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// IdempotencyStore хранилище ответов на запросы с Idempotency-Key (см. usecase.IdempotencyUseCase)
+type IdempotencyStore interface {
+	Begin(ctx context.Context, scope, key, requestHash string) (*dto.IdempotentResponseDTO, error)
+	Complete(ctx context.Context, scope, key string, response dto.IdempotentResponseDTO) error
+	Release(ctx context.Context, scope, key string) error
+}
+
+// Idempotency middleware повторяет сохраненный ответ на POST запрос с заголовком Idempotency-Key.
+// Первый ответ со статусом ниже 500 сохраняется, повторный запрос с тем же ключом, методом, путем
+// и телом получает его с заголовком Idempotent-Replayed: true, не выполняясь заново. Тот же ключ
+// с другим запросом дает 422, а пока первый запрос выполняется - 409. Ключи разных субъектов
+// не пересекаются, а без аутентификации ключи отделяются по IP адресу. После ответа 5xx или panic ключ освобождается, и запрос можно повторить.
+// Пути из exemptPaths не обрабатываются
+func Idempotency(store IdempotencyStore, exemptPaths []string, log domainlogger.Logger) func(http.Handler) http.Handler {
+	exempt := pathSet(exemptPaths)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" || exempt[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, "failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := r.Context()
+			ctxLog := log.WithContext(ctx)
+			scope := clientKey(r)
+
+			replay, err := store.Begin(ctx, scope, key, requestHash(r, body))
+			if err != nil {
+				statusCode, code, message := presenter.MapUseCaseError(err)
+				if statusCode == http.StatusInternalServerError {
+					ctxLog.Error("Failed to begin idempotent request", "error", err)
+				}
+				// Запросы API выполняются быстро, повторить можно через секунду
+				if errors.Is(err, usecase.ErrIdempotencyKeyInProgress) {
+					w.Header().Set("Retry-After", "1")
+				}
+				presenter.RespondError(w, statusCode, code, message)
+				return
+			}
+			if replay != nil {
+				if replay.ContentType != "" {
+					w.Header().Set("Content-Type", replay.ContentType)
+				}
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(replay.StatusCode)
+				//nolint:gosec
+				_, _ = w.Write(replay.Body)
+				return
+			}
+
+			// Ответ сохраняется и после отмены запроса клиентом, иначе ключ останется занятым
+			storeCtx := context.WithoutCancel(ctx)
+			captured := &captureWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := store.Release(storeCtx, scope, key); err != nil {
+					ctxLog.Error("Failed to release idempotency key", "error", err)
+				}
+			}()
+
+			next.ServeHTTP(captured, r)
+
+			if captured.statusCode >= http.StatusInternalServerError {
+				return
+			}
+			completed = true
+
+			response := dto.IdempotentResponseDTO{
+				StatusCode:  captured.statusCode,
+				ContentType: w.Header().Get("Content-Type"),
+				Body:        captured.body.Bytes(),
+			}
+			if err := store.Complete(storeCtx, scope, key, response); err != nil {
+				ctxLog.Error("Failed to save idempotent response", "error", err)
+			}
+		})
+	}
+}
+
+// requestHash SHA-256 метода, пути с параметрами и тела запроса
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// captureWriter передает ответ клиенту и запоминает его статус и тело
+type captureWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (cw *captureWriter) WriteHeader(statusCode int) {
+	if !cw.wroteHeader {
+		cw.statusCode = statusCode
+		cw.wroteHeader = true
+	}
+	cw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (cw *captureWriter) Write(b []byte) (int, error) {
+	cw.wroteHeader = true
+	cw.body.Write(b)
+	return cw.ResponseWriter.Write(b)
+}
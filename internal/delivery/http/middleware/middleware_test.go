@@ -2,13 +2,26 @@ package middleware
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/memory"
+	infraLogger "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 )
 
 func TestLimitBodySize(t *testing.T) {
@@ -218,3 +231,467 @@ func TestRequestID(t *testing.T) {
 		})
 	}
 }
+
+type mockAuthenticator struct {
+	principals map[string]*entity.Principal
+}
+
+func (m *mockAuthenticator) Authenticate(_ context.Context, token string) (*entity.Principal, error) {
+	if principal, ok := m.principals[token]; ok {
+		return principal, nil
+	}
+	return nil, usecase.ErrUnauthenticated
+}
+
+func TestAuthenticateAndAuthorize(t *testing.T) {
+	admin, _ := entity.NewPrincipal("root", entity.RoleAdmin)
+	member, _ := entity.NewPrincipal("u1", entity.RoleMember)
+
+	authenticator := &mockAuthenticator{principals: map[string]*entity.Principal{
+		"admin-token":  admin,
+		"member-token": member,
+	}}
+	permissions := map[string][]entity.Role{
+		"/pullRequest/get":   {entity.RoleAdmin, entity.RoleTeamLead, entity.RoleMember, entity.RoleService},
+		"/users/setIsActive": {entity.RoleAdmin, entity.RoleTeamLead},
+	}
+	publicPaths := []string{"/health"}
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantStatus    int
+		wantUserID    string
+	}{
+		{
+			name:       "public path without token",
+			path:       "/health",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing token",
+			path:       "/pullRequest/get",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "not a bearer scheme",
+			path:          "/pullRequest/get",
+			authorization: "Basic dTE6cGFzcw==",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "unknown token",
+			path:          "/pullRequest/get",
+			authorization: "Bearer forged",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "member allowed",
+			path:          "/pullRequest/get",
+			authorization: "Bearer member-token",
+			wantStatus:    http.StatusOK,
+			wantUserID:    "u1",
+		},
+		{
+			name:          "member forbidden",
+			path:          "/users/setIsActive",
+			authorization: "bearer member-token",
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:          "unlisted path is admin only",
+			path:          "/auth/tokens/list",
+			authorization: "Bearer member-token",
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:          "admin on unlisted path",
+			path:          "/auth/tokens/list",
+			authorization: "Bearer admin-token",
+			wantStatus:    http.StatusOK,
+			wantUserID:    "root",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUserID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUserID = infraLogger.GetUserID(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := Authenticate(authenticator, publicPaths)(Authorize(permissions, publicPaths)(next))
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected WWW-Authenticate header on 401")
+			}
+			if gotUserID != tt.wantUserID {
+				t.Errorf("expected user id %q in context, got %q", tt.wantUserID, gotUserID)
+			}
+		})
+	}
+}
+
+// observedRequest запрос, переданный в RequestObserver
+type observedRequest struct {
+	method string
+	route  string
+	status int
+}
+
+type recordingObserver struct {
+	requests []observedRequest
+}
+
+func (o *recordingObserver) ObserveHTTPRequest(method, route string, status int, _ time.Duration) {
+	o.requests = append(o.requests, observedRequest{method: method, route: route, status: status})
+}
+
+func TestMetrics(t *testing.T) {
+	observer := &recordingObserver{}
+	router := chi.NewRouter()
+	router.Use(Metrics(observer))
+	router.Get("/team/get", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	router.Route("/teams", func(r chi.Router) {
+		r.Get("/{name}", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+	})
+
+	for _, path := range []string{"/team/get?team_name=backend", "/teams/backend", "/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	want := []observedRequest{
+		{method: http.MethodGet, route: "/team/get", status: http.StatusOK},
+		{method: http.MethodGet, route: "/teams/{name}", status: http.StatusNotFound},
+		{method: http.MethodGet, route: unmatchedRoute, status: http.StatusNotFound},
+	}
+	if len(observer.requests) != len(want) {
+		t.Fatalf("expected %d observed requests, got %+v", len(want), observer.requests)
+	}
+	for i := range want {
+		if observer.requests[i] != want[i] {
+			t.Errorf("request %d: expected %+v, got %+v", i, want[i], observer.requests[i])
+		}
+	}
+}
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	propagator := propagation.TraceContext{}
+
+	var handlerSpan trace.SpanContext
+	router := chi.NewRouter()
+	router.Use(Tracing(provider, propagator))
+	router.Get("/teams/{name}", func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/teams/backend", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+
+	if span.Name != "GET /teams/{name}" {
+		t.Errorf("expected span name by route pattern, got %q", span.Name)
+	}
+	if span.SpanContext.TraceID().String() != traceID || span.Parent.SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("expected span to continue incoming trace, got trace %s parent %s", span.SpanContext.TraceID(), span.Parent.SpanID())
+	}
+	if handlerSpan.SpanID() != span.SpanContext.SpanID() {
+		t.Errorf("expected request span in handler context")
+	}
+	if span.Status.Code != codes.Error {
+		t.Errorf("expected error status for 500 response, got %v", span.Status.Code)
+	}
+}
+
+// recordingLimiter пропускает первые allow запросов и запоминает ключи клиентов
+type recordingLimiter struct {
+	allow   int
+	clients []string
+}
+
+func (l *recordingLimiter) Allow(_ string, client string) (bool, time.Duration) {
+	l.clients = append(l.clients, client)
+	if len(l.clients) > l.allow {
+		return false, 1500 * time.Millisecond
+	}
+	return true, 0
+}
+
+func TestRateLimit(t *testing.T) {
+	member, _ := entity.NewPrincipal("u1", entity.RoleMember)
+	limiter := &recordingLimiter{allow: 2}
+
+	handler := RateLimit(limiter, []string{"/health"})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string, principal *entity.Principal) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.RemoteAddr = "10.0.0.1:51234"
+		if principal != nil {
+			req = req.WithContext(infraLogger.WithPrincipal(req.Context(), principal))
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := serve("/pullRequest/create", member); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	if w := serve("/pullRequest/create", nil); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	w := serve("/pullRequest/create", member)
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("expected Retry-After rounded up to 2, got %q", got)
+	}
+	if !bytes.Contains(w.Body.Bytes(), []byte(`"code":"RATE_LIMITED"`)) {
+		t.Errorf("expected RATE_LIMITED error, got %s", w.Body.String())
+	}
+
+	// Исключенные пути не расходуют лимит
+	if w := serve("/health", member); w.Code != http.StatusOK {
+		t.Errorf("expected exempt path to pass, got %d", w.Code)
+	}
+
+	want := []string{"principal:u1", "ip:10.0.0.1", "principal:u1"}
+	if len(limiter.clients) != len(want) {
+		t.Fatalf("expected clients %v, got %v", want, limiter.clients)
+	}
+	for i := range want {
+		if limiter.clients[i] != want[i] {
+			t.Errorf("request %d: expected client %q, got %q", i, want[i], limiter.clients[i])
+		}
+	}
+}
+
+// failureLimiter блокирует клиента после limit неудачных попыток
+type failureLimiter struct {
+	limit    int
+	failures map[string]int
+}
+
+func (l *failureLimiter) Allow(_ string, client string) (bool, time.Duration) {
+	l.failures[client]++
+	return l.failures[client] <= l.limit, 0
+}
+
+func (l *failureLimiter) Check(_ string, client string) (bool, time.Duration) {
+	if l.failures[client] >= l.limit {
+		return false, 30 * time.Second
+	}
+	return true, 0
+}
+
+func TestAuthFailureLimit(t *testing.T) {
+	member, _ := entity.NewPrincipal("u1", entity.RoleMember)
+	authenticator := &mockAuthenticator{principals: map[string]*entity.Principal{"member-token": member}}
+	limiter := &failureLimiter{limit: 2, failures: make(map[string]int)}
+
+	authenticated := false
+	handler := AuthFailureLimit(limiter)(Authenticate(authenticator, []string{"/health"})(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			authenticated = true
+			w.WriteHeader(http.StatusOK)
+		}),
+	))
+
+	serve := func(remoteAddr, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/get", nil)
+		req.RemoteAddr = remoteAddr
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	// Успешные запросы не расходуют попытки
+	for i := 0; i < 3; i++ {
+		if w := serve("10.0.0.1:51234", "member-token"); w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+	}
+	if w := serve("10.0.0.1:51234", "forged"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", w.Code)
+	}
+	if w := serve("10.0.0.1:51235", ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", w.Code)
+	}
+	if limiter.failures["ip:10.0.0.1"] != 2 {
+		t.Fatalf("expected 2 failures of ip:10.0.0.1, got %v", limiter.failures)
+	}
+
+	// Исчерпавший попытки адрес отклоняется до проверки токена, даже с верным токеном
+	authenticated = false
+	w := serve("10.0.0.1:51236", "member-token")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", w.Code)
+	}
+	if authenticated {
+		t.Error("expected request to be rejected before authentication")
+	}
+	if got := w.Header().Get("Retry-After"); got != "30" {
+		t.Errorf("expected Retry-After 30, got %q", got)
+	}
+	if !bytes.Contains(w.Body.Bytes(), []byte(`"code":"RATE_LIMITED"`)) {
+		t.Errorf("expected RATE_LIMITED error, got %s", w.Body.String())
+	}
+
+	// Другие адреса не затронуты
+	if w := serve("10.0.0.2:51234", "member-token"); w.Code != http.StatusOK {
+		t.Errorf("expected another address to pass, got %d", w.Code)
+	}
+}
+
+func TestIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := loggermocks.NewMockLogger(ctrl)
+	logger.EXPECT().WithContext(gomock.Any()).Return(logger).AnyTimes()
+
+	store := usecase.NewIdempotencyUseCase(
+		memory.NewIdempotencyRepository(memory.NewStore()),
+		usecase.IdempotencySettings{TTL: time.Hour, Lease: time.Minute, CleanupInterval: time.Minute},
+		logger,
+	)
+
+	calls := 0
+	status := http.StatusCreated
+	handler := Idempotency(store, []string{"/auth/tokens/create"}, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/panic" {
+			panic("test panic")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(calls) + `}`))
+	}))
+
+	serve := func(method, path, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	first := serve(http.MethodPost, "/pullRequest/create", "key-1", `{"id":"pr-1"}`)
+	retry := serve(http.MethodPost, "/pullRequest/create", "key-1", `{"id":"pr-1"}`)
+	if calls != 1 {
+		t.Fatalf("expected handler to run once, got %d", calls)
+	}
+	if retry.Code != first.Code || retry.Body.String() != first.Body.String() ||
+		retry.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("expected replay of %d %s, got %d %s", first.Code, first.Body.String(), retry.Code, retry.Body.String())
+	}
+	if retry.Header().Get(IdempotentReplayedHeader) != "true" || first.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Error("expected only the retry to be marked as replayed")
+	}
+
+	// Тот же ключ с другим телом или путем
+	for _, path := range []string{"/pullRequest/create", "/pullRequest/merge"} {
+		body := `{"id":"pr-1"}`
+		if path == "/pullRequest/create" {
+			body = `{"id":"pr-2"}`
+		}
+		w := serve(http.MethodPost, path, "key-1", body)
+		if w.Code != http.StatusUnprocessableEntity || !bytes.Contains(w.Body.Bytes(), []byte(`"code":"IDEMPOTENCY_KEY_REUSED"`)) {
+			t.Errorf("%s: expected 422 IDEMPOTENCY_KEY_REUSED, got %d %s", path, w.Code, w.Body.String())
+		}
+	}
+
+	// Ответ 5xx не сохраняется, и запрос можно повторить
+	status = http.StatusInternalServerError
+	serve(http.MethodPost, "/team/add", "key-2", `{}`)
+	status = http.StatusCreated
+	if w := serve(http.MethodPost, "/team/add", "key-2", `{}`); w.Code != http.StatusCreated || calls != 3 {
+		t.Errorf("expected retry after 500 to run handler, got %d after %d calls", w.Code, calls)
+	}
+
+	// После panic ключ тоже освобождается
+	func() {
+		defer func() { _ = recover() }()
+		serve(http.MethodPost, "/panic", "key-3", `{}`)
+	}()
+	func() {
+		defer func() { _ = recover() }()
+		serve(http.MethodPost, "/panic", "key-3", `{}`)
+	}()
+	if calls != 5 {
+		t.Errorf("expected key to be released after panic, got %d calls", calls)
+	}
+
+	// Запросы без ключа, не POST и исключенные пути выполняются каждый раз
+	serve(http.MethodPost, "/team/add", "", `{}`)
+	serve(http.MethodGet, "/team/get", "key-4", "")
+	serve(http.MethodGet, "/team/get", "key-4", "")
+	serve(http.MethodPost, "/auth/tokens/create", "key-5", `{}`)
+	serve(http.MethodPost, "/auth/tokens/create", "key-5", `{}`)
+	if calls != 10 {
+		t.Errorf("expected 10 handler calls, got %d", calls)
+	}
+
+	if w := serve(http.MethodPost, "/team/add", "ключ-1", `{}`); w.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for invalid key, got %d", w.Code)
+	}
+
+	// Без аутентификации ключи отделяются по IP адресу, а у субъекта своя область
+	member, _ := entity.NewPrincipal("u1", entity.RoleMember)
+	serveAs := func(remoteAddr string, principal *entity.Principal) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewBufferString(`{"id":"pr-9"}`))
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(IdempotencyKeyHeader, "key-9")
+		if principal != nil {
+			req = req.WithContext(infraLogger.WithPrincipal(req.Context(), principal))
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+	calls = 0
+	serveAs("10.0.0.1:51234", nil)
+	if w := serveAs("10.0.0.1:51235", nil); w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Error("expected retry from the same address to be replayed")
+	}
+	serveAs("10.0.0.2:51234", nil)
+	serveAs("10.0.0.1:51234", member)
+	if calls != 3 {
+		t.Errorf("expected other address and principal to run handler, got %d calls", calls)
+	}
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+)
+
+// RateLimiter решает, пропустить ли запрос клиента к маршруту. При отказе возвращает,
+// через сколько клиенту стоит повторить запрос
+type RateLimiter interface {
+	Allow(route, client string) (bool, time.Duration)
+}
+
+// AuthFailureLimiter считает неудачные попытки аутентификации клиента: Allow расходует попытку,
+// а Check проверяет, остались ли они, ничего не расходуя
+type AuthFailureLimiter interface {
+	RateLimiter
+	Check(route, client string) (bool, time.Duration)
+}
+
+// authFailureRoute маршрут лимитера неудачных попыток: они считаются по IP на все пути сразу
+const authFailureRoute = ""
+
+// AuthFailureLimit middleware ограничивает по IP адресу число ответов 401. Ставится перед
+// Authenticate: исчерпавший попытки адрес получает 429 с Retry-After до проверки токена,
+// поэтому перебор токенов упирается в лимит, а успешные запросы его не расходуют
+func AuthFailureLimit(limiter AuthFailureLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client := "ip:" + clientIP(r)
+
+			if allowed, retryAfter := limiter.Check(authFailureRoute, client); !allowed {
+				seconds := retryAfterSeconds(retryAfter)
+				w.Header().Set("Retry-After", strconv.Itoa(seconds))
+				presenter.RespondError(w, http.StatusTooManyRequests, presenter.ErrorCodeRateLimited,
+					fmt.Sprintf("too many failed authentication attempts, retry after %d seconds", seconds))
+				return
+			}
+
+			wrapped := newResponseWriter(w)
+			next.ServeHTTP(wrapped, r)
+
+			if wrapped.statusCode == http.StatusUnauthorized {
+				limiter.Allow(authFailureRoute, client)
+			}
+		})
+	}
+}
+
+// RateLimit middleware ограничивает частоту запросов клиента. Клиент - аутентифицированный
+// субъект, а без аутентификации - IP адрес (после chimw.RealIP он учитывает X-Forwarded-For).
+// Пути из exemptPaths не ограничиваются. При превышении лимита отвечает 429 с Retry-After
+func RateLimit(limiter RateLimiter, exemptPaths []string) func(http.Handler) http.Handler {
+	exempt := pathSet(exemptPaths)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exempt[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, retryAfter := limiter.Allow(r.URL.Path, clientKey(r))
+			if !allowed {
+				seconds := retryAfterSeconds(retryAfter)
+				w.Header().Set("Retry-After", strconv.Itoa(seconds))
+				presenter.RespondError(w, http.StatusTooManyRequests, presenter.ErrorCodeRateLimited,
+					fmt.Sprintf("rate limit exceeded, retry after %d seconds", seconds))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientKey ключ клиента: у субъектов и IP адресов разные префиксы, чтобы не пересекаться
+func clientKey(r *http.Request) string {
+	if principal, ok := logger.GetPrincipal(r.Context()); ok {
+		return "principal:" + principal.ID()
+	}
+	return "ip:" + clientIP(r)
+}
+
+// clientIP адрес клиента без порта
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		// chimw.RealIP записывает адрес без порта
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// retryAfterSeconds округляет ожидание вверх до целых секунд, как того требует Retry-After
+func retryAfterSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
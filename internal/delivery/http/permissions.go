@@ -0,0 +1,64 @@
+package http
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+// PublicPaths пути, доступные без Bearer токена. Вебхуки git-хостингов проверяют свою подпись.
+// /metrics не публичный: метрики раскрывают объем и состав работы команд
+var PublicPaths = []string{
+	"/health",
+	"/livez",
+	"/readyz",
+	"/webhooks/github",
+	"/webhooks/gitlab",
+}
+
+// RateLimitExemptPaths пути, которые не ограничиваются по частоте: их опрашивают
+// балансировщик и Prometheus
+var RateLimitExemptPaths = []string{
+	"/health",
+	"/livez",
+	"/readyz",
+	"/metrics",
+}
+
+// IdempotencyExemptPaths пути, ответы которых не сохраняются для Idempotency-Key:
+// ответ на создание API токена содержит открытое значение токена
+var IdempotencyExemptPaths = []string{
+	"/auth/tokens/create",
+}
+
+var (
+	allRoles     = entity.Roles()
+	managerRoles = []entity.Role{entity.RoleAdmin, entity.RoleTeamLead}
+	reviewRoles  = []entity.Role{entity.RoleAdmin, entity.RoleTeamLead, entity.RoleMember}
+)
+
+// RoutePermissions роли, которым разрешен путь. Пути, которых здесь нет
+// (API токены, подписки, сопоставление логинов, журнал аудита), доступны только администратору
+var RoutePermissions = map[string][]entity.Role{
+	"/team/add":               managerRoles,
+	"/team/get":               allRoles,
+	"/team/update":            managerRoles,
+	"/team/deactivateMembers": managerRoles,
+
+	"/users/setIsActive": managerRoles,
+	"/users/getReview":   allRoles,
+
+	"/pullRequest/create":         allRoles,
+	"/pullRequest/ready":          allRoles,
+	"/pullRequest/close":          allRoles,
+	"/pullRequest/reopen":         allRoles,
+	"/pullRequest/merge":          {entity.RoleAdmin, entity.RoleTeamLead, entity.RoleService},
+	"/pullRequest/reassign":       reviewRoles,
+	"/pullRequest/addReviewer":    reviewRoles,
+	"/pullRequest/removeReviewer": managerRoles,
+	"/pullRequest/review":         reviewRoles,
+	"/pullRequest/history":        allRoles,
+
+	"/statistics": allRoles,
+
+	"/auth/whoami": allRoles,
+
+	// Prometheus собирает метрики с токеном роли service
+	"/metrics": {entity.RoleAdmin, entity.RoleService},
+}
@@ -0,0 +1,19 @@
+package presenter
+
+import (
+	"net/http"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// RespondAuditPage отправляет страницу журнала аудита в формате API
+func RespondAuditPage(w http.ResponseWriter, statusCode int, page *dto.AuditPageDTO) {
+	if page == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "audit page is nil")
+		return
+	}
+	if page.Entries == nil {
+		page.Entries = []dto.AuditEntryDTO{}
+	}
+	RespondJSON(w, statusCode, page)
+}
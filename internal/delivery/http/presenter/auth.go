@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"net/http"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// RespondAPIToken отправляет API токен в формате API
+func RespondAPIToken(w http.ResponseWriter, statusCode int, token *dto.APITokenDTO) {
+	if token == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "api token data is nil")
+		return
+	}
+	RespondJSON(w, statusCode, map[string]*dto.APITokenDTO{
+		"token": token,
+	})
+}
+
+// RespondAPITokens отправляет список API токенов в формате API
+func RespondAPITokens(w http.ResponseWriter, statusCode int, tokens []dto.APITokenDTO) {
+	if tokens == nil {
+		tokens = []dto.APITokenDTO{}
+	}
+	RespondJSON(w, statusCode, map[string][]dto.APITokenDTO{
+		"tokens": tokens,
+	})
+}
+
+// RespondPrincipal отправляет аутентифицированного субъекта в формате API
+func RespondPrincipal(w http.ResponseWriter, statusCode int, principal dto.PrincipalDTO) {
+	RespondJSON(w, statusCode, map[string]dto.PrincipalDTO{
+		"principal": principal,
+	})
+}
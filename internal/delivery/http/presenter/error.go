@@ -7,18 +7,36 @@ type ErrorResponse struct {
 
 // ErrorDetail детали ошибки
 type ErrorDetail struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code    string   `json:"code"`
+	Message string   `json:"message"`
+	Details []string `json:"details,omitempty"`
 }
 
 // Error codes согласно OpenAPI
 const (
-	ErrorCodeTeamExists     = "TEAM_EXISTS"
-	ErrorCodePRExists       = "PR_EXISTS"
-	ErrorCodePRMerged       = "PR_MERGED"
-	ErrorCodeNotAssigned    = "NOT_ASSIGNED"
-	ErrorCodeNoCandidate    = "NO_CANDIDATE"
-	ErrorCodeNotFound       = "NOT_FOUND"
-	ErrorCodeInvalidRequest = "INVALID_REQUEST"
-	ErrorCodeInternalError  = "INTERNAL_ERROR"
+	ErrorCodeTeamExists            = "TEAM_EXISTS"
+	ErrorCodePRExists              = "PR_EXISTS"
+	ErrorCodePRMerged              = "PR_MERGED"
+	ErrorCodePRClosed              = "PR_CLOSED"
+	ErrorCodePRDraft               = "PR_DRAFT"
+	ErrorCodeInvalidTransition     = "INVALID_STATUS_TRANSITION"
+	ErrorCodeMergeBlocked          = "MERGE_BLOCKED"
+	ErrorCodeNotAssigned           = "NOT_ASSIGNED"
+	ErrorCodeAlreadyAssigned       = "ALREADY_ASSIGNED"
+	ErrorCodeAuthorCannotReview    = "AUTHOR_CANNOT_REVIEW"
+	ErrorCodeTooManyReviewers      = "TOO_MANY_REVIEWERS"
+	ErrorCodeTooFewReviewers       = "TOO_FEW_REVIEWERS"
+	ErrorCodeUserInactive          = "USER_INACTIVE"
+	ErrorCodeTeamNotAllowed        = "TEAM_NOT_ALLOWED"
+	ErrorCodeNoCandidate           = "NO_CANDIDATE"
+	ErrorCodeNotEnough             = "NOT_ENOUGH_REVIEWERS"
+	ErrorCodeNotFound              = "NOT_FOUND"
+	ErrorCodeInvalidSignature      = "INVALID_SIGNATURE"
+	ErrorCodeUnauthorized          = "UNAUTHORIZED"
+	ErrorCodeForbidden             = "FORBIDDEN"
+	ErrorCodeInvalidRequest        = "INVALID_REQUEST"
+	ErrorCodeRateLimited           = "RATE_LIMITED"
+	ErrorCodeIdempotencyReused     = "IDEMPOTENCY_KEY_REUSED"
+	ErrorCodeIdempotencyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	ErrorCodeInternalError         = "INTERNAL_ERROR"
 )
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"net/http"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// RespondHealth отправляет состояние сервиса: 200, если все проверки прошли, иначе 503
+func RespondHealth(w http.ResponseWriter, health *dto.HealthDTO) {
+	if health == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "health data is nil")
+		return
+	}
+
+	statusCode := http.StatusOK
+	if health.Status != dto.HealthStatusOK {
+		statusCode = http.StatusServiceUnavailable
+	}
+	RespondJSON(w, statusCode, health)
+}
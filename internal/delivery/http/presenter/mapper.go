@@ -15,9 +15,66 @@ func MapUseCaseError(err error) (int, string, string) {
 	if errors.Is(err, usecase.ErrTeamNotFound) {
 		return http.StatusNotFound, ErrorCodeNotFound, "team not found"
 	}
+	if errors.Is(err, usecase.ErrInvalidReviewState) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "invalid review state"
+	}
+	if errors.Is(err, usecase.ErrInvalidReviewerLimits) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "min_reviewers cannot be greater than max_reviewers"
+	}
+	if errors.Is(err, usecase.ErrInvalidFallbackTeams) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "invalid fallback_teams"
+	}
+	if errors.Is(err, usecase.ErrInvalidMergePolicy) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "invalid merge_policy"
+	}
+	if errors.Is(err, usecase.ErrCodeOwnerNotFound) {
+		return http.StatusNotFound, ErrorCodeNotFound, "code owner not found"
+	}
+	if errors.Is(err, usecase.ErrFallbackTeamNotFound) {
+		return http.StatusNotFound, ErrorCodeNotFound, "fallback team not found"
+	}
 	if errors.Is(err, usecase.ErrUserNotFound) {
 		return http.StatusNotFound, ErrorCodeNotFound, "user not found"
 	}
+	if errors.Is(err, usecase.ErrInvalidGitProvider) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "provider must be github or gitlab"
+	}
+	if errors.Is(err, usecase.ErrGitLoginNotLinked) {
+		return http.StatusNotFound, ErrorCodeNotFound, "git login is not linked to a user"
+	}
+	if errors.Is(err, usecase.ErrInvalidSubscription) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "invalid subscription"
+	}
+	if errors.Is(err, usecase.ErrSubscriptionNotFound) {
+		return http.StatusNotFound, ErrorCodeNotFound, "subscription not found"
+	}
+	if errors.Is(err, usecase.ErrUnauthenticated) {
+		return http.StatusUnauthorized, ErrorCodeUnauthorized, "authentication required"
+	}
+	if errors.Is(err, usecase.ErrForbidden) {
+		return http.StatusForbidden, ErrorCodeForbidden, "insufficient permissions"
+	}
+	if errors.Is(err, usecase.ErrInvalidAuditQuery) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "invalid audit query"
+	}
+	if errors.Is(err, usecase.ErrInvalidAPIToken) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "invalid api token"
+	}
+	if errors.Is(err, usecase.ErrAPITokenNotFound) {
+		return http.StatusNotFound, ErrorCodeNotFound, "api token not found"
+	}
+	if errors.Is(err, usecase.ErrInvalidIdempotencyKey) {
+		return http.StatusBadRequest, ErrorCodeInvalidRequest, "Idempotency-Key must be 1-255 printable ASCII characters"
+	}
+	if errors.Is(err, usecase.ErrIdempotencyKeyReused) {
+		return http.StatusUnprocessableEntity, ErrorCodeIdempotencyReused, "Idempotency-Key is already used with a different request"
+	}
+	if errors.Is(err, usecase.ErrIdempotencyKeyInProgress) {
+		return http.StatusConflict, ErrorCodeIdempotencyInProgress, "request with this Idempotency-Key is still in progress"
+	}
+	if errors.Is(err, usecase.ErrUserInactive) {
+		return http.StatusConflict, ErrorCodeUserInactive, "user is inactive"
+	}
 	if errors.Is(err, usecase.ErrPRAlreadyExists) {
 		return http.StatusConflict, ErrorCodePRExists, "PR id already exists"
 	}
@@ -25,13 +82,43 @@ func MapUseCaseError(err error) (int, string, string) {
 		return http.StatusNotFound, ErrorCodeNotFound, "pull request not found"
 	}
 	if errors.Is(err, usecase.ErrPRAlreadyMerged) {
-		return http.StatusConflict, ErrorCodePRMerged, "cannot reassign on merged PR"
+		return http.StatusConflict, ErrorCodePRMerged, "cannot change reviewers on merged PR"
+	}
+	if errors.Is(err, usecase.ErrPRClosed) {
+		return http.StatusConflict, ErrorCodePRClosed, "pull request is closed"
+	}
+	if errors.Is(err, usecase.ErrPRDraft) {
+		return http.StatusConflict, ErrorCodePRDraft, "pull request is a draft"
+	}
+	if errors.Is(err, usecase.ErrInvalidStatusTransition) {
+		return http.StatusConflict, ErrorCodeInvalidTransition, "status transition is not allowed"
+	}
+	if errors.Is(err, usecase.ErrMergeBlocked) {
+		return http.StatusConflict, ErrorCodeMergeBlocked, "merge is blocked by merge policy"
 	}
 	if errors.Is(err, usecase.ErrReviewerNotAssigned) {
 		return http.StatusConflict, ErrorCodeNotAssigned, "reviewer is not assigned to this PR"
 	}
+	if errors.Is(err, usecase.ErrReviewerAlreadyAssigned) {
+		return http.StatusConflict, ErrorCodeAlreadyAssigned, "reviewer is already assigned to this PR"
+	}
+	if errors.Is(err, usecase.ErrAuthorCannotReview) {
+		return http.StatusConflict, ErrorCodeAuthorCannotReview, "author cannot review their own PR"
+	}
+	if errors.Is(err, usecase.ErrReviewerTeamNotAllowed) {
+		return http.StatusConflict, ErrorCodeTeamNotAllowed, "reviewer team is not allowed for this PR"
+	}
+	if errors.Is(err, usecase.ErrTooManyReviewers) {
+		return http.StatusConflict, ErrorCodeTooManyReviewers, "PR already has max_reviewers reviewers"
+	}
+	if errors.Is(err, usecase.ErrTooFewReviewers) {
+		return http.StatusConflict, ErrorCodeTooFewReviewers, "PR cannot have fewer than min_reviewers reviewers"
+	}
 	if errors.Is(err, usecase.ErrNoActiveCandidates) {
 		return http.StatusConflict, ErrorCodeNoCandidate, "no active replacement candidate in team"
 	}
+	if errors.Is(err, usecase.ErrNotEnoughReviewers) {
+		return http.StatusConflict, ErrorCodeNotEnough, "not enough active reviewers in team to satisfy min_reviewers"
+	}
 	return http.StatusInternalServerError, ErrorCodeInternalError, "internal server error"
 }
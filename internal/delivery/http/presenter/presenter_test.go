@@ -3,6 +3,7 @@ package presenter
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -127,7 +128,7 @@ func TestMapUseCaseError(t *testing.T) {
 			err:            usecase.ErrPRAlreadyMerged,
 			wantStatusCode: http.StatusConflict,
 			wantCode:       ErrorCodePRMerged,
-			wantMessage:    "cannot reassign on merged PR",
+			wantMessage:    "cannot change reviewers on merged PR",
 		},
 		{
 			name:           "reviewer not assigned",
@@ -143,6 +144,195 @@ func TestMapUseCaseError(t *testing.T) {
 			wantCode:       ErrorCodeNoCandidate,
 			wantMessage:    "no active replacement candidate in team",
 		},
+		{
+			name:           "not enough reviewers",
+			err:            usecase.ErrNotEnoughReviewers,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeNotEnough,
+			wantMessage:    "not enough active reviewers in team to satisfy min_reviewers",
+		},
+		{
+			name:           "invalid reviewer limits",
+			err:            usecase.ErrInvalidReviewerLimits,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "min_reviewers cannot be greater than max_reviewers",
+		},
+		{
+			name:           "invalid fallback teams",
+			err:            usecase.ErrInvalidFallbackTeams,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "invalid fallback_teams",
+		},
+		{
+			name:           "fallback team not found",
+			err:            usecase.ErrFallbackTeamNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       ErrorCodeNotFound,
+			wantMessage:    "fallback team not found",
+		},
+		{
+			name:           "idempotency key reused",
+			err:            usecase.ErrIdempotencyKeyReused,
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantCode:       ErrorCodeIdempotencyReused,
+			wantMessage:    "Idempotency-Key is already used with a different request",
+		},
+		{
+			name:           "idempotency key in progress",
+			err:            usecase.ErrIdempotencyKeyInProgress,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeIdempotencyInProgress,
+			wantMessage:    "request with this Idempotency-Key is still in progress",
+		},
+		{
+			name:           "user inactive",
+			err:            usecase.ErrUserInactive,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeUserInactive,
+			wantMessage:    "user is inactive",
+		},
+		{
+			name:           "reviewer already assigned",
+			err:            usecase.ErrReviewerAlreadyAssigned,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeAlreadyAssigned,
+			wantMessage:    "reviewer is already assigned to this PR",
+		},
+		{
+			name:           "PR closed",
+			err:            usecase.ErrPRClosed,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodePRClosed,
+			wantMessage:    "pull request is closed",
+		},
+		{
+			name:           "PR draft",
+			err:            usecase.ErrPRDraft,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodePRDraft,
+			wantMessage:    "pull request is a draft",
+		},
+		{
+			name:           "invalid status transition",
+			err:            fmt.Errorf("%w: CLOSED -> CLOSED", usecase.ErrInvalidStatusTransition),
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeInvalidTransition,
+			wantMessage:    "status transition is not allowed",
+		},
+		{
+			name:           "merge blocked",
+			err:            &usecase.MergeBlockedError{Conditions: []string{"2 approvals required, got 0"}},
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeMergeBlocked,
+			wantMessage:    "merge is blocked by merge policy",
+		},
+		{
+			name:           "invalid merge policy",
+			err:            fmt.Errorf("%w: bad", usecase.ErrInvalidMergePolicy),
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "invalid merge_policy",
+		},
+		{
+			name:           "code owner not found",
+			err:            usecase.ErrCodeOwnerNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       ErrorCodeNotFound,
+			wantMessage:    "code owner not found",
+		},
+		{
+			name:           "invalid git provider",
+			err:            usecase.ErrInvalidGitProvider,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "provider must be github or gitlab",
+		},
+		{
+			name:           "invalid subscription",
+			err:            usecase.ErrInvalidSubscription,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "invalid subscription",
+		},
+		{
+			name:           "subscription not found",
+			err:            usecase.ErrSubscriptionNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       ErrorCodeNotFound,
+			wantMessage:    "subscription not found",
+		},
+		{
+			name:           "unauthenticated",
+			err:            usecase.ErrUnauthenticated,
+			wantStatusCode: http.StatusUnauthorized,
+			wantCode:       ErrorCodeUnauthorized,
+			wantMessage:    "authentication required",
+		},
+		{
+			name:           "forbidden",
+			err:            usecase.ErrForbidden,
+			wantStatusCode: http.StatusForbidden,
+			wantCode:       ErrorCodeForbidden,
+			wantMessage:    "insufficient permissions",
+		},
+		{
+			name:           "api token not found",
+			err:            usecase.ErrAPITokenNotFound,
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       ErrorCodeNotFound,
+			wantMessage:    "api token not found",
+		},
+		{
+			name:           "invalid audit query",
+			err:            fmt.Errorf("%w: malformed cursor", usecase.ErrInvalidAuditQuery),
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "invalid audit query",
+		},
+		{
+			name:           "git login not linked",
+			err:            usecase.ErrGitLoginNotLinked,
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       ErrorCodeNotFound,
+			wantMessage:    "git login is not linked to a user",
+		},
+		{
+			name:           "invalid review state",
+			err:            usecase.ErrInvalidReviewState,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       ErrorCodeInvalidRequest,
+			wantMessage:    "invalid review state",
+		},
+		{
+			name:           "author cannot review",
+			err:            usecase.ErrAuthorCannotReview,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeAuthorCannotReview,
+			wantMessage:    "author cannot review their own PR",
+		},
+		{
+			name:           "reviewer team not allowed",
+			err:            usecase.ErrReviewerTeamNotAllowed,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeTeamNotAllowed,
+			wantMessage:    "reviewer team is not allowed for this PR",
+		},
+		{
+			name:           "too many reviewers",
+			err:            usecase.ErrTooManyReviewers,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeTooManyReviewers,
+			wantMessage:    "PR already has max_reviewers reviewers",
+		},
+		{
+			name:           "too few reviewers",
+			err:            usecase.ErrTooFewReviewers,
+			wantStatusCode: http.StatusConflict,
+			wantCode:       ErrorCodeTooFewReviewers,
+			wantMessage:    "PR cannot have fewer than min_reviewers reviewers",
+		},
 		{
 			name:           "nil error",
 			err:            nil,
@@ -376,6 +566,78 @@ func TestRespondTeam(t *testing.T) {
 	})
 }
 
+func TestRespondTeamDeactivation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		team := &dto.TeamDTO{
+			TeamName: "team-1",
+			Members: []dto.TeamMemberDTO{
+				{UserID: "user-1", Username: "User 1", IsActive: false},
+			},
+		}
+		newUserID := "user-3"
+		reassignments := []dto.ReviewerReassignmentDTO{
+			{PullRequestID: "pr-1", OldUserID: "user-1", NewUserID: &newUserID},
+			{PullRequestID: "pr-2", OldUserID: "user-1"},
+		}
+
+		RespondTeamDeactivation(w, http.StatusOK, team, reassignments)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var result struct {
+			Team          *dto.TeamDTO                  `json:"team"`
+			Reassignments []dto.ReviewerReassignmentDTO `json:"reassignments"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if result.Team == nil || result.Team.TeamName != "team-1" {
+			t.Fatalf("expected team 'team-1' in response, got %+v", result.Team)
+		}
+
+		if len(result.Reassignments) != 2 {
+			t.Fatalf("expected 2 reassignments, got %d", len(result.Reassignments))
+		}
+
+		if result.Reassignments[0].NewUserID == nil || *result.Reassignments[0].NewUserID != "user-3" {
+			t.Errorf("expected first slot moved to 'user-3', got %v", result.Reassignments[0].NewUserID)
+		}
+
+		if result.Reassignments[1].NewUserID != nil {
+			t.Errorf("expected second slot to be released, got %v", *result.Reassignments[1].NewUserID)
+		}
+	})
+
+	t.Run("nil reassignments", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		RespondTeamDeactivation(w, http.StatusOK, &dto.TeamDTO{TeamName: "team-1"}, nil)
+
+		var result map[string]json.RawMessage
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if string(result["reassignments"]) != "[]" {
+			t.Errorf("expected empty reassignments array, got %s", result["reassignments"])
+		}
+	})
+
+	t.Run("nil team", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		RespondTeamDeactivation(w, http.StatusOK, nil, nil)
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+		}
+	})
+}
+
 func TestRespondUser(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -434,6 +696,48 @@ func TestRespondUser(t *testing.T) {
 	})
 }
 
+func TestRespondUserActivity(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		user := &dto.UserDTO{UserID: "user-1", Username: "User 1", TeamName: "team-1", IsActive: false}
+		newUserID := "user-2"
+
+		RespondUserActivity(w, http.StatusOK, user, []dto.ReviewerReassignmentDTO{
+			{PullRequestID: "pr-1", OldUserID: "user-1", NewUserID: &newUserID},
+		})
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var result struct {
+			User          *dto.UserDTO                  `json:"user"`
+			Reassignments []dto.ReviewerReassignmentDTO `json:"reassignments"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+
+		if result.User == nil || result.User.UserID != "user-1" {
+			t.Fatalf("expected user 'user-1' in response, got %+v", result.User)
+		}
+
+		if len(result.Reassignments) != 1 || *result.Reassignments[0].NewUserID != "user-2" {
+			t.Errorf("expected slot moved to 'user-2', got %+v", result.Reassignments)
+		}
+	})
+
+	t.Run("nil user", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		RespondUserActivity(w, http.StatusOK, nil, nil)
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+		}
+	})
+}
+
 func TestRespondUserReviews(t *testing.T) {
 	t.Run("success - with reviews", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -32,3 +32,14 @@ func RespondPullRequestReassign(w http.ResponseWriter, statusCode int, pr *dto.P
 		"replaced_by": replacedBy,
 	})
 }
+
+// RespondReviewerHistory отправляет историю назначений ревьюверов PR в формате API
+func RespondReviewerHistory(w http.ResponseWriter, statusCode int, prID string, history []dto.ReviewerHistoryEntryDTO) {
+	if history == nil {
+		history = []dto.ReviewerHistoryEntryDTO{}
+	}
+	RespondJSON(w, statusCode, map[string]interface{}{
+		"pull_request_id": prID,
+		"history":         history,
+	})
+}
@@ -28,6 +28,17 @@ func RespondError(w http.ResponseWriter, statusCode int, code, message string) {
 	})
 }
 
+// RespondErrorWithDetails отправляет ошибку в формате API со списком подробностей
+func RespondErrorWithDetails(w http.ResponseWriter, statusCode int, code, message string, details []string) {
+	RespondJSON(w, statusCode, ErrorResponse{
+		Error: ErrorDetail{
+			Code:    code,
+			Message: message,
+			Details: details,
+		},
+	})
+}
+
 // RespondSuccess отправляет успешный ответ с данными
 func RespondSuccess(w http.ResponseWriter, statusCode int, data interface{}) {
 	RespondJSON(w, statusCode, data)
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"net/http"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// RespondSubscription отправляет подписку в формате API
+func RespondSubscription(w http.ResponseWriter, statusCode int, subscription *dto.SubscriptionDTO) {
+	if subscription == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "subscription data is nil")
+		return
+	}
+	RespondJSON(w, statusCode, map[string]*dto.SubscriptionDTO{
+		"subscription": subscription,
+	})
+}
+
+// RespondSubscriptions отправляет список подписок в формате API
+func RespondSubscriptions(w http.ResponseWriter, statusCode int, subscriptions []dto.SubscriptionDTO) {
+	if subscriptions == nil {
+		subscriptions = []dto.SubscriptionDTO{}
+	}
+	RespondJSON(w, statusCode, map[string][]dto.SubscriptionDTO{
+		"subscriptions": subscriptions,
+	})
+}
+
+// RespondDeadLetters отправляет проваленные доставки подписки в формате API
+func RespondDeadLetters(w http.ResponseWriter, statusCode int, subscriptionID string, deadLetters []dto.DeadLetterDTO) {
+	if deadLetters == nil {
+		deadLetters = []dto.DeadLetterDTO{}
+	}
+	RespondJSON(w, statusCode, map[string]interface{}{
+		"subscription_id": subscriptionID,
+		"dead_letters":    deadLetters,
+	})
+}
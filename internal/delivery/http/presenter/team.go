@@ -16,3 +16,18 @@ func RespondTeam(w http.ResponseWriter, statusCode int, team *dto.TeamDTO) {
 		"team": team,
 	})
 }
+
+// RespondTeamDeactivation отправляет результат деактивации команды вместе с переносами слотов ревьюверов
+func RespondTeamDeactivation(w http.ResponseWriter, statusCode int, team *dto.TeamDTO, reassignments []dto.ReviewerReassignmentDTO) {
+	if team == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "team data is nil")
+		return
+	}
+	if reassignments == nil {
+		reassignments = []dto.ReviewerReassignmentDTO{}
+	}
+	RespondJSON(w, statusCode, map[string]interface{}{
+		"team":          team,
+		"reassignments": reassignments,
+	})
+}
@@ -17,6 +17,21 @@ func RespondUser(w http.ResponseWriter, statusCode int, user *dto.UserDTO) {
 	})
 }
 
+// RespondUserActivity отправляет пользователя после смены активности вместе с переносами его слотов ревьювера
+func RespondUserActivity(w http.ResponseWriter, statusCode int, user *dto.UserDTO, reassignments []dto.ReviewerReassignmentDTO) {
+	if user == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "user data is nil")
+		return
+	}
+	if reassignments == nil {
+		reassignments = []dto.ReviewerReassignmentDTO{}
+	}
+	RespondJSON(w, statusCode, map[string]interface{}{
+		"user":          user,
+		"reassignments": reassignments,
+	})
+}
+
 // RespondUserReviews отправляет список PR пользователя в формате API
 func RespondUserReviews(w http.ResponseWriter, statusCode int, userID string, prs []dto.PullRequestShortDTO) {
 	if prs == nil {
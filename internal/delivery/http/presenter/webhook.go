@@ -0,0 +1,27 @@
+package presenter
+
+import (
+	"net/http"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// RespondWebhookResult отправляет результат обработки доставки вебхука
+func RespondWebhookResult(w http.ResponseWriter, statusCode int, result *dto.WebhookResultDTO) {
+	if result == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "webhook result is nil")
+		return
+	}
+	RespondJSON(w, statusCode, result)
+}
+
+// RespondGitLogin отправляет сопоставление логина git-хостинга в формате API
+func RespondGitLogin(w http.ResponseWriter, statusCode int, login *dto.GitLoginDTO) {
+	if login == nil {
+		RespondError(w, http.StatusInternalServerError, ErrorCodeInternalError, "git login data is nil")
+		return
+	}
+	RespondJSON(w, statusCode, map[string]*dto.GitLoginDTO{
+		"git_login": login,
+	})
+}
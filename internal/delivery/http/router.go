@@ -5,38 +5,79 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	chimw "github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/handler"
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/middleware"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
 )
 
+// Metrics метрики HTTP запросов и их публикация на /metrics
+type Metrics interface {
+	middleware.RequestObserver
+	Handler() http.Handler
+}
+
 // Router настраивает HTTP роутер и middleware
 type Router struct {
-	teamHandler        *handler.TeamHandler
-	userHandler        *handler.UserHandler
-	pullRequestHandler *handler.PullRequestHandler
-	statisticsHandler  *handler.StatisticsHandler
-	logger             logger.Logger
-	maxBodySize        int64
+	teamHandler         *handler.TeamHandler
+	userHandler         *handler.UserHandler
+	pullRequestHandler  *handler.PullRequestHandler
+	statisticsHandler   *handler.StatisticsHandler
+	webhookHandler      *handler.WebhookHandler
+	subscriptionHandler *handler.SubscriptionHandler
+	authHandler         *handler.AuthHandler
+	auditHandler        *handler.AuditHandler
+	healthHandler       *handler.HealthHandler
+	authenticator       middleware.Authenticator
+	metrics             Metrics
+	rateLimiter         middleware.RateLimiter
+	authFailureLimiter  middleware.AuthFailureLimiter
+	idempotency         middleware.IdempotencyStore
+	logger              logger.Logger
+	maxBodySize         int64
 }
 
-// NewRouter создает новый Router
+// NewRouter создает новый Router. Если authenticator равен nil, аутентификация отключена,
+// если metrics равен nil - метрики не собираются и /metrics не публикуется,
+// если rateLimiter равен nil - частота запросов не ограничивается,
+// если authFailureLimiter равен nil - не ограничиваются неудачные попытки аутентификации,
+// если idempotency равен nil - заголовок Idempotency-Key не обрабатывается
 func NewRouter(
 	teamHandler *handler.TeamHandler,
 	userHandler *handler.UserHandler,
 	pullRequestHandler *handler.PullRequestHandler,
 	statisticsHandler *handler.StatisticsHandler,
+	webhookHandler *handler.WebhookHandler,
+	subscriptionHandler *handler.SubscriptionHandler,
+	authHandler *handler.AuthHandler,
+	auditHandler *handler.AuditHandler,
+	healthHandler *handler.HealthHandler,
+	authenticator middleware.Authenticator,
+	metrics Metrics,
+	rateLimiter middleware.RateLimiter,
+	authFailureLimiter middleware.AuthFailureLimiter,
+	idempotency middleware.IdempotencyStore,
 	logger logger.Logger,
 	maxBodySize int64,
 ) *Router {
 	return &Router{
-		teamHandler:        teamHandler,
-		userHandler:        userHandler,
-		pullRequestHandler: pullRequestHandler,
-		statisticsHandler:  statisticsHandler,
-		logger:             logger,
-		maxBodySize:        maxBodySize,
+		teamHandler:         teamHandler,
+		userHandler:         userHandler,
+		pullRequestHandler:  pullRequestHandler,
+		statisticsHandler:   statisticsHandler,
+		webhookHandler:      webhookHandler,
+		subscriptionHandler: subscriptionHandler,
+		authHandler:         authHandler,
+		auditHandler:        auditHandler,
+		healthHandler:       healthHandler,
+		authenticator:       authenticator,
+		metrics:             metrics,
+		rateLimiter:         rateLimiter,
+		authFailureLimiter:  authFailureLimiter,
+		idempotency:         idempotency,
+		logger:              logger,
+		maxBodySize:         maxBodySize,
 	}
 }
 
@@ -44,14 +85,36 @@ func NewRouter(
 func (r *Router) Setup() *chi.Mux {
 	router := chi.NewRouter()
 
+	// Трассировка первой, чтобы спан запроса был в контексте логов и всех следующих middleware
+	router.Use(middleware.Tracing(otel.GetTracerProvider(), otel.GetTextMapPropagator()))
 	router.Use(chimw.RequestID)
 	router.Use(middleware.RequestID)
+	if r.metrics != nil {
+		router.Use(middleware.Metrics(r.metrics))
+	}
 	router.Use(middleware.LimitBodySize(r.maxBodySize))
 	router.Use(middleware.Logger(r.logger))
 	router.Use(middleware.Recovery(r.logger))
 	router.Use(chimw.RealIP)
 	router.Use(chimw.NoCache)
 
+	// Неудачные попытки считаются по IP до аутентификации: иначе перебор токенов не ограничен
+	if r.authFailureLimiter != nil {
+		router.Use(middleware.AuthFailureLimit(r.authFailureLimiter))
+	}
+	if r.authenticator != nil {
+		router.Use(middleware.Authenticate(r.authenticator, PublicPaths))
+		router.Use(middleware.Authorize(RoutePermissions, PublicPaths))
+	}
+	// Лимит проверяется после аутентификации, чтобы квота считалась по субъекту, а не по IP
+	if r.rateLimiter != nil {
+		router.Use(middleware.RateLimit(r.rateLimiter, RateLimitExemptPaths))
+	}
+	// Ключи идемпотентности отделяются по субъекту или IP, а отклоненные запросы не занимают ключ
+	if r.idempotency != nil {
+		router.Use(middleware.Idempotency(r.idempotency, IdempotencyExemptPaths, r.logger))
+	}
+
 	healthHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/plain")
 		w.WriteHeader(http.StatusOK)
@@ -63,11 +126,20 @@ func (r *Router) Setup() *chi.Mux {
 	})
 	router.Method(http.MethodGet, "/health", healthHandler)
 	router.Method(http.MethodHead, "/health", healthHandler)
+	r.healthHandler.RegisterRoutes(router)
+
+	if r.metrics != nil {
+		router.Method(http.MethodGet, "/metrics", r.metrics.Handler())
+	}
 
 	r.teamHandler.RegisterRoutes(router)
 	r.userHandler.RegisterRoutes(router)
 	r.pullRequestHandler.RegisterRoutes(router)
 	r.statisticsHandler.RegisterRoutes(router)
+	r.webhookHandler.RegisterRoutes(router)
+	r.subscriptionHandler.RegisterRoutes(router)
+	r.authHandler.RegisterRoutes(router)
+	r.auditHandler.RegisterRoutes(router)
 
 	return router
 }
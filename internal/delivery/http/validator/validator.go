@@ -3,9 +3,12 @@ package validator
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/presenter"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
@@ -30,6 +33,9 @@ func ValidateCreateTeamRequest(req dto.CreateTeamRequest) []ValidationError {
 		})
 	}
 
+	errors = append(errors, validateReviewerSettings(req.ReviewerStrategy, req.MinReviewers, req.MaxReviewers)...)
+	errors = append(errors, validateFallbackTeams(req.TeamName, req.FallbackTeams)...)
+
 	if len(req.Members) == 0 {
 		errors = append(errors, ValidationError{
 			Field:   "members",
@@ -112,6 +118,76 @@ func ValidateMergePRRequest(req dto.MergePRRequest) []ValidationError {
 	return errors
 }
 
+// ValidateSubmitReviewRequest валидирует SubmitReviewRequest
+func ValidateSubmitReviewRequest(req dto.SubmitReviewRequest) []ValidationError {
+	var errors []ValidationError
+
+	if req.PullRequestID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "pull_request_id",
+			Message: "pull_request_id is required",
+		})
+	}
+
+	if req.UserID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "user_id",
+			Message: "user_id is required",
+		})
+	}
+
+	if _, err := entity.ParseReviewState(req.State); err != nil {
+		errors = append(errors, ValidationError{
+			Field:   "state",
+			Message: "state must be one of APPROVED, CHANGES_REQUESTED, COMMENTED",
+		})
+	}
+
+	return errors
+}
+
+// ValidateLinkGitLoginRequest валидирует LinkGitLoginRequest
+func ValidateLinkGitLoginRequest(req dto.LinkGitLoginRequest) []ValidationError {
+	var errors []ValidationError
+
+	if _, err := entity.ParseGitProvider(req.Provider); err != nil {
+		errors = append(errors, ValidationError{
+			Field:   "provider",
+			Message: "provider must be one of github, gitlab",
+		})
+	}
+
+	if strings.TrimSpace(req.Login) == "" {
+		errors = append(errors, ValidationError{
+			Field:   "login",
+			Message: "login is required",
+		})
+	}
+
+	if req.UserID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "user_id",
+			Message: "user_id is required",
+		})
+	}
+
+	return errors
+}
+
+// ValidateChangePRStatusRequest валидирует ChangePRStatusRequest
+func ValidateChangePRStatusRequest(req dto.ChangePRStatusRequest) []ValidationError {
+	var errors []ValidationError
+
+	if req.PullRequestID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "pull_request_id",
+			Message: "pull_request_id is required",
+		})
+	}
+
+	return errors
+}
+
 // ValidateReassignReviewerRequest валидирует ReassignReviewerRequest
 func ValidateReassignReviewerRequest(req dto.ReassignReviewerRequest) []ValidationError {
 	var errors []ValidationError
@@ -130,6 +206,44 @@ func ValidateReassignReviewerRequest(req dto.ReassignReviewerRequest) []Validati
 		})
 	}
 
+	if req.NewUserID != "" && req.NewUserID == req.OldUserID {
+		errors = append(errors, ValidationError{
+			Field:   "new_user_id",
+			Message: "new_user_id must differ from old_user_id",
+		})
+	}
+
+	return errors
+}
+
+// ValidateAddReviewerRequest валидирует AddReviewerRequest
+func ValidateAddReviewerRequest(req dto.AddReviewerRequest) []ValidationError {
+	return validateReviewerChange(req.PullRequestID, req.UserID)
+}
+
+// ValidateRemoveReviewerRequest валидирует RemoveReviewerRequest
+func ValidateRemoveReviewerRequest(req dto.RemoveReviewerRequest) []ValidationError {
+	return validateReviewerChange(req.PullRequestID, req.UserID)
+}
+
+// validateReviewerChange проверяет поля ручного назначения/снятия ревьювера
+func validateReviewerChange(pullRequestID, userID string) []ValidationError {
+	var errors []ValidationError
+
+	if pullRequestID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "pull_request_id",
+			Message: "pull_request_id is required",
+		})
+	}
+
+	if userID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "user_id",
+			Message: "user_id is required",
+		})
+	}
+
 	return errors
 }
 
@@ -147,6 +261,78 @@ func ValidateDeactivateTeamMembersRequest(req dto.DeactivateTeamMembersRequest)
 	return errors
 }
 
+// ValidateUpdateTeamRequest валидирует UpdateTeamRequest
+func ValidateUpdateTeamRequest(req dto.UpdateTeamRequest) []ValidationError {
+	var errors []ValidationError
+
+	if strings.TrimSpace(req.TeamName) == "" {
+		errors = append(errors, ValidationError{
+			Field:   "team_name",
+			Message: "team_name is required",
+		})
+	}
+
+	if req.ReviewerStrategy == nil && req.MinReviewers == nil && req.MaxReviewers == nil &&
+		req.FallbackTeams == nil && req.MergePolicy == nil {
+		errors = append(errors, ValidationError{
+			Field:   "team",
+			Message: "at least one of reviewer_strategy, min_reviewers, max_reviewers, fallback_teams, merge_policy is required",
+		})
+	}
+
+	strategy := ""
+	if req.ReviewerStrategy != nil {
+		strategy = *req.ReviewerStrategy
+	}
+	errors = append(errors, validateReviewerSettings(strategy, req.MinReviewers, req.MaxReviewers)...)
+
+	if req.FallbackTeams != nil {
+		errors = append(errors, validateFallbackTeams(req.TeamName, *req.FallbackTeams)...)
+	}
+
+	if req.MergePolicy != nil {
+		errors = append(errors, validateMergePolicy(*req.MergePolicy)...)
+	}
+
+	return errors
+}
+
+// validateReviewerSettings проверяет стратегию и ограничения на число ревьюверов.
+// Согласованность min и max с текущими значениями команды проверяется в use case
+func validateReviewerSettings(strategy string, minReviewers, maxReviewers *int) []ValidationError {
+	var errors []ValidationError
+
+	if strategy != "" && !entity.ReviewerStrategyName(strategy).IsValid() {
+		errors = append(errors, ValidationError{
+			Field:   "reviewer_strategy",
+			Message: "reviewer_strategy must be one of: least_loaded, random, weighted_random, round_robin",
+		})
+	}
+
+	if minReviewers != nil && *minReviewers < 0 {
+		errors = append(errors, ValidationError{
+			Field:   "min_reviewers",
+			Message: "min_reviewers must be non-negative",
+		})
+	}
+
+	if maxReviewers != nil && (*maxReviewers < 1 || *maxReviewers > entity.MaxReviewersLimit) {
+		errors = append(errors, ValidationError{
+			Field:   "max_reviewers",
+			Message: fmt.Sprintf("max_reviewers must be between 1 and %d", entity.MaxReviewersLimit),
+		})
+	}
+
+	if minReviewers != nil && maxReviewers != nil && *minReviewers > *maxReviewers {
+		errors = append(errors, ValidationError{
+			Field:   "min_reviewers",
+			Message: "min_reviewers cannot be greater than max_reviewers",
+		})
+	}
+
+	return errors
+}
+
 // RespondValidationErrors отправляет ошибки валидации в формате API
 func RespondValidationErrors(w http.ResponseWriter, errors []ValidationError) {
 	if len(errors) == 0 {
@@ -161,3 +347,262 @@ func RespondValidationErrors(w http.ResponseWriter, errors []ValidationError) {
 	message := strings.Join(messages, "; ")
 	presenter.RespondError(w, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest, message)
 }
+
+// validateFallbackTeams проверяет список запасных команд: без пустых имен, повторов и самой команды.
+// Существование команд проверяется в use case
+func validateFallbackTeams(teamName string, fallbackTeams []string) []ValidationError {
+	var errors []ValidationError
+
+	seen := make(map[string]bool, len(fallbackTeams))
+	for i, fallbackTeam := range fallbackTeams {
+		field := fmt.Sprintf("fallback_teams[%d]", i)
+		name := strings.TrimSpace(fallbackTeam)
+		switch {
+		case name == "":
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "team name cannot be empty",
+			})
+		case name == strings.TrimSpace(teamName):
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "team cannot be its own fallback",
+			})
+		case seen[name]:
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "duplicate fallback team",
+			})
+		}
+		seen[name] = true
+	}
+
+	return errors
+}
+
+// validateMergePolicy проверяет политику мержа: число одобрений и список владельцев кода без пустых значений и повторов.
+// Существование владельцев кода проверяется в use case
+func validateMergePolicy(policy dto.MergePolicyRequest) []ValidationError {
+	var errors []ValidationError
+
+	if policy.RequiredApprovals < 0 || policy.RequiredApprovals > entity.MaxReviewersLimit {
+		errors = append(errors, ValidationError{
+			Field:   "merge_policy.required_approvals",
+			Message: fmt.Sprintf("required_approvals must be between 0 and %d", entity.MaxReviewersLimit),
+		})
+	}
+
+	seen := make(map[string]bool, len(policy.CodeOwners))
+	for i, codeOwner := range policy.CodeOwners {
+		field := fmt.Sprintf("merge_policy.code_owners[%d]", i)
+		userID := strings.TrimSpace(codeOwner)
+		switch {
+		case userID == "":
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "user_id cannot be empty",
+			})
+		case seen[userID]:
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "duplicate code owner",
+			})
+		}
+		seen[userID] = true
+	}
+
+	return errors
+}
+
+// ValidateCreateSubscriptionRequest валидирует CreateSubscriptionRequest
+func ValidateCreateSubscriptionRequest(req dto.CreateSubscriptionRequest) []ValidationError {
+	var errors []ValidationError
+
+	errors = append(errors, validateSubscriptionURL(req.URL)...)
+
+	if req.Secret != "" {
+		errors = append(errors, validateSubscriptionSecret(req.Secret)...)
+	}
+
+	errors = append(errors, validateEventTypes(req.EventTypes)...)
+
+	return errors
+}
+
+// ValidateUpdateSubscriptionRequest валидирует UpdateSubscriptionRequest
+func ValidateUpdateSubscriptionRequest(req dto.UpdateSubscriptionRequest) []ValidationError {
+	var errors []ValidationError
+
+	if req.SubscriptionID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "subscription_id",
+			Message: "subscription_id is required",
+		})
+	}
+
+	if req.URL != nil {
+		errors = append(errors, validateSubscriptionURL(*req.URL)...)
+	}
+
+	if req.Secret != nil {
+		errors = append(errors, validateSubscriptionSecret(*req.Secret)...)
+	}
+
+	if req.EventTypes != nil {
+		errors = append(errors, validateEventTypes(*req.EventTypes)...)
+	}
+
+	return errors
+}
+
+// ValidateDeleteSubscriptionRequest валидирует DeleteSubscriptionRequest
+func ValidateDeleteSubscriptionRequest(req dto.DeleteSubscriptionRequest) []ValidationError {
+	var errors []ValidationError
+
+	if req.SubscriptionID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "subscription_id",
+			Message: "subscription_id is required",
+		})
+	}
+
+	return errors
+}
+
+// validateSubscriptionURL проверяет адрес доставки: абсолютный http или https
+func validateSubscriptionURL(rawURL string) []ValidationError {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return []ValidationError{{
+			Field:   "url",
+			Message: "url must be an absolute http or https URL",
+		}}
+	}
+	return nil
+}
+
+// validateSubscriptionSecret проверяет длину секрета подписи
+func validateSubscriptionSecret(secret string) []ValidationError {
+	if len(secret) < entity.MinSubscriptionSecretLength {
+		return []ValidationError{{
+			Field:   "secret",
+			Message: fmt.Sprintf("secret must be at least %d characters", entity.MinSubscriptionSecretLength),
+		}}
+	}
+	return nil
+}
+
+// validateEventTypes проверяет список типов событий: непустой, только известные типы, без повторов
+func validateEventTypes(eventTypes []string) []ValidationError {
+	if len(eventTypes) == 0 {
+		return []ValidationError{{
+			Field:   "event_types",
+			Message: "at least one event type is required",
+		}}
+	}
+
+	var errors []ValidationError
+	seen := make(map[string]bool, len(eventTypes))
+	for i, name := range eventTypes {
+		field := fmt.Sprintf("event_types[%d]", i)
+		switch _, err := entity.ParseEventType(name); {
+		case err != nil:
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "event type must be one of reviewer.assigned, reviewer.reassigned, pr.merged, user.deactivated",
+			})
+		case seen[name]:
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "duplicate event type",
+			})
+		}
+		seen[name] = true
+	}
+
+	return errors
+}
+
+// ValidateCreateAPITokenRequest валидирует CreateAPITokenRequest
+func ValidateCreateAPITokenRequest(req dto.CreateAPITokenRequest) []ValidationError {
+	var errors []ValidationError
+
+	if strings.TrimSpace(req.Subject) == "" {
+		errors = append(errors, ValidationError{
+			Field:   "subject",
+			Message: "subject is required",
+		})
+	}
+
+	if _, err := entity.ParseRole(req.Role); err != nil {
+		errors = append(errors, ValidationError{
+			Field:   "role",
+			Message: "role must be one of admin, team-lead, member, service",
+		})
+	}
+
+	return errors
+}
+
+// ValidateRevokeAPITokenRequest валидирует RevokeAPITokenRequest
+func ValidateRevokeAPITokenRequest(req dto.RevokeAPITokenRequest) []ValidationError {
+	var errors []ValidationError
+
+	if req.TokenID == "" {
+		errors = append(errors, ValidationError{
+			Field:   "token_id",
+			Message: "token_id is required",
+		})
+	}
+
+	return errors
+}
+
+// ValidateListAuditRequest валидирует фильтры журнала аудита
+func ValidateListAuditRequest(req dto.ListAuditRequest) []ValidationError {
+	var errors []ValidationError
+
+	if req.EntityType != "" {
+		if _, err := entity.ParseAuditEntityType(req.EntityType); err != nil {
+			errors = append(errors, ValidationError{
+				Field:   "entity_type",
+				Message: "entity_type must be one of team, user, pull_request, subscription, api_token, git_login",
+			})
+		}
+	}
+
+	from, fromErrors := validateTimestamp("from", req.From)
+	errors = append(errors, fromErrors...)
+	to, toErrors := validateTimestamp("to", req.To)
+	errors = append(errors, toErrors...)
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		errors = append(errors, ValidationError{
+			Field:   "to",
+			Message: "to must be after from",
+		})
+	}
+
+	if req.Limit < 0 || req.Limit > entity.MaxAuditPageSize {
+		errors = append(errors, ValidationError{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", entity.MaxAuditPageSize),
+		})
+	}
+
+	return errors
+}
+
+// validateTimestamp проверяет необязательную метку времени RFC 3339. Пустое значение возвращает нулевое время
+func validateTimestamp(field, value string) (time.Time, []ValidationError) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, []ValidationError{{
+			Field:   field,
+			Message: field + " must be an RFC 3339 timestamp",
+		}}
+	}
+	return parsed, nil
+}
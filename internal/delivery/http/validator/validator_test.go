@@ -60,6 +60,39 @@ func TestValidateCreateTeamRequest(t *testing.T) {
 			},
 			wantErrs: 1,
 		},
+		{
+			name: "valid reviewer strategy",
+			req: dto.CreateTeamRequest{
+				TeamName:         "team-1",
+				ReviewerStrategy: "round_robin",
+				Members: []dto.TeamMemberRequest{
+					{UserID: "user-1", Username: "User 1", IsActive: true},
+				},
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "unknown reviewer strategy",
+			req: dto.CreateTeamRequest{
+				TeamName:         "team-1",
+				ReviewerStrategy: "fastest",
+				Members: []dto.TeamMemberRequest{
+					{UserID: "user-1", Username: "User 1", IsActive: true},
+				},
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "team is its own fallback",
+			req: dto.CreateTeamRequest{
+				TeamName:      "team-1",
+				FallbackTeams: []string{"team-2", "team-1"},
+				Members: []dto.TeamMemberRequest{
+					{UserID: "user-1", Username: "User 1", IsActive: true},
+				},
+			},
+			wantErrs: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -190,6 +223,24 @@ func TestValidateReassignReviewerRequest(t *testing.T) {
 			},
 			wantErrs: 1,
 		},
+		{
+			name: "valid request with new_user_id",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+				NewUserID:     "reviewer-2",
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "new_user_id equals old_user_id",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+				NewUserID:     "reviewer-1",
+			},
+			wantErrs: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -239,3 +290,382 @@ func TestRespondValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUpdateTeamRequest(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	strPtr := func(v string) *string { return &v }
+
+	tests := []struct {
+		name     string
+		req      dto.UpdateTeamRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid limits",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", MinReviewers: intPtr(1), MaxReviewers: intPtr(3)},
+			wantErrs: 0,
+		},
+		{
+			name:     "reset strategy to default",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", ReviewerStrategy: strPtr("")},
+			wantErrs: 0,
+		},
+		{
+			name:     "empty team name",
+			req:      dto.UpdateTeamRequest{MaxReviewers: intPtr(1)},
+			wantErrs: 1,
+		},
+		{
+			name:     "nothing to update",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1"},
+			wantErrs: 1,
+		},
+		{
+			name:     "unknown strategy",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", ReviewerStrategy: strPtr("fastest")},
+			wantErrs: 1,
+		},
+		{
+			name:     "negative min and too large max",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", MinReviewers: intPtr(-1), MaxReviewers: intPtr(11)},
+			wantErrs: 2,
+		},
+		{
+			name:     "min greater than max",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", MinReviewers: intPtr(3), MaxReviewers: intPtr(2)},
+			wantErrs: 1,
+		},
+		{
+			name:     "valid fallback teams",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", FallbackTeams: &[]string{"team-2", "team-3"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "clear fallback teams",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", FallbackTeams: &[]string{}},
+			wantErrs: 0,
+		},
+		{
+			name:     "invalid fallback teams",
+			req:      dto.UpdateTeamRequest{TeamName: "team-1", FallbackTeams: &[]string{"team-1", "", "team-2", "team-2"}},
+			wantErrs: 3,
+		},
+		{
+			name: "valid merge policy",
+			req: dto.UpdateTeamRequest{TeamName: "team-1", MergePolicy: &dto.MergePolicyRequest{
+				RequiredApprovals: 2, BlockOnChangesRequested: true, CodeOwners: []string{"u1"},
+			}},
+			wantErrs: 0,
+		},
+		{
+			name: "invalid merge policy",
+			req: dto.UpdateTeamRequest{TeamName: "team-1", MergePolicy: &dto.MergePolicyRequest{
+				RequiredApprovals: 11, CodeOwners: []string{"u1", " ", "u1"},
+			}},
+			wantErrs: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateUpdateTeamRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d", tt.wantErrs, len(errs))
+			}
+		})
+	}
+}
+
+func TestValidateAddReviewerRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.AddReviewerRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid request",
+			req:      dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "user-1"},
+			wantErrs: 0,
+		},
+		{
+			name:     "empty user_id",
+			req:      dto.AddReviewerRequest{PullRequestID: "pr-1"},
+			wantErrs: 1,
+		},
+		{
+			name:     "empty request",
+			req:      dto.AddReviewerRequest{},
+			wantErrs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateAddReviewerRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d", tt.wantErrs, len(errs))
+			}
+		})
+	}
+}
+
+func TestValidateRemoveReviewerRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.RemoveReviewerRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid request",
+			req:      dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "user-1"},
+			wantErrs: 0,
+		},
+		{
+			name:     "empty pull_request_id",
+			req:      dto.RemoveReviewerRequest{UserID: "user-1"},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateRemoveReviewerRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d", tt.wantErrs, len(errs))
+			}
+		})
+	}
+}
+
+func TestValidateSubmitReviewRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.SubmitReviewRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid request",
+			req:      dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "user-1", State: "CHANGES_REQUESTED"},
+			wantErrs: 0,
+		},
+		{
+			name:     "pending state is not allowed",
+			req:      dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "user-1", State: "PENDING"},
+			wantErrs: 1,
+		},
+		{
+			name:     "all fields empty",
+			req:      dto.SubmitReviewRequest{},
+			wantErrs: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateSubmitReviewRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d", tt.wantErrs, len(errs))
+			}
+		})
+	}
+}
+
+func TestValidateLinkGitLoginRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.LinkGitLoginRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid request",
+			req:      dto.LinkGitLoginRequest{Provider: "gitlab", Login: "jane.doe", UserID: "user-1"},
+			wantErrs: 0,
+		},
+		{
+			name:     "unknown provider",
+			req:      dto.LinkGitLoginRequest{Provider: "bitbucket", Login: "jane.doe", UserID: "user-1"},
+			wantErrs: 1,
+		},
+		{
+			name:     "blank login",
+			req:      dto.LinkGitLoginRequest{Provider: "github", Login: "  ", UserID: "user-1"},
+			wantErrs: 1,
+		},
+		{
+			name:     "all fields empty",
+			req:      dto.LinkGitLoginRequest{},
+			wantErrs: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateLinkGitLoginRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d", tt.wantErrs, len(errs))
+			}
+		})
+	}
+}
+
+func TestValidateCreateSubscriptionRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.CreateSubscriptionRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid request without secret",
+			req:      dto.CreateSubscriptionRequest{URL: "https://hooks.example.com/pr", EventTypes: []string{"pr.merged", "reviewer.assigned"}},
+			wantErrs: 0,
+		},
+		{
+			name:     "relative url",
+			req:      dto.CreateSubscriptionRequest{URL: "/hooks", EventTypes: []string{"pr.merged"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "short secret",
+			req:      dto.CreateSubscriptionRequest{URL: "https://hooks.example.com/pr", Secret: "short", EventTypes: []string{"pr.merged"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "unknown and duplicate event types",
+			req:      dto.CreateSubscriptionRequest{URL: "https://hooks.example.com/pr", EventTypes: []string{"pr.opened", "pr.merged", "pr.merged"}},
+			wantErrs: 2,
+		},
+		{
+			name:     "all fields empty",
+			req:      dto.CreateSubscriptionRequest{},
+			wantErrs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateCreateSubscriptionRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d: %v", tt.wantErrs, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateSubscriptionRequest(t *testing.T) {
+	emptyTypes := []string{}
+	badURL := "ftp://hooks.example.com"
+
+	tests := []struct {
+		name     string
+		req      dto.UpdateSubscriptionRequest
+		wantErrs int
+	}{
+		{
+			name:     "only subscription_id",
+			req:      dto.UpdateSubscriptionRequest{SubscriptionID: "sub-1"},
+			wantErrs: 0,
+		},
+		{
+			name:     "missing subscription_id",
+			req:      dto.UpdateSubscriptionRequest{},
+			wantErrs: 1,
+		},
+		{
+			name:     "invalid url and empty event types",
+			req:      dto.UpdateSubscriptionRequest{SubscriptionID: "sub-1", URL: &badURL, EventTypes: &emptyTypes},
+			wantErrs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateUpdateSubscriptionRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d: %v", tt.wantErrs, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestValidateCreateAPITokenRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.CreateAPITokenRequest
+		wantErrs int
+	}{
+		{
+			name:     "valid",
+			req:      dto.CreateAPITokenRequest{Subject: "ci-bot", Role: "service"},
+			wantErrs: 0,
+		},
+		{
+			name:     "missing subject",
+			req:      dto.CreateAPITokenRequest{Role: "member"},
+			wantErrs: 1,
+		},
+		{
+			name:     "unknown role and missing subject",
+			req:      dto.CreateAPITokenRequest{Subject: " ", Role: "root"},
+			wantErrs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateCreateAPITokenRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d: %v", tt.wantErrs, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestValidateListAuditRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      dto.ListAuditRequest
+		wantErrs int
+	}{
+		{
+			name:     "empty filters",
+			req:      dto.ListAuditRequest{},
+			wantErrs: 0,
+		},
+		{
+			name: "all filters",
+			req: dto.ListAuditRequest{
+				EntityType: "pull_request",
+				EntityID:   "pr-1",
+				Actor:      "u1",
+				From:       "2025-01-01T00:00:00Z",
+				To:         "2025-02-01T00:00:00+03:00",
+				Limit:      100,
+			},
+			wantErrs: 0,
+		},
+		{
+			name:     "unknown entity type and bad timestamp",
+			req:      dto.ListAuditRequest{EntityType: "repository", From: "2025-01-01"},
+			wantErrs: 2,
+		},
+		{
+			name:     "empty time range",
+			req:      dto.ListAuditRequest{From: "2025-02-01T00:00:00Z", To: "2025-01-01T00:00:00Z"},
+			wantErrs: 1,
+		},
+		{
+			name:     "limit too large",
+			req:      dto.ListAuditRequest{Limit: 1000},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateListAuditRequest(tt.req)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("expected %d errors, got %d: %v", tt.wantErrs, len(errs), errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// Record изменение для журнала аудита. Before и After сериализуются в JSON как есть; nil - снимка нет.
+// Автор и request ID берутся из контекста при записи
+type Record struct {
+	Action     entity.AuditAction
+	EntityType entity.AuditEntityType
+	EntityID   string
+	Before     any
+	After      any
+	// Reason поясняет изменение, например причину выбора нового ревьювера
+	Reason string
+}
+
+// Recorder записывает изменения в журнал аудита.
+// Record вызывается внутри транзакции (txManager.Do), в которой записано изменение,
+// поэтому запись аудита откатывается вместе с ним
+type Recorder interface {
+	Record(ctx context.Context, records ...Record) error
+}
@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// APITokenPrefix префикс статических API токенов, отличает их от JWT
+const APITokenPrefix = "prt_"
+
+// APIToken статический API токен. Сам токен не хранится: в хранилище лежит только его SHA-256,
+// а открытое значение показывается один раз при создании
+type APIToken struct {
+	id        string
+	subject   string
+	role      Role
+	tokenHash string
+	createdAt time.Time
+	revokedAt *time.Time
+}
+
+// NewAPIToken создаёт токен для субъекта и возвращает его вместе с открытым значением
+func NewAPIToken(id, subject string, role Role) (*APIToken, string, error) {
+	normalizedID, err := validateAndNormalizeID(id)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrInvalidID, err)
+	}
+
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return nil, "", fmt.Errorf("%w: subject is required", ErrInvalidAPIToken)
+	}
+
+	if _, err := ParseRole(string(role)); err != nil {
+		return nil, "", err
+	}
+
+	plain := APITokenPrefix + rand.Text()
+
+	return &APIToken{
+		id:        normalizedID,
+		subject:   subject,
+		role:      role,
+		tokenHash: HashAPIToken(plain),
+		createdAt: time.Now().UTC(),
+	}, plain, nil
+}
+
+// NewAPITokenFromRepository восстанавливает токен из хранилища без валидации
+func NewAPITokenFromRepository(
+	id string,
+	subject string,
+	role Role,
+	tokenHash string,
+	createdAt time.Time,
+	revokedAt *time.Time,
+) *APIToken {
+	return &APIToken{
+		id:        id,
+		subject:   subject,
+		role:      role,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		revokedAt: revokedAt,
+	}
+}
+
+// HashAPIToken возвращает hex SHA-256 открытого значения токена.
+// Токен случайный и длинный, поэтому медленный хеш паролей не нужен
+func HashAPIToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *APIToken) ID() string {
+	return t.id
+}
+
+func (t *APIToken) Subject() string {
+	return t.subject
+}
+
+func (t *APIToken) Role() Role {
+	return t.role
+}
+
+func (t *APIToken) TokenHash() string {
+	return t.tokenHash
+}
+
+func (t *APIToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *APIToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+// IsRevoked возвращает true для отозванного токена
+func (t *APIToken) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+// Revoke отзывает токен. Повторный отзыв не меняет время отзыва
+func (t *APIToken) Revoke(now time.Time) {
+	if t.revokedAt == nil {
+		t.revokedAt = &now
+	}
+}
+
+// Principal возвращает субъекта, от имени которого действует токен
+func (t *APIToken) Principal() *Principal {
+	return &Principal{id: t.subject, role: t.role}
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	token, plain, err := NewAPIToken("t1", "ci-bot", RoleService)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(plain, APITokenPrefix) {
+		t.Errorf("expected plain token with prefix %q, got %q", APITokenPrefix, plain)
+	}
+	if token.TokenHash() != HashAPIToken(plain) {
+		t.Error("expected token hash to match plain token")
+	}
+	if strings.Contains(token.TokenHash(), plain) {
+		t.Error("expected plain token not to be stored")
+	}
+
+	principal := token.Principal()
+	if principal.ID() != "ci-bot" || principal.Role() != RoleService {
+		t.Errorf("expected ci-bot/service, got %s/%s", principal.ID(), principal.Role())
+	}
+
+	if _, _, err := NewAPIToken("t2", " ", RoleMember); !errors.Is(err, ErrInvalidAPIToken) {
+		t.Errorf("expected ErrInvalidAPIToken for empty subject, got %v", err)
+	}
+	if _, _, err := NewAPIToken("t3", "u1", Role("root")); !errors.Is(err, ErrInvalidRole) {
+		t.Errorf("expected ErrInvalidRole, got %v", err)
+	}
+}
+
+func TestAPIToken_Revoke(t *testing.T) {
+	token, _, _ := NewAPIToken("t1", "u1", RoleMember)
+	if token.IsRevoked() {
+		t.Fatal("expected new token not to be revoked")
+	}
+
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	token.Revoke(first)
+	token.Revoke(first.Add(time.Hour))
+
+	if !token.IsRevoked() || !token.RevokedAt().Equal(first) {
+		t.Errorf("expected token revoked at %v, got %v", first, token.RevokedAt())
+	}
+}
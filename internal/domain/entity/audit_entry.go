@@ -0,0 +1,220 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// AuditAction изменение, записанное в журнал аудита
+type AuditAction string
+
+const (
+	// AuditTeamCreated создана команда
+	AuditTeamCreated AuditAction = "team.created"
+	// AuditTeamUpdated изменены настройки выбора ревьюверов или политика мержа команды
+	AuditTeamUpdated AuditAction = "team.updated"
+	// AuditUserActivated пользователь активирован
+	AuditUserActivated AuditAction = "user.activated"
+	// AuditUserDeactivated пользователь деактивирован
+	AuditUserDeactivated AuditAction = "user.deactivated"
+	// AuditPRCreated создан PR
+	AuditPRCreated AuditAction = "pr.created"
+	// AuditReviewerReassigned слот ревьювера перешел к другому пользователю или освободился
+	AuditReviewerReassigned AuditAction = "pr.reviewer_reassigned"
+	// AuditReviewerAdded ревьювер назначен вручную
+	AuditReviewerAdded AuditAction = "pr.reviewer_added"
+	// AuditReviewerRemoved ревьювер снят вручную
+	AuditReviewerRemoved AuditAction = "pr.reviewer_removed"
+	// AuditPRMerged PR смержен
+	AuditPRMerged AuditAction = "pr.merged"
+	// AuditPRClosed PR закрыт без мержа
+	AuditPRClosed AuditAction = "pr.closed"
+	// AuditPRReopened закрытый PR открыт снова
+	AuditPRReopened AuditAction = "pr.reopened"
+	// AuditPRReady черновик переведен в OPEN
+	AuditPRReady AuditAction = "pr.ready"
+	// AuditReviewSubmitted ревьювер оставил ревью
+	AuditReviewSubmitted AuditAction = "pr.review_submitted"
+	// AuditSubscriptionCreated создана подписка на события
+	AuditSubscriptionCreated AuditAction = "subscription.created"
+	// AuditSubscriptionUpdated изменены адрес, секрет, события или активность подписки
+	AuditSubscriptionUpdated AuditAction = "subscription.updated"
+	// AuditSubscriptionDeleted подписка удалена
+	AuditSubscriptionDeleted AuditAction = "subscription.deleted"
+	// AuditAPITokenCreated выпущен API токен
+	AuditAPITokenCreated AuditAction = "api_token.created"
+	// AuditAPITokenRevoked API токен отозван
+	AuditAPITokenRevoked AuditAction = "api_token.revoked"
+	// AuditGitLoginLinked логин git-хостинга сопоставлен пользователю
+	AuditGitLoginLinked AuditAction = "git_login.linked"
+)
+
+// AuditEntityType тип сущности, к которой относится запись аудита
+type AuditEntityType string
+
+const (
+	AuditEntityTeam         AuditEntityType = "team"
+	AuditEntityUser         AuditEntityType = "user"
+	AuditEntityPullRequest  AuditEntityType = "pull_request"
+	AuditEntitySubscription AuditEntityType = "subscription"
+	AuditEntityAPIToken     AuditEntityType = "api_token"
+	AuditEntityGitLogin     AuditEntityType = "git_login"
+)
+
+// AuditActorAnonymous автор изменения, выполненного без аутентифицированного субъекта
+// (аутентификация выключена или запрос пришел на публичный путь, например вебхук)
+const AuditActorAnonymous = "anonymous"
+
+// Размер страницы журнала аудита
+const (
+	DefaultAuditPageSize = 50
+	MaxAuditPageSize     = 200
+)
+
+// AuditEntityTypes возвращает все типы сущностей аудита в порядке объявления
+func AuditEntityTypes() []AuditEntityType {
+	return []AuditEntityType{
+		AuditEntityTeam,
+		AuditEntityUser,
+		AuditEntityPullRequest,
+		AuditEntitySubscription,
+		AuditEntityAPIToken,
+		AuditEntityGitLogin,
+	}
+}
+
+// ParseAuditEntityType проверяет тип сущности аудита
+func ParseAuditEntityType(name string) (AuditEntityType, error) {
+	for _, entityType := range AuditEntityTypes() {
+		if string(entityType) == name {
+			return entityType, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown entity type %q", ErrInvalidAuditEntry, name)
+}
+
+// AuditEntry запись журнала аудита. Журнал только дополняется: записи не меняются и не удаляются.
+// Снимки before/after хранятся как JSON; nil означает, что состояния нет (например, before у создания)
+type AuditEntry struct {
+	seq        int64 // порядковый номер, назначается хранилищем; используется как курсор
+	id         string
+	action     AuditAction
+	entityType AuditEntityType
+	entityID   string
+	actor      string
+	requestID  string
+	before     []byte
+	after      []byte
+	reason     string
+	occurredAt time.Time
+}
+
+// NewAuditEntry создаёт запись аудита. Пустой actor заменяется на AuditActorAnonymous
+func NewAuditEntry(
+	id string,
+	action AuditAction,
+	entityType AuditEntityType,
+	entityID string,
+	actor string,
+	requestID string,
+	before []byte,
+	after []byte,
+	reason string,
+	occurredAt time.Time,
+) (*AuditEntry, error) {
+	if id == "" || action == "" || entityID == "" {
+		return nil, fmt.Errorf("%w: id, action and entity id are required", ErrInvalidAuditEntry)
+	}
+	if _, err := ParseAuditEntityType(string(entityType)); err != nil {
+		return nil, err
+	}
+	if actor == "" {
+		actor = AuditActorAnonymous
+	}
+
+	return &AuditEntry{
+		id:         id,
+		action:     action,
+		entityType: entityType,
+		entityID:   entityID,
+		actor:      actor,
+		requestID:  requestID,
+		before:     before,
+		after:      after,
+		reason:     reason,
+		occurredAt: occurredAt,
+	}, nil
+}
+
+// NewAuditEntryFromRepository восстанавливает запись аудита из хранилища без валидации
+func NewAuditEntryFromRepository(
+	seq int64,
+	id string,
+	action AuditAction,
+	entityType AuditEntityType,
+	entityID string,
+	actor string,
+	requestID string,
+	before []byte,
+	after []byte,
+	reason string,
+	occurredAt time.Time,
+) *AuditEntry {
+	return &AuditEntry{
+		seq:        seq,
+		id:         id,
+		action:     action,
+		entityType: entityType,
+		entityID:   entityID,
+		actor:      actor,
+		requestID:  requestID,
+		before:     before,
+		after:      after,
+		reason:     reason,
+		occurredAt: occurredAt,
+	}
+}
+
+func (e *AuditEntry) Seq() int64 {
+	return e.seq
+}
+
+func (e *AuditEntry) ID() string {
+	return e.id
+}
+
+func (e *AuditEntry) Action() AuditAction {
+	return e.action
+}
+
+func (e *AuditEntry) EntityType() AuditEntityType {
+	return e.entityType
+}
+
+func (e *AuditEntry) EntityID() string {
+	return e.entityID
+}
+
+func (e *AuditEntry) Actor() string {
+	return e.actor
+}
+
+func (e *AuditEntry) RequestID() string {
+	return e.requestID
+}
+
+func (e *AuditEntry) Before() []byte {
+	return e.before
+}
+
+func (e *AuditEntry) After() []byte {
+	return e.after
+}
+
+func (e *AuditEntry) Reason() string {
+	return e.reason
+}
+
+func (e *AuditEntry) OccurredAt() time.Time {
+	return e.occurredAt
+}
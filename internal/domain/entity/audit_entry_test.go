@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestNewAuditEntry проверяет обязательные поля записи аудита и подстановку анонимного автора
+func TestNewAuditEntry(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		entityType    AuditEntityType
+		entityID      string
+		actor         string
+		expectedActor string
+		expectedErr   error
+	}{
+		{name: "valid entry", entityType: AuditEntityTeam, entityID: "backend", actor: "u1", expectedActor: "u1"},
+		{name: "anonymous actor", entityType: AuditEntityUser, entityID: "u2", expectedActor: AuditActorAnonymous},
+		{name: "unknown entity type", entityType: "repository", entityID: "r1", actor: "u1", expectedErr: ErrInvalidAuditEntry},
+		{name: "empty entity id", entityType: AuditEntityPullRequest, actor: "u1", expectedErr: ErrInvalidAuditEntry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewAuditEntry("a1", AuditTeamCreated, tt.entityType, tt.entityID, tt.actor, "req-1", nil, []byte(`{}`), "", now)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if entry.Actor() != tt.expectedActor {
+				t.Errorf("expected actor %q, got %q", tt.expectedActor, entry.Actor())
+			}
+			if entry.Before() != nil {
+				t.Error("expected empty before snapshot")
+			}
+		})
+	}
+}
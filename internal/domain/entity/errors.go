@@ -24,6 +24,15 @@ var (
 	// ErrPRMerged возвращается при попытке изменить merged PR
 	ErrPRMerged = errors.New("pull request already merged")
 
+	// ErrPRClosed возвращается при попытке изменить ревьюверов закрытого PR
+	ErrPRClosed = errors.New("pull request is closed")
+
+	// ErrPRDraft возвращается при попытке изменить ревьюверов черновика
+	ErrPRDraft = errors.New("pull request is a draft")
+
+	// ErrInvalidStatusTransition возвращается при недопустимом переходе между статусами PR
+	ErrInvalidStatusTransition = errors.New("invalid pull request status transition")
+
 	// ErrAuthorCannotReview возвращается при попытке назначить автора ревьювером
 	ErrAuthorCannotReview = errors.New("author cannot review their own PR")
 
@@ -33,6 +42,48 @@ var (
 	// ErrReviewerNotAssigned возвращается при попытке удалить не назначенного ревьювера
 	ErrReviewerNotAssigned = errors.New("reviewer not assigned")
 
-	// ErrTooManyReviewers возвращается при попытке назначить больше MaxReviewersCount ревьюверов
+	// ErrInvalidReviewerStrategy возвращается при неизвестной стратегии выбора ревьюверов
+	ErrInvalidReviewerStrategy = errors.New("invalid reviewer strategy")
+
+	// ErrInvalidReviewerLimits возвращается при некорректных min_reviewers/max_reviewers
+	ErrInvalidReviewerLimits = errors.New("invalid reviewer limits")
+
+	// ErrInvalidFallbackTeams возвращается при некорректном списке запасных команд
+	ErrInvalidFallbackTeams = errors.New("invalid fallback teams")
+
+	// ErrInvalidMergePolicy возвращается при некорректной политике мержа
+	ErrInvalidMergePolicy = errors.New("invalid merge policy")
+
+	// ErrTooManyReviewers возвращается при попытке назначить больше max_reviewers ревьюверов
 	ErrTooManyReviewers = errors.New("too many reviewers")
+
+	// ErrTooFewReviewers возвращается при попытке оставить у PR меньше min_reviewers ревьюверов
+	ErrTooFewReviewers = errors.New("too few reviewers")
+
+	// ErrInvalidReviewState возвращается при неизвестном состоянии ревью
+	ErrInvalidReviewState = errors.New("invalid review state")
+
+	// ErrInvalidGitProvider возвращается при неизвестном git-хостинге
+	ErrInvalidGitProvider = errors.New("invalid git provider")
+
+	// ErrInvalidSubscription возвращается при некорректной подписке на события
+	ErrInvalidSubscription = errors.New("invalid subscription")
+
+	// ErrInvalidRole возвращается при неизвестной роли
+	ErrInvalidRole = errors.New("invalid role")
+
+	// ErrInvalidAPIToken возвращается при некорректных атрибутах API токена
+	ErrInvalidAPIToken = errors.New("invalid api token")
+
+	// ErrInvalidAuditEntry возвращается при некорректной записи журнала аудита
+	ErrInvalidAuditEntry = errors.New("invalid audit entry")
+
+	// ErrInvalidReviewerHistory возвращается при некорректном событии истории назначений ревьюверов
+	ErrInvalidReviewerHistory = errors.New("invalid reviewer history entry")
+
+	// ErrInvalidIdempotencyKey возвращается при пустом или слишком длинном Idempotency-Key
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+
+	// ErrTeamRequired возвращается при создании PR без команды
+	ErrTeamRequired = errors.New("team is required")
 )
@@ -0,0 +1,129 @@
+package entity
+
+import "time"
+
+// RetryPolicy политика повторов доставки: задержка удваивается после каждой неудачи, но не превышает MaxBackoff
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff возвращает задержку перед следующей попыткой после attempt неудачных
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+// EventDelivery доставка события одной подписке. Доставка, исчерпавшая попытки,
+// переносится в dead letter и больше не отправляется
+type EventDelivery struct {
+	id             string
+	subscriptionID string
+	eventID        string
+	eventType      EventType
+	payload        []byte
+	attempts       int
+	nextAttemptAt  time.Time
+	lastError      string
+	createdAt      time.Time
+	failedAt       *time.Time // не nil у доставки в dead letter
+}
+
+// NewEventDelivery создаёт доставку, готовую к немедленной отправке
+func NewEventDelivery(id, subscriptionID, eventID string, eventType EventType, payload []byte, now time.Time) *EventDelivery {
+	return &EventDelivery{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		nextAttemptAt:  now,
+		createdAt:      now,
+	}
+}
+
+// NewEventDeliveryFromRepository восстанавливает доставку из хранилища без валидации
+func NewEventDeliveryFromRepository(
+	id string,
+	subscriptionID string,
+	eventID string,
+	eventType EventType,
+	payload []byte,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastError string,
+	createdAt time.Time,
+	failedAt *time.Time,
+) *EventDelivery {
+	return &EventDelivery{
+		id:             id,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastError:      lastError,
+		createdAt:      createdAt,
+		failedAt:       failedAt,
+	}
+}
+
+func (d *EventDelivery) ID() string {
+	return d.id
+}
+
+func (d *EventDelivery) SubscriptionID() string {
+	return d.subscriptionID
+}
+
+func (d *EventDelivery) EventID() string {
+	return d.eventID
+}
+
+func (d *EventDelivery) EventType() EventType {
+	return d.eventType
+}
+
+func (d *EventDelivery) Payload() []byte {
+	return d.payload
+}
+
+func (d *EventDelivery) Attempts() int {
+	return d.attempts
+}
+
+func (d *EventDelivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *EventDelivery) LastError() string {
+	return d.lastError
+}
+
+func (d *EventDelivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *EventDelivery) FailedAt() *time.Time {
+	return d.failedAt
+}
+
+// RecordFailure учитывает неудачную попытку и планирует следующую по политике повторов.
+// Возвращает true, если попытки исчерпаны: доставка помечается как проваленная и уходит в dead letter
+func (d *EventDelivery) RecordFailure(reason string, now time.Time, policy RetryPolicy) bool {
+	d.attempts++
+	d.lastError = reason
+
+	if d.attempts >= policy.MaxAttempts {
+		d.failedAt = &now
+		return true
+	}
+
+	d.nextAttemptAt = now.Add(policy.Backoff(d.attempts))
+	return false
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRetryPolicy_Backoff проверяет экспоненциальный рост задержки с ограничением сверху
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 10 * time.Second, MaxBackoff: time.Minute}
+
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 1, expected: 10 * time.Second},
+		{attempt: 2, expected: 20 * time.Second},
+		{attempt: 3, expected: 40 * time.Second},
+		{attempt: 4, expected: time.Minute},
+		{attempt: 50, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		if delay := policy.Backoff(tt.attempt); delay != tt.expected {
+			t.Errorf("Backoff(%d): expected %s, got %s", tt.attempt, tt.expected, delay)
+		}
+	}
+}
+
+// TestEventDelivery_RecordFailure проверяет планирование повтора и перенос в dead letter
+func TestEventDelivery_RecordFailure(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+	now := time.Now().UTC()
+	delivery := NewEventDelivery("delivery-1", "sub-1", "event-1", EventPRMerged, []byte(`{}`), now)
+
+	if exhausted := delivery.RecordFailure("status 500", now, policy); exhausted {
+		t.Fatal("expected retry after first failure")
+	}
+	if delivery.Attempts() != 1 || delivery.LastError() != "status 500" {
+		t.Errorf("unexpected delivery state: attempts=%d last_error=%q", delivery.Attempts(), delivery.LastError())
+	}
+	if !delivery.NextAttemptAt().Equal(now.Add(time.Second)) {
+		t.Errorf("expected next attempt at %s, got %s", now.Add(time.Second), delivery.NextAttemptAt())
+	}
+	if delivery.FailedAt() != nil {
+		t.Error("expected failed_at to be empty before attempts are exhausted")
+	}
+
+	if exhausted := delivery.RecordFailure("timeout", now, policy); !exhausted {
+		t.Fatal("expected attempts to be exhausted")
+	}
+	if delivery.FailedAt() == nil || !delivery.FailedAt().Equal(now) {
+		t.Errorf("expected failed_at %s, got %v", now, delivery.FailedAt())
+	}
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "fmt"
+
+// EventType тип события об изменении назначений, на которое можно подписаться
+type EventType string
+
+const (
+	// EventReviewerAssigned PR получил новых ревьюверов
+	EventReviewerAssigned EventType = "reviewer.assigned"
+	// EventReviewerReassigned слот ревьювера перешел к другому пользователю или освободился
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	// EventPRMerged PR смержен
+	EventPRMerged EventType = "pr.merged"
+	// EventUserDeactivated пользователь деактивирован
+	EventUserDeactivated EventType = "user.deactivated"
+)
+
+// EventTypes возвращает все типы событий в порядке объявления
+func EventTypes() []EventType {
+	return []EventType{EventReviewerAssigned, EventReviewerReassigned, EventPRMerged, EventUserDeactivated}
+}
+
+// ParseEventType проверяет тип события
+func ParseEventType(name string) (EventType, error) {
+	for _, eventType := range EventTypes() {
+		if string(eventType) == name {
+			return eventType, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown event type %q", ErrInvalidSubscription, name)
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// GitProvider git-хостинг, присылающий вебхуки о pull requests
+type GitProvider string
+
+const (
+	GitProviderGitHub GitProvider = "github"
+	GitProviderGitLab GitProvider = "gitlab"
+)
+
+// ParseGitProvider проверяет имя git-хостинга
+func ParseGitProvider(name string) (GitProvider, error) {
+	switch GitProvider(name) {
+	case GitProviderGitHub, GitProviderGitLab:
+		return GitProvider(name), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidGitProvider, name)
+	}
+}
+
+// ExternalPullRequestID строит ID PR для pull request с git-хостинга.
+// Символы пути репозитория, недопустимые в ID, заменяются на подчёркивание:
+// github, acme/backend, 42 -> github-acme_backend-42
+func ExternalPullRequestID(provider GitProvider, repository string, number int64) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r > 0x7f || !idPattern.MatchString(string(r)) {
+			return '_'
+		}
+		return r
+	}, repository)
+
+	return fmt.Sprintf("%s-%s-%d", provider, normalized, number)
+}
+
+// ExternalPullRequestName приводит заголовок pull request с git-хостинга к допустимому названию PR:
+// недопустимые символы заменяются пробелами, длина обрезается до допустимой.
+// Для пустого заголовка возвращается "PR <number>"
+func ExternalPullRequestName(title string, number int64) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if !usernamePattern.MatchString(string(r)) {
+			return ' '
+		}
+		return r
+	}, title)
+	name := strings.Join(strings.Fields(cleaned), " ")
+
+	for len(name) > maxPRNameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = strings.TrimSpace(name[:len(name)-size])
+	}
+
+	if name == "" {
+		return fmt.Sprintf("PR %d", number)
+	}
+	return name
+}
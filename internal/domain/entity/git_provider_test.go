@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestParseGitProvider проверяет разбор имени git-хостинга
+func TestParseGitProvider(t *testing.T) {
+	for _, name := range []string{"github", "gitlab"} {
+		if _, err := ParseGitProvider(name); err != nil {
+			t.Errorf("ParseGitProvider(%q) unexpected error: %v", name, err)
+		}
+	}
+
+	if _, err := ParseGitProvider("GitHub"); !errors.Is(err, ErrInvalidGitProvider) {
+		t.Errorf("expected ErrInvalidGitProvider, got %v", err)
+	}
+}
+
+// TestExternalPullRequestID проверяет построение ID PR из репозитория и номера
+func TestExternalPullRequestID(t *testing.T) {
+	tests := []struct {
+		name       string
+		provider   GitProvider
+		repository string
+		number     int64
+		expected   string
+	}{
+		{name: "github repository", provider: GitProviderGitHub, repository: "acme/backend", number: 42, expected: "github-acme_backend-42"},
+		{name: "gitlab subgroup", provider: GitProviderGitLab, repository: "acme/platform/api.v2", number: 7, expected: "gitlab-acme_platform_api_v2-7"},
+		{name: "non-ascii path", provider: GitProviderGitLab, repository: "команда/api", number: 1, expected: "gitlab-________api-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := ExternalPullRequestID(tt.provider, tt.repository, tt.number)
+			if id != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, id)
+			}
+			if _, err := validateAndNormalizeID(id); err != nil {
+				t.Errorf("generated id %s is invalid: %v", id, err)
+			}
+		})
+	}
+}
+
+// TestExternalPullRequestName проверяет приведение заголовка к допустимому названию PR
+func TestExternalPullRequestName(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{name: "valid title", title: "Add webhooks", expected: "Add webhooks"},
+		{name: "punctuation replaced", title: "fix: handle nil (#12)", expected: "fix handle nil 12"},
+		{name: "whitespace collapsed", title: "  Add\n\twebhooks  ", expected: "Add webhooks"},
+		{name: "empty title", title: "!!!", expected: "PR 5"},
+		{name: "long title truncated", title: strings.Repeat("ы", 150), expected: strings.Repeat("ы", 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := ExternalPullRequestName(tt.title, 5)
+			if name != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, name)
+			}
+			if _, err := validateAndNormalizePRName(name); err != nil {
+				t.Errorf("generated name %q is invalid: %v", name, err)
+			}
+		})
+	}
+}
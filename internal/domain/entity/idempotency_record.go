@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxIdempotencyKeyLength максимальная длина Idempotency-Key
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyRecord запрос с заголовком Idempotency-Key и ответ на него.
+// Пока ответ не сохранен, запрос считается выполняющимся, а запись действует только на время
+// аренды: если выполнявший запрос экземпляр упал, ключ освободится без ожидания срока хранения ответа
+type IdempotencyRecord struct {
+	scope       string
+	key         string
+	requestHash string
+	statusCode  int
+	contentType string
+	body        []byte
+	createdAt   time.Time
+	expiresAt   time.Time
+}
+
+// NewIdempotencyRecord создаёт запись выполняющегося запроса. scope отделяет ключи разных клиентов,
+// requestHash - хеш метода, пути и тела запроса. Пока ответ не сохранен, запись действует lease
+func NewIdempotencyRecord(scope, key, requestHash string, now time.Time, lease time.Duration) (*IdempotencyRecord, error) {
+	if key == "" || len(key) > MaxIdempotencyKeyLength {
+		return nil, fmt.Errorf("%w: key must be 1 to %d characters", ErrInvalidIdempotencyKey, MaxIdempotencyKeyLength)
+	}
+	for _, c := range key {
+		if c < ' ' || c > '~' {
+			return nil, fmt.Errorf("%w: key must contain printable ASCII characters only", ErrInvalidIdempotencyKey)
+		}
+	}
+	if requestHash == "" {
+		return nil, fmt.Errorf("%w: request hash is required", ErrInvalidIdempotencyKey)
+	}
+	if lease <= 0 {
+		return nil, fmt.Errorf("%w: lease must be positive", ErrInvalidIdempotencyKey)
+	}
+
+	return &IdempotencyRecord{
+		scope:       scope,
+		key:         key,
+		requestHash: requestHash,
+		createdAt:   now,
+		expiresAt:   now.Add(lease),
+	}, nil
+}
+
+// NewIdempotencyRecordFromRepository восстанавливает запись из хранилища без валидации
+func NewIdempotencyRecordFromRepository(
+	scope string,
+	key string,
+	requestHash string,
+	statusCode int,
+	contentType string,
+	body []byte,
+	createdAt time.Time,
+	expiresAt time.Time,
+) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		scope:       scope,
+		key:         key,
+		requestHash: requestHash,
+		statusCode:  statusCode,
+		contentType: contentType,
+		body:        body,
+		createdAt:   createdAt,
+		expiresAt:   expiresAt,
+	}
+}
+
+func (r *IdempotencyRecord) Scope() string {
+	return r.scope
+}
+
+func (r *IdempotencyRecord) Key() string {
+	return r.key
+}
+
+func (r *IdempotencyRecord) RequestHash() string {
+	return r.requestHash
+}
+
+// StatusCode HTTP статус сохраненного ответа, 0 - запрос еще выполняется
+func (r *IdempotencyRecord) StatusCode() int {
+	return r.statusCode
+}
+
+func (r *IdempotencyRecord) ContentType() string {
+	return r.contentType
+}
+
+func (r *IdempotencyRecord) Body() []byte {
+	return r.body
+}
+
+func (r *IdempotencyRecord) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *IdempotencyRecord) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+// IsCompleted возвращает true, если ответ на запрос сохранен
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.statusCode != 0
+}
+
+// IsExpired возвращает true, если запись больше не действует
+func (r *IdempotencyRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+// Matches возвращает true, если ключ повторно использован с тем же запросом
+func (r *IdempotencyRecord) Matches(requestHash string) bool {
+	return r.requestHash == requestHash
+}
+
+// Complete сохраняет ответ на запрос. Вместо аренды запись действует до expiresAt
+func (r *IdempotencyRecord) Complete(statusCode int, contentType string, body []byte, expiresAt time.Time) {
+	r.statusCode = statusCode
+	r.contentType = contentType
+	r.body = body
+	r.expiresAt = expiresAt
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewIdempotencyRecord(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	record, err := NewIdempotencyRecord("u1", "retry-1", "hash", now, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.IsCompleted() {
+		t.Error("expected new record to be in progress")
+	}
+	if !record.ExpiresAt().Equal(now.Add(time.Minute)) {
+		t.Errorf("expected expiration after one minute lease, got %v", record.ExpiresAt())
+	}
+	if record.IsExpired(now.Add(time.Minute-time.Second)) || !record.IsExpired(now.Add(time.Minute)) {
+		t.Error("expected record to expire exactly after lease")
+	}
+	if !record.Matches("hash") || record.Matches("other") {
+		t.Error("expected record to match only its request hash")
+	}
+
+	record.Complete(201, "application/json", []byte(`{"ok":true}`), now.Add(time.Hour))
+	if !record.IsCompleted() || record.StatusCode() != 201 {
+		t.Errorf("expected completed record with status 201, got %d", record.StatusCode())
+	}
+	// Ответ хранится дольше аренды
+	if record.IsExpired(now.Add(time.Minute)) || !record.IsExpired(now.Add(time.Hour)) {
+		t.Error("expected completed record to expire after ttl")
+	}
+
+	for name, key := range map[string]string{
+		"empty":       "",
+		"too long":    strings.Repeat("k", MaxIdempotencyKeyLength+1),
+		"non-ascii":   "ключ",
+		"line breaks": "a\nb",
+	} {
+		if _, err := NewIdempotencyRecord("u1", key, "hash", now, time.Hour); !errors.Is(err, ErrInvalidIdempotencyKey) {
+			t.Errorf("%s: expected ErrInvalidIdempotencyKey, got %v", name, err)
+		}
+	}
+	if _, err := NewIdempotencyRecord("u1", "retry-1", "hash", now, 0); !errors.Is(err, ErrInvalidIdempotencyKey) {
+		t.Errorf("expected ErrInvalidIdempotencyKey for zero lease, got %v", err)
+	}
+}
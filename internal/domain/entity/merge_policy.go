@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MergePolicy политика мержа команды. Нулевое значение ничего не требует.
+// Запрос изменений блокирует мерж при любой настроенной политике; blockOnChangesRequested
+// включает эту блокировку и без других условий
+type MergePolicy struct {
+	requiredApprovals       int
+	blockOnChangesRequested bool
+	codeOwners              []string // если не пуст, PR должен одобрить хотя бы один из них
+}
+
+// NewMergePolicy создаёт политику мержа с валидацией: 0 <= requiredApprovals <= MaxReviewersLimit,
+// владельцы кода без пустых значений и повторов
+func NewMergePolicy(requiredApprovals int, blockOnChangesRequested bool, codeOwners []string) (MergePolicy, error) {
+	if requiredApprovals < 0 || requiredApprovals > MaxReviewersLimit {
+		return MergePolicy{}, fmt.Errorf("%w: required_approvals must be between 0 and %d", ErrInvalidMergePolicy, MaxReviewersLimit)
+	}
+
+	owners := make([]string, 0, len(codeOwners))
+	for _, owner := range codeOwners {
+		normalizedID, err := validateAndNormalizeID(owner)
+		if err != nil {
+			return MergePolicy{}, fmt.Errorf("%w: invalid code owner: %w", ErrInvalidMergePolicy, err)
+		}
+		if slices.Contains(owners, normalizedID) {
+			return MergePolicy{}, fmt.Errorf("%w: duplicate code owner %s", ErrInvalidMergePolicy, normalizedID)
+		}
+		owners = append(owners, normalizedID)
+	}
+
+	return MergePolicy{
+		requiredApprovals:       requiredApprovals,
+		blockOnChangesRequested: blockOnChangesRequested,
+		codeOwners:              owners,
+	}, nil
+}
+
+// NewMergePolicyFromRepository восстанавливает политику мержа из хранилища без валидации
+func NewMergePolicyFromRepository(requiredApprovals int, blockOnChangesRequested bool, codeOwners []string) MergePolicy {
+	return MergePolicy{
+		requiredApprovals:       requiredApprovals,
+		blockOnChangesRequested: blockOnChangesRequested,
+		codeOwners:              codeOwners,
+	}
+}
+
+func (p MergePolicy) RequiredApprovals() int {
+	return p.requiredApprovals
+}
+
+func (p MergePolicy) BlockOnChangesRequested() bool {
+	return p.blockOnChangesRequested
+}
+
+func (p MergePolicy) CodeOwners() []string {
+	owners := make([]string, len(p.codeOwners))
+	copy(owners, p.codeOwners)
+	return owners
+}
+
+// blocksOnChangesRequested сообщает, блокирует ли мерж запрос изменений: иначе PR с
+// нерешенным CHANGES_REQUESTED проходил бы политику, набрав одобрения остальных ревьюверов
+func (p MergePolicy) blocksOnChangesRequested() bool {
+	return p.blockOnChangesRequested || p.requiredApprovals > 0 || len(p.codeOwners) > 0
+}
+
+// Equal сравнивает две политики по значению
+func (p MergePolicy) Equal(other MergePolicy) bool {
+	return p.requiredApprovals == other.requiredApprovals &&
+		p.blockOnChangesRequested == other.blockOnChangesRequested &&
+		slices.Equal(p.codeOwners, other.codeOwners)
+}
+
+// UnmetConditions возвращает невыполненные условия политики для PR.
+// Пустой список означает, что PR можно мержить
+func (p MergePolicy) UnmetConditions(pr *PullRequest) []string {
+	var conditions []string
+
+	approved := make([]string, 0, len(pr.assignedReviewers))
+	var changesRequested []string
+	for _, review := range pr.Reviews() {
+		switch review.State() {
+		case ReviewStateApproved:
+			approved = append(approved, review.ReviewerID())
+		case ReviewStateChangesRequested:
+			changesRequested = append(changesRequested, review.ReviewerID())
+		}
+	}
+
+	if len(approved) < p.requiredApprovals {
+		conditions = append(conditions, fmt.Sprintf("%d approvals required, got %d", p.requiredApprovals, len(approved)))
+	}
+
+	if p.blocksOnChangesRequested() && len(changesRequested) > 0 {
+		conditions = append(conditions, fmt.Sprintf("changes requested by %s", strings.Join(changesRequested, ", ")))
+	}
+
+	if len(p.codeOwners) > 0 && !slices.ContainsFunc(approved, func(reviewerID string) bool {
+		return slices.Contains(p.codeOwners, reviewerID)
+	}) {
+		conditions = append(conditions, fmt.Sprintf("approval from a code owner required (%s)", strings.Join(p.codeOwners, ", ")))
+	}
+
+	return conditions
+}
@@ -0,0 +1,60 @@
+package entity
+
+import "time"
+
+// OutboxMessage доменное событие в outbox. Сообщение записывается в транзакции изменения,
+// а публикуется позже фоновым relay, поэтому опубликованы будут только закоммиченные события
+type OutboxMessage struct {
+	id         string
+	eventType  EventType
+	payload    []byte // JSON данных события
+	occurredAt time.Time
+	sentAt     *time.Time // nil, пока сообщение не опубликовано
+}
+
+// NewOutboxMessage создаёт неопубликованное сообщение
+func NewOutboxMessage(id string, eventType EventType, payload []byte, occurredAt time.Time) *OutboxMessage {
+	return &OutboxMessage{
+		id:         id,
+		eventType:  eventType,
+		payload:    payload,
+		occurredAt: occurredAt,
+	}
+}
+
+// NewOutboxMessageFromRepository восстанавливает сообщение из хранилища без валидации
+func NewOutboxMessageFromRepository(
+	id string,
+	eventType EventType,
+	payload []byte,
+	occurredAt time.Time,
+	sentAt *time.Time,
+) *OutboxMessage {
+	return &OutboxMessage{
+		id:         id,
+		eventType:  eventType,
+		payload:    payload,
+		occurredAt: occurredAt,
+		sentAt:     sentAt,
+	}
+}
+
+func (m *OutboxMessage) ID() string {
+	return m.id
+}
+
+func (m *OutboxMessage) EventType() EventType {
+	return m.eventType
+}
+
+func (m *OutboxMessage) Payload() []byte {
+	return m.payload
+}
+
+func (m *OutboxMessage) OccurredAt() time.Time {
+	return m.occurredAt
+}
+
+func (m *OutboxMessage) SentAt() *time.Time {
+	return m.sentAt
+}
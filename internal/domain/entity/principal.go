@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Role роль субъекта API, определяет доступные операции
+type Role string
+
+const (
+	// RoleAdmin полный доступ, включая токены, подписки и мерж в обход политики
+	RoleAdmin Role = "admin"
+	// RoleTeamLead управление командами и пользователями, мерж PR
+	RoleTeamLead Role = "team-lead"
+	// RoleMember работа с PR и ревью
+	RoleMember Role = "member"
+	// RoleService интеграции: создание и смена статуса PR
+	RoleService Role = "service"
+)
+
+// Roles возвращает все роли
+func Roles() []Role {
+	return []Role{RoleAdmin, RoleTeamLead, RoleMember, RoleService}
+}
+
+// ParseRole проверяет роль
+func ParseRole(name string) (Role, error) {
+	role := Role(name)
+	if !slices.Contains(Roles(), role) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRole, name)
+	}
+	return role, nil
+}
+
+// Principal аутентифицированный субъект запроса: пользователь или сервис
+type Principal struct {
+	id   string
+	role Role
+}
+
+// NewPrincipal создаёт субъекта с валидацией роли
+func NewPrincipal(id string, role Role) (*Principal, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w: principal id is required", ErrInvalidID)
+	}
+	if _, err := ParseRole(string(role)); err != nil {
+		return nil, err
+	}
+	return &Principal{id: id, role: role}, nil
+}
+
+func (p *Principal) ID() string {
+	return p.id
+}
+
+func (p *Principal) Role() Role {
+	return p.role
+}
+
+// HasRole возвращает true, если роль субъекта входит в список
+func (p *Principal) HasRole(roles ...Role) bool {
+	return slices.Contains(roles, p.role)
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPrincipal(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		role      Role
+		expectErr error
+	}{
+		{name: "valid", id: "u1", role: RoleTeamLead},
+		{name: "empty id", id: "", role: RoleMember, expectErr: ErrInvalidID},
+		{name: "unknown role", id: "u1", role: Role("root"), expectErr: ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := NewPrincipal(tt.id, tt.role)
+
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if principal.ID() != tt.id || principal.Role() != tt.role {
+				t.Errorf("expected %s/%s, got %s/%s", tt.id, tt.role, principal.ID(), principal.Role())
+			}
+		})
+	}
+}
+
+func TestPrincipal_HasRole(t *testing.T) {
+	principal, _ := NewPrincipal("u1", RoleService)
+
+	if !principal.HasRole(RoleAdmin, RoleService) {
+		t.Error("expected service principal to match service role")
+	}
+	if principal.HasRole(RoleAdmin, RoleTeamLead) {
+		t.Error("expected service principal not to match admin and team-lead roles")
+	}
+}
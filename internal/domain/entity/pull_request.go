@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"slices"
 	"time"
 )
 
@@ -9,25 +10,61 @@ import (
 type PRStatus string
 
 const (
+	PRStatusDraft  PRStatus = "DRAFT"
 	PRStatusOpen   PRStatus = "OPEN"
 	PRStatusMerged PRStatus = "MERGED"
-
-	MaxReviewersCount = 2
+	PRStatusClosed PRStatus = "CLOSED"
 )
 
+// prStatusTransitions допустимые переходы между статусами PR.
+// MERGED конечный статус, из CLOSED PR можно только переоткрыть
+var prStatusTransitions = map[PRStatus][]PRStatus{
+	PRStatusDraft:  {PRStatusOpen, PRStatusClosed},
+	PRStatusOpen:   {PRStatusMerged, PRStatusClosed},
+	PRStatusClosed: {PRStatusOpen},
+}
+
+// CanTransitionTo проверяет, допустим ли переход из текущего статуса в target
+func (s PRStatus) CanTransitionTo(target PRStatus) bool {
+	for _, allowed := range prStatusTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
 // PullRequest представляет Pull Request в доменной модели
 type PullRequest struct {
 	id                string
 	name              string
 	authorID          string
+	teamName          string // команда автора на момент создания PR
 	status            PRStatus
 	assignedReviewers []string
+	fallbackReviewers map[string]bool   // ревьюверы, назначенные из запасных команд
+	reviews           map[string]Review // последние ревью назначенных ревьюверов
+	reviewerLimits    ReviewerLimits    // ограничения команды на момент создания PR
 	createdAt         time.Time
 	mergedAt          *time.Time // nullable заполняется при merge
+	mergeForced       bool       // PR смержен в обход политики мержа команды
 }
 
-// NewPullRequest создаёт новый Pull Request с валидацией
-func NewPullRequest(id, name, authorID string) (*PullRequest, error) {
+// NewDraftPullRequest создаёт PR в статусе DRAFT.
+// Ревьюверы черновику не назначаются, пока он не будет переведен в OPEN
+func NewDraftPullRequest(id, name, authorID string, team *Team) (*PullRequest, error) {
+	pr, err := NewPullRequest(id, name, authorID, team)
+	if err != nil {
+		return nil, err
+	}
+
+	pr.status = PRStatusDraft
+	return pr, nil
+}
+
+// NewPullRequest создаёт новый Pull Request с валидацией.
+// PR запоминает команду автора и её ограничения на число ревьюверов на момент создания
+func NewPullRequest(id, name, authorID string, team *Team) (*PullRequest, error) {
 	normalizedID, err := validateAndNormalizeID(id)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidID, err)
@@ -43,14 +80,22 @@ func NewPullRequest(id, name, authorID string) (*PullRequest, error) {
 		return nil, fmt.Errorf("invalid author_id: %w: %w", ErrInvalidID, err)
 	}
 
+	if team == nil {
+		return nil, ErrTeamRequired
+	}
+
 	now := time.Now().UTC()
 
 	return &PullRequest{
 		id:                normalizedID,
 		name:              normalizedName,
 		authorID:          normalizedAuthorID,
+		teamName:          team.Name(),
 		status:            PRStatusOpen,
 		assignedReviewers: []string{},
+		fallbackReviewers: make(map[string]bool),
+		reviews:           make(map[string]Review),
+		reviewerLimits:    team.ReviewerLimits(),
 		createdAt:         now,
 		mergedAt:          nil,
 	}, nil
@@ -61,19 +106,39 @@ func NewPullRequestFromRepository(
 	id string,
 	name string,
 	authorID string,
+	teamName string,
 	status PRStatus,
 	assignedReviewers []string,
+	fallbackReviewers []string,
+	reviews []Review,
+	reviewerLimits ReviewerLimits,
 	createdAt time.Time,
 	mergedAt *time.Time,
+	mergeForced bool,
 ) *PullRequest {
+	fallback := make(map[string]bool, len(fallbackReviewers))
+	for _, reviewerID := range fallbackReviewers {
+		fallback[reviewerID] = true
+	}
+
+	reviewsByReviewer := make(map[string]Review, len(reviews))
+	for _, review := range reviews {
+		reviewsByReviewer[review.ReviewerID()] = review
+	}
+
 	return &PullRequest{
 		id:                id,
 		name:              name,
 		authorID:          authorID,
+		teamName:          teamName,
 		status:            status,
 		assignedReviewers: assignedReviewers,
+		fallbackReviewers: fallback,
+		reviews:           reviewsByReviewer,
+		reviewerLimits:    reviewerLimits,
 		createdAt:         createdAt,
 		mergedAt:          mergedAt,
+		mergeForced:       mergeForced,
 	}
 }
 
@@ -89,6 +154,16 @@ func (pr *PullRequest) AuthorID() string {
 	return pr.authorID
 }
 
+// TeamName возвращает команду, в которой был создан PR
+func (pr *PullRequest) TeamName() string {
+	return pr.teamName
+}
+
+// ReviewerLimits возвращает ограничения на число ревьюверов, действующие для PR
+func (pr *PullRequest) ReviewerLimits() ReviewerLimits {
+	return pr.reviewerLimits
+}
+
 func (pr *PullRequest) Status() PRStatus {
 	return pr.status
 }
@@ -99,6 +174,58 @@ func (pr *PullRequest) AssignedReviewers() []string {
 	return reviewers
 }
 
+// FallbackReviewers возвращает ревьюверов из запасных команд в порядке назначения
+func (pr *PullRequest) FallbackReviewers() []string {
+	reviewers := make([]string, 0, len(pr.fallbackReviewers))
+	for _, reviewerID := range pr.assignedReviewers {
+		if pr.fallbackReviewers[reviewerID] {
+			reviewers = append(reviewers, reviewerID)
+		}
+	}
+	return reviewers
+}
+
+// IsFallbackReviewer возвращает true если ревьювер назначен из запасной команды
+func (pr *PullRequest) IsFallbackReviewer(reviewerID string) bool {
+	return pr.fallbackReviewers[reviewerID]
+}
+
+// ReviewOf возвращает последнее ревью ревьювера (PENDING, если ревью еще не было)
+func (pr *PullRequest) ReviewOf(reviewerID string) Review {
+	if review, ok := pr.reviews[reviewerID]; ok {
+		return review
+	}
+	return Review{reviewerID: reviewerID, state: ReviewStatePending}
+}
+
+// Reviews возвращает ревью всех назначенных ревьюверов в порядке назначения
+func (pr *PullRequest) Reviews() []Review {
+	reviews := make([]Review, 0, len(pr.assignedReviewers))
+	for _, reviewerID := range pr.assignedReviewers {
+		reviews = append(reviews, pr.ReviewOf(reviewerID))
+	}
+	return reviews
+}
+
+// ReviewDecision агрегирует ревью: CHANGES_REQUESTED, если изменения запросил хотя бы один ревьювер,
+// APPROVED, если одобрили все назначенные ревьюверы, иначе REVIEW_REQUIRED
+func (pr *PullRequest) ReviewDecision() ReviewDecision {
+	approved := 0
+	for _, review := range pr.Reviews() {
+		switch review.State() {
+		case ReviewStateChangesRequested:
+			return ReviewDecisionChangesRequested
+		case ReviewStateApproved:
+			approved++
+		}
+	}
+
+	if approved > 0 && approved == len(pr.assignedReviewers) {
+		return ReviewDecisionApproved
+	}
+	return ReviewDecisionReviewRequired
+}
+
 func (pr *PullRequest) CreatedAt() time.Time {
 	return pr.createdAt
 }
@@ -107,6 +234,11 @@ func (pr *PullRequest) MergedAt() *time.Time {
 	return pr.mergedAt
 }
 
+// MergeForced возвращает true, если PR смержен в обход политики мержа
+func (pr *PullRequest) MergeForced() bool {
+	return pr.mergeForced
+}
+
 // IsOpen возвращает true если PR в статусе OPEN
 func (pr *PullRequest) IsOpen() bool {
 	return pr.status == PRStatusOpen
@@ -117,10 +249,49 @@ func (pr *PullRequest) IsMerged() bool {
 	return pr.status == PRStatusMerged
 }
 
+// IsDraft возвращает true если PR в статусе DRAFT
+func (pr *PullRequest) IsDraft() bool {
+	return pr.status == PRStatusDraft
+}
+
+// IsClosed возвращает true если PR закрыт без мержа
+func (pr *PullRequest) IsClosed() bool {
+	return pr.status == PRStatusClosed
+}
+
+// checkReviewersEditable проверяет, что состав ревьюверов можно менять.
+// Менять ревьюверов можно только у открытого PR: у черновика их еще нет, у закрытого они заморожены
+func (pr *PullRequest) checkReviewersEditable() error {
+	switch pr.status {
+	case PRStatusMerged:
+		return ErrPRMerged
+	case PRStatusClosed:
+		return ErrPRClosed
+	case PRStatusDraft:
+		return ErrPRDraft
+	default:
+		return nil
+	}
+}
+
+// HasEnoughReviewers возвращает true если назначено не меньше min_reviewers ревьюверов
+func (pr *PullRequest) HasEnoughReviewers() bool {
+	return len(pr.assignedReviewers) >= pr.reviewerLimits.Min()
+}
+
 // AddReviewer добавляет ревьювера к PR
 func (pr *PullRequest) AddReviewer(reviewerID string) error {
-	if pr.IsMerged() {
-		return ErrPRMerged
+	return pr.addReviewer(reviewerID, false)
+}
+
+// AddFallbackReviewer добавляет ревьювера из запасной команды
+func (pr *PullRequest) AddFallbackReviewer(reviewerID string) error {
+	return pr.addReviewer(reviewerID, true)
+}
+
+func (pr *PullRequest) addReviewer(reviewerID string, fallback bool) error {
+	if err := pr.checkReviewersEditable(); err != nil {
+		return err
 	}
 
 	normalizedID, err := validateAndNormalizeID(reviewerID)
@@ -138,19 +309,32 @@ func (pr *PullRequest) AddReviewer(reviewerID string) error {
 		}
 	}
 
-	if len(pr.assignedReviewers) >= MaxReviewersCount {
+	if len(pr.assignedReviewers) >= pr.reviewerLimits.Max() {
 		return ErrTooManyReviewers
 	}
 
 	pr.assignedReviewers = append(pr.assignedReviewers, normalizedID)
+	if fallback {
+		pr.fallbackReviewers[normalizedID] = true
+	}
 
 	return nil
 }
 
-// RemoveReviewer удаляет ревьювера из PR
+// RemoveReviewer удаляет ревьювера из PR, не допуская меньше min_reviewers ревьюверов
 func (pr *PullRequest) RemoveReviewer(reviewerID string) error {
-	if pr.IsMerged() {
-		return ErrPRMerged
+	return pr.removeReviewer(reviewerID, true)
+}
+
+// ReleaseReviewer освобождает слот ревьювера без проверки min_reviewers.
+// Используется, когда ревьювер ушел (деактивирован), а замены для него нет
+func (pr *PullRequest) ReleaseReviewer(reviewerID string) error {
+	return pr.removeReviewer(reviewerID, false)
+}
+
+func (pr *PullRequest) removeReviewer(reviewerID string, keepMin bool) error {
+	if err := pr.checkReviewersEditable(); err != nil {
+		return err
 	}
 
 	normalizedID, err := validateAndNormalizeID(reviewerID)
@@ -159,7 +343,7 @@ func (pr *PullRequest) RemoveReviewer(reviewerID string) error {
 	}
 
 	found := false
-	newReviewers := make([]string, 0, len(pr.assignedReviewers)-1)
+	newReviewers := make([]string, 0, len(pr.assignedReviewers))
 	for _, existingReviewer := range pr.assignedReviewers {
 		if existingReviewer == normalizedID {
 			found = true
@@ -172,15 +356,23 @@ func (pr *PullRequest) RemoveReviewer(reviewerID string) error {
 		return ErrReviewerNotAssigned
 	}
 
+	if keepMin && len(newReviewers) < pr.reviewerLimits.Min() {
+		return ErrTooFewReviewers
+	}
+
 	pr.assignedReviewers = newReviewers
+	delete(pr.fallbackReviewers, normalizedID)
+	delete(pr.reviews, normalizedID)
 
 	return nil
 }
 
-// ReplaceReviewer заменяет одного ревьювера на другого
-func (pr *PullRequest) ReplaceReviewer(oldReviewerID, newReviewerID string) error {
-	if pr.IsMerged() {
-		return ErrPRMerged
+// ReplaceReviewer заменяет одного ревьювера на другого.
+// Новый ревьювер занимает место старого в списке, признак запасной команды задает isFallback.
+// Ревью старого ревьювера к нему не переходит
+func (pr *PullRequest) ReplaceReviewer(oldReviewerID, newReviewerID string, isFallback bool) error {
+	if err := pr.checkReviewersEditable(); err != nil {
+		return err
 	}
 
 	normalizedOldID, err := validateAndNormalizeID(oldReviewerID)
@@ -207,6 +399,11 @@ func (pr *PullRequest) ReplaceReviewer(oldReviewerID, newReviewerID string) erro
 	for i, existingReviewer := range pr.assignedReviewers {
 		if existingReviewer == normalizedOldID {
 			pr.assignedReviewers[i] = normalizedNewID
+			delete(pr.reviews, normalizedOldID)
+			delete(pr.fallbackReviewers, normalizedOldID)
+			if isFallback {
+				pr.fallbackReviewers[normalizedNewID] = true
+			}
 			found = true
 			break
 		}
@@ -219,9 +416,36 @@ func (pr *PullRequest) ReplaceReviewer(oldReviewerID, newReviewerID string) erro
 	return nil
 }
 
-// Merge помечает PR как смерженный
+// SubmitReview записывает ревью назначенного ревьювера, повторное ревью заменяет предыдущее.
+// Оставить ревью можно только у открытого PR
+func (pr *PullRequest) SubmitReview(reviewerID string, state ReviewState) error {
+	if err := pr.checkReviewersEditable(); err != nil {
+		return err
+	}
+
+	if _, err := ParseReviewState(string(state)); err != nil {
+		return err
+	}
+
+	normalizedID, err := validateAndNormalizeID(reviewerID)
+	if err != nil {
+		return fmt.Errorf("invalid reviewer_id: %w: %w", ErrInvalidID, err)
+	}
+
+	if !slices.Contains(pr.assignedReviewers, normalizedID) {
+		return ErrReviewerNotAssigned
+	}
+
+	now := time.Now().UTC()
+	pr.reviews[normalizedID] = Review{reviewerID: normalizedID, state: state, submittedAt: &now}
+
+	return nil
+}
+
+// Merge помечает PR как смерженный.
+// Смержить можно только открытый PR, для остальных статусов возвращает false
 func (pr *PullRequest) Merge() bool {
-	if pr.IsMerged() {
+	if !pr.status.CanTransitionTo(PRStatusMerged) {
 		return false
 	}
 
@@ -232,6 +456,55 @@ func (pr *PullRequest) Merge() bool {
 	return true
 }
 
+// ForceMerge мержит PR в обход политики мержа и запоминает это
+func (pr *PullRequest) ForceMerge() bool {
+	if !pr.Merge() {
+		return false
+	}
+
+	pr.mergeForced = true
+	return true
+}
+
+// MarkReady переводит черновик в OPEN
+func (pr *PullRequest) MarkReady() error {
+	if !pr.IsDraft() {
+		return pr.transitionError(PRStatusOpen)
+	}
+
+	pr.status = PRStatusOpen
+	return nil
+}
+
+// Close закрывает PR без мержа, ревьюверы остаются замороженными
+func (pr *PullRequest) Close() error {
+	if !pr.status.CanTransitionTo(PRStatusClosed) {
+		return pr.transitionError(PRStatusClosed)
+	}
+
+	pr.status = PRStatusClosed
+	return nil
+}
+
+// Reopen возвращает закрытый PR в OPEN
+func (pr *PullRequest) Reopen() error {
+	if !pr.IsClosed() {
+		return pr.transitionError(PRStatusOpen)
+	}
+
+	pr.status = PRStatusOpen
+	return nil
+}
+
+// transitionError возвращает ошибку недопустимого перехода в target.
+// Для смерженного PR возвращается ErrPRMerged, так как MERGED конечный статус
+func (pr *PullRequest) transitionError(target PRStatus) error {
+	if pr.IsMerged() {
+		return ErrPRMerged
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, pr.status, target)
+}
+
 // Equals сравнивает два PR по идентификатору
 func (pr *PullRequest) Equals(other *PullRequest) bool {
 	if other == nil {
@@ -74,7 +74,7 @@ func TestNewPullRequest(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pr, err := NewPullRequest(tt.prID, tt.prName, tt.authorID)
+			pr, err := NewPullRequest(tt.prID, tt.prName, tt.authorID, newTestTeam())
 
 			if tt.wantErr {
 				if err == nil {
@@ -123,7 +123,7 @@ func TestNewPullRequest(t *testing.T) {
 
 // TestPullRequestAddReviewer проверяет добавление ревьюверов
 func TestPullRequestAddReviewer(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 
 	err := pr.AddReviewer("reviewer-1")
 	if err != nil {
@@ -140,8 +140,8 @@ func TestPullRequestAddReviewer(t *testing.T) {
 	if err != nil {
 		t.Fatalf("AddReviewer() failed: %v", err)
 	}
-	if len(pr.AssignedReviewers()) != MaxReviewersCount {
-		t.Errorf("AssignedReviewers length = %d, want %d", len(pr.AssignedReviewers()), MaxReviewersCount)
+	if len(pr.AssignedReviewers()) != DefaultMaxReviewers {
+		t.Errorf("AssignedReviewers length = %d, want %d", len(pr.AssignedReviewers()), DefaultMaxReviewers)
 	}
 
 	err = pr.AddReviewer("reviewer-3")
@@ -152,7 +152,7 @@ func TestPullRequestAddReviewer(t *testing.T) {
 
 // TestPullRequestAddReviewerValidation проверяет валидацию при добавлении
 func TestPullRequestAddReviewerValidation(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 
 	err := pr.AddReviewer("author-1")
 	if !errors.Is(err, ErrAuthorCannotReview) {
@@ -175,7 +175,7 @@ func TestPullRequestAddReviewerValidation(t *testing.T) {
 
 // TestPullRequestRemoveReviewer проверяет удаление ревьюверов
 func TestPullRequestRemoveReviewer(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 	//nolint:errcheck
 	_ = pr.AddReviewer("reviewer-1")
 	//nolint:errcheck
@@ -206,20 +206,73 @@ func TestPullRequestRemoveReviewer(t *testing.T) {
 	}
 }
 
+// TestPullRequestTeamReviewerLimits проверяет, что PR соблюдает ограничения команды, в которой создан
+func TestPullRequestTeamReviewerLimits(t *testing.T) {
+	limits, err := NewReviewerLimits(1, 3)
+	if err != nil {
+		t.Fatalf("NewReviewerLimits() failed: %v", err)
+	}
+	team := NewTeamFromRepository("security", ReviewerStrategyDefault, limits, nil, MergePolicy{}, time.Now(), time.Now())
+
+	pr, err := NewPullRequest("pr-1", "Test PR", "author-1", team)
+	if err != nil {
+		t.Fatalf("NewPullRequest() failed: %v", err)
+	}
+	if pr.TeamName() != "security" {
+		t.Errorf("TeamName() = %v, want security", pr.TeamName())
+	}
+	if pr.HasEnoughReviewers() {
+		t.Errorf("HasEnoughReviewers() = true, want false without reviewers")
+	}
+
+	for _, id := range []string{"reviewer-1", "reviewer-2", "reviewer-3"} {
+		if err := pr.AddReviewer(id); err != nil {
+			t.Fatalf("AddReviewer(%s) failed: %v", id, err)
+		}
+	}
+	if err := pr.AddReviewer("reviewer-4"); !errors.Is(err, ErrTooManyReviewers) {
+		t.Errorf("AddReviewer() error = %v, want ErrTooManyReviewers", err)
+	}
+
+	// Изменение ограничений команды не влияет на уже созданный PR
+	//nolint:errcheck
+	_ = team.ChangeReviewerLimits(DefaultReviewerLimits())
+	if pr.ReviewerLimits().Max() != 3 {
+		t.Errorf("ReviewerLimits().Max() = %d, want 3", pr.ReviewerLimits().Max())
+	}
+
+	_ = pr.RemoveReviewer("reviewer-1")
+	_ = pr.RemoveReviewer("reviewer-2")
+	if err := pr.RemoveReviewer("reviewer-3"); !errors.Is(err, ErrTooFewReviewers) {
+		t.Errorf("RemoveReviewer() error = %v, want ErrTooFewReviewers", err)
+	}
+
+	if err := pr.ReleaseReviewer("reviewer-3"); err != nil {
+		t.Fatalf("ReleaseReviewer() failed: %v", err)
+	}
+	if len(pr.AssignedReviewers()) != 0 {
+		t.Errorf("AssignedReviewers length = %d, want 0", len(pr.AssignedReviewers()))
+	}
+
+	if _, err := NewPullRequest("pr-2", "Test PR", "author-1", nil); !errors.Is(err, ErrTeamRequired) {
+		t.Errorf("NewPullRequest(nil team) error = %v, want ErrTeamRequired", err)
+	}
+}
+
 // TestPullRequestReplaceReviewer проверяет замену ревьювера
 func TestPullRequestReplaceReviewer(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 	_ = pr.AddReviewer("reviewer-1")
 	_ = pr.AddReviewer("reviewer-2")
 
-	err := pr.ReplaceReviewer("reviewer-1", "reviewer-3")
+	err := pr.ReplaceReviewer("reviewer-1", "reviewer-3", false)
 	if err != nil {
 		t.Fatalf("ReplaceReviewer() failed: %v", err)
 	}
 
 	reviewers := pr.AssignedReviewers()
-	if len(reviewers) != MaxReviewersCount {
-		t.Errorf("AssignedReviewers length = %d, want %d", len(reviewers), MaxReviewersCount)
+	if len(reviewers) != DefaultMaxReviewers {
+		t.Errorf("AssignedReviewers length = %d, want %d", len(reviewers), DefaultMaxReviewers)
 	}
 	if reviewers[0] != "reviewer-3" {
 		t.Errorf("First reviewer = %v, want reviewer-3", reviewers[0])
@@ -228,17 +281,17 @@ func TestPullRequestReplaceReviewer(t *testing.T) {
 		t.Errorf("Second reviewer = %v, want reviewer-2", reviewers[1])
 	}
 
-	err = pr.ReplaceReviewer("reviewer-999", "reviewer-4")
+	err = pr.ReplaceReviewer("reviewer-999", "reviewer-4", false)
 	if !errors.Is(err, ErrReviewerNotAssigned) {
 		t.Errorf("ReplaceReviewer(not assigned) error = %v, want ErrReviewerNotAssigned", err)
 	}
 
-	err = pr.ReplaceReviewer("reviewer-3", "reviewer-2")
+	err = pr.ReplaceReviewer("reviewer-3", "reviewer-2", false)
 	if !errors.Is(err, ErrReviewerAlreadyAssigned) {
 		t.Errorf("ReplaceReviewer(already assigned) error = %v, want ErrReviewerAlreadyAssigned", err)
 	}
 
-	err = pr.ReplaceReviewer("reviewer-3", "author-1")
+	err = pr.ReplaceReviewer("reviewer-3", "author-1", false)
 	if !errors.Is(err, ErrAuthorCannotReview) {
 		t.Errorf("ReplaceReviewer(author) error = %v, want ErrAuthorCannotReview", err)
 	}
@@ -246,7 +299,7 @@ func TestPullRequestReplaceReviewer(t *testing.T) {
 
 // TestPullRequestMerge проверяет merge PR
 func TestPullRequestMerge(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 
 	if pr.IsMerged() {
 		t.Errorf("IsMerged() = true, want false initially")
@@ -277,7 +330,7 @@ func TestPullRequestMerge(t *testing.T) {
 
 // TestPullRequestOperationsAfterMerge проверяет, что после merge нельзя менять ревьюверов
 func TestPullRequestOperationsAfterMerge(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 	_ = pr.AddReviewer("reviewer-1")
 	pr.Merge()
 
@@ -291,7 +344,7 @@ func TestPullRequestOperationsAfterMerge(t *testing.T) {
 		t.Errorf("RemoveReviewer() after merge error = %v, want ErrPRMerged", err)
 	}
 
-	err = pr.ReplaceReviewer("reviewer-1", "reviewer-2")
+	err = pr.ReplaceReviewer("reviewer-1", "reviewer-2", false)
 	if !errors.Is(err, ErrPRMerged) {
 		t.Errorf("ReplaceReviewer() after merge error = %v, want ErrPRMerged", err)
 	}
@@ -299,7 +352,7 @@ func TestPullRequestOperationsAfterMerge(t *testing.T) {
 
 // TestPullRequestAssignedReviewersImmutability проверяет защиту от изменения списка
 func TestPullRequestAssignedReviewersImmutability(t *testing.T) {
-	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1")
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
 	_ = pr.AddReviewer("reviewer-1")
 	_ = pr.AddReviewer("reviewer-2")
 
@@ -312,16 +365,16 @@ func TestPullRequestAssignedReviewersImmutability(t *testing.T) {
 	if actualReviewers[0] == "hacker" {
 		t.Errorf("Internal state was modified! AssignedReviewers() should return a copy")
 	}
-	if len(actualReviewers) != MaxReviewersCount {
-		t.Errorf("Internal state was modified! Length changed from %d to %d", MaxReviewersCount, len(actualReviewers))
+	if len(actualReviewers) != DefaultMaxReviewers {
+		t.Errorf("Internal state was modified! Length changed from %d to %d", DefaultMaxReviewers, len(actualReviewers))
 	}
 }
 
 // TestPullRequestEquals проверяет сравнение PR
 func TestPullRequestEquals(t *testing.T) {
-	pr1, _ := NewPullRequest("pr-1", "Test PR 1", "author-1")
-	pr2, _ := NewPullRequest("pr-1", "Different Name", "author-2")
-	pr3, _ := NewPullRequest("pr-2", "Test PR 2", "author-1")
+	pr1, _ := NewPullRequest("pr-1", "Test PR 1", "author-1", newTestTeam())
+	pr2, _ := NewPullRequest("pr-1", "Different Name", "author-2", newTestTeam())
+	pr3, _ := NewPullRequest("pr-2", "Test PR 2", "author-1", newTestTeam())
 
 	if !pr1.Equals(pr2) {
 		t.Errorf("pr1.Equals(pr2) = false, want true (same ID)")
@@ -343,7 +396,7 @@ func TestPullRequestEquals(t *testing.T) {
 
 // TestPullRequestLifecycle проверяет полный жизненный цикл PR
 func TestPullRequestLifecycle(t *testing.T) {
-	pr, err := NewPullRequest("pr-123", "Implement feature X", "john-doe")
+	pr, err := NewPullRequest("pr-123", "Implement feature X", "john-doe", newTestTeam())
 	if err != nil {
 		t.Fatalf("Failed to create PR: %v", err)
 	}
@@ -355,7 +408,7 @@ func TestPullRequestLifecycle(t *testing.T) {
 		t.Fatalf("Failed to add second reviewer: %v", err)
 	}
 
-	if err := pr.ReplaceReviewer("alice", "charlie"); err != nil {
+	if err := pr.ReplaceReviewer("alice", "charlie", false); err != nil {
 		t.Fatalf("Failed to replace reviewer: %v", err)
 	}
 
@@ -371,8 +424,8 @@ func TestPullRequestLifecycle(t *testing.T) {
 	}
 
 	reviewers := pr.AssignedReviewers()
-	if len(reviewers) != MaxReviewersCount {
-		t.Errorf("Final reviewers count = %d, want %d", len(reviewers), MaxReviewersCount)
+	if len(reviewers) != DefaultMaxReviewers {
+		t.Errorf("Final reviewers count = %d, want %d", len(reviewers), DefaultMaxReviewers)
 	}
 
 	if err := pr.AddReviewer("dan"); !errors.Is(err, ErrPRMerged) {
@@ -380,6 +433,82 @@ func TestPullRequestLifecycle(t *testing.T) {
 	}
 }
 
+// TestPullRequestStatusTransitions проверяет машину состояний PR
+func TestPullRequestStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    PRStatus
+		action    func(*PullRequest) error
+		want      PRStatus
+		expectErr error
+	}{
+		{name: "draft ready", status: PRStatusDraft, action: (*PullRequest).MarkReady, want: PRStatusOpen},
+		{name: "draft close", status: PRStatusDraft, action: (*PullRequest).Close, want: PRStatusClosed},
+		{name: "open close", status: PRStatusOpen, action: (*PullRequest).Close, want: PRStatusClosed},
+		{name: "closed reopen", status: PRStatusClosed, action: (*PullRequest).Reopen, want: PRStatusOpen},
+		{name: "open ready", status: PRStatusOpen, action: (*PullRequest).MarkReady, expectErr: ErrInvalidStatusTransition},
+		{name: "open reopen", status: PRStatusOpen, action: (*PullRequest).Reopen, expectErr: ErrInvalidStatusTransition},
+		{name: "closed close", status: PRStatusClosed, action: (*PullRequest).Close, expectErr: ErrInvalidStatusTransition},
+		{name: "closed ready", status: PRStatusClosed, action: (*PullRequest).MarkReady, expectErr: ErrInvalidStatusTransition},
+		{name: "merged close", status: PRStatusMerged, action: (*PullRequest).Close, expectErr: ErrPRMerged},
+		{name: "merged reopen", status: PRStatusMerged, action: (*PullRequest).Reopen, expectErr: ErrPRMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", tt.status, []string{}, nil, nil, DefaultReviewerLimits(), time.Now(), nil, false)
+
+			err := tt.action(pr)
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Errorf("error = %v, want %v", err, tt.expectErr)
+				}
+				if pr.Status() != tt.status {
+					t.Errorf("Status() = %s, want unchanged %s", pr.Status(), tt.status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if pr.Status() != tt.want {
+				t.Errorf("Status() = %s, want %s", pr.Status(), tt.want)
+			}
+		})
+	}
+}
+
+// TestPullRequestReviewersFrozenOutsideOpen проверяет, что у черновика и закрытого PR нельзя менять ревьюверов
+func TestPullRequestReviewersFrozenOutsideOpen(t *testing.T) {
+	draft, err := NewDraftPullRequest("pr-1", "Draft PR", "author-1", newTestTeam())
+	if err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+	if !draft.IsDraft() {
+		t.Errorf("IsDraft() = false, want true")
+	}
+	if err := draft.AddReviewer("reviewer-1"); !errors.Is(err, ErrPRDraft) {
+		t.Errorf("AddReviewer() on draft error = %v, want ErrPRDraft", err)
+	}
+	if draft.Merge() {
+		t.Errorf("Merge() on draft = true, want false")
+	}
+
+	closed := NewPullRequestFromRepository("pr-2", "Closed PR", "author-1", "team-1", PRStatusClosed, []string{"reviewer-1"}, nil, nil, DefaultReviewerLimits(), time.Now(), nil, false)
+	if err := closed.AddReviewer("reviewer-2"); !errors.Is(err, ErrPRClosed) {
+		t.Errorf("AddReviewer() on closed error = %v, want ErrPRClosed", err)
+	}
+	if err := closed.RemoveReviewer("reviewer-1"); !errors.Is(err, ErrPRClosed) {
+		t.Errorf("RemoveReviewer() on closed error = %v, want ErrPRClosed", err)
+	}
+	if err := closed.ReplaceReviewer("reviewer-1", "reviewer-2", false); !errors.Is(err, ErrPRClosed) {
+		t.Errorf("ReplaceReviewer() on closed error = %v, want ErrPRClosed", err)
+	}
+	if closed.Merge() {
+		t.Errorf("Merge() on closed = true, want false")
+	}
+}
+
 // TestNewPullRequestFromRepository проверяет восстановление из БД
 func TestNewPullRequestFromRepository(t *testing.T) {
 	reviewers := []string{"reviewer-1", "reviewer-2"}
@@ -392,10 +521,15 @@ func TestNewPullRequestFromRepository(t *testing.T) {
 		"pr-100",
 		"Legacy PR",
 		"author-1",
+		"team-1",
 		PRStatusMerged,
 		reviewers,
+		[]string{"reviewer-2"},
+		[]Review{NewReviewFromRepository("reviewer-1", ReviewStateApproved, mergedAt)},
+		DefaultReviewerLimits(),
 		time.Now().Add(-24*time.Hour).UTC(),
 		mergedAt,
+		false,
 	)
 
 	if pr.ID() != "pr-100" {
@@ -404,10 +538,192 @@ func TestNewPullRequestFromRepository(t *testing.T) {
 	if pr.Status() != PRStatusMerged {
 		t.Errorf("Status = %v, want MERGED", pr.Status())
 	}
-	if len(pr.AssignedReviewers()) != MaxReviewersCount {
-		t.Errorf("AssignedReviewers length = %d, want %d", len(pr.AssignedReviewers()), MaxReviewersCount)
+	if len(pr.AssignedReviewers()) != DefaultMaxReviewers {
+		t.Errorf("AssignedReviewers length = %d, want %d", len(pr.AssignedReviewers()), DefaultMaxReviewers)
 	}
 	if pr.MergedAt() == nil {
 		t.Errorf("MergedAt = nil, want non-nil")
 	}
+	if !pr.IsFallbackReviewer("reviewer-2") || pr.IsFallbackReviewer("reviewer-1") {
+		t.Errorf("FallbackReviewers = %v, want [reviewer-2]", pr.FallbackReviewers())
+	}
+}
+
+// TestPullRequestFallbackReviewers проверяет признак ревьювера из запасной команды
+func TestPullRequestFallbackReviewers(t *testing.T) {
+	pr, err := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := pr.AddReviewer("reviewer-1"); err != nil {
+		t.Fatalf("AddReviewer() error = %v", err)
+	}
+	if err := pr.AddFallbackReviewer("reviewer-2"); err != nil {
+		t.Fatalf("AddFallbackReviewer() error = %v", err)
+	}
+
+	if got := pr.FallbackReviewers(); len(got) != 1 || got[0] != "reviewer-2" {
+		t.Errorf("FallbackReviewers() = %v, want [reviewer-2]", got)
+	}
+
+	if err := pr.ReplaceReviewer("reviewer-2", "reviewer-3", true); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+	if !pr.IsFallbackReviewer("reviewer-3") || pr.IsFallbackReviewer("reviewer-2") {
+		t.Errorf("fallback replacement must keep fallback flag, got %v", pr.FallbackReviewers())
+	}
+
+	if err := pr.ReplaceReviewer("reviewer-3", "reviewer-4", false); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+	if pr.IsFallbackReviewer("reviewer-4") {
+		t.Errorf("home team replacement must not be fallback, got %v", pr.FallbackReviewers())
+	}
+
+	if err := pr.ReplaceReviewer("reviewer-1", "reviewer-3", true); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+	if !pr.IsFallbackReviewer("reviewer-3") {
+		t.Errorf("fallback replacement of home reviewer must be fallback, got %v", pr.FallbackReviewers())
+	}
+
+	if err := pr.ReleaseReviewer("reviewer-4"); err != nil {
+		t.Fatalf("ReleaseReviewer() error = %v", err)
+	}
+
+	if err := pr.ReleaseReviewer("reviewer-3"); err != nil {
+		t.Fatalf("ReleaseReviewer() error = %v", err)
+	}
+	if len(pr.FallbackReviewers()) != 0 {
+		t.Errorf("FallbackReviewers() = %v, want empty", pr.FallbackReviewers())
+	}
+}
+
+func newTestTeam() *Team {
+	return NewTeamFromRepository("team-1", ReviewerStrategyDefault, DefaultReviewerLimits(), nil, MergePolicy{}, time.Now(), time.Now())
+}
+
+// TestPullRequestSubmitReview проверяет запись ревью и агрегированное решение
+func TestPullRequestSubmitReview(t *testing.T) {
+	pr, _ := NewPullRequest("pr-1", "Test PR", "author-1", newTestTeam())
+	_ = pr.AddReviewer("reviewer-1")
+	_ = pr.AddReviewer("reviewer-2")
+
+	if got := pr.ReviewDecision(); got != ReviewDecisionReviewRequired {
+		t.Errorf("ReviewDecision() = %s, want REVIEW_REQUIRED", got)
+	}
+	if got := pr.ReviewOf("reviewer-1"); got.State() != ReviewStatePending || got.SubmittedAt() != nil {
+		t.Errorf("ReviewOf() = %+v, want PENDING without timestamp", got)
+	}
+
+	if err := pr.SubmitReview("reviewer-1", ReviewStateApproved); err != nil {
+		t.Fatalf("SubmitReview() error = %v", err)
+	}
+	if got := pr.ReviewDecision(); got != ReviewDecisionReviewRequired {
+		t.Errorf("ReviewDecision() with one approval = %s, want REVIEW_REQUIRED", got)
+	}
+	if pr.ReviewOf("reviewer-1").SubmittedAt() == nil {
+		t.Errorf("SubmittedAt() = nil, want timestamp")
+	}
+
+	if err := pr.SubmitReview("reviewer-2", ReviewStateChangesRequested); err != nil {
+		t.Fatalf("SubmitReview() error = %v", err)
+	}
+	if got := pr.ReviewDecision(); got != ReviewDecisionChangesRequested {
+		t.Errorf("ReviewDecision() = %s, want CHANGES_REQUESTED", got)
+	}
+
+	if err := pr.SubmitReview("reviewer-2", ReviewStateApproved); err != nil {
+		t.Fatalf("SubmitReview() error = %v", err)
+	}
+	if got := pr.ReviewDecision(); got != ReviewDecisionApproved {
+		t.Errorf("ReviewDecision() = %s, want APPROVED", got)
+	}
+
+	if err := pr.ReplaceReviewer("reviewer-2", "reviewer-3", false); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+	if got := pr.ReviewOf("reviewer-3").State(); got != ReviewStatePending {
+		t.Errorf("replacement review state = %s, want PENDING", got)
+	}
+	if got := pr.ReviewDecision(); got != ReviewDecisionReviewRequired {
+		t.Errorf("ReviewDecision() after replacement = %s, want REVIEW_REQUIRED", got)
+	}
+
+	if err := pr.SubmitReview("stranger", ReviewStateApproved); !errors.Is(err, ErrReviewerNotAssigned) {
+		t.Errorf("SubmitReview() by stranger error = %v, want ErrReviewerNotAssigned", err)
+	}
+	if err := pr.SubmitReview("reviewer-1", ReviewStatePending); !errors.Is(err, ErrInvalidReviewState) {
+		t.Errorf("SubmitReview(PENDING) error = %v, want ErrInvalidReviewState", err)
+	}
+
+	pr.Merge()
+	if err := pr.SubmitReview("reviewer-1", ReviewStateCommented); !errors.Is(err, ErrPRMerged) {
+		t.Errorf("SubmitReview() after merge error = %v, want ErrPRMerged", err)
+	}
+}
+
+// TestMergePolicyUnmetConditions проверяет условия политики мержа и force-мерж
+func TestMergePolicyUnmetConditions(t *testing.T) {
+	submitted := time.Now()
+	reviews := []Review{
+		NewReviewFromRepository("reviewer-1", ReviewStateApproved, &submitted),
+		NewReviewFromRepository("reviewer-2", ReviewStateChangesRequested, &submitted),
+	}
+
+	tests := []struct {
+		name   string
+		policy MergePolicy
+		want   []string
+	}{
+		{
+			name:   "empty policy",
+			policy: MergePolicy{},
+		},
+		{
+			name:   "enough approvals, changes requested still block",
+			policy: NewMergePolicyFromRepository(1, false, nil),
+			want:   []string{"changes requested by reviewer-2"},
+		},
+		{
+			name:   "not enough approvals",
+			policy: NewMergePolicyFromRepository(2, false, nil),
+			want:   []string{"2 approvals required, got 1", "changes requested by reviewer-2"},
+		},
+		{
+			name:   "changes requested",
+			policy: NewMergePolicyFromRepository(0, true, nil),
+			want:   []string{"changes requested by reviewer-2"},
+		},
+		{
+			name:   "code owner approved, changes requested still block",
+			policy: NewMergePolicyFromRepository(0, false, []string{"reviewer-1"}),
+			want:   []string{"changes requested by reviewer-2"},
+		},
+		{
+			name:   "code owner did not approve",
+			policy: NewMergePolicyFromRepository(0, false, []string{"reviewer-2", "owner"}),
+			want:   []string{"changes requested by reviewer-2", "approval from a code owner required (reviewer-2, owner)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", PRStatusOpen, []string{"reviewer-1", "reviewer-2"}, nil, reviews, DefaultReviewerLimits(), time.Now(), nil, false)
+
+			got := tt.policy.UnmetConditions(pr)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("UnmetConditions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	pr := NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", PRStatusOpen, []string{"reviewer-1"}, nil, nil, DefaultReviewerLimits(), time.Now(), nil, false)
+	if !pr.ForceMerge() || !pr.IsMerged() || !pr.MergeForced() {
+		t.Errorf("ForceMerge() must merge PR and record it, got status %s forced %v", pr.Status(), pr.MergeForced())
+	}
+	if pr.ForceMerge() {
+		t.Error("ForceMerge() on merged PR must return false")
+	}
 }
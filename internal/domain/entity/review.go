@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReviewState состояние ревью конкретного ревьювера
+type ReviewState string
+
+const (
+	// ReviewStatePending ревьювер назначен, но еще не оставил ревью
+	ReviewStatePending          ReviewState = "PENDING"
+	ReviewStateApproved         ReviewState = "APPROVED"
+	ReviewStateChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewStateCommented        ReviewState = "COMMENTED"
+)
+
+// ParseReviewState проверяет состояние, которое ревьювер может выставить сам.
+// PENDING выставить нельзя: это состояние до первого ревью
+func ParseReviewState(state string) (ReviewState, error) {
+	switch ReviewState(state) {
+	case ReviewStateApproved, ReviewStateChangesRequested, ReviewStateCommented:
+		return ReviewState(state), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidReviewState, state)
+	}
+}
+
+// IsDecisive возвращает true для состояний, которыми ревьювер завершает ревью
+func (s ReviewState) IsDecisive() bool {
+	return s == ReviewStateApproved || s == ReviewStateChangesRequested
+}
+
+// ReviewDecision агрегированное решение по PR
+type ReviewDecision string
+
+const (
+	// ReviewDecisionApproved все назначенные ревьюверы одобрили PR
+	ReviewDecisionApproved ReviewDecision = "APPROVED"
+	// ReviewDecisionChangesRequested хотя бы один ревьювер запросил изменения
+	ReviewDecisionChangesRequested ReviewDecision = "CHANGES_REQUESTED"
+	// ReviewDecisionReviewRequired ревью еще не завершено
+	ReviewDecisionReviewRequired ReviewDecision = "REVIEW_REQUIRED"
+)
+
+// Review последнее ревью, оставленное ревьювером
+type Review struct {
+	reviewerID  string
+	state       ReviewState
+	submittedAt *time.Time // nil пока ревьювер не оставил ревью
+}
+
+// NewReviewFromRepository восстанавливает ревью из хранилища без валидации
+func NewReviewFromRepository(reviewerID string, state ReviewState, submittedAt *time.Time) Review {
+	return Review{reviewerID: reviewerID, state: state, submittedAt: submittedAt}
+}
+
+func (r Review) ReviewerID() string {
+	return r.reviewerID
+}
+
+func (r Review) State() ReviewState {
+	return r.state
+}
+
+func (r Review) SubmittedAt() *time.Time {
+	return r.submittedAt
+}
@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReviewerHistoryAction событие в истории назначений ревьюверов PR
+type ReviewerHistoryAction string
+
+const (
+	// ReviewerAssigned пользователь назначен ревьювером
+	ReviewerAssigned ReviewerHistoryAction = "assigned"
+	// ReviewerUnassigned пользователь снят с ревью
+	ReviewerUnassigned ReviewerHistoryAction = "unassigned"
+)
+
+// ReviewerHistoryReason причина назначения или снятия ревьювера
+type ReviewerHistoryReason string
+
+const (
+	// ReviewerReasonAuto автоматическое назначение при создании PR или переходе в OPEN
+	ReviewerReasonAuto ReviewerHistoryReason = "auto"
+	// ReviewerReasonReassign переназначение через /pullRequest/reassign
+	ReviewerReasonReassign ReviewerHistoryReason = "reassign"
+	// ReviewerReasonManual ручное назначение или снятие через /pullRequest/addReviewer и /pullRequest/removeReviewer
+	ReviewerReasonManual ReviewerHistoryReason = "manual"
+	// ReviewerReasonDeactivation перенос слота деактивированного ревьювера
+	ReviewerReasonDeactivation ReviewerHistoryReason = "deactivation"
+)
+
+// ReviewerHistoryReasons возвращает все причины в порядке объявления
+func ReviewerHistoryReasons() []ReviewerHistoryReason {
+	return []ReviewerHistoryReason{
+		ReviewerReasonAuto,
+		ReviewerReasonReassign,
+		ReviewerReasonManual,
+		ReviewerReasonDeactivation,
+	}
+}
+
+// ReviewerHistoryEntry событие истории назначений ревьюверов. История только дополняется,
+// поэтому в отличие от pr_reviewers хранит всех, кто когда-либо был ревьювером PR
+type ReviewerHistoryEntry struct {
+	pullRequestID string
+	userID        string
+	action        ReviewerHistoryAction
+	reason        ReviewerHistoryReason
+	occurredAt    time.Time
+}
+
+// NewReviewerHistoryEntry создаёт событие истории назначений с валидацией
+func NewReviewerHistoryEntry(
+	pullRequestID string,
+	userID string,
+	action ReviewerHistoryAction,
+	reason ReviewerHistoryReason,
+	occurredAt time.Time,
+) (*ReviewerHistoryEntry, error) {
+	if pullRequestID == "" || userID == "" {
+		return nil, fmt.Errorf("%w: pull request id and user id are required", ErrInvalidReviewerHistory)
+	}
+	if action != ReviewerAssigned && action != ReviewerUnassigned {
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidReviewerHistory, action)
+	}
+	if !isReviewerHistoryReason(reason) {
+		return nil, fmt.Errorf("%w: unknown reason %q", ErrInvalidReviewerHistory, reason)
+	}
+
+	return &ReviewerHistoryEntry{
+		pullRequestID: pullRequestID,
+		userID:        userID,
+		action:        action,
+		reason:        reason,
+		occurredAt:    occurredAt,
+	}, nil
+}
+
+// NewReviewerHistoryEntryFromRepository восстанавливает событие истории из хранилища без валидации
+func NewReviewerHistoryEntryFromRepository(
+	pullRequestID string,
+	userID string,
+	action ReviewerHistoryAction,
+	reason ReviewerHistoryReason,
+	occurredAt time.Time,
+) *ReviewerHistoryEntry {
+	return &ReviewerHistoryEntry{
+		pullRequestID: pullRequestID,
+		userID:        userID,
+		action:        action,
+		reason:        reason,
+		occurredAt:    occurredAt,
+	}
+}
+
+func isReviewerHistoryReason(reason ReviewerHistoryReason) bool {
+	for _, known := range ReviewerHistoryReasons() {
+		if known == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ReviewerHistoryEntry) PullRequestID() string {
+	return e.pullRequestID
+}
+
+func (e *ReviewerHistoryEntry) UserID() string {
+	return e.userID
+}
+
+func (e *ReviewerHistoryEntry) Action() ReviewerHistoryAction {
+	return e.action
+}
+
+func (e *ReviewerHistoryEntry) Reason() ReviewerHistoryReason {
+	return e.reason
+}
+
+func (e *ReviewerHistoryEntry) OccurredAt() time.Time {
+	return e.occurredAt
+}
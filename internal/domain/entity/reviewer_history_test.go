@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestNewReviewerHistoryEntry проверяет обязательные поля, событие и причину истории назначений
+func TestNewReviewerHistoryEntry(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		userID      string
+		action      ReviewerHistoryAction
+		reason      ReviewerHistoryReason
+		expectedErr error
+	}{
+		{name: "auto assignment", userID: "u1", action: ReviewerAssigned, reason: ReviewerReasonAuto},
+		{name: "unassigned on deactivation", userID: "u1", action: ReviewerUnassigned, reason: ReviewerReasonDeactivation},
+		{name: "empty user id", action: ReviewerAssigned, reason: ReviewerReasonManual, expectedErr: ErrInvalidReviewerHistory},
+		{name: "unknown action", userID: "u1", action: "replaced", reason: ReviewerReasonReassign, expectedErr: ErrInvalidReviewerHistory},
+		{name: "unknown reason", userID: "u1", action: ReviewerAssigned, reason: "random", expectedErr: ErrInvalidReviewerHistory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := NewReviewerHistoryEntry("pr-1", tt.userID, tt.action, tt.reason, now)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if entry.Action() != tt.action || entry.Reason() != tt.reason {
+				t.Errorf("expected %s/%s, got %s/%s", tt.action, tt.reason, entry.Action(), entry.Reason())
+			}
+		})
+	}
+}
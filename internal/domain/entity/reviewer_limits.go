@@ -0,0 +1,48 @@
+package entity
+
+import "fmt"
+
+const (
+	// DefaultMinReviewers минимальное число ревьюверов PR по умолчанию
+	DefaultMinReviewers = 0
+	// DefaultMaxReviewers максимальное число ревьюверов PR по умолчанию
+	DefaultMaxReviewers = 2
+	// MaxReviewersLimit верхняя граница max_reviewers для любой команды
+	MaxReviewersLimit = 10
+)
+
+// ReviewerLimits ограничения на число ревьюверов PR, задаются командой
+type ReviewerLimits struct {
+	minReviewers int
+	maxReviewers int
+}
+
+// NewReviewerLimits создаёт ограничения с валидацией: 0 <= min <= max, 1 <= max <= MaxReviewersLimit
+func NewReviewerLimits(minReviewers, maxReviewers int) (ReviewerLimits, error) {
+	if maxReviewers < 1 || maxReviewers > MaxReviewersLimit {
+		return ReviewerLimits{}, fmt.Errorf("%w: max_reviewers must be between 1 and %d", ErrInvalidReviewerLimits, MaxReviewersLimit)
+	}
+	if minReviewers < 0 || minReviewers > maxReviewers {
+		return ReviewerLimits{}, fmt.Errorf("%w: min_reviewers must be between 0 and max_reviewers", ErrInvalidReviewerLimits)
+	}
+
+	return ReviewerLimits{minReviewers: minReviewers, maxReviewers: maxReviewers}, nil
+}
+
+// NewReviewerLimitsFromRepository восстанавливает ограничения из хранилища без валидации
+func NewReviewerLimitsFromRepository(minReviewers, maxReviewers int) ReviewerLimits {
+	return ReviewerLimits{minReviewers: minReviewers, maxReviewers: maxReviewers}
+}
+
+// DefaultReviewerLimits возвращает ограничения по умолчанию
+func DefaultReviewerLimits() ReviewerLimits {
+	return ReviewerLimits{minReviewers: DefaultMinReviewers, maxReviewers: DefaultMaxReviewers}
+}
+
+func (l ReviewerLimits) Min() int {
+	return l.minReviewers
+}
+
+func (l ReviewerLimits) Max() int {
+	return l.maxReviewers
+}
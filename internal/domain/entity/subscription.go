@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// MinSubscriptionSecretLength минимальная длина секрета подписи
+const MinSubscriptionSecretLength = 16
+
+// Subscription подписка внешнего сервиса на события об изменении назначений.
+// Тело каждой доставки подписывается HMAC-SHA256 секретом подписки
+type Subscription struct {
+	id         string
+	url        string
+	secret     string
+	eventTypes []EventType
+	isActive   bool
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewSubscription создаёт активную подписку с валидацией адреса, секрета и списка событий
+func NewSubscription(id, rawURL, secret string, eventTypes []EventType) (*Subscription, error) {
+	normalizedID, err := validateAndNormalizeID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidID, err)
+	}
+
+	subscription := &Subscription{id: normalizedID, isActive: true}
+	if err := subscription.ChangeURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := subscription.ChangeSecret(secret); err != nil {
+		return nil, err
+	}
+	if err := subscription.ChangeEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	subscription.createdAt = now
+	subscription.updatedAt = now
+
+	return subscription, nil
+}
+
+// NewSubscriptionFromRepository восстанавливает подписку из хранилища без валидации
+func NewSubscriptionFromRepository(
+	id string,
+	url string,
+	secret string,
+	eventTypes []EventType,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Subscription {
+	return &Subscription{
+		id:         id,
+		url:        url,
+		secret:     secret,
+		eventTypes: eventTypes,
+		isActive:   isActive,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+func (s *Subscription) ID() string {
+	return s.id
+}
+
+func (s *Subscription) URL() string {
+	return s.url
+}
+
+func (s *Subscription) Secret() string {
+	return s.secret
+}
+
+func (s *Subscription) EventTypes() []EventType {
+	eventTypes := make([]EventType, len(s.eventTypes))
+	copy(eventTypes, s.eventTypes)
+	return eventTypes
+}
+
+func (s *Subscription) IsActive() bool {
+	return s.isActive
+}
+
+func (s *Subscription) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Subscription) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// ChangeURL меняет адрес доставки: допускаются только абсолютные http(s) адреса
+func (s *Subscription) ChangeURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidSubscription)
+	}
+
+	s.url = parsed.String()
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// ChangeSecret меняет секрет подписи
+func (s *Subscription) ChangeSecret(secret string) error {
+	if len(secret) < MinSubscriptionSecretLength {
+		return fmt.Errorf("%w: secret must be at least %d characters", ErrInvalidSubscription, MinSubscriptionSecretLength)
+	}
+
+	s.secret = secret
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// ChangeEventTypes заменяет список событий подписки: непустой, без повторов
+func (s *Subscription) ChangeEventTypes(eventTypes []EventType) error {
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", ErrInvalidSubscription)
+	}
+
+	normalized := make([]EventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if _, err := ParseEventType(string(eventType)); err != nil {
+			return err
+		}
+		if slices.Contains(normalized, eventType) {
+			return fmt.Errorf("%w: duplicate event type %s", ErrInvalidSubscription, eventType)
+		}
+		normalized = append(normalized, eventType)
+	}
+
+	s.eventTypes = normalized
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetActive включает или приостанавливает доставку событий
+func (s *Subscription) SetActive(isActive bool) {
+	s.isActive = isActive
+	s.updatedAt = time.Now().UTC()
+}
+
+// Subscribes возвращает true, если активная подписка получает события этого типа
+func (s *Subscription) Subscribes(eventType EventType) bool {
+	return s.isActive && slices.Contains(s.eventTypes, eventType)
+}
+
+// Sign возвращает подпись тела доставки в формате sha256=<hex HMAC-SHA256>
+func (s *Subscription) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
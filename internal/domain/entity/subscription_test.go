@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+const testSubscriptionSecret = "0123456789abcdef"
+
+// TestNewSubscription проверяет валидацию адреса, секрета и списка событий
+func TestNewSubscription(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		secret      string
+		eventTypes  []EventType
+		expectedErr error
+	}{
+		{name: "valid subscription", url: "https://hooks.example.com/pr", secret: testSubscriptionSecret, eventTypes: []EventType{EventPRMerged}},
+		{name: "relative url", url: "/hooks", secret: testSubscriptionSecret, eventTypes: []EventType{EventPRMerged}, expectedErr: ErrInvalidSubscription},
+		{name: "unsupported scheme", url: "ftp://hooks.example.com", secret: testSubscriptionSecret, eventTypes: []EventType{EventPRMerged}, expectedErr: ErrInvalidSubscription},
+		{name: "short secret", url: "https://hooks.example.com", secret: "short", eventTypes: []EventType{EventPRMerged}, expectedErr: ErrInvalidSubscription},
+		{name: "no event types", url: "https://hooks.example.com", secret: testSubscriptionSecret, expectedErr: ErrInvalidSubscription},
+		{name: "duplicate event type", url: "https://hooks.example.com", secret: testSubscriptionSecret, eventTypes: []EventType{EventPRMerged, EventPRMerged}, expectedErr: ErrInvalidSubscription},
+		{name: "unknown event type", url: "https://hooks.example.com", secret: testSubscriptionSecret, eventTypes: []EventType{"pr.opened"}, expectedErr: ErrInvalidSubscription},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription, err := NewSubscription("sub-1", tt.url, tt.secret, tt.eventTypes)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !subscription.IsActive() {
+				t.Error("expected new subscription to be active")
+			}
+		})
+	}
+}
+
+// TestSubscription_Subscribes проверяет отбор событий по типу и активности подписки
+func TestSubscription_Subscribes(t *testing.T) {
+	subscription, err := NewSubscription("sub-1", "https://hooks.example.com", testSubscriptionSecret, []EventType{EventReviewerAssigned})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !subscription.Subscribes(EventReviewerAssigned) {
+		t.Error("expected subscription to receive reviewer.assigned")
+	}
+	if subscription.Subscribes(EventPRMerged) {
+		t.Error("expected subscription to skip pr.merged")
+	}
+
+	subscription.SetActive(false)
+	if subscription.Subscribes(EventReviewerAssigned) {
+		t.Error("expected inactive subscription to receive nothing")
+	}
+}
+
+// TestSubscription_Sign проверяет формат и значение подписи тела
+func TestSubscription_Sign(t *testing.T) {
+	subscription, err := NewSubscription("sub-1", "https://hooks.example.com", testSubscriptionSecret, []EventType{EventPRMerged})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := []byte(`{"type":"pr.merged"}`)
+	mac := hmac.New(sha256.New, []byte(testSubscriptionSecret))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if signature := subscription.Sign(body); signature != expected {
+		t.Errorf("expected %s, got %s", expected, signature)
+	}
+}
@@ -2,14 +2,48 @@ package entity
 
 import (
 	"fmt"
+	"slices"
 	"time"
 )
 
+// ReviewerStrategyName название стратегии выбора ревьюверов
+type ReviewerStrategyName string
+
+const (
+	// ReviewerStrategyDefault означает, что команда использует глобальную стратегию из конфигурации
+	ReviewerStrategyDefault ReviewerStrategyName = ""
+	// ReviewerStrategyLeastLoaded выбирает кандидатов с наименьшим числом открытых ревью
+	ReviewerStrategyLeastLoaded ReviewerStrategyName = "least_loaded"
+	// ReviewerStrategyRandom выбирает кандидатов равновероятно
+	ReviewerStrategyRandom ReviewerStrategyName = "random"
+	// ReviewerStrategyWeightedRandom выбирает случайно с весом, обратным загрузке
+	ReviewerStrategyWeightedRandom ReviewerStrategyName = "weighted_random"
+	// ReviewerStrategyRoundRobin выбирает по кругу, начиная после последнего назначенного
+	ReviewerStrategyRoundRobin ReviewerStrategyName = "round_robin"
+)
+
+// IsValid проверяет, что название стратегии известно (пустое значение допустимо)
+func (s ReviewerStrategyName) IsValid() bool {
+	switch s {
+	case ReviewerStrategyDefault,
+		ReviewerStrategyLeastLoaded,
+		ReviewerStrategyRandom,
+		ReviewerStrategyWeightedRandom,
+		ReviewerStrategyRoundRobin:
+		return true
+	}
+	return false
+}
+
 // Team представляет команду разработчиков в доменной модели
 type Team struct {
-	name      string
-	createdAt time.Time
-	updatedAt time.Time
+	name             string
+	reviewerStrategy ReviewerStrategyName
+	reviewerLimits   ReviewerLimits
+	fallbackTeams    []string // запасные команды в порядке приоритета
+	mergePolicy      MergePolicy
+	createdAt        time.Time
+	updatedAt        time.Time
 }
 
 // NewTeam создаёт новую команду с валидацией
@@ -22,22 +56,31 @@ func NewTeam(name string) (*Team, error) {
 	now := time.Now().UTC()
 
 	return &Team{
-		name:      normalizedName,
-		createdAt: now,
-		updatedAt: now,
+		name:           normalizedName,
+		reviewerLimits: DefaultReviewerLimits(),
+		createdAt:      now,
+		updatedAt:      now,
 	}, nil
 }
 
 // NewTeamFromRepository восстанавливает команду из хранилища без валидации
 func NewTeamFromRepository(
 	name string,
+	reviewerStrategy ReviewerStrategyName,
+	reviewerLimits ReviewerLimits,
+	fallbackTeams []string,
+	mergePolicy MergePolicy,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Team {
 	return &Team{
-		name:      name,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		name:             name,
+		reviewerStrategy: reviewerStrategy,
+		reviewerLimits:   reviewerLimits,
+		fallbackTeams:    fallbackTeams,
+		mergePolicy:      mergePolicy,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
 	}
 }
 
@@ -45,6 +88,29 @@ func (t *Team) Name() string {
 	return t.name
 }
 
+// ReviewerStrategy возвращает стратегию выбора ревьюверов команды (пустая - глобальная по умолчанию)
+func (t *Team) ReviewerStrategy() ReviewerStrategyName {
+	return t.reviewerStrategy
+}
+
+// ReviewerLimits возвращает ограничения на число ревьюверов PR команды
+func (t *Team) ReviewerLimits() ReviewerLimits {
+	return t.reviewerLimits
+}
+
+// FallbackTeams возвращает запасные команды в порядке приоритета.
+// Из них добираются ревьюверы, если в самой команде не хватает кандидатов
+func (t *Team) FallbackTeams() []string {
+	teams := make([]string, len(t.fallbackTeams))
+	copy(teams, t.fallbackTeams)
+	return teams
+}
+
+// MergePolicy возвращает условия, при которых PR команды можно смержить
+func (t *Team) MergePolicy() MergePolicy {
+	return t.mergePolicy
+}
+
 func (t *Team) CreatedAt() time.Time {
 	return t.createdAt
 }
@@ -53,6 +119,76 @@ func (t *Team) UpdatedAt() time.Time {
 	return t.updatedAt
 }
 
+// ChangeReviewerStrategy меняет стратегию выбора ревьюверов команды
+func (t *Team) ChangeReviewerStrategy(strategy ReviewerStrategyName) error {
+	if !strategy.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidReviewerStrategy, strategy)
+	}
+
+	if t.reviewerStrategy == strategy {
+		return ErrNoChange
+	}
+
+	t.reviewerStrategy = strategy
+	t.updatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// ChangeReviewerLimits меняет ограничения на число ревьюверов.
+// Действует только на PR, созданные после изменения
+func (t *Team) ChangeReviewerLimits(limits ReviewerLimits) error {
+	if t.reviewerLimits == limits {
+		return ErrNoChange
+	}
+
+	t.reviewerLimits = limits
+	t.updatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// ChangeFallbackTeams задает запасные команды в порядке приоритета (пустой список - без запасных команд)
+func (t *Team) ChangeFallbackTeams(teamNames []string) error {
+	normalized := make([]string, 0, len(teamNames))
+	seen := make(map[string]bool, len(teamNames))
+	for _, teamName := range teamNames {
+		normalizedName, err := validateAndNormalizeTeamName(teamName)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidFallbackTeams, err)
+		}
+		if normalizedName == t.name {
+			return fmt.Errorf("%w: team cannot be its own fallback", ErrInvalidFallbackTeams)
+		}
+		if seen[normalizedName] {
+			return fmt.Errorf("%w: duplicate team %s", ErrInvalidFallbackTeams, normalizedName)
+		}
+		seen[normalizedName] = true
+		normalized = append(normalized, normalizedName)
+	}
+
+	if slices.Equal(t.fallbackTeams, normalized) {
+		return ErrNoChange
+	}
+
+	t.fallbackTeams = normalized
+	t.updatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// ChangeMergePolicy меняет политику мержа. Действует на все открытые PR команды
+func (t *Team) ChangeMergePolicy(policy MergePolicy) error {
+	if t.mergePolicy.Equal(policy) {
+		return ErrNoChange
+	}
+
+	t.mergePolicy = policy
+	t.updatedAt = time.Now().UTC()
+
+	return nil
+}
+
 // Equals сравнивает две команды по имени
 func (t *Team) Equals(other *Team) bool {
 	if other == nil {
@@ -112,12 +112,16 @@ func TestNewTeamFromRepository(t *testing.T) {
 	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
 
-	team := NewTeamFromRepository("backend-team", createdAt, updatedAt)
+	team := NewTeamFromRepository("backend-team", ReviewerStrategyRoundRobin, DefaultReviewerLimits(), nil, MergePolicy{}, createdAt, updatedAt)
 
 	if team.Name() != "backend-team" {
 		t.Errorf("Name = %v, want backend-team", team.Name())
 	}
 
+	if team.ReviewerStrategy() != ReviewerStrategyRoundRobin {
+		t.Errorf("ReviewerStrategy = %v, want %v", team.ReviewerStrategy(), ReviewerStrategyRoundRobin)
+	}
+
 	if !team.CreatedAt().Equal(createdAt) {
 		t.Errorf("CreatedAt = %v, want %v", team.CreatedAt(), createdAt)
 	}
@@ -127,6 +131,102 @@ func TestNewTeamFromRepository(t *testing.T) {
 	}
 }
 
+// TestTeamChangeReviewerStrategy проверяет смену стратегии выбора ревьюверов
+func TestTeamChangeReviewerStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy ReviewerStrategyName
+		wantErr  error
+	}{
+		{name: "least loaded", strategy: ReviewerStrategyLeastLoaded},
+		{name: "random", strategy: ReviewerStrategyRandom},
+		{name: "weighted random", strategy: ReviewerStrategyWeightedRandom},
+		{name: "round robin", strategy: ReviewerStrategyRoundRobin},
+		{name: "same as current", strategy: ReviewerStrategyDefault, wantErr: ErrNoChange},
+		{name: "unknown strategy", strategy: "fastest", wantErr: ErrInvalidReviewerStrategy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			team, _ := NewTeam("backend")
+
+			err := team.ChangeReviewerStrategy(tt.strategy)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ChangeReviewerStrategy() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ChangeReviewerStrategy() unexpected error = %v", err)
+			}
+
+			if team.ReviewerStrategy() != tt.strategy {
+				t.Errorf("ReviewerStrategy() = %v, want %v", team.ReviewerStrategy(), tt.strategy)
+			}
+		})
+	}
+}
+
+// TestNewReviewerLimits проверяет валидацию ограничений на число ревьюверов
+func TestNewReviewerLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		min     int
+		max     int
+		wantErr bool
+	}{
+		{name: "defaults", min: DefaultMinReviewers, max: DefaultMaxReviewers},
+		{name: "single reviewer", min: 1, max: 1},
+		{name: "upper bound", min: 0, max: MaxReviewersLimit},
+		{name: "zero max", min: 0, max: 0, wantErr: true},
+		{name: "max above limit", min: 0, max: MaxReviewersLimit + 1, wantErr: true},
+		{name: "negative min", min: -1, max: 2, wantErr: true},
+		{name: "min above max", min: 3, max: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits, err := NewReviewerLimits(tt.min, tt.max)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidReviewerLimits) {
+					t.Errorf("NewReviewerLimits() error = %v, want ErrInvalidReviewerLimits", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewReviewerLimits() unexpected error = %v", err)
+			}
+			if limits.Min() != tt.min || limits.Max() != tt.max {
+				t.Errorf("NewReviewerLimits() = (%d, %d), want (%d, %d)", limits.Min(), limits.Max(), tt.min, tt.max)
+			}
+		})
+	}
+}
+
+// TestTeamChangeReviewerLimits проверяет смену ограничений на число ревьюверов
+func TestTeamChangeReviewerLimits(t *testing.T) {
+	team, _ := NewTeam("backend")
+
+	if team.ReviewerLimits() != DefaultReviewerLimits() {
+		t.Errorf("ReviewerLimits() = %v, want defaults", team.ReviewerLimits())
+	}
+
+	if err := team.ChangeReviewerLimits(DefaultReviewerLimits()); !errors.Is(err, ErrNoChange) {
+		t.Errorf("ChangeReviewerLimits(same) error = %v, want ErrNoChange", err)
+	}
+
+	limits, _ := NewReviewerLimits(1, 3)
+	if err := team.ChangeReviewerLimits(limits); err != nil {
+		t.Fatalf("ChangeReviewerLimits() unexpected error = %v", err)
+	}
+	if team.ReviewerLimits() != limits {
+		t.Errorf("ReviewerLimits() = %v, want %v", team.ReviewerLimits(), limits)
+	}
+}
+
 // TestTeamEquals проверяет сравнение команд
 func TestTeamEquals(t *testing.T) {
 	team1, _ := NewTeam("backend")
@@ -156,7 +256,7 @@ func TestTeamGetters(t *testing.T) {
 	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
 
-	team := NewTeamFromRepository("payments-team", createdAt, updatedAt)
+	team := NewTeamFromRepository("payments-team", ReviewerStrategyDefault, DefaultReviewerLimits(), nil, MergePolicy{}, createdAt, updatedAt)
 
 	if got := team.Name(); got != "payments-team" {
 		t.Errorf("Name() = %v, want payments-team", got)
@@ -229,3 +329,128 @@ func TestTeamInvalidNames(t *testing.T) {
 		})
 	}
 }
+
+// TestTeamChangeFallbackTeams проверяет задание запасных команд
+func TestTeamChangeFallbackTeams(t *testing.T) {
+	tests := []struct {
+		name          string
+		fallbackTeams []string
+		want          []string
+		wantErr       error
+	}{
+		{
+			name:          "valid list keeps priority order",
+			fallbackTeams: []string{"platform", " infra "},
+			want:          []string{"platform", "infra"},
+		},
+		{
+			name:          "same list",
+			fallbackTeams: []string{"qa"},
+			want:          []string{"qa"},
+			wantErr:       ErrNoChange,
+		},
+		{
+			name:          "empty list removes fallbacks",
+			fallbackTeams: []string{},
+			want:          []string{},
+		},
+		{
+			name:          "team itself",
+			fallbackTeams: []string{"backend"},
+			want:          []string{"qa"},
+			wantErr:       ErrInvalidFallbackTeams,
+		},
+		{
+			name:          "duplicate",
+			fallbackTeams: []string{"platform", "platform"},
+			want:          []string{"qa"},
+			wantErr:       ErrInvalidFallbackTeams,
+		},
+		{
+			name:          "empty name",
+			fallbackTeams: []string{""},
+			want:          []string{"qa"},
+			wantErr:       ErrInvalidFallbackTeams,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			team := NewTeamFromRepository("backend", ReviewerStrategyDefault, DefaultReviewerLimits(), []string{"qa"}, MergePolicy{}, time.Now(), time.Now())
+
+			err := team.ChangeFallbackTeams(tt.fallbackTeams)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ChangeFallbackTeams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			got := team.FallbackTeams()
+			if len(got) != len(tt.want) {
+				t.Fatalf("FallbackTeams() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("FallbackTeams() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestNewMergePolicy проверяет валидацию политики мержа
+func TestNewMergePolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		approvals  int
+		codeOwners []string
+		wantErr    bool
+	}{
+		{name: "empty policy", approvals: 0},
+		{name: "approvals and code owners", approvals: 2, codeOwners: []string{"u1", "u2"}},
+		{name: "upper bound", approvals: MaxReviewersLimit},
+		{name: "negative approvals", approvals: -1, wantErr: true},
+		{name: "approvals above limit", approvals: MaxReviewersLimit + 1, wantErr: true},
+		{name: "empty code owner", codeOwners: []string{""}, wantErr: true},
+		{name: "duplicate code owner", codeOwners: []string{"u1", " u1 "}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewMergePolicy(tt.approvals, true, tt.codeOwners)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidMergePolicy) {
+					t.Errorf("NewMergePolicy() error = %v, want ErrInvalidMergePolicy", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewMergePolicy() unexpected error = %v", err)
+			}
+			if policy.RequiredApprovals() != tt.approvals || len(policy.CodeOwners()) != len(tt.codeOwners) {
+				t.Errorf("NewMergePolicy() = %+v", policy)
+			}
+		})
+	}
+}
+
+// TestTeamChangeMergePolicy проверяет смену политики мержа
+func TestTeamChangeMergePolicy(t *testing.T) {
+	team, _ := NewTeam("backend")
+
+	if !team.MergePolicy().Equal(MergePolicy{}) {
+		t.Errorf("MergePolicy() = %+v, want empty policy", team.MergePolicy())
+	}
+
+	policy, _ := NewMergePolicy(1, true, []string{"u1"})
+	if err := team.ChangeMergePolicy(policy); err != nil {
+		t.Fatalf("ChangeMergePolicy() unexpected error = %v", err)
+	}
+	if !team.MergePolicy().Equal(policy) {
+		t.Errorf("MergePolicy() = %+v, want %+v", team.MergePolicy(), policy)
+	}
+
+	same, _ := NewMergePolicy(1, true, []string{"u1"})
+	if err := team.ChangeMergePolicy(same); !errors.Is(err, ErrNoChange) {
+		t.Errorf("ChangeMergePolicy(same) error = %v, want ErrNoChange", err)
+	}
+}
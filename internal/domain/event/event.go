@@ -0,0 +1,106 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// Event доменное событие об изменении назначений. Data сериализуется в JSON как есть.
+// ID присваивается при записи события в outbox и одинаков для всех получателей
+type Event struct {
+	ID         string
+	Type       entity.EventType
+	OccurredAt time.Time
+	Data       any
+}
+
+// Emitter принимает доменные события.
+// Emit вызывается внутри транзакции (txManager.Do), в которой записано изменение,
+// поэтому событие откатывается вместе с ним
+type Emitter interface {
+	Emit(ctx context.Context, events ...Event) error
+}
+
+// ReviewerAssigned данные события reviewer.assigned
+type ReviewerAssigned struct {
+	PullRequestID string   `json:"pull_request_id"`
+	AuthorID      string   `json:"author_id"`
+	TeamName      string   `json:"team_name"`
+	ReviewerIDs   []string `json:"reviewer_ids"`
+}
+
+// ReviewerReassigned данные события reviewer.reassigned. NewUserID nil, если слот освобожден
+type ReviewerReassigned struct {
+	PullRequestID string  `json:"pull_request_id"`
+	OldUserID     string  `json:"old_user_id"`
+	NewUserID     *string `json:"new_user_id"`
+}
+
+// PRMerged данные события pr.merged
+type PRMerged struct {
+	PullRequestID string     `json:"pull_request_id"`
+	AuthorID      string     `json:"author_id"`
+	TeamName      string     `json:"team_name"`
+	MergedAt      *time.Time `json:"merged_at"`
+	MergeForced   bool       `json:"merge_forced"`
+}
+
+// UserDeactivated данные события user.deactivated
+type UserDeactivated struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+// NewReviewerAssigned создает событие о назначении ревьюверов PR
+func NewReviewerAssigned(pr *entity.PullRequest, reviewerIDs []string) Event {
+	return Event{
+		Type:       entity.EventReviewerAssigned,
+		OccurredAt: time.Now().UTC(),
+		Data: ReviewerAssigned{
+			PullRequestID: pr.ID(),
+			AuthorID:      pr.AuthorID(),
+			TeamName:      pr.TeamName(),
+			ReviewerIDs:   reviewerIDs,
+		},
+	}
+}
+
+// NewReviewerReassigned создает событие о переносе слота ревьювера. Пустой newUserID означает освобожденный слот
+func NewReviewerReassigned(pullRequestID, oldUserID, newUserID string) Event {
+	data := ReviewerReassigned{PullRequestID: pullRequestID, OldUserID: oldUserID}
+	if newUserID != "" {
+		data.NewUserID = &newUserID
+	}
+
+	return Event{
+		Type:       entity.EventReviewerReassigned,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+// NewPRMerged создает событие о мерже PR
+func NewPRMerged(pr *entity.PullRequest) Event {
+	return Event{
+		Type:       entity.EventPRMerged,
+		OccurredAt: time.Now().UTC(),
+		Data: PRMerged{
+			PullRequestID: pr.ID(),
+			AuthorID:      pr.AuthorID(),
+			TeamName:      pr.TeamName(),
+			MergedAt:      pr.MergedAt(),
+			MergeForced:   pr.MergeForced(),
+		},
+	}
+}
+
+// NewUserDeactivated создает событие о деактивации пользователя
+func NewUserDeactivated(userID, teamName string) Event {
+	return Event{
+		Type:       entity.EventUserDeactivated,
+		OccurredAt: time.Now().UTC(),
+		Data:       UserDeactivated{UserID: userID, TeamName: teamName},
+	}
+}
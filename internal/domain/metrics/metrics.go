@@ -0,0 +1,10 @@
+package metrics
+
+// Recorder учитывает бизнес-метрики назначения ревьюверов.
+// Методы вызываются после успешного завершения операции, откатившиеся транзакции не учитываются
+type Recorder interface {
+	// ReviewersAssigned PR открыт и автоматически получил count ревьюверов
+	ReviewersAssigned(count int)
+	// ReassignNoCandidate переназначение ревьювера не нашло замену (NO_CANDIDATE)
+	ReassignNoCandidate()
+}
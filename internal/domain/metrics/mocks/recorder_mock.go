@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/exPriceD/pr-reviewer-service/internal/domain/metrics (interfaces: Recorder)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination=internal/domain/metrics/mocks/recorder_mock.go github.com/exPriceD/pr-reviewer-service/internal/domain/metrics Recorder
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecorder is a mock of Recorder interface.
+type MockRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockRecorderMockRecorder is the mock recorder for MockRecorder.
+type MockRecorderMockRecorder struct {
+	mock *MockRecorder
+}
+
+// NewMockRecorder creates a new mock instance.
+func NewMockRecorder(ctrl *gomock.Controller) *MockRecorder {
+	mock := &MockRecorder{ctrl: ctrl}
+	mock.recorder = &MockRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecorder) EXPECT() *MockRecorderMockRecorder {
+	return m.recorder
+}
+
+// ReassignNoCandidate mocks base method.
+func (m *MockRecorder) ReassignNoCandidate() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReassignNoCandidate")
+}
+
+// ReassignNoCandidate indicates an expected call of ReassignNoCandidate.
+func (mr *MockRecorderMockRecorder) ReassignNoCandidate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignNoCandidate", reflect.TypeOf((*MockRecorder)(nil).ReassignNoCandidate))
+}
+
+// ReviewersAssigned mocks base method.
+func (m *MockRecorder) ReviewersAssigned(count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReviewersAssigned", count)
+}
+
+// ReviewersAssigned indicates an expected call of ReviewersAssigned.
+func (mr *MockRecorderMockRecorder) ReviewersAssigned(count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewersAssigned", reflect.TypeOf((*MockRecorder)(nil).ReviewersAssigned), count)
+}
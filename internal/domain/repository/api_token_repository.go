@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// APITokenRepository хранит статические API токены (только хеши)
+type APITokenRepository interface {
+	Create(ctx context.Context, token *entity.APIToken) error
+	FindByID(ctx context.Context, id string) (*entity.APIToken, error)
+	// FindByHash ищет токен по SHA-256 открытого значения
+	FindByHash(ctx context.Context, tokenHash string) (*entity.APIToken, error)
+	FindAll(ctx context.Context) ([]*entity.APIToken, error)
+	Update(ctx context.Context, token *entity.APIToken) error
+}
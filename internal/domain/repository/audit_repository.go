@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// AuditFilter условия выборки журнала аудита. Пустые поля не фильтруют
+type AuditFilter struct {
+	EntityType entity.AuditEntityType
+	EntityID   string
+	Actor      string
+	// From и To ограничивают occurred_at: From включительно, To не включительно
+	From *time.Time
+	To   *time.Time
+	// BeforeSeq возвращает только записи с seq меньше заданного (курсор страницы); 0 - с самой новой
+	BeforeSeq int64
+	Limit     int
+}
+
+// AuditRepository журнал аудита, который только дополняется
+type AuditRepository interface {
+	// Add записывает записи аудита, назначая им seq в порядке передачи
+	// ВАЖНО: Должен вызываться в транзакции изменения, которое описывают записи
+	Add(ctx context.Context, entries []*entity.AuditEntry) error
+	// Find возвращает записи по фильтру, новые первыми (по убыванию seq)
+	Find(ctx context.Context, filter AuditFilter) ([]*entity.AuditEntry, error)
+}
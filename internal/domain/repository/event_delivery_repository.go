@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// EventDeliveryRepository очередь доставок событий подписчикам и dead letter для проваленных
+type EventDeliveryRepository interface {
+	// Enqueue ставит доставки в очередь
+	Enqueue(ctx context.Context, deliveries []*entity.EventDelivery) error
+	// ClaimDue забирает до limit доставок, время которых наступило, и откладывает их на lease,
+	// чтобы параллельные обработчики не отправили их повторно
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.EventDelivery, error)
+	// Delete удаляет успешно отправленную доставку
+	Delete(ctx context.Context, id string) error
+	// Reschedule сохраняет число попыток, ошибку и время следующей попытки
+	Reschedule(ctx context.Context, delivery *entity.EventDelivery) error
+	// MoveToDeadLetter переносит доставку, исчерпавшую попытки, в dead letter
+	MoveToDeadLetter(ctx context.Context, delivery *entity.EventDelivery) error
+	// FindDeadLetters возвращает проваленные доставки подписки, новые первыми
+	FindDeadLetters(ctx context.Context, subscriptionID string) ([]*entity.EventDelivery, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// IdempotencyRepository хранит запросы с заголовком Idempotency-Key и ответы на них.
+// Ключ записи - пара scope и key
+type IdempotencyRepository interface {
+	// Reserve сохраняет запись выполняющегося запроса и возвращает true. Если ключ занят записью,
+	// действующей на момент record.CreatedAt, возвращает false; истекшая запись заменяется,
+	// в том числе запись запроса, который не успел сохранить ответ за время аренды
+	Reserve(ctx context.Context, record *entity.IdempotencyRecord) (bool, error)
+	// Find возвращает запись по ключу или ErrNotFound
+	Find(ctx context.Context, scope, key string) (*entity.IdempotencyRecord, error)
+	// SaveResponse сохраняет ответ и срок хранения записи. Возвращает ErrNotFound, если записи уже нет
+	// или ответ в ней уже сохранен
+	SaveResponse(ctx context.Context, record *entity.IdempotencyRecord) error
+	// Delete удаляет запись, чтобы повторный запрос выполнился заново
+	Delete(ctx context.Context, scope, key string) error
+	// DeleteExpired удаляет записи, истекшие к now, и возвращает их количество
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
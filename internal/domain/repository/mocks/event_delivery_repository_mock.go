@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/exPriceD/pr-reviewer-service/internal/domain/repository (interfaces: EventDeliveryRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination=internal/domain/repository/mocks/event_delivery_repository_mock.go github.com/exPriceD/pr-reviewer-service/internal/domain/repository EventDeliveryRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventDeliveryRepository is a mock of EventDeliveryRepository interface.
+type MockEventDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventDeliveryRepositoryMockRecorder is the mock recorder for MockEventDeliveryRepository.
+type MockEventDeliveryRepositoryMockRecorder struct {
+	mock *MockEventDeliveryRepository
+}
+
+// NewMockEventDeliveryRepository creates a new mock instance.
+func NewMockEventDeliveryRepository(ctrl *gomock.Controller) *MockEventDeliveryRepository {
+	mock := &MockEventDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockEventDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventDeliveryRepository) EXPECT() *MockEventDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockEventDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.EventDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]*entity.EventDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockEventDeliveryRepositoryMockRecorder) ClaimDue(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockEventDeliveryRepository)(nil).ClaimDue), ctx, now, lease, limit)
+}
+
+// Delete mocks base method.
+func (m *MockEventDeliveryRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEventDeliveryRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEventDeliveryRepository)(nil).Delete), ctx, id)
+}
+
+// Enqueue mocks base method.
+func (m *MockEventDeliveryRepository) Enqueue(ctx context.Context, deliveries []*entity.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockEventDeliveryRepositoryMockRecorder) Enqueue(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockEventDeliveryRepository)(nil).Enqueue), ctx, deliveries)
+}
+
+// FindDeadLetters mocks base method.
+func (m *MockEventDeliveryRepository) FindDeadLetters(ctx context.Context, subscriptionID string) ([]*entity.EventDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeadLetters", ctx, subscriptionID)
+	ret0, _ := ret[0].([]*entity.EventDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeadLetters indicates an expected call of FindDeadLetters.
+func (mr *MockEventDeliveryRepositoryMockRecorder) FindDeadLetters(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeadLetters", reflect.TypeOf((*MockEventDeliveryRepository)(nil).FindDeadLetters), ctx, subscriptionID)
+}
+
+// MoveToDeadLetter mocks base method.
+func (m *MockEventDeliveryRepository) MoveToDeadLetter(ctx context.Context, delivery *entity.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToDeadLetter", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToDeadLetter indicates an expected call of MoveToDeadLetter.
+func (mr *MockEventDeliveryRepositoryMockRecorder) MoveToDeadLetter(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToDeadLetter", reflect.TypeOf((*MockEventDeliveryRepository)(nil).MoveToDeadLetter), ctx, delivery)
+}
+
+// Reschedule mocks base method.
+func (m *MockEventDeliveryRepository) Reschedule(ctx context.Context, delivery *entity.EventDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockEventDeliveryRepositoryMockRecorder) Reschedule(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockEventDeliveryRepository)(nil).Reschedule), ctx, delivery)
+}
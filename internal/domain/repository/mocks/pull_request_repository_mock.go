@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	repository "github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,34 +42,33 @@ func (m *MockPullRequestRepository) EXPECT() *MockPullRequestRepositoryMockRecor
 	return m.recorder
 }
 
-// CountActiveReviewsByUserIDs mocks base method.
-func (m *MockPullRequestRepository) CountActiveReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+// AddReviewer mocks base method.
+func (m *MockPullRequestRepository) AddReviewer(ctx context.Context, prID, reviewerID string, isFallback bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountActiveReviewsByUserIDs", ctx, userIDs)
-	ret0, _ := ret[0].(map[string]int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AddReviewer", ctx, prID, reviewerID, isFallback)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CountActiveReviewsByUserIDs indicates an expected call of CountActiveReviewsByUserIDs.
-func (mr *MockPullRequestRepositoryMockRecorder) CountActiveReviewsByUserIDs(ctx, userIDs any) *gomock.Call {
+// AddReviewer indicates an expected call of AddReviewer.
+func (mr *MockPullRequestRepositoryMockRecorder) AddReviewer(ctx, prID, reviewerID, isFallback any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveReviewsByUserIDs", reflect.TypeOf((*MockPullRequestRepository)(nil).CountActiveReviewsByUserIDs), ctx, userIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).AddReviewer), ctx, prID, reviewerID, isFallback)
 }
 
-// CountReviewsByUserIDs mocks base method.
-func (m *MockPullRequestRepository) CountReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+// CountActiveReviewsByUserIDs mocks base method.
+func (m *MockPullRequestRepository) CountActiveReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountReviewsByUserIDs", ctx, userIDs)
+	ret := m.ctrl.Call(m, "CountActiveReviewsByUserIDs", ctx, userIDs)
 	ret0, _ := ret[0].(map[string]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountReviewsByUserIDs indicates an expected call of CountReviewsByUserIDs.
-func (mr *MockPullRequestRepositoryMockRecorder) CountReviewsByUserIDs(ctx, userIDs any) *gomock.Call {
+// CountActiveReviewsByUserIDs indicates an expected call of CountActiveReviewsByUserIDs.
+func (mr *MockPullRequestRepositoryMockRecorder) CountActiveReviewsByUserIDs(ctx, userIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviewsByUserIDs", reflect.TypeOf((*MockPullRequestRepository)(nil).CountReviewsByUserIDs), ctx, userIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveReviewsByUserIDs", reflect.TypeOf((*MockPullRequestRepository)(nil).CountActiveReviewsByUserIDs), ctx, userIDs)
 }
 
 // Create mocks base method.
@@ -174,15 +174,28 @@ func (mr *MockPullRequestRepositoryMockRecorder) FindByReviewerID(ctx, reviewerI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByReviewerID", reflect.TypeOf((*MockPullRequestRepository)(nil).FindByReviewerID), ctx, reviewerID)
 }
 
+// FindOpenByReviewerIDsForUpdate mocks base method.
+func (m *MockPullRequestRepository) FindOpenByReviewerIDsForUpdate(ctx context.Context, reviewerIDs []string) ([]*entity.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenByReviewerIDsForUpdate", ctx, reviewerIDs)
+	ret0, _ := ret[0].([]*entity.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenByReviewerIDsForUpdate indicates an expected call of FindOpenByReviewerIDsForUpdate.
+func (mr *MockPullRequestRepositoryMockRecorder) FindOpenByReviewerIDsForUpdate(ctx, reviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenByReviewerIDsForUpdate", reflect.TypeOf((*MockPullRequestRepository)(nil).FindOpenByReviewerIDsForUpdate), ctx, reviewerIDs)
+}
+
 // GetStats mocks base method.
-func (m *MockPullRequestRepository) GetStats(ctx context.Context) (int, int, int, error) {
+func (m *MockPullRequestRepository) GetStats(ctx context.Context) (repository.PRStats, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStats", ctx)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(int)
-	ret3, _ := ret[3].(error)
-	return ret0, ret1, ret2, ret3
+	ret0, _ := ret[0].(repository.PRStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetStats indicates an expected call of GetStats.
@@ -191,32 +204,60 @@ func (mr *MockPullRequestRepositoryMockRecorder) GetStats(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockPullRequestRepository)(nil).GetStats), ctx)
 }
 
-// MergePR mocks base method.
-func (m *MockPullRequestRepository) MergePR(ctx context.Context, prID string) error {
+// ReassignReviewers mocks base method.
+func (m *MockPullRequestRepository) ReassignReviewers(ctx context.Context, changes []repository.ReviewerChange) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergePR", ctx, prID)
+	ret := m.ctrl.Call(m, "ReassignReviewers", ctx, changes)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MergePR indicates an expected call of MergePR.
-func (mr *MockPullRequestRepositoryMockRecorder) MergePR(ctx, prID any) *gomock.Call {
+// ReassignReviewers indicates an expected call of ReassignReviewers.
+func (mr *MockPullRequestRepositoryMockRecorder) ReassignReviewers(ctx, changes any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePR", reflect.TypeOf((*MockPullRequestRepository)(nil).MergePR), ctx, prID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewers", reflect.TypeOf((*MockPullRequestRepository)(nil).ReassignReviewers), ctx, changes)
+}
+
+// RemoveReviewer mocks base method.
+func (m *MockPullRequestRepository) RemoveReviewer(ctx context.Context, prID, reviewerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReviewer", ctx, prID, reviewerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReviewer indicates an expected call of RemoveReviewer.
+func (mr *MockPullRequestRepositoryMockRecorder) RemoveReviewer(ctx, prID, reviewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).RemoveReviewer), ctx, prID, reviewerID)
 }
 
 // ReplaceReviewer mocks base method.
-func (m *MockPullRequestRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+func (m *MockPullRequestRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, isFallback bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceReviewer", ctx, prID, oldReviewerID, newReviewerID)
+	ret := m.ctrl.Call(m, "ReplaceReviewer", ctx, prID, oldReviewerID, newReviewerID, isFallback)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReplaceReviewer indicates an expected call of ReplaceReviewer.
-func (mr *MockPullRequestRepositoryMockRecorder) ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID any) *gomock.Call {
+func (mr *MockPullRequestRepositoryMockRecorder) ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID, isFallback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).ReplaceReviewer), ctx, prID, oldReviewerID, newReviewerID, isFallback)
+}
+
+// SaveReview mocks base method.
+func (m *MockPullRequestRepository) SaveReview(ctx context.Context, prID string, review entity.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReview", ctx, prID, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveReview indicates an expected call of SaveReview.
+func (mr *MockPullRequestRepositoryMockRecorder) SaveReview(ctx, prID, review any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).ReplaceReviewer), ctx, prID, oldReviewerID, newReviewerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReview", reflect.TypeOf((*MockPullRequestRepository)(nil).SaveReview), ctx, prID, review)
 }
 
 // Update mocks base method.
@@ -232,3 +273,17 @@ func (mr *MockPullRequestRepositoryMockRecorder) Update(ctx, pr any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPullRequestRepository)(nil).Update), ctx, pr)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockPullRequestRepository) UpdateStatus(ctx context.Context, pr *entity.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, pr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPullRequestRepositoryMockRecorder) UpdateStatus(ctx, pr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPullRequestRepository)(nil).UpdateStatus), ctx, pr)
+}
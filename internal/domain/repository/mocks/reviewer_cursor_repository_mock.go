@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/exPriceD/pr-reviewer-service/internal/domain/repository (interfaces: ReviewerCursorRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination=internal/domain/repository/mocks/reviewer_cursor_repository_mock.go github.com/exPriceD/pr-reviewer-service/internal/domain/repository ReviewerCursorRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewerCursorRepository is a mock of ReviewerCursorRepository interface.
+type MockReviewerCursorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewerCursorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewerCursorRepositoryMockRecorder is the mock recorder for MockReviewerCursorRepository.
+type MockReviewerCursorRepositoryMockRecorder struct {
+	mock *MockReviewerCursorRepository
+}
+
+// NewMockReviewerCursorRepository creates a new mock instance.
+func NewMockReviewerCursorRepository(ctrl *gomock.Controller) *MockReviewerCursorRepository {
+	mock := &MockReviewerCursorRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewerCursorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewerCursorRepository) EXPECT() *MockReviewerCursorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// LockCursor mocks base method.
+func (m *MockReviewerCursorRepository) LockCursor(ctx context.Context, teamName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCursor", ctx, teamName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCursor indicates an expected call of LockCursor.
+func (mr *MockReviewerCursorRepositoryMockRecorder) LockCursor(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCursor", reflect.TypeOf((*MockReviewerCursorRepository)(nil).LockCursor), ctx, teamName)
+}
+
+// SaveCursor mocks base method.
+func (m *MockReviewerCursorRepository) SaveCursor(ctx context.Context, teamName, lastUserID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCursor", ctx, teamName, lastUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCursor indicates an expected call of SaveCursor.
+func (mr *MockReviewerCursorRepositoryMockRecorder) SaveCursor(ctx, teamName, lastUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCursor", reflect.TypeOf((*MockReviewerCursorRepository)(nil).SaveCursor), ctx, teamName, lastUserID)
+}
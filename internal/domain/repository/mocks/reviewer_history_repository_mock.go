@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/exPriceD/pr-reviewer-service/internal/domain/repository (interfaces: ReviewerHistoryRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination=internal/domain/repository/mocks/reviewer_history_repository_mock.go github.com/exPriceD/pr-reviewer-service/internal/domain/repository ReviewerHistoryRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewerHistoryRepository is a mock of ReviewerHistoryRepository interface.
+type MockReviewerHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewerHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewerHistoryRepositoryMockRecorder is the mock recorder for MockReviewerHistoryRepository.
+type MockReviewerHistoryRepositoryMockRecorder struct {
+	mock *MockReviewerHistoryRepository
+}
+
+// NewMockReviewerHistoryRepository creates a new mock instance.
+func NewMockReviewerHistoryRepository(ctrl *gomock.Controller) *MockReviewerHistoryRepository {
+	mock := &MockReviewerHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewerHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewerHistoryRepository) EXPECT() *MockReviewerHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockReviewerHistoryRepository) Add(ctx context.Context, entries []*entity.ReviewerHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockReviewerHistoryRepositoryMockRecorder) Add(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockReviewerHistoryRepository)(nil).Add), ctx, entries)
+}
+
+// CountAssignedPRsByUserIDs mocks base method.
+func (m *MockReviewerHistoryRepository) CountAssignedPRsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAssignedPRsByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAssignedPRsByUserIDs indicates an expected call of CountAssignedPRsByUserIDs.
+func (mr *MockReviewerHistoryRepositoryMockRecorder) CountAssignedPRsByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAssignedPRsByUserIDs", reflect.TypeOf((*MockReviewerHistoryRepository)(nil).CountAssignedPRsByUserIDs), ctx, userIDs)
+}
+
+// FindByPRID mocks base method.
+func (m *MockReviewerHistoryRepository) FindByPRID(ctx context.Context, prID string) ([]*entity.ReviewerHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPRID", ctx, prID)
+	ret0, _ := ret[0].([]*entity.ReviewerHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPRID indicates an expected call of FindByPRID.
+func (mr *MockReviewerHistoryRepositoryMockRecorder) FindByPRID(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPRID", reflect.TypeOf((*MockReviewerHistoryRepository)(nil).FindByPRID), ctx, prID)
+}
@@ -41,6 +41,21 @@ func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountActiveMembers mocks base method.
+func (m *MockTeamRepository) CountActiveMembers(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveMembers", ctx)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveMembers indicates an expected call of CountActiveMembers.
+func (mr *MockTeamRepositoryMockRecorder) CountActiveMembers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveMembers", reflect.TypeOf((*MockTeamRepository)(nil).CountActiveMembers), ctx)
+}
+
 // Create mocks base method.
 func (m *MockTeamRepository) Create(ctx context.Context, team *entity.Team) error {
 	m.ctrl.T.Helper()
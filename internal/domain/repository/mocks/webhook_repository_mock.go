@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/exPriceD/pr-reviewer-service/internal/domain/repository (interfaces: WebhookRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination=internal/domain/repository/mocks/webhook_repository_mock.go github.com/exPriceD/pr-reviewer-service/internal/domain/repository WebhookRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindUserIDByLogin mocks base method.
+func (m *MockWebhookRepository) FindUserIDByLogin(ctx context.Context, provider entity.GitProvider, login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserIDByLogin", ctx, provider, login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserIDByLogin indicates an expected call of FindUserIDByLogin.
+func (mr *MockWebhookRepositoryMockRecorder) FindUserIDByLogin(ctx, provider, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserIDByLogin", reflect.TypeOf((*MockWebhookRepository)(nil).FindUserIDByLogin), ctx, provider, login)
+}
+
+// RegisterDelivery mocks base method.
+func (m *MockWebhookRepository) RegisterDelivery(ctx context.Context, provider entity.GitProvider, deliveryID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDelivery", ctx, provider, deliveryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDelivery indicates an expected call of RegisterDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) RegisterDelivery(ctx, provider, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).RegisterDelivery), ctx, provider, deliveryID)
+}
+
+// SaveLogin mocks base method.
+func (m *MockWebhookRepository) SaveLogin(ctx context.Context, provider entity.GitProvider, login, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLogin", ctx, provider, login, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLogin indicates an expected call of SaveLogin.
+func (mr *MockWebhookRepositoryMockRecorder) SaveLogin(ctx, provider, login, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLogin", reflect.TypeOf((*MockWebhookRepository)(nil).SaveLogin), ctx, provider, login, userID)
+}
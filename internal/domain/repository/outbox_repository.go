@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// OutboxRepository outbox доменных событий
+type OutboxRepository interface {
+	// Add записывает сообщения в outbox
+	// ВАЖНО: Должен вызываться в транзакции изменения, которое порождает события
+	Add(ctx context.Context, messages []*entity.OutboxMessage) error
+	// FindPendingForUpdate возвращает до limit неопубликованных сообщений в порядке записи и блокирует их.
+	// Сообщения, заблокированные другой транзакцией, пропускаются
+	// ВАЖНО: Должен вызываться внутри транзакции
+	FindPendingForUpdate(ctx context.Context, limit int) ([]*entity.OutboxMessage, error)
+	// MarkSent отмечает сообщения опубликованными
+	MarkSent(ctx context.Context, ids []string, sentAt time.Time) error
+}
@@ -6,6 +6,27 @@ import (
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 )
 
+// ReviewerChange описывает перенос слота ревьювера в PR.
+// Пустой NewReviewerID означает, что слот освобождается без замены
+type ReviewerChange struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+	// IsFallback новый ревьювер из запасной команды, а не из команды PR
+	IsFallback bool
+	// Reason поясняет выбор замены (или почему слот освобожден) для журнала аудита
+	Reason string
+}
+
+// PRStats количество PR по статусам
+type PRStats struct {
+	Total  int
+	Draft  int
+	Open   int
+	Merged int
+	Closed int
+}
+
 // PullRequestRepository интерфейс для работы с Pull Request
 type PullRequestRepository interface {
 	Create(ctx context.Context, pr *entity.PullRequest) error
@@ -13,12 +34,16 @@ type PullRequestRepository interface {
 	FindByIDForUpdate(ctx context.Context, id string) (*entity.PullRequest, error)
 	FindByReviewerID(ctx context.Context, reviewerID string) ([]*entity.PullRequest, error)
 	FindByAuthorID(ctx context.Context, authorID string) ([]*entity.PullRequest, error)
+	FindOpenByReviewerIDsForUpdate(ctx context.Context, reviewerIDs []string) ([]*entity.PullRequest, error)
 	Update(ctx context.Context, pr *entity.PullRequest) error
-	ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
-	MergePR(ctx context.Context, prID string) error
+	UpdateStatus(ctx context.Context, pr *entity.PullRequest) error
+	AddReviewer(ctx context.Context, prID, reviewerID string, isFallback bool) error
+	RemoveReviewer(ctx context.Context, prID, reviewerID string) error
+	ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, isFallback bool) error
+	SaveReview(ctx context.Context, prID string, review entity.Review) error
+	ReassignReviewers(ctx context.Context, changes []ReviewerChange) error
 	Delete(ctx context.Context, id string) error
 	Exists(ctx context.Context, id string) (bool, error)
 	CountActiveReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error)
-	GetStats(ctx context.Context) (total, open, merged int, err error)
-	CountReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error)
+	GetStats(ctx context.Context) (PRStats, error)
 }
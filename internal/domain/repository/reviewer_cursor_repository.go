@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+)
+
+// ReviewerCursorRepository хранит курсор стратегии round_robin для каждой команды
+type ReviewerCursorRepository interface {
+	// LockCursor возвращает последнего назначенного ревьювера команды и блокирует курсор до конца транзакции
+	LockCursor(ctx context.Context, teamName string) (string, error)
+	// SaveCursor сохраняет последнего назначенного ревьювера команды
+	SaveCursor(ctx context.Context, teamName, lastUserID string) error
+}
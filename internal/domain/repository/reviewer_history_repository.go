@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// ReviewerHistoryRepository интерфейс для истории назначений ревьюверов.
+// История только дополняется и пишется в транзакции изменения pr_reviewers
+type ReviewerHistoryRepository interface {
+	Add(ctx context.Context, entries []*entity.ReviewerHistoryEntry) error
+	// FindByPRID возвращает историю PR в порядке записи, старые события первыми
+	FindByPRID(ctx context.Context, prID string) ([]*entity.ReviewerHistoryEntry, error)
+	// CountAssignedPRsByUserIDs возвращает число PR, в которых пользователь когда-либо был ревьювером,
+	// включая PR, с которых он позже снят
+	CountAssignedPRsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error)
+}
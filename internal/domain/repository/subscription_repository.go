@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// SubscriptionRepository хранит подписки на события
+type SubscriptionRepository interface {
+	Create(ctx context.Context, subscription *entity.Subscription) error
+	FindByID(ctx context.Context, id string) (*entity.Subscription, error)
+	FindAll(ctx context.Context) ([]*entity.Subscription, error)
+	// FindActiveByEventType возвращает активные подписки на события этого типа
+	FindActiveByEventType(ctx context.Context, eventType entity.EventType) ([]*entity.Subscription, error)
+	Update(ctx context.Context, subscription *entity.Subscription) error
+	Delete(ctx context.Context, id string) error
+}
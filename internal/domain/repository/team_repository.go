@@ -12,4 +12,6 @@ type TeamRepository interface {
 	Update(ctx context.Context, team *entity.Team) error
 	Delete(ctx context.Context, name string) error
 	Exists(ctx context.Context, name string) (bool, error)
+	// CountActiveMembers возвращает число активных участников каждой команды, включая команды без активных
+	CountActiveMembers(ctx context.Context) (map[string]int, error)
 }
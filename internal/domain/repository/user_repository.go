@@ -9,6 +9,7 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	FindByID(ctx context.Context, id string) (*entity.User, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
 	FindByTeamName(ctx context.Context, teamName string) ([]*entity.User, error)
 	FindActiveByTeamName(ctx context.Context, teamName string) ([]*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
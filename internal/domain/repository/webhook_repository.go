@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// WebhookRepository хранит сопоставление логинов git-хостингов с пользователями и принятые доставки вебхуков
+type WebhookRepository interface {
+	// FindUserIDByLogin возвращает пользователя, сопоставленного логину, или ErrNotFound
+	FindUserIDByLogin(ctx context.Context, provider entity.GitProvider, login string) (string, error)
+	// SaveLogin сопоставляет логин пользователю, заменяя прежнее сопоставление
+	SaveLogin(ctx context.Context, provider entity.GitProvider, login, userID string) error
+	// RegisterDelivery запоминает доставку и возвращает false, если она уже была принята
+	RegisterDelivery(ctx context.Context, provider entity.GitProvider, deliveryID string) (bool, error)
+}
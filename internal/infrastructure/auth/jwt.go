@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+// Алгоритмы подписи JWT
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+)
+
+// ErrInvalidJWT токен не прошел проверку
+var ErrInvalidJWT = errors.New("invalid jwt")
+
+// JWTConfig ключ и ожидаемые claims. Задается ровно один из Secret (HS256) и PublicKey (RS256).
+// Пустые Issuer и Audience не проверяются
+type JWTConfig struct {
+	Secret    []byte
+	PublicKey *rsa.PublicKey
+	Issuer    string
+	Audience  string
+}
+
+// JWTVerifier проверяет JWT, подписанный настроенным ключом.
+// Субъект берется из claim sub, роль из claim role; exp обязателен
+type JWTVerifier struct {
+	cfg       JWTConfig
+	algorithm string
+	now       func() time.Time
+}
+
+// NewJWTVerifier создает новый JWTVerifier
+func NewJWTVerifier(cfg JWTConfig) (*JWTVerifier, error) {
+	var algorithm string
+	switch {
+	case len(cfg.Secret) > 0 && cfg.PublicKey != nil:
+		return nil, fmt.Errorf("jwt secret and public key are mutually exclusive")
+	case len(cfg.Secret) > 0:
+		algorithm = AlgorithmHS256
+	case cfg.PublicKey != nil:
+		algorithm = AlgorithmRS256
+	default:
+		return nil, fmt.Errorf("jwt secret or public key is required")
+	}
+
+	return &JWTVerifier{
+		cfg:       cfg,
+		algorithm: algorithm,
+		now:       time.Now,
+	}, nil
+}
+
+// LoadRSAPublicKey читает открытый RSA ключ из PEM файла (PKIX или PKCS#1)
+func LoadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	//nolint:gosec // путь к ключу задается конфигурацией, а не пользовательским вводом
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("public key %s is not PEM encoded", path)
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %s: %w", path, err)
+	}
+
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key %s is not an RSA key", path)
+	}
+	return key, nil
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	Role      string   `json:"role"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *int64   `json:"exp"`
+	NotBefore *int64   `json:"nbf"`
+}
+
+// audience claim aud: строка или массив строк
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("aud must be a string or an array of strings")
+	}
+	*a = multiple
+	return nil
+}
+
+// Verify проверяет подпись и claims токена и возвращает субъекта
+func (v *JWTVerifier) Verify(token string) (*entity.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidJWT)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %w", ErrInvalidJWT, err)
+	}
+	// Алгоритм фиксируется конфигурацией, чтобы токен не мог выбрать none или HS256 с открытым ключом
+	if header.Algorithm != v.algorithm {
+		return nil, fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidJWT, header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature encoding: %w", ErrInvalidJWT, err)
+	}
+	if err := v.verifySignature(parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrInvalidJWT, err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+
+	role, err := entity.ParseRole(claims.Role)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+
+	principal, err := entity.NewPrincipal(claims.Subject, role)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+	return principal, nil
+}
+
+func (v *JWTVerifier) verifySignature(signingInput string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signingInput))
+
+	switch v.algorithm {
+	case AlgorithmHS256:
+		mac := hmac.New(sha256.New, v.cfg.Secret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return fmt.Errorf("%w: signature mismatch", ErrInvalidJWT)
+		}
+	case AlgorithmRS256:
+		if err := rsa.VerifyPKCS1v15(v.cfg.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("%w: signature mismatch", ErrInvalidJWT)
+		}
+	}
+	return nil
+}
+
+func (v *JWTVerifier) validateClaims(claims jwtClaims) error {
+	now := v.now().Unix()
+
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("%w: exp is required", ErrInvalidJWT)
+	}
+	if now >= *claims.ExpiresAt {
+		return fmt.Errorf("%w: token is expired", ErrInvalidJWT)
+	}
+	if claims.NotBefore != nil && now < *claims.NotBefore {
+		return fmt.Errorf("%w: token is not valid yet", ErrInvalidJWT)
+	}
+	if v.cfg.Issuer != "" && claims.Issuer != v.cfg.Issuer {
+		return fmt.Errorf("%w: unexpected issuer %q", ErrInvalidJWT, claims.Issuer)
+	}
+	if v.cfg.Audience != "" && !slices.Contains(claims.Audience, v.cfg.Audience) {
+		return fmt.Errorf("%w: token is not issued for this audience", ErrInvalidJWT)
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
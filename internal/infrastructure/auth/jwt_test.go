@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret []byte, header, claims map[string]any) string {
+	t.Helper()
+	input := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	input := encodeSegment(t, map[string]any{"alg": "RS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub":  "u1",
+		"role": "team-lead",
+		"iss":  "sso",
+		"aud":  []string{"pr-reviewer", "other"},
+		"exp":  testNow.Add(time.Hour).Unix(),
+	}
+}
+
+func TestJWTVerifier_VerifyHS256(t *testing.T) {
+	secret := []byte("test-jwt-secret")
+	hs256 := map[string]any{"alg": "HS256", "typ": "JWT"}
+
+	withClaim := func(key string, value any) map[string]any {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		expectErr bool
+	}{
+		{
+			name:  "valid token",
+			token: signHS256(t, secret, hs256, validClaims()),
+		},
+		{
+			name:  "audience as string",
+			token: signHS256(t, secret, hs256, withClaim("aud", "pr-reviewer")),
+		},
+		{
+			name:      "wrong secret",
+			token:     signHS256(t, []byte("another-secret"), hs256, validClaims()),
+			expectErr: true,
+		},
+		{
+			name:      "alg none",
+			token:     encodeSegment(t, map[string]any{"alg": "none"}) + "." + encodeSegment(t, validClaims()) + ".",
+			expectErr: true,
+		},
+		{
+			name:      "expired",
+			token:     signHS256(t, secret, hs256, withClaim("exp", testNow.Add(-time.Minute).Unix())),
+			expectErr: true,
+		},
+		{
+			name:      "exp missing",
+			token:     signHS256(t, secret, hs256, withClaim("exp", nil)),
+			expectErr: true,
+		},
+		{
+			name:      "not valid yet",
+			token:     signHS256(t, secret, hs256, withClaim("nbf", testNow.Add(time.Minute).Unix())),
+			expectErr: true,
+		},
+		{
+			name:      "wrong issuer",
+			token:     signHS256(t, secret, hs256, withClaim("iss", "someone-else")),
+			expectErr: true,
+		},
+		{
+			name:      "wrong audience",
+			token:     signHS256(t, secret, hs256, withClaim("aud", "other")),
+			expectErr: true,
+		},
+		{
+			name:      "unknown role",
+			token:     signHS256(t, secret, hs256, withClaim("role", "root")),
+			expectErr: true,
+		},
+		{
+			name:      "subject missing",
+			token:     signHS256(t, secret, hs256, withClaim("sub", nil)),
+			expectErr: true,
+		},
+		{
+			name:      "malformed",
+			token:     "not-a-jwt",
+			expectErr: true,
+		},
+	}
+
+	verifier, err := NewJWTVerifier(JWTConfig{Secret: secret, Issuer: "sso", Audience: "pr-reviewer"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	verifier.now = func() time.Time { return testNow }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := verifier.Verify(tt.token)
+
+			if tt.expectErr {
+				if !errors.Is(err, ErrInvalidJWT) {
+					t.Fatalf("expected ErrInvalidJWT, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if principal.ID() != "u1" || principal.Role() != entity.RoleTeamLead {
+				t.Errorf("expected u1/team-lead, got %s/%s", principal.ID(), principal.Role())
+			}
+		})
+	}
+}
+
+func TestJWTVerifier_VerifyRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwt.pub")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write public key: %v", err)
+	}
+
+	publicKey, err := LoadRSAPublicKey(path)
+	if err != nil {
+		t.Fatalf("failed to load public key: %v", err)
+	}
+
+	verifier, err := NewJWTVerifier(JWTConfig{PublicKey: publicKey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	verifier.now = func() time.Time { return testNow }
+
+	if _, err := verifier.Verify(signRS256(t, key, validClaims())); err != nil {
+		t.Errorf("expected RS256 token to be valid, got %v", err)
+	}
+
+	// HS256 токен, подписанный открытым ключом как секретом, не должен приниматься
+	forged := signHS256(t, der, map[string]any{"alg": "HS256"}, validClaims())
+	if _, err := verifier.Verify(forged); !errors.Is(err, ErrInvalidJWT) {
+		t.Errorf("expected ErrInvalidJWT for algorithm confusion, got %v", err)
+	}
+}
+
+func TestNewJWTVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	if _, err := NewJWTVerifier(JWTConfig{}); err == nil {
+		t.Error("expected error without key")
+	}
+	if _, err := NewJWTVerifier(JWTConfig{Secret: []byte("s"), PublicKey: &key.PublicKey}); err == nil {
+		t.Error("expected error with both secret and public key")
+	}
+}
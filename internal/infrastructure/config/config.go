@@ -2,9 +2,11 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -27,12 +29,28 @@ const (
 	DefaultServerMaxHeaderBytes = 1 << 20
 	// DefaultServerMaxBodySize максимальный размер тела запроса по умолчанию (10MB)
 	DefaultServerMaxBodySize = 10 * 1024 * 1024
+	// DefaultServerReadinessTimeout таймаут одной проверки /readyz по умолчанию (секунды)
+	DefaultServerReadinessTimeout = 2
 
 	// MinServerTimeout минимальный таймаут сервера (секунды)
 	MinServerTimeout = 1
 	// MinServerSize минимальный размер в байтах для сервера
 	MinServerSize = 1024
 
+	// StorageDriverPostgres хранилище в PostgreSQL
+	StorageDriverPostgres = "postgres"
+	// StorageDriverMemory хранилище в памяти процесса, данные теряются при перезапуске
+	StorageDriverMemory = "memory"
+	// StorageDriverSQLite хранилище в файле SQLite
+	StorageDriverSQLite = "sqlite"
+	// DefaultStorageDriver хранилище по умолчанию
+	DefaultStorageDriver = StorageDriverPostgres
+
+	// DefaultSQLitePath файл базы SQLite по умолчанию
+	DefaultSQLitePath = "data/pr-reviewer.db"
+	// DefaultSQLiteBusyTimeout сколько ждать освобождения блокировки записи по умолчанию (секунды)
+	DefaultSQLiteBusyTimeout = 5
+
 	// MinDatabasePort минимальный порт базы данных
 	MinDatabasePort = 1
 	// MaxDatabasePort максимальный порт базы данных
@@ -47,13 +65,66 @@ const (
 	DefaultDatabasePingTimeout = 5
 	// MinDatabaseTimeout минимальный таймаут базы данных (секунды/минуты)
 	MinDatabaseTimeout = 1
+
+	// DefaultReviewerStrategy стратегия выбора ревьюверов по умолчанию
+	DefaultReviewerStrategy = "least_loaded"
+
+	// DefaultNotificationsPollInterval интервал опроса очереди доставок по умолчанию (секунды)
+	DefaultNotificationsPollInterval = 5
+	// DefaultNotificationsMaxAttempts число попыток доставки по умолчанию
+	DefaultNotificationsMaxAttempts = 8
+	// DefaultNotificationsInitialBackoff задержка перед первым повтором по умолчанию (секунды)
+	DefaultNotificationsInitialBackoff = 10
+	// DefaultNotificationsMaxBackoff максимальная задержка между повторами по умолчанию (секунды)
+	DefaultNotificationsMaxBackoff = 3600
+	// DefaultNotificationsRequestTimeout таймаут запроса к подписчику по умолчанию (секунды)
+	DefaultNotificationsRequestTimeout = 10
+	// DefaultNotificationsBatchSize размер пачки доставок по умолчанию
+	DefaultNotificationsBatchSize = 50
+
+	// DefaultOutboxPollInterval интервал опроса outbox по умолчанию (секунды)
+	DefaultOutboxPollInterval = 1
+	// DefaultOutboxBatchSize размер пачки публикуемых событий по умолчанию
+	DefaultOutboxBatchSize = 100
+
+	// MinAuthBootstrapTokenLength минимальная длина bootstrap токена
+	MinAuthBootstrapTokenLength = 16
+
+	// TracingExporterOTLP экспорт трассировок по OTLP/HTTP
+	TracingExporterOTLP = "otlp"
+	// TracingExporterStdout вывод трассировок в stdout, для отладки
+	TracingExporterStdout = "stdout"
+	// DefaultTracingExporter экспорт трассировок по умолчанию
+	DefaultTracingExporter = TracingExporterOTLP
+	// DefaultTracingSampleRatio доля записываемых трассировок по умолчанию
+	DefaultTracingSampleRatio = 1.0
+	// DefaultTracingServiceName имя сервиса в трассировках по умолчанию
+	DefaultTracingServiceName = "pr-reviewer-service"
+
+	// DefaultIdempotencyTTL время хранения ответа на запрос с Idempotency-Key по умолчанию (секунды)
+	DefaultIdempotencyTTL = 86400
+	// DefaultIdempotencyLease сколько ключ занят выполняющимся запросом по умолчанию (секунды)
+	DefaultIdempotencyLease = 60
+	// DefaultIdempotencyCleanupInterval интервал удаления истекших ключей по умолчанию (секунды)
+	DefaultIdempotencyCleanupInterval = 600
 )
 
 // Config конфигурация приложения
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Database DatabaseConfig `yaml:"database"`
-	Logger   LoggerConfig   `yaml:"logger"`
+	Server        ServerConfig        `yaml:"server"`
+	Storage       StorageConfig       `yaml:"storage"`
+	Database      DatabaseConfig      `yaml:"database"`
+	SQLite        SQLiteConfig        `yaml:"sqlite"`
+	Logger        LoggerConfig        `yaml:"logger"`
+	Reviewer      ReviewerConfig      `yaml:"reviewer"`
+	Webhooks      WebhooksConfig      `yaml:"webhooks"`
+	Notifications NotificationsConfig `yaml:"notifications"`
+	Outbox        OutboxConfig        `yaml:"outbox"`
+	Auth          AuthConfig          `yaml:"auth"`
+	Metrics       MetricsConfig       `yaml:"metrics"`
+	Tracing       TracingConfig       `yaml:"tracing"`
+	RateLimit     RateLimitConfig     `yaml:"rate_limit"`
+	Idempotency   IdempotencyConfig   `yaml:"idempotency"`
 }
 
 // ServerConfig конфигурация HTTP сервера
@@ -66,6 +137,19 @@ type ServerConfig struct {
 	MaxHeaderBytes  int    `yaml:"max_header_bytes"` // в байтах
 	MaxBodySize     int    `yaml:"max_body_size"`    // в байтах
 	ShutdownTimeout int    `yaml:"shutdown_timeout"` // в секундах
+	// ReadinessTimeout таймаут одной проверки зависимости в /readyz, в секундах
+	ReadinessTimeout int `yaml:"readiness_timeout"`
+	// DrainDelay сколько /readyz отвечает 503 перед остановкой сервера, чтобы балансировщик
+	// успел вывести экземпляр из ротации, в секундах. 0 - останавливаться сразу
+	DrainDelay int `yaml:"drain_delay"`
+}
+
+// StorageConfig выбор хранилища. Секция database нужна только для драйвера postgres, sqlite - для sqlite
+type StorageConfig struct {
+	Driver string `yaml:"driver"` // postgres, sqlite или memory
+	// AutoMigrate применять встроенные миграции при старте. Без него сервис не стартует,
+	// если версия схемы базы не совпадает с ожидаемой
+	AutoMigrate bool `yaml:"auto_migrate"`
 }
 
 // DatabaseConfig конфигурация базы данных
@@ -82,12 +166,97 @@ type DatabaseConfig struct {
 	PingTimeout     int    `yaml:"ping_timeout"` // в секундах
 }
 
+// SQLiteConfig конфигурация хранилища SQLite
+type SQLiteConfig struct {
+	Path        string `yaml:"path"`         // путь к файлу базы, ":memory:" - база в памяти процесса
+	BusyTimeout int    `yaml:"busy_timeout"` // в секундах
+}
+
 // LoggerConfig конфигурация логгера
 type LoggerConfig struct {
 	Level  string `yaml:"level"`
 	Format string `yaml:"format"`
 }
 
+// ReviewerConfig конфигурация выбора ревьюверов
+type ReviewerConfig struct {
+	// Strategy стратегия для команд, у которых своя стратегия не задана
+	Strategy string `yaml:"strategy"`
+}
+
+// WebhooksConfig секреты вебхуков git-хостингов. Пустой секрет отключает вебхук
+type WebhooksConfig struct {
+	GitHubSecret string `yaml:"github_secret"`
+	GitLabSecret string `yaml:"gitlab_secret"`
+}
+
+// NotificationsConfig конфигурация доставки событий подписчикам
+type NotificationsConfig struct {
+	PollInterval   int `yaml:"poll_interval"`   // в секундах
+	MaxAttempts    int `yaml:"max_attempts"`    // после стольких неудач доставка уходит в dead letter
+	InitialBackoff int `yaml:"initial_backoff"` // в секундах, удваивается после каждой неудачи
+	MaxBackoff     int `yaml:"max_backoff"`     // в секундах
+	RequestTimeout int `yaml:"request_timeout"` // в секундах
+	BatchSize      int `yaml:"batch_size"`
+}
+
+// OutboxConfig конфигурация публикации доменных событий из outbox
+type OutboxConfig struct {
+	PollInterval int `yaml:"poll_interval"` // в секундах
+	BatchSize    int `yaml:"batch_size"`
+}
+
+// AuthConfig конфигурация аутентификации по Bearer токену.
+// JWT принимаются, если задан ровно один из jwt_secret (HS256) и jwt_public_key_file (RS256)
+type AuthConfig struct {
+	Enabled          bool   `yaml:"enabled"`
+	BootstrapToken   string `yaml:"bootstrap_token"` // токен администратора для выпуска первых API токенов
+	JWTSecret        string `yaml:"jwt_secret"`
+	JWTPublicKeyFile string `yaml:"jwt_public_key_file"` // PEM файл открытого RSA ключа
+	JWTIssuer        string `yaml:"jwt_issuer"`          // пустое значение не проверяется
+	JWTAudience      string `yaml:"jwt_audience"`        // пустое значение не проверяется
+}
+
+// MetricsConfig конфигурация метрик Prometheus
+type MetricsConfig struct {
+	Enabled bool `yaml:"enabled"` // публиковать метрики на GET /metrics
+}
+
+// TracingConfig конфигурация трассировки OpenTelemetry. Контекст W3C traceparent
+// принимается и передается дальше и при выключенной трассировке, тогда trace_id попадает в логи,
+// но спаны не записываются
+type TracingConfig struct {
+	Enabled     bool    `yaml:"enabled"`
+	Exporter    string  `yaml:"exporter"`     // otlp или stdout
+	Endpoint    string  `yaml:"endpoint"`     // URL коллектора OTLP/HTTP, пустое значение - из OTEL_EXPORTER_OTLP_ENDPOINT
+	SampleRatio float64 `yaml:"sample_ratio"` // доля трассировок, начатых сервисом, до 1; для продолженных решает вызывающий
+	ServiceName string  `yaml:"service_name"`
+}
+
+// RateLimitConfig ограничение частоты запросов одного клиента (субъекта или IP адреса).
+// Маршруты без собственного лимита делят квоту клиента default
+type RateLimitConfig struct {
+	Enabled bool                 `yaml:"enabled"`
+	Default RateLimit            `yaml:"default"`
+	Routes  map[string]RateLimit `yaml:"routes"` // лимиты по пути запроса, например /pullRequest/create
+	// AuthFailures лимит ответов 401 на IP адрес: исчерпавший его адрес получает 429 до проверки токена
+	AuthFailures RateLimit `yaml:"auth_failures"`
+}
+
+// RateLimit лимит token bucket
+type RateLimit struct {
+	RPS   float64 `yaml:"rps"`   // запросов в секунду в среднем; 0 - без ограничения
+	Burst int     `yaml:"burst"` // сколько запросов можно сделать подряд; 0 - равен rps, но не меньше 1
+}
+
+// IdempotencyConfig конфигурация заголовка Idempotency-Key в POST запросах
+type IdempotencyConfig struct {
+	Enabled         bool `yaml:"enabled"`
+	TTL             int  `yaml:"ttl"`              // сколько хранится первый ответ, в секундах
+	Lease           int  `yaml:"lease"`            // сколько ключ занят запросом без ответа, в секундах
+	CleanupInterval int  `yaml:"cleanup_interval"` // интервал удаления истекших ключей, в секундах
+}
+
 // Load загружает конфигурацию из файла и переопределяет значения из переменных окружения
 // CONFIG_FILE определяет имя конфиг-файла (например, development для configs/development.yaml)
 // По умолчанию используется development
@@ -108,8 +277,19 @@ func Load() (*Config, error) {
 	}
 
 	applyServerOverrides(cfg)
+	applyStorageOverrides(cfg)
 	applyDatabaseOverrides(cfg)
+	applySQLiteOverrides(cfg)
 	applyLoggerOverrides(cfg)
+	applyReviewerOverrides(cfg)
+	applyWebhooksOverrides(cfg)
+	applyNotificationsOverrides(cfg)
+	applyOutboxOverrides(cfg)
+	applyAuthOverrides(cfg)
+	applyMetricsOverrides(cfg)
+	applyTracingOverrides(cfg)
+	applyRateLimitOverrides(cfg)
+	applyIdempotencyOverrides(cfg)
 
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("config validation failed: %w", err)
@@ -155,6 +335,38 @@ func applyServerOverrides(cfg *Config) {
 			cfg.Server.ShutdownTimeout = t
 		}
 	}
+	if readinessTimeout := os.Getenv("SERVER_READINESS_TIMEOUT"); readinessTimeout != "" {
+		if t, err := strconv.Atoi(readinessTimeout); err == nil {
+			cfg.Server.ReadinessTimeout = t
+		}
+	}
+	if drainDelay := os.Getenv("SERVER_DRAIN_DELAY"); drainDelay != "" {
+		if d, err := strconv.Atoi(drainDelay); err == nil {
+			cfg.Server.DrainDelay = d
+		}
+	}
+}
+
+func applyStorageOverrides(cfg *Config) {
+	if driver := os.Getenv("STORAGE_DRIVER"); driver != "" {
+		cfg.Storage.Driver = driver
+	}
+	if autoMigrate := os.Getenv("STORAGE_AUTO_MIGRATE"); autoMigrate != "" {
+		if v, err := strconv.ParseBool(autoMigrate); err == nil {
+			cfg.Storage.AutoMigrate = v
+		}
+	}
+}
+
+func applySQLiteOverrides(cfg *Config) {
+	if path := os.Getenv("SQLITE_PATH"); path != "" {
+		cfg.SQLite.Path = path
+	}
+	if busyTimeout := os.Getenv("SQLITE_BUSY_TIMEOUT"); busyTimeout != "" {
+		if t, err := strconv.Atoi(busyTimeout); err == nil {
+			cfg.SQLite.BusyTimeout = t
+		}
+	}
 }
 
 func applyDatabaseOverrides(cfg *Config) {
@@ -209,15 +421,160 @@ func applyLoggerOverrides(cfg *Config) {
 	}
 }
 
+func applyReviewerOverrides(cfg *Config) {
+	if strategy := os.Getenv("REVIEWER_STRATEGY"); strategy != "" {
+		cfg.Reviewer.Strategy = strategy
+	}
+}
+
+func applyWebhooksOverrides(cfg *Config) {
+	if secret := os.Getenv("WEBHOOK_GITHUB_SECRET"); secret != "" {
+		cfg.Webhooks.GitHubSecret = secret
+	}
+	if secret := os.Getenv("WEBHOOK_GITLAB_SECRET"); secret != "" {
+		cfg.Webhooks.GitLabSecret = secret
+	}
+}
+
+func applyNotificationsOverrides(cfg *Config) {
+	if pollInterval := os.Getenv("NOTIFICATIONS_POLL_INTERVAL"); pollInterval != "" {
+		if v, err := strconv.Atoi(pollInterval); err == nil {
+			cfg.Notifications.PollInterval = v
+		}
+	}
+	if maxAttempts := os.Getenv("NOTIFICATIONS_MAX_ATTEMPTS"); maxAttempts != "" {
+		if v, err := strconv.Atoi(maxAttempts); err == nil {
+			cfg.Notifications.MaxAttempts = v
+		}
+	}
+	if initialBackoff := os.Getenv("NOTIFICATIONS_INITIAL_BACKOFF"); initialBackoff != "" {
+		if v, err := strconv.Atoi(initialBackoff); err == nil {
+			cfg.Notifications.InitialBackoff = v
+		}
+	}
+	if maxBackoff := os.Getenv("NOTIFICATIONS_MAX_BACKOFF"); maxBackoff != "" {
+		if v, err := strconv.Atoi(maxBackoff); err == nil {
+			cfg.Notifications.MaxBackoff = v
+		}
+	}
+	if requestTimeout := os.Getenv("NOTIFICATIONS_REQUEST_TIMEOUT"); requestTimeout != "" {
+		if v, err := strconv.Atoi(requestTimeout); err == nil {
+			cfg.Notifications.RequestTimeout = v
+		}
+	}
+	if batchSize := os.Getenv("NOTIFICATIONS_BATCH_SIZE"); batchSize != "" {
+		if v, err := strconv.Atoi(batchSize); err == nil {
+			cfg.Notifications.BatchSize = v
+		}
+	}
+}
+
+func applyOutboxOverrides(cfg *Config) {
+	if pollInterval := os.Getenv("OUTBOX_POLL_INTERVAL"); pollInterval != "" {
+		if v, err := strconv.Atoi(pollInterval); err == nil {
+			cfg.Outbox.PollInterval = v
+		}
+	}
+	if batchSize := os.Getenv("OUTBOX_BATCH_SIZE"); batchSize != "" {
+		if v, err := strconv.Atoi(batchSize); err == nil {
+			cfg.Outbox.BatchSize = v
+		}
+	}
+}
+
+func applyAuthOverrides(cfg *Config) {
+	if enabled := os.Getenv("AUTH_ENABLED"); enabled != "" {
+		if v, err := strconv.ParseBool(enabled); err == nil {
+			cfg.Auth.Enabled = v
+		}
+	}
+	if token := os.Getenv("AUTH_BOOTSTRAP_TOKEN"); token != "" {
+		cfg.Auth.BootstrapToken = token
+	}
+	if secret := os.Getenv("AUTH_JWT_SECRET"); secret != "" {
+		cfg.Auth.JWTSecret = secret
+	}
+	if keyFile := os.Getenv("AUTH_JWT_PUBLIC_KEY_FILE"); keyFile != "" {
+		cfg.Auth.JWTPublicKeyFile = keyFile
+	}
+	if issuer := os.Getenv("AUTH_JWT_ISSUER"); issuer != "" {
+		cfg.Auth.JWTIssuer = issuer
+	}
+	if audience := os.Getenv("AUTH_JWT_AUDIENCE"); audience != "" {
+		cfg.Auth.JWTAudience = audience
+	}
+}
+
+func applyMetricsOverrides(cfg *Config) {
+	if enabled := os.Getenv("METRICS_ENABLED"); enabled != "" {
+		if v, err := strconv.ParseBool(enabled); err == nil {
+			cfg.Metrics.Enabled = v
+		}
+	}
+}
+
+func applyTracingOverrides(cfg *Config) {
+	if enabled := os.Getenv("TRACING_ENABLED"); enabled != "" {
+		if v, err := strconv.ParseBool(enabled); err == nil {
+			cfg.Tracing.Enabled = v
+		}
+	}
+	if exporter := os.Getenv("TRACING_EXPORTER"); exporter != "" {
+		cfg.Tracing.Exporter = exporter
+	}
+	if endpoint := os.Getenv("TRACING_ENDPOINT"); endpoint != "" {
+		cfg.Tracing.Endpoint = endpoint
+	}
+	if sampleRatio := os.Getenv("TRACING_SAMPLE_RATIO"); sampleRatio != "" {
+		if v, err := strconv.ParseFloat(sampleRatio, 64); err == nil {
+			cfg.Tracing.SampleRatio = v
+		}
+	}
+	if serviceName := os.Getenv("TRACING_SERVICE_NAME"); serviceName != "" {
+		cfg.Tracing.ServiceName = serviceName
+	}
+}
+
 // Validate проверяет корректность конфигурации
 func (c *Config) Validate() error {
 	if err := c.validateServer(); err != nil {
 		return err
 	}
-	if err := c.validateDatabase(); err != nil {
+	if err := c.validateStorage(); err != nil {
+		return err
+	}
+	switch c.Storage.Driver {
+	case StorageDriverPostgres:
+		if err := c.validateDatabase(); err != nil {
+			return err
+		}
+	case StorageDriverSQLite:
+		if err := c.validateSQLite(); err != nil {
+			return err
+		}
+	}
+	if err := c.validateLogger(); err != nil {
+		return err
+	}
+	if err := c.validateReviewer(); err != nil {
+		return err
+	}
+	if err := c.validateNotifications(); err != nil {
+		return err
+	}
+	if err := c.validateOutbox(); err != nil {
+		return err
+	}
+	if err := c.validateAuth(); err != nil {
+		return err
+	}
+	if err := c.validateTracing(); err != nil {
+		return err
+	}
+	if err := c.validateRateLimit(); err != nil {
 		return err
 	}
-	return c.validateLogger()
+	return c.validateIdempotency()
 }
 
 func (c *Config) validateServer() error {
@@ -243,6 +600,9 @@ func (c *Config) validateServer() error {
 	if c.Server.ShutdownTimeout == 0 {
 		c.Server.ShutdownTimeout = DefaultServerShutdownTimeout
 	}
+	if c.Server.ReadinessTimeout == 0 {
+		c.Server.ReadinessTimeout = DefaultServerReadinessTimeout
+	}
 
 	if c.Server.ReadTimeout < MinServerTimeout {
 		return fmt.Errorf("server read_timeout must be at least %d second", MinServerTimeout)
@@ -262,6 +622,40 @@ func (c *Config) validateServer() error {
 	if c.Server.ShutdownTimeout < MinServerTimeout {
 		return fmt.Errorf("server shutdown_timeout must be at least %d second", MinServerTimeout)
 	}
+	if c.Server.ReadinessTimeout < MinServerTimeout {
+		return fmt.Errorf("server readiness_timeout must be at least %d second", MinServerTimeout)
+	}
+	if c.Server.DrainDelay < 0 {
+		return fmt.Errorf("server drain_delay must not be negative")
+	}
+
+	return nil
+}
+
+func (c *Config) validateStorage() error {
+	if c.Storage.Driver == "" {
+		c.Storage.Driver = DefaultStorageDriver
+	}
+
+	validDrivers := map[string]bool{StorageDriverPostgres: true, StorageDriverSQLite: true, StorageDriverMemory: true}
+	if !validDrivers[c.Storage.Driver] {
+		return fmt.Errorf("invalid storage driver: %s (must be postgres, sqlite or memory)", c.Storage.Driver)
+	}
+
+	return nil
+}
+
+func (c *Config) validateSQLite() error {
+	if c.SQLite.Path == "" {
+		c.SQLite.Path = DefaultSQLitePath
+	}
+	if c.SQLite.BusyTimeout == 0 {
+		c.SQLite.BusyTimeout = DefaultSQLiteBusyTimeout
+	}
+
+	if c.SQLite.BusyTimeout < MinDatabaseTimeout {
+		return fmt.Errorf("sqlite busy_timeout must be at least %d second", MinDatabaseTimeout)
+	}
 
 	return nil
 }
@@ -329,6 +723,229 @@ func (c *Config) validateLogger() error {
 	return nil
 }
 
+func (c *Config) validateReviewer() error {
+	if c.Reviewer.Strategy == "" {
+		c.Reviewer.Strategy = DefaultReviewerStrategy
+	}
+
+	validStrategies := map[string]bool{"least_loaded": true, "random": true, "weighted_random": true, "round_robin": true}
+	if !validStrategies[c.Reviewer.Strategy] {
+		return fmt.Errorf("invalid reviewer strategy: %s (must be least_loaded, random, weighted_random, or round_robin)", c.Reviewer.Strategy)
+	}
+
+	return nil
+}
+
+func (c *Config) validateNotifications() error {
+	n := &c.Notifications
+
+	if n.PollInterval == 0 {
+		n.PollInterval = DefaultNotificationsPollInterval
+	}
+	if n.MaxAttempts == 0 {
+		n.MaxAttempts = DefaultNotificationsMaxAttempts
+	}
+	if n.InitialBackoff == 0 {
+		n.InitialBackoff = DefaultNotificationsInitialBackoff
+	}
+	if n.MaxBackoff == 0 {
+		n.MaxBackoff = DefaultNotificationsMaxBackoff
+	}
+	if n.RequestTimeout == 0 {
+		n.RequestTimeout = DefaultNotificationsRequestTimeout
+	}
+	if n.BatchSize == 0 {
+		n.BatchSize = DefaultNotificationsBatchSize
+	}
+
+	if n.PollInterval < 1 {
+		return fmt.Errorf("notifications poll_interval must be at least 1 second")
+	}
+	if n.MaxAttempts < 1 {
+		return fmt.Errorf("notifications max_attempts must be at least 1")
+	}
+	if n.InitialBackoff < 1 {
+		return fmt.Errorf("notifications initial_backoff must be at least 1 second")
+	}
+	if n.MaxBackoff < n.InitialBackoff {
+		return fmt.Errorf("notifications max_backoff cannot be less than initial_backoff")
+	}
+	if n.RequestTimeout < 1 {
+		return fmt.Errorf("notifications request_timeout must be at least 1 second")
+	}
+	if n.BatchSize < 1 {
+		return fmt.Errorf("notifications batch_size must be at least 1")
+	}
+
+	return nil
+}
+
+func (c *Config) validateOutbox() error {
+	if c.Outbox.PollInterval == 0 {
+		c.Outbox.PollInterval = DefaultOutboxPollInterval
+	}
+	if c.Outbox.BatchSize == 0 {
+		c.Outbox.BatchSize = DefaultOutboxBatchSize
+	}
+
+	if c.Outbox.PollInterval < 1 {
+		return fmt.Errorf("outbox poll_interval must be at least 1 second")
+	}
+	if c.Outbox.BatchSize < 1 {
+		return fmt.Errorf("outbox batch_size must be at least 1")
+	}
+
+	return nil
+}
+
+func (c *Config) validateAuth() error {
+	if !c.Auth.Enabled {
+		return nil
+	}
+
+	if c.Auth.JWTSecret != "" && c.Auth.JWTPublicKeyFile != "" {
+		return fmt.Errorf("auth jwt_secret and jwt_public_key_file are mutually exclusive")
+	}
+	if c.Auth.BootstrapToken != "" && len(c.Auth.BootstrapToken) < MinAuthBootstrapTokenLength {
+		return fmt.Errorf("auth bootstrap_token must be at least %d characters", MinAuthBootstrapTokenLength)
+	}
+
+	return nil
+}
+
+func (c *Config) validateTracing() error {
+	if c.Tracing.Exporter == "" {
+		c.Tracing.Exporter = DefaultTracingExporter
+	}
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = DefaultTracingServiceName
+	}
+	if c.Tracing.SampleRatio == 0 {
+		c.Tracing.SampleRatio = DefaultTracingSampleRatio
+	}
+
+	switch c.Tracing.Exporter {
+	case TracingExporterOTLP, TracingExporterStdout:
+	default:
+		return fmt.Errorf("tracing exporter must be one of: %s, %s", TracingExporterOTLP, TracingExporterStdout)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample_ratio must be between 0 and 1")
+	}
+
+	return nil
+}
+
+func applyRateLimitOverrides(cfg *Config) {
+	if enabled := os.Getenv("RATE_LIMIT_ENABLED"); enabled != "" {
+		if v, err := strconv.ParseBool(enabled); err == nil {
+			cfg.RateLimit.Enabled = v
+		}
+	}
+	if rps := os.Getenv("RATE_LIMIT_RPS"); rps != "" {
+		if v, err := strconv.ParseFloat(rps, 64); err == nil {
+			cfg.RateLimit.Default.RPS = v
+		}
+	}
+	if burst := os.Getenv("RATE_LIMIT_BURST"); burst != "" {
+		if v, err := strconv.Atoi(burst); err == nil {
+			cfg.RateLimit.Default.Burst = v
+		}
+	}
+	if rps := os.Getenv("RATE_LIMIT_AUTH_FAILURES_RPS"); rps != "" {
+		if v, err := strconv.ParseFloat(rps, 64); err == nil {
+			cfg.RateLimit.AuthFailures.RPS = v
+		}
+	}
+	if burst := os.Getenv("RATE_LIMIT_AUTH_FAILURES_BURST"); burst != "" {
+		if v, err := strconv.Atoi(burst); err == nil {
+			cfg.RateLimit.AuthFailures.Burst = v
+		}
+	}
+}
+
+func (c *Config) validateRateLimit() error {
+	if err := validateRateLimit("default", &c.RateLimit.Default); err != nil {
+		return err
+	}
+	if err := validateRateLimit("auth_failures", &c.RateLimit.AuthFailures); err != nil {
+		return err
+	}
+
+	for route, limit := range c.RateLimit.Routes {
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("rate_limit route %q must start with /", route)
+		}
+		if err := validateRateLimit(route, &limit); err != nil {
+			return err
+		}
+		c.RateLimit.Routes[route] = limit
+	}
+
+	return nil
+}
+
+func validateRateLimit(name string, limit *RateLimit) error {
+	if limit.RPS < 0 {
+		return fmt.Errorf("rate_limit %s rps must not be negative", name)
+	}
+	if limit.Burst < 0 {
+		return fmt.Errorf("rate_limit %s burst must not be negative", name)
+	}
+	if limit.Burst == 0 {
+		limit.Burst = max(1, int(math.Ceil(limit.RPS)))
+	}
+	return nil
+}
+
+func applyIdempotencyOverrides(cfg *Config) {
+	if enabled := os.Getenv("IDEMPOTENCY_ENABLED"); enabled != "" {
+		if v, err := strconv.ParseBool(enabled); err == nil {
+			cfg.Idempotency.Enabled = v
+		}
+	}
+	if ttl := os.Getenv("IDEMPOTENCY_TTL"); ttl != "" {
+		if v, err := strconv.Atoi(ttl); err == nil {
+			cfg.Idempotency.TTL = v
+		}
+	}
+	if lease := os.Getenv("IDEMPOTENCY_LEASE"); lease != "" {
+		if v, err := strconv.Atoi(lease); err == nil {
+			cfg.Idempotency.Lease = v
+		}
+	}
+	if interval := os.Getenv("IDEMPOTENCY_CLEANUP_INTERVAL"); interval != "" {
+		if v, err := strconv.Atoi(interval); err == nil {
+			cfg.Idempotency.CleanupInterval = v
+		}
+	}
+}
+
+func (c *Config) validateIdempotency() error {
+	if c.Idempotency.TTL == 0 {
+		c.Idempotency.TTL = DefaultIdempotencyTTL
+	}
+	if c.Idempotency.Lease == 0 {
+		c.Idempotency.Lease = DefaultIdempotencyLease
+	}
+	if c.Idempotency.CleanupInterval == 0 {
+		c.Idempotency.CleanupInterval = DefaultIdempotencyCleanupInterval
+	}
+
+	if c.Idempotency.TTL < 1 {
+		return fmt.Errorf("idempotency ttl must be at least 1 second")
+	}
+	// Аренда короче таймаута записи отдала бы ключ запросу, который еще выполняется
+	if c.Idempotency.Lease < c.Server.WriteTimeout {
+		return fmt.Errorf("idempotency lease must be at least server write_timeout (%d seconds)", c.Server.WriteTimeout)
+	}
+	if c.Idempotency.CleanupInterval < 1 {
+		return fmt.Errorf("idempotency cleanup_interval must be at least 1 second")
+	}
+
+	return nil
+}
+
 // getEnv получает значение из environment или возвращает default
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -0,0 +1,25 @@
+package api_token
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model) *entity.APIToken {
+	return entity.NewAPITokenFromRepository(
+		m.ID,
+		m.Subject,
+		entity.Role(m.Role),
+		m.TokenHash,
+		m.CreatedAt,
+		m.RevokedAt,
+	)
+}
+
+func FromEntity(t *entity.APIToken) *Model {
+	return &Model{
+		ID:        t.ID(),
+		Subject:   t.Subject(),
+		Role:      string(t.Role()),
+		TokenHash: t.TokenHash(),
+		CreatedAt: t.CreatedAt(),
+		RevokedAt: t.RevokedAt(),
+	}
+}
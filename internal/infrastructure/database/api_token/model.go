@@ -0,0 +1,12 @@
+package api_token
+
+import "time"
+
+type Model struct {
+	ID        string     `db:"token_id"`
+	Subject   string     `db:"subject"`
+	Role      string     `db:"role"`
+	TokenHash string     `db:"token_hash"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
@@ -0,0 +1,35 @@
+package audit
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model) *entity.AuditEntry {
+	return entity.NewAuditEntryFromRepository(
+		m.Seq,
+		m.ID,
+		entity.AuditAction(m.Action),
+		entity.AuditEntityType(m.EntityType),
+		m.EntityID,
+		m.Actor,
+		m.RequestID,
+		m.Before,
+		m.After,
+		m.Reason,
+		m.OccurredAt,
+	)
+}
+
+func FromEntity(entry *entity.AuditEntry) *Model {
+	return &Model{
+		Seq:        entry.Seq(),
+		ID:         entry.ID(),
+		Action:     string(entry.Action()),
+		EntityType: string(entry.EntityType()),
+		EntityID:   entry.EntityID(),
+		Actor:      entry.Actor(),
+		RequestID:  entry.RequestID(),
+		Before:     entry.Before(),
+		After:      entry.After(),
+		Reason:     entry.Reason(),
+		OccurredAt: entry.OccurredAt(),
+	}
+}
@@ -0,0 +1,17 @@
+package audit
+
+import "time"
+
+type Model struct {
+	Seq        int64     `db:"seq"`
+	ID         string    `db:"entry_id"`
+	Action     string    `db:"action"`
+	EntityType string    `db:"entity_type"`
+	EntityID   string    `db:"entity_id"`
+	Actor      string    `db:"actor"`
+	RequestID  string    `db:"request_id"`
+	Before     []byte    `db:"before_state"`
+	After      []byte    `db:"after_state"`
+	Reason     string    `db:"reason"`
+	OccurredAt time.Time `db:"occurred_at"`
+}
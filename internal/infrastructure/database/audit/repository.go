@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.AuditRepository = (*Repository)(nil)
+
+const entryParamsCount = 10
+
+const entryColumns = `seq, entry_id, action, entity_type, entity_id, actor, request_id, before_state, after_state, reason, occurred_at`
+
+type Repository struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func NewRepository(db *sql.DB, getter *trmsql.CtxGetter) *Repository {
+	return &Repository{
+		db:     db,
+		getter: getter,
+	}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (r *Repository) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *Repository) Add(ctx context.Context, entries []*entity.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(entries))
+	valueArgs := make([]interface{}, 0, len(entries)*entryParamsCount)
+	for i, entry := range entries {
+		model := FromEntity(entry)
+		paramOffset := i * entryParamsCount
+		placeholders := make([]string, entryParamsCount)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", paramOffset+j+1)
+		}
+		valueStrings = append(valueStrings, "("+strings.Join(placeholders, ", ")+")")
+		valueArgs = append(valueArgs,
+			model.ID,
+			model.Action,
+			model.EntityType,
+			model.EntityID,
+			model.Actor,
+			model.RequestID,
+			jsonOrNull(model.Before),
+			jsonOrNull(model.After),
+			model.Reason,
+			model.OccurredAt,
+		)
+	}
+
+	// seq назначается в порядке VALUES, поэтому записи одной операции читаются в порядке Record
+	query := fmt.Sprintf(`
+		INSERT INTO audit_log (entry_id, action, entity_type, entity_id, actor, request_id, before_state, after_state, reason, occurred_at)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to add audit entries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Find(ctx context.Context, filter repository.AuditFilter) ([]*entity.AuditEntry, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.EntityType != "" {
+		addCondition("entity_type = $%d", string(filter.EntityType))
+	}
+	if filter.EntityID != "" {
+		addCondition("entity_id = $%d", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.From != nil {
+		addCondition("occurred_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("occurred_at < $%d", *filter.To)
+	}
+	if filter.BeforeSeq > 0 {
+		addCondition("seq < $%d", filter.BeforeSeq)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM audit_log
+		%s
+		ORDER BY seq DESC
+		LIMIT $%d
+	`, entryColumns, where, len(args))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find audit entries: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var entries []*entity.AuditEntry
+	for rows.Next() {
+		var model Model
+		if err := rows.Scan(
+			&model.Seq,
+			&model.ID,
+			&model.Action,
+			&model.EntityType,
+			&model.EntityID,
+			&model.Actor,
+			&model.RequestID,
+			&model.Before,
+			&model.After,
+			&model.Reason,
+			&model.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		entries = append(entries, ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// jsonOrNull передает снимок как текст: []byte lib/pq отправляет как bytea, а колонка имеет тип JSONB
+func jsonOrNull(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,33 @@
+package event_delivery
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model) *entity.EventDelivery {
+	return entity.NewEventDeliveryFromRepository(
+		m.ID,
+		m.SubscriptionID,
+		m.EventID,
+		entity.EventType(m.EventType),
+		m.Payload,
+		m.Attempts,
+		m.NextAttemptAt,
+		m.LastError,
+		m.CreatedAt,
+		m.FailedAt,
+	)
+}
+
+func FromEntity(d *entity.EventDelivery) *Model {
+	return &Model{
+		ID:             d.ID(),
+		SubscriptionID: d.SubscriptionID(),
+		EventID:        d.EventID(),
+		EventType:      string(d.EventType()),
+		Payload:        d.Payload(),
+		Attempts:       d.Attempts(),
+		NextAttemptAt:  d.NextAttemptAt(),
+		LastError:      d.LastError(),
+		CreatedAt:      d.CreatedAt(),
+		FailedAt:       d.FailedAt(),
+	}
+}
@@ -0,0 +1,16 @@
+package event_delivery
+
+import "time"
+
+type Model struct {
+	ID             string     `db:"delivery_id"`
+	SubscriptionID string     `db:"subscription_id"`
+	EventID        string     `db:"event_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Attempts       int        `db:"attempts"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	LastError      string     `db:"last_error"`
+	CreatedAt      time.Time  `db:"created_at"`
+	FailedAt       *time.Time `db:"failed_at"`
+}
@@ -0,0 +1,29 @@
+package idempotency
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model) *entity.IdempotencyRecord {
+	return entity.NewIdempotencyRecordFromRepository(
+		m.Scope,
+		m.Key,
+		m.RequestHash,
+		m.StatusCode,
+		m.ContentType,
+		m.ResponseBody,
+		m.CreatedAt,
+		m.ExpiresAt,
+	)
+}
+
+func FromEntity(r *entity.IdempotencyRecord) *Model {
+	return &Model{
+		Scope:        r.Scope(),
+		Key:          r.Key(),
+		RequestHash:  r.RequestHash(),
+		StatusCode:   r.StatusCode(),
+		ContentType:  r.ContentType(),
+		ResponseBody: r.Body(),
+		CreatedAt:    r.CreatedAt(),
+		ExpiresAt:    r.ExpiresAt(),
+	}
+}
@@ -0,0 +1,148 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.IdempotencyRepository = (*Repository)(nil)
+
+type Repository struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func NewRepository(db *sql.DB, getter *trmsql.CtxGetter) *Repository {
+	return &Repository{
+		db:     db,
+		getter: getter,
+	}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (r *Repository) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// Reserve вставляет запись с ON CONFLICT DO UPDATE, который срабатывает только для истекшей записи.
+// Параллельный запрос с тем же ключом ждет коммита первой вставки и получает false
+func (r *Repository) Reserve(ctx context.Context, record *entity.IdempotencyRecord) (bool, error) {
+	model := FromEntity(record)
+
+	query := `
+		INSERT INTO idempotency_keys (scope, idempotency_key, request_hash, status_code, content_type, response_body, created_at, expires_at)
+		VALUES ($1, $2, $3, 0, '', NULL, $4, $5)
+		ON CONFLICT (scope, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = 0,
+			content_type = '',
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, model.Scope, model.Key, model.RequestHash, model.CreatedAt, model.ExpiresAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) Find(ctx context.Context, scope, key string) (*entity.IdempotencyRecord, error) {
+	query := `
+		SELECT scope, idempotency_key, request_hash, status_code, content_type, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE scope = $1 AND idempotency_key = $2
+	`
+
+	var model Model
+	err := r.getDB(ctx).QueryRowContext(ctx, query, scope, key).Scan(
+		&model.Scope,
+		&model.Key,
+		&model.RequestHash,
+		&model.StatusCode,
+		&model.ContentType,
+		&model.ResponseBody,
+		&model.CreatedAt,
+		&model.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	return ToEntity(&model), nil
+}
+
+func (r *Repository) SaveResponse(ctx context.Context, record *entity.IdempotencyRecord) error {
+	model := FromEntity(record)
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, response_body = $5, expires_at = $6
+		WHERE scope = $1 AND idempotency_key = $2 AND status_code = 0
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query,
+		model.Scope, model.Key, model.StatusCode, model.ContentType, model.ResponseBody, model.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, scope, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, scope, key); err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
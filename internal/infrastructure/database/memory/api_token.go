@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.APITokenRepository = (*APITokenRepository)(nil)
+
+type apiTokenRow struct {
+	id        string
+	subject   string
+	role      entity.Role
+	tokenHash string
+	createdAt time.Time
+	revokedAt *time.Time
+}
+
+func (r apiTokenRow) toEntity() *entity.APIToken {
+	return entity.NewAPITokenFromRepository(r.id, r.subject, r.role, r.tokenHash, r.createdAt, cloneTime(r.revokedAt))
+}
+
+type APITokenRepository struct {
+	store *Store
+}
+
+func NewAPITokenRepository(store *Store) *APITokenRepository {
+	return &APITokenRepository{store: store}
+}
+
+func (r *APITokenRepository) Create(ctx context.Context, token *entity.APIToken) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if _, exists := r.store.apiTokens[token.ID()]; exists {
+			return fmt.Errorf("failed to create api token: token already exists: %s", token.ID())
+		}
+		for _, row := range r.store.apiTokens {
+			if row.tokenHash == token.TokenHash() {
+				return fmt.Errorf("failed to create api token: token hash already exists")
+			}
+		}
+
+		r.store.apiTokens.put(tx, token.ID(), apiTokenRow{
+			id:        token.ID(),
+			subject:   token.Subject(),
+			role:      token.Role(),
+			tokenHash: token.TokenHash(),
+			createdAt: token.CreatedAt(),
+			revokedAt: cloneTime(token.RevokedAt()),
+		})
+		return nil
+	})
+}
+
+func (r *APITokenRepository) FindByID(ctx context.Context, id string) (*entity.APIToken, error) {
+	return r.findOne(ctx, func(row apiTokenRow) bool { return row.id == id })
+}
+
+// FindByHash ищет токен по SHA-256 открытого значения
+func (r *APITokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.APIToken, error) {
+	return r.findOne(ctx, func(row apiTokenRow) bool { return row.tokenHash == tokenHash })
+}
+
+func (r *APITokenRepository) FindAll(ctx context.Context) ([]*entity.APIToken, error) {
+	var rows []apiTokenRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.apiTokens {
+			rows = append(rows, row)
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b apiTokenRow) int {
+		if c := a.createdAt.Compare(b.createdAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+
+	tokens := make([]*entity.APIToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, row.toEntity())
+	}
+	return tokens, nil
+}
+
+func (r *APITokenRepository) Update(ctx context.Context, token *entity.APIToken) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.apiTokens[token.ID()]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		row.subject = token.Subject()
+		row.role = token.Role()
+		row.revokedAt = cloneTime(token.RevokedAt())
+		r.store.apiTokens.put(tx, token.ID(), row)
+		return nil
+	})
+}
+
+func (r *APITokenRepository) findOne(ctx context.Context, match func(row apiTokenRow) bool) (*entity.APIToken, error) {
+	var token *entity.APIToken
+	err := r.store.read(ctx, func() error {
+		for _, row := range r.store.apiTokens {
+			if match(row) {
+				token = row.toEntity()
+				return nil
+			}
+		}
+		return repository.ErrNotFound
+	})
+	return token, err
+}
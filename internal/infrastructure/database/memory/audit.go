@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.AuditRepository = (*AuditRepository)(nil)
+
+type auditRow struct {
+	seq        int64
+	id         string
+	action     entity.AuditAction
+	entityType entity.AuditEntityType
+	entityID   string
+	actor      string
+	requestID  string
+	before     []byte
+	after      []byte
+	reason     string
+	occurredAt time.Time
+}
+
+func (r auditRow) toEntity() *entity.AuditEntry {
+	return entity.NewAuditEntryFromRepository(
+		r.seq,
+		r.id,
+		r.action,
+		r.entityType,
+		r.entityID,
+		r.actor,
+		r.requestID,
+		slices.Clone(r.before),
+		slices.Clone(r.after),
+		r.reason,
+		r.occurredAt,
+	)
+}
+
+// matches проверяет запись по фильтру журнала
+func (r auditRow) matches(filter repository.AuditFilter) bool {
+	switch {
+	case filter.EntityType != "" && r.entityType != filter.EntityType:
+		return false
+	case filter.EntityID != "" && r.entityID != filter.EntityID:
+		return false
+	case filter.Actor != "" && r.actor != filter.Actor:
+		return false
+	case filter.From != nil && r.occurredAt.Before(*filter.From):
+		return false
+	case filter.To != nil && !r.occurredAt.Before(*filter.To):
+		return false
+	case filter.BeforeSeq > 0 && r.seq >= filter.BeforeSeq:
+		return false
+	}
+	return true
+}
+
+type AuditRepository struct {
+	store *Store
+}
+
+func NewAuditRepository(store *Store) *AuditRepository {
+	return &AuditRepository{store: store}
+}
+
+// Add записывает записи аудита, назначая seq в порядке передачи
+func (r *AuditRepository) Add(ctx context.Context, entries []*entity.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return r.store.write(ctx, func(tx *tx) error {
+		for _, entry := range entries {
+			seq := r.store.nextSeq()
+			r.store.auditLog.put(tx, seq, auditRow{
+				seq:        seq,
+				id:         entry.ID(),
+				action:     entry.Action(),
+				entityType: entry.EntityType(),
+				entityID:   entry.EntityID(),
+				actor:      entry.Actor(),
+				requestID:  entry.RequestID(),
+				before:     jsonOrNil(entry.Before()),
+				after:      jsonOrNil(entry.After()),
+				reason:     entry.Reason(),
+				occurredAt: entry.OccurredAt(),
+			})
+		}
+		return nil
+	})
+}
+
+// Find возвращает записи по фильтру, новые первыми
+func (r *AuditRepository) Find(ctx context.Context, filter repository.AuditFilter) ([]*entity.AuditEntry, error) {
+	var rows []auditRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.auditLog {
+			if row.matches(filter) {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b auditRow) int { return cmp.Compare(b.seq, a.seq) })
+	if len(rows) > filter.Limit {
+		rows = rows[:filter.Limit]
+	}
+
+	entries := make([]*entity.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.toEntity())
+	}
+	return entries, nil
+}
+
+// jsonOrNil хранит пустой снимок как отсутствующий, как NULL в PostgreSQL
+func jsonOrNil(data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	return slices.Clone(data)
+}
@@ -0,0 +1,21 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/memory"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/storagetest"
+)
+
+func TestStorageContract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Backend {
+		store := memory.NewStore()
+		return storagetest.Backend{
+			TxManager:    memory.NewTransactionManager(store),
+			Users:        memory.NewUserRepository(store),
+			Teams:        memory.NewTeamRepository(store),
+			PullRequests: memory.NewPullRequestRepository(store),
+			Idempotency:  memory.NewIdempotencyRepository(store),
+		}
+	})
+}
@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.EventDeliveryRepository = (*EventDeliveryRepository)(nil)
+
+type eventDeliveryRow struct {
+	id             string
+	subscriptionID string
+	eventID        string
+	eventType      entity.EventType
+	payload        []byte
+	attempts       int
+	nextAttemptAt  time.Time
+	lastError      string
+	createdAt      time.Time
+	failedAt       *time.Time
+}
+
+func eventDeliveryRowFromEntity(d *entity.EventDelivery) eventDeliveryRow {
+	return eventDeliveryRow{
+		id:             d.ID(),
+		subscriptionID: d.SubscriptionID(),
+		eventID:        d.EventID(),
+		eventType:      d.EventType(),
+		payload:        slices.Clone(d.Payload()),
+		attempts:       d.Attempts(),
+		nextAttemptAt:  d.NextAttemptAt(),
+		lastError:      d.LastError(),
+		createdAt:      d.CreatedAt(),
+		failedAt:       cloneTime(d.FailedAt()),
+	}
+}
+
+func (r eventDeliveryRow) toEntity() *entity.EventDelivery {
+	return entity.NewEventDeliveryFromRepository(
+		r.id,
+		r.subscriptionID,
+		r.eventID,
+		r.eventType,
+		slices.Clone(r.payload),
+		r.attempts,
+		r.nextAttemptAt,
+		r.lastError,
+		r.createdAt,
+		cloneTime(r.failedAt),
+	)
+}
+
+type EventDeliveryRepository struct {
+	store *Store
+}
+
+func NewEventDeliveryRepository(store *Store) *EventDeliveryRepository {
+	return &EventDeliveryRepository{store: store}
+}
+
+func (r *EventDeliveryRepository) Enqueue(ctx context.Context, deliveries []*entity.EventDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return r.store.write(ctx, func(tx *tx) error {
+		for _, delivery := range deliveries {
+			r.store.eventDeliveries.put(tx, delivery.ID(), eventDeliveryRowFromEntity(delivery))
+		}
+		return nil
+	})
+}
+
+// ClaimDue сдвигает next_attempt_at забранных доставок на lease, чтобы их не забрали повторно,
+// и возвращает их уже со сдвинутым временем
+func (r *EventDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.EventDelivery, error) {
+	var claimed []eventDeliveryRow
+	_ = r.store.write(ctx, func(tx *tx) error {
+		for _, row := range r.store.eventDeliveries {
+			if !row.nextAttemptAt.After(now) {
+				claimed = append(claimed, row)
+			}
+		}
+
+		slices.SortFunc(claimed, func(a, b eventDeliveryRow) int {
+			if c := a.nextAttemptAt.Compare(b.nextAttemptAt); c != 0 {
+				return c
+			}
+			return strings.Compare(a.id, b.id)
+		})
+		if len(claimed) > limit {
+			claimed = claimed[:limit]
+		}
+
+		for i := range claimed {
+			claimed[i].nextAttemptAt = now.Add(lease)
+			r.store.eventDeliveries.put(tx, claimed[i].id, claimed[i])
+		}
+		return nil
+	})
+
+	deliveries := make([]*entity.EventDelivery, 0, len(claimed))
+	for _, row := range claimed {
+		deliveries = append(deliveries, row.toEntity())
+	}
+	return deliveries, nil
+}
+
+func (r *EventDeliveryRepository) Delete(ctx context.Context, id string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		r.store.eventDeliveries.remove(tx, id)
+		return nil
+	})
+}
+
+func (r *EventDeliveryRepository) Reschedule(ctx context.Context, delivery *entity.EventDelivery) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.eventDeliveries[delivery.ID()]
+		if !ok {
+			return nil
+		}
+
+		row.attempts = delivery.Attempts()
+		row.nextAttemptAt = delivery.NextAttemptAt()
+		row.lastError = delivery.LastError()
+		r.store.eventDeliveries.put(tx, delivery.ID(), row)
+		return nil
+	})
+}
+
+// MoveToDeadLetter переносит доставку в dead letter и удаляет из очереди
+func (r *EventDeliveryRepository) MoveToDeadLetter(ctx context.Context, delivery *entity.EventDelivery) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if _, exists := r.store.deadLetters[delivery.ID()]; !exists {
+			row := eventDeliveryRowFromEntity(delivery)
+			if row.failedAt == nil {
+				failedAt := time.Now()
+				row.failedAt = &failedAt
+			}
+			r.store.deadLetters.put(tx, delivery.ID(), row)
+		}
+		r.store.eventDeliveries.remove(tx, delivery.ID())
+		return nil
+	})
+}
+
+// FindDeadLetters возвращает проваленные доставки подписки, новые первыми
+func (r *EventDeliveryRepository) FindDeadLetters(ctx context.Context, subscriptionID string) ([]*entity.EventDelivery, error) {
+	var rows []eventDeliveryRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.deadLetters {
+			if row.subscriptionID == subscriptionID {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b eventDeliveryRow) int {
+		if c := b.failedAt.Compare(*a.failedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+
+	deliveries := make([]*entity.EventDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, row.toEntity())
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.IdempotencyRepository = (*IdempotencyRepository)(nil)
+
+// idempotencyKey первичный ключ записи: ключ клиента в пределах его области
+type idempotencyKey struct {
+	scope string
+	key   string
+}
+
+type idempotencyRow struct {
+	requestHash string
+	statusCode  int
+	contentType string
+	body        []byte
+	createdAt   time.Time
+	expiresAt   time.Time
+}
+
+func (r idempotencyRow) toEntity(key idempotencyKey) *entity.IdempotencyRecord {
+	return entity.NewIdempotencyRecordFromRepository(
+		key.scope,
+		key.key,
+		r.requestHash,
+		r.statusCode,
+		r.contentType,
+		slices.Clone(r.body),
+		r.createdAt,
+		r.expiresAt,
+	)
+}
+
+type IdempotencyRepository struct {
+	store *Store
+}
+
+func NewIdempotencyRepository(store *Store) *IdempotencyRepository {
+	return &IdempotencyRepository{store: store}
+}
+
+// Reserve вставляет запись или заменяет истекшую и возвращает false, если ключ занят
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *entity.IdempotencyRecord) (bool, error) {
+	var reserved bool
+	err := r.store.write(ctx, func(tx *tx) error {
+		key := idempotencyKey{scope: record.Scope(), key: record.Key()}
+		if row, exists := r.store.idempotencyKeys[key]; exists && row.expiresAt.After(record.CreatedAt()) {
+			return nil
+		}
+
+		r.store.idempotencyKeys.put(tx, key, idempotencyRow{
+			requestHash: record.RequestHash(),
+			createdAt:   record.CreatedAt(),
+			expiresAt:   record.ExpiresAt(),
+		})
+		reserved = true
+		return nil
+	})
+	return reserved, err
+}
+
+func (r *IdempotencyRepository) Find(ctx context.Context, scope, key string) (*entity.IdempotencyRecord, error) {
+	var record *entity.IdempotencyRecord
+	err := r.store.read(ctx, func() error {
+		k := idempotencyKey{scope: scope, key: key}
+		row, ok := r.store.idempotencyKeys[k]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		record = row.toEntity(k)
+		return nil
+	})
+	return record, err
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, record *entity.IdempotencyRecord) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		key := idempotencyKey{scope: record.Scope(), key: record.Key()}
+		row, ok := r.store.idempotencyKeys[key]
+		if !ok || row.statusCode != 0 {
+			return repository.ErrNotFound
+		}
+
+		row.statusCode = record.StatusCode()
+		row.contentType = record.ContentType()
+		row.body = slices.Clone(record.Body())
+		row.expiresAt = record.ExpiresAt()
+		r.store.idempotencyKeys.put(tx, key, row)
+		return nil
+	})
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, scope, key string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		r.store.idempotencyKeys.remove(tx, idempotencyKey{scope: scope, key: key})
+		return nil
+	})
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	var deleted int
+	err := r.store.write(ctx, func(tx *tx) error {
+		for key, row := range r.store.idempotencyKeys {
+			if !row.expiresAt.After(now) && r.store.idempotencyKeys.remove(tx, key) {
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.OutboxRepository = (*OutboxRepository)(nil)
+
+type outboxRow struct {
+	seq        int64
+	id         string
+	eventType  entity.EventType
+	payload    []byte
+	occurredAt time.Time
+	sentAt     *time.Time
+}
+
+func (r outboxRow) toEntity() *entity.OutboxMessage {
+	return entity.NewOutboxMessageFromRepository(r.id, r.eventType, slices.Clone(r.payload), r.occurredAt, cloneTime(r.sentAt))
+}
+
+type OutboxRepository struct {
+	store *Store
+}
+
+func NewOutboxRepository(store *Store) *OutboxRepository {
+	return &OutboxRepository{store: store}
+}
+
+// Add записывает сообщения, назначая seq в порядке передачи
+func (r *OutboxRepository) Add(ctx context.Context, messages []*entity.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return r.store.write(ctx, func(tx *tx) error {
+		for _, message := range messages {
+			r.store.outbox.put(tx, message.ID(), outboxRow{
+				seq:        r.store.nextSeq(),
+				id:         message.ID(),
+				eventType:  message.EventType(),
+				payload:    slices.Clone(message.Payload()),
+				occurredAt: message.OccurredAt(),
+				sentAt:     cloneTime(message.SentAt()),
+			})
+		}
+		return nil
+	})
+}
+
+// FindPendingForUpdate возвращает до limit неопубликованных сообщений в порядке записи.
+// Параллельный relay ждет окончания транзакции и не увидит опубликованные ею сообщения
+// ВАЖНО: Должен вызываться внутри транзакции
+func (r *OutboxRepository) FindPendingForUpdate(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	var rows []outboxRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.outbox {
+			if row.sentAt == nil {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b outboxRow) int { return cmp.Compare(a.seq, b.seq) })
+	if len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	messages := make([]*entity.OutboxMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, row.toEntity())
+	}
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkSent(ctx context.Context, ids []string, sentAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.store.write(ctx, func(tx *tx) error {
+		for _, id := range ids {
+			row, ok := r.store.outbox[id]
+			if !ok {
+				continue
+			}
+			row.sentAt = &sentAt
+			r.store.outbox.put(tx, id, row)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,442 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.PullRequestRepository = (*PullRequestRepository)(nil)
+
+type pullRequestRow struct {
+	id           string
+	name         string
+	authorID     string
+	teamName     string
+	status       entity.PRStatus
+	minReviewers int
+	maxReviewers int
+	createdAt    time.Time
+	mergedAt     *time.Time
+	mergeForced  bool
+	reviewers    []reviewerRow // в порядке назначения
+}
+
+// reviewerRow строка pr_reviewers
+type reviewerRow struct {
+	userID      string
+	isFallback  bool
+	reviewState entity.ReviewState
+	reviewedAt  *time.Time
+}
+
+func pullRequestRowFromEntity(pr *entity.PullRequest) pullRequestRow {
+	return pullRequestRow{
+		id:           pr.ID(),
+		name:         pr.Name(),
+		authorID:     pr.AuthorID(),
+		teamName:     pr.TeamName(),
+		status:       pr.Status(),
+		minReviewers: pr.ReviewerLimits().Min(),
+		maxReviewers: pr.ReviewerLimits().Max(),
+		createdAt:    pr.CreatedAt(),
+		mergedAt:     cloneTime(pr.MergedAt()),
+		mergeForced:  pr.MergeForced(),
+		reviewers:    reviewerRowsFromEntity(pr),
+	}
+}
+
+func reviewerRowsFromEntity(pr *entity.PullRequest) []reviewerRow {
+	reviewers := make([]reviewerRow, 0, len(pr.AssignedReviewers()))
+	for _, reviewerID := range pr.AssignedReviewers() {
+		review := pr.ReviewOf(reviewerID)
+		reviewers = append(reviewers, reviewerRow{
+			userID:      reviewerID,
+			isFallback:  pr.IsFallbackReviewer(reviewerID),
+			reviewState: review.State(),
+			reviewedAt:  cloneTime(review.SubmittedAt()),
+		})
+	}
+	return reviewers
+}
+
+func (r pullRequestRow) toEntity() *entity.PullRequest {
+	reviewerIDs := make([]string, 0, len(r.reviewers))
+	var fallbackReviewerIDs []string
+	var reviews []entity.Review
+	for _, reviewer := range r.reviewers {
+		reviewerIDs = append(reviewerIDs, reviewer.userID)
+		if reviewer.isFallback {
+			fallbackReviewerIDs = append(fallbackReviewerIDs, reviewer.userID)
+		}
+		if reviewer.reviewedAt != nil {
+			reviews = append(reviews, entity.NewReviewFromRepository(reviewer.userID, reviewer.reviewState, cloneTime(reviewer.reviewedAt)))
+		}
+	}
+
+	return entity.NewPullRequestFromRepository(
+		r.id,
+		r.name,
+		r.authorID,
+		r.teamName,
+		r.status,
+		reviewerIDs,
+		fallbackReviewerIDs,
+		reviews,
+		entity.NewReviewerLimitsFromRepository(r.minReviewers, r.maxReviewers),
+		r.createdAt,
+		cloneTime(r.mergedAt),
+		r.mergeForced,
+	)
+}
+
+// hasReviewer проверяет, назначен ли пользователь ревьювером PR
+func (r pullRequestRow) hasReviewer(userID string) bool {
+	return slices.ContainsFunc(r.reviewers, func(reviewer reviewerRow) bool { return reviewer.userID == userID })
+}
+
+// withReviewers возвращает копию строки с новым списком ревьюверов, не затрагивая исходный
+func (r pullRequestRow) withReviewers(reviewers []reviewerRow) pullRequestRow {
+	r.reviewers = reviewers
+	return r
+}
+
+type PullRequestRepository struct {
+	store *Store
+}
+
+func NewPullRequestRepository(store *Store) *PullRequestRepository {
+	return &PullRequestRepository{store: store}
+}
+
+func (r *PullRequestRepository) Create(ctx context.Context, pr *entity.PullRequest) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if _, exists := r.store.pullRequests[pr.ID()]; exists {
+			return fmt.Errorf("failed to create pull request: pull request already exists: %s", pr.ID())
+		}
+		r.store.pullRequests.put(tx, pr.ID(), pullRequestRowFromEntity(pr))
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) FindByID(ctx context.Context, id string) (*entity.PullRequest, error) {
+	var pr *entity.PullRequest
+	err := r.store.read(ctx, func() error {
+		row, ok := r.store.pullRequests[id]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		pr = row.toEntity()
+		return nil
+	})
+	return pr, err
+}
+
+// FindByIDForUpdate находит PR для изменения. Отдельная блокировка строки не нужна:
+// транзакция держит все хранилище до завершения
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *PullRequestRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.PullRequest, error) {
+	return r.FindByID(ctx, id)
+}
+
+func (r *PullRequestRepository) FindByReviewerID(ctx context.Context, reviewerID string) ([]*entity.PullRequest, error) {
+	rows := r.filter(ctx, func(row pullRequestRow) bool { return row.hasReviewer(reviewerID) })
+	sortNewestFirst(rows)
+	return toPullRequestEntities(rows), nil
+}
+
+func (r *PullRequestRepository) FindByAuthorID(ctx context.Context, authorID string) ([]*entity.PullRequest, error) {
+	rows := r.filter(ctx, func(row pullRequestRow) bool { return row.authorID == authorID })
+	sortNewestFirst(rows)
+	return toPullRequestEntities(rows), nil
+}
+
+// FindOpenByReviewerIDsForUpdate находит открытые PR, где ревьювером назначен кто-то из reviewerIDs,
+// старые первыми
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *PullRequestRepository) FindOpenByReviewerIDsForUpdate(ctx context.Context, reviewerIDs []string) ([]*entity.PullRequest, error) {
+	if len(reviewerIDs) == 0 {
+		return []*entity.PullRequest{}, nil
+	}
+
+	rows := r.filter(ctx, func(row pullRequestRow) bool {
+		return row.status == entity.PRStatusOpen && slices.ContainsFunc(reviewerIDs, row.hasReviewer)
+	})
+	slices.SortFunc(rows, func(a, b pullRequestRow) int {
+		if c := a.createdAt.Compare(b.createdAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+	return toPullRequestEntities(rows), nil
+}
+
+func (r *PullRequestRepository) Update(ctx context.Context, pr *entity.PullRequest) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.pullRequests[pr.ID()]
+		if !ok {
+			return fmt.Errorf("pull request not found: %s", pr.ID())
+		}
+
+		row.name = pr.Name()
+		row.authorID = pr.AuthorID()
+		row.status = pr.Status()
+		row.mergedAt = cloneTime(pr.MergedAt())
+		r.store.pullRequests.put(tx, pr.ID(), row.withReviewers(reviewerRowsFromEntity(pr)))
+		return nil
+	})
+}
+
+// UpdateStatus сохраняет только статус PR и данные мержа, не трогая ревьюверов
+func (r *PullRequestRepository) UpdateStatus(ctx context.Context, pr *entity.PullRequest) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.pullRequests[pr.ID()]
+		if !ok {
+			return fmt.Errorf("pull request not found: %s", pr.ID())
+		}
+
+		row.status = pr.Status()
+		row.mergedAt = cloneTime(pr.MergedAt())
+		row.mergeForced = pr.MergeForced()
+		r.store.pullRequests.put(tx, pr.ID(), row)
+		return nil
+	})
+}
+
+// AddReviewer добавляет одного ревьювера к PR, не трогая остальных
+func (r *PullRequestRepository) AddReviewer(ctx context.Context, prID, reviewerID string, isFallback bool) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.pullRequests[prID]
+		if !ok {
+			return fmt.Errorf("failed to add reviewer: pull request not found: %s", prID)
+		}
+		if row.hasReviewer(reviewerID) {
+			return fmt.Errorf("failed to add reviewer: reviewer already assigned: pr_id=%s, reviewer_id=%s", prID, reviewerID)
+		}
+
+		reviewers := append(slices.Clone(row.reviewers), reviewerRow{
+			userID:      reviewerID,
+			isFallback:  isFallback,
+			reviewState: entity.ReviewStatePending,
+		})
+		r.store.pullRequests.put(tx, prID, row.withReviewers(reviewers))
+		return nil
+	})
+}
+
+// SaveReview сохраняет последнее ревью ревьювера
+func (r *PullRequestRepository) SaveReview(ctx context.Context, prID string, review entity.Review) error {
+	return r.updateReviewer(ctx, prID, review.ReviewerID(), func(reviewer *reviewerRow) {
+		reviewer.reviewState = review.State()
+		reviewer.reviewedAt = cloneTime(review.SubmittedAt())
+	})
+}
+
+// RemoveReviewer снимает одного ревьювера с PR
+func (r *PullRequestRepository) RemoveReviewer(ctx context.Context, prID, reviewerID string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.pullRequests[prID]
+		if !ok || !row.hasReviewer(reviewerID) {
+			return fmt.Errorf("reviewer not found or already removed: pr_id=%s, reviewer_id=%s", prID, reviewerID)
+		}
+
+		reviewers := slices.DeleteFunc(slices.Clone(row.reviewers), func(reviewer reviewerRow) bool {
+			return reviewer.userID == reviewerID
+		})
+		r.store.pullRequests.put(tx, prID, row.withReviewers(reviewers))
+		return nil
+	})
+}
+
+// ReplaceReviewer заменяет одного ревьювера на другого на том же месте в списке.
+// Ревью старого ревьювера сбрасывается, новый начинает с PENDING
+func (r *PullRequestRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, isFallback bool) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		return r.replaceReviewer(tx, prID, oldReviewerID, newReviewerID, isFallback)
+	})
+}
+
+// ReassignReviewers применяет пачку переносов слотов ревьюверов. Если какой-то слот не найден,
+// не применяется ни один перенос
+func (r *PullRequestRepository) ReassignReviewers(ctx context.Context, changes []repository.ReviewerChange) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		for _, change := range changes {
+			if change.NewReviewerID != "" {
+				if err := r.replaceReviewer(tx, change.PullRequestID, change.OldReviewerID, change.NewReviewerID, change.IsFallback); err != nil {
+					return err
+				}
+				continue
+			}
+
+			row, ok := r.store.pullRequests[change.PullRequestID]
+			if !ok || !row.hasReviewer(change.OldReviewerID) {
+				return fmt.Errorf("reviewer not found or already removed: pr_id=%s, reviewer_id=%s", change.PullRequestID, change.OldReviewerID)
+			}
+			reviewers := slices.DeleteFunc(slices.Clone(row.reviewers), func(reviewer reviewerRow) bool {
+				return reviewer.userID == change.OldReviewerID
+			})
+			r.store.pullRequests.put(tx, change.PullRequestID, row.withReviewers(reviewers))
+		}
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) replaceReviewer(tx *tx, prID, oldReviewerID, newReviewerID string, isFallback bool) error {
+	row, ok := r.store.pullRequests[prID]
+	if !ok || !row.hasReviewer(oldReviewerID) {
+		return fmt.Errorf("reviewer not found or already replaced: pr_id=%s, old_reviewer_id=%s", prID, oldReviewerID)
+	}
+	if row.hasReviewer(newReviewerID) {
+		return fmt.Errorf("failed to replace reviewer: reviewer already assigned: pr_id=%s, reviewer_id=%s", prID, newReviewerID)
+	}
+
+	reviewers := slices.Clone(row.reviewers)
+	for i := range reviewers {
+		if reviewers[i].userID == oldReviewerID {
+			reviewers[i].userID = newReviewerID
+			reviewers[i].isFallback = isFallback
+			reviewers[i].reviewState = entity.ReviewStatePending
+			reviewers[i].reviewedAt = nil
+		}
+	}
+	r.store.pullRequests.put(tx, prID, row.withReviewers(reviewers))
+	return nil
+}
+
+// updateReviewer изменяет строку назначенного ревьювера
+func (r *PullRequestRepository) updateReviewer(ctx context.Context, prID, reviewerID string, update func(reviewer *reviewerRow)) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.pullRequests[prID]
+		if !ok || !row.hasReviewer(reviewerID) {
+			return fmt.Errorf("reviewer not found: pr_id=%s, reviewer_id=%s", prID, reviewerID)
+		}
+
+		reviewers := slices.Clone(row.reviewers)
+		for i := range reviewers {
+			if reviewers[i].userID == reviewerID {
+				update(&reviewers[i])
+			}
+		}
+		r.store.pullRequests.put(tx, prID, row.withReviewers(reviewers))
+		return nil
+	})
+}
+
+// Delete удаляет PR вместе с его историей назначений (ON DELETE CASCADE в PostgreSQL)
+func (r *PullRequestRepository) Delete(ctx context.Context, id string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if !r.store.pullRequests.remove(tx, id) {
+			return fmt.Errorf("pull request not found: %s", id)
+		}
+		for seq, entry := range r.store.reviewerHistory {
+			if entry.pullRequestID == id {
+				r.store.reviewerHistory.remove(tx, seq)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) Exists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	_ = r.store.read(ctx, func() error {
+		_, exists = r.store.pullRequests[id]
+		return nil
+	})
+	return exists, nil
+}
+
+// CountActiveReviewsByUserIDs возвращает текущую загрузку пользователей - число открытых PR на ревью.
+// Черновики и закрытые PR в загрузку не входят
+func (r *PullRequestRepository) CountActiveReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	result := make(map[string]int, len(userIDs))
+	for _, userID := range userIDs {
+		result[userID] = 0
+	}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.pullRequests {
+			if row.status != entity.PRStatusOpen {
+				continue
+			}
+			for _, reviewer := range row.reviewers {
+				if _, requested := result[reviewer.userID]; requested {
+					result[reviewer.userID]++
+				}
+			}
+		}
+		return nil
+	})
+
+	return result, nil
+}
+
+// GetStats возвращает количество PR по статусам
+func (r *PullRequestRepository) GetStats(ctx context.Context) (repository.PRStats, error) {
+	var stats repository.PRStats
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.pullRequests {
+			stats.Total++
+			switch row.status {
+			case entity.PRStatusDraft:
+				stats.Draft++
+			case entity.PRStatusOpen:
+				stats.Open++
+			case entity.PRStatusMerged:
+				stats.Merged++
+			case entity.PRStatusClosed:
+				stats.Closed++
+			}
+		}
+		return nil
+	})
+	return stats, nil
+}
+
+// filter возвращает строки PR, подходящие под условие
+func (r *PullRequestRepository) filter(ctx context.Context, match func(row pullRequestRow) bool) []pullRequestRow {
+	var rows []pullRequestRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.pullRequests {
+			if match(row) {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+	return rows
+}
+
+func sortNewestFirst(rows []pullRequestRow) {
+	slices.SortFunc(rows, func(a, b pullRequestRow) int {
+		if c := b.createdAt.Compare(a.createdAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+}
+
+func toPullRequestEntities(rows []pullRequestRow) []*entity.PullRequest {
+	pullRequests := make([]*entity.PullRequest, 0, len(rows))
+	for _, row := range rows {
+		pullRequests = append(pullRequests, row.toEntity())
+	}
+	return pullRequests
+}
+
+// cloneTime копирует необязательное время, чтобы строка не разделяла его с сущностью
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.ReviewerCursorRepository = (*ReviewerCursorRepository)(nil)
+
+type ReviewerCursorRepository struct {
+	store *Store
+}
+
+func NewReviewerCursorRepository(store *Store) *ReviewerCursorRepository {
+	return &ReviewerCursorRepository{store: store}
+}
+
+// LockCursor возвращает последнего назначенного ревьювера команды или пустую строку для новой команды.
+// Курсор заблокирован вместе со всем хранилищем до конца транзакции
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *ReviewerCursorRepository) LockCursor(ctx context.Context, teamName string) (string, error) {
+	var lastUserID string
+	_ = r.store.read(ctx, func() error {
+		lastUserID = r.store.reviewerCursors[teamName]
+		return nil
+	})
+	return lastUserID, nil
+}
+
+// SaveCursor сохраняет последнего назначенного ревьювера команды
+func (r *ReviewerCursorRepository) SaveCursor(ctx context.Context, teamName, lastUserID string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		r.store.reviewerCursors.put(tx, teamName, lastUserID)
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.ReviewerHistoryRepository = (*ReviewerHistoryRepository)(nil)
+
+type reviewerHistoryRow struct {
+	seq           int64
+	pullRequestID string
+	userID        string
+	action        entity.ReviewerHistoryAction
+	reason        entity.ReviewerHistoryReason
+	occurredAt    time.Time
+}
+
+func (r reviewerHistoryRow) toEntity() *entity.ReviewerHistoryEntry {
+	return entity.NewReviewerHistoryEntryFromRepository(r.pullRequestID, r.userID, r.action, r.reason, r.occurredAt)
+}
+
+type ReviewerHistoryRepository struct {
+	store *Store
+}
+
+func NewReviewerHistoryRepository(store *Store) *ReviewerHistoryRepository {
+	return &ReviewerHistoryRepository{store: store}
+}
+
+func (r *ReviewerHistoryRepository) Add(ctx context.Context, entries []*entity.ReviewerHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return r.store.write(ctx, func(tx *tx) error {
+		for _, entry := range entries {
+			seq := r.store.nextSeq()
+			r.store.reviewerHistory.put(tx, seq, reviewerHistoryRow{
+				seq:           seq,
+				pullRequestID: entry.PullRequestID(),
+				userID:        entry.UserID(),
+				action:        entry.Action(),
+				reason:        entry.Reason(),
+				occurredAt:    entry.OccurredAt(),
+			})
+		}
+		return nil
+	})
+}
+
+// FindByPRID возвращает историю PR в порядке записи
+func (r *ReviewerHistoryRepository) FindByPRID(ctx context.Context, prID string) ([]*entity.ReviewerHistoryEntry, error) {
+	var rows []reviewerHistoryRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.reviewerHistory {
+			if row.pullRequestID == prID {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b reviewerHistoryRow) int { return cmp.Compare(a.seq, b.seq) })
+
+	entries := make([]*entity.ReviewerHistoryEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.toEntity())
+	}
+	return entries, nil
+}
+
+// CountAssignedPRsByUserIDs возвращает число PR, в которых пользователь когда-либо был назначен ревьювером
+func (r *ReviewerHistoryRepository) CountAssignedPRsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	result := make(map[string]int, len(userIDs))
+	for _, userID := range userIDs {
+		result[userID] = 0
+	}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	type assignment struct{ userID, pullRequestID string }
+	seen := make(map[assignment]bool)
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.reviewerHistory {
+			if row.action != entity.ReviewerAssigned {
+				continue
+			}
+			if _, requested := result[row.userID]; !requested {
+				continue
+			}
+			key := assignment{userID: row.userID, pullRequestID: row.pullRequestID}
+			if !seen[key] {
+				seen[key] = true
+				result[row.userID]++
+			}
+		}
+		return nil
+	})
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+// Package memory хранилище в памяти процесса для запуска сервиса без PostgreSQL.
+// Данные теряются при перезапуске, внешние ключи схемы не проверяются
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store общие таблицы репозиториев. Строки хранятся по значению и при изменении заменяются целиком,
+// поэтому сущность, отданная репозиторием, не связана с данными хранилища
+type Store struct {
+	mu       sync.RWMutex
+	sequence int64 // общий счетчик seq для таблиц, где важен порядок записи
+
+	users             table[string, userRow]
+	teams             table[string, teamRow]
+	pullRequests      table[string, pullRequestRow]
+	reviewerCursors   table[string, string]
+	reviewerHistory   table[int64, reviewerHistoryRow]
+	gitLogins         table[gitProviderKey, string]
+	webhookDeliveries table[gitProviderKey, time.Time]
+	subscriptions     table[string, subscriptionRow]
+	eventDeliveries   table[string, eventDeliveryRow]
+	deadLetters       table[string, eventDeliveryRow]
+	outbox            table[string, outboxRow]
+	apiTokens         table[string, apiTokenRow]
+	auditLog          table[int64, auditRow]
+	idempotencyKeys   table[idempotencyKey, idempotencyRow]
+}
+
+// NewStore создает пустое хранилище
+func NewStore() *Store {
+	return &Store{
+		users:             make(table[string, userRow]),
+		teams:             make(table[string, teamRow]),
+		pullRequests:      make(table[string, pullRequestRow]),
+		reviewerCursors:   make(table[string, string]),
+		reviewerHistory:   make(table[int64, reviewerHistoryRow]),
+		gitLogins:         make(table[gitProviderKey, string]),
+		webhookDeliveries: make(table[gitProviderKey, time.Time]),
+		subscriptions:     make(table[string, subscriptionRow]),
+		eventDeliveries:   make(table[string, eventDeliveryRow]),
+		deadLetters:       make(table[string, eventDeliveryRow]),
+		outbox:            make(table[string, outboxRow]),
+		apiTokens:         make(table[string, apiTokenRow]),
+		auditLog:          make(table[int64, auditRow]),
+		idempotencyKeys:   make(table[idempotencyKey, idempotencyRow]),
+	}
+}
+
+// nextSeq выдает следующий номер. Как и последовательности PostgreSQL, номера не возвращаются при откате
+// ВАЖНО: Вызывается под блокировкой записи
+func (s *Store) nextSeq() int64 {
+	s.sequence++
+	return s.sequence
+}
+
+// read выполняет чтение. Внутри транзакции хранилище уже заблокировано ею,
+// вне транзакции чтение идет под блокировкой чтения
+func (s *Store) read(ctx context.Context, fn func() error) error {
+	if _, ok := s.txFrom(ctx); ok {
+		return fn()
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn()
+}
+
+// write выполняет изменение с журналом отката. Вне транзакции изменение атомарно, как отдельный
+// SQL запрос: при ошибке уже сделанные им записи откатываются
+func (s *Store) write(ctx context.Context, fn func(tx *tx) error) error {
+	if tx, ok := s.txFrom(ctx); ok {
+		return fn(tx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statement := &tx{store: s}
+	if err := fn(statement); err != nil {
+		statement.rollback()
+		return err
+	}
+	return nil
+}
+
+// table таблица хранилища, ключ - первичный ключ строки
+type table[K comparable, V any] map[K]V
+
+// put вставляет или заменяет строку, запоминая прежнее значение для отката
+func (t table[K, V]) put(tx *tx, key K, row V) {
+	old, existed := t[key]
+	tx.onRollback(func() {
+		if existed {
+			t[key] = old
+		} else {
+			delete(t, key)
+		}
+	})
+	t[key] = row
+}
+
+// remove удаляет строку и возвращает false, если ее не было
+func (t table[K, V]) remove(tx *tx, key K) bool {
+	old, existed := t[key]
+	if !existed {
+		return false
+	}
+	tx.onRollback(func() {
+		t[key] = old
+	})
+	delete(t, key)
+	return true
+}
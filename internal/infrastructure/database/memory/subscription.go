@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.SubscriptionRepository = (*SubscriptionRepository)(nil)
+
+type subscriptionRow struct {
+	id         string
+	url        string
+	secret     string
+	eventTypes []entity.EventType
+	isActive   bool
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func subscriptionRowFromEntity(s *entity.Subscription) subscriptionRow {
+	return subscriptionRow{
+		id:         s.ID(),
+		url:        s.URL(),
+		secret:     s.Secret(),
+		eventTypes: s.EventTypes(),
+		isActive:   s.IsActive(),
+		createdAt:  s.CreatedAt(),
+		updatedAt:  s.UpdatedAt(),
+	}
+}
+
+func (r subscriptionRow) toEntity() *entity.Subscription {
+	return entity.NewSubscriptionFromRepository(
+		r.id,
+		r.url,
+		r.secret,
+		slices.Clone(r.eventTypes),
+		r.isActive,
+		r.createdAt,
+		r.updatedAt,
+	)
+}
+
+type SubscriptionRepository struct {
+	store *Store
+}
+
+func NewSubscriptionRepository(store *Store) *SubscriptionRepository {
+	return &SubscriptionRepository{store: store}
+}
+
+func (r *SubscriptionRepository) Create(ctx context.Context, subscription *entity.Subscription) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if _, exists := r.store.subscriptions[subscription.ID()]; exists {
+			return fmt.Errorf("failed to create subscription: subscription already exists: %s", subscription.ID())
+		}
+		r.store.subscriptions.put(tx, subscription.ID(), subscriptionRowFromEntity(subscription))
+		return nil
+	})
+}
+
+func (r *SubscriptionRepository) FindByID(ctx context.Context, id string) (*entity.Subscription, error) {
+	var subscription *entity.Subscription
+	err := r.store.read(ctx, func() error {
+		row, ok := r.store.subscriptions[id]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		subscription = row.toEntity()
+		return nil
+	})
+	return subscription, err
+}
+
+func (r *SubscriptionRepository) FindAll(ctx context.Context) ([]*entity.Subscription, error) {
+	return r.find(ctx, func(subscriptionRow) bool { return true }), nil
+}
+
+func (r *SubscriptionRepository) FindActiveByEventType(ctx context.Context, eventType entity.EventType) ([]*entity.Subscription, error) {
+	return r.find(ctx, func(row subscriptionRow) bool {
+		return row.isActive && slices.Contains(row.eventTypes, eventType)
+	}), nil
+}
+
+func (r *SubscriptionRepository) Update(ctx context.Context, subscription *entity.Subscription) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.subscriptions[subscription.ID()]
+		if !ok {
+			return repository.ErrNotFound
+		}
+
+		updated := subscriptionRowFromEntity(subscription)
+		updated.createdAt = row.createdAt
+		r.store.subscriptions.put(tx, subscription.ID(), updated)
+		return nil
+	})
+}
+
+// Delete удаляет подписку вместе с ее очередью доставок и dead letter
+func (r *SubscriptionRepository) Delete(ctx context.Context, id string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if !r.store.subscriptions.remove(tx, id) {
+			return repository.ErrNotFound
+		}
+		for deliveryID, delivery := range r.store.eventDeliveries {
+			if delivery.subscriptionID == id {
+				r.store.eventDeliveries.remove(tx, deliveryID)
+			}
+		}
+		for deliveryID, delivery := range r.store.deadLetters {
+			if delivery.subscriptionID == id {
+				r.store.deadLetters.remove(tx, deliveryID)
+			}
+		}
+		return nil
+	})
+}
+
+// find возвращает подписки по условию в порядке создания
+func (r *SubscriptionRepository) find(ctx context.Context, match func(row subscriptionRow) bool) []*entity.Subscription {
+	var rows []subscriptionRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.subscriptions {
+			if match(row) {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b subscriptionRow) int {
+		if c := a.createdAt.Compare(b.createdAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+
+	subscriptions := make([]*entity.Subscription, 0, len(rows))
+	for _, row := range rows {
+		subscriptions = append(subscriptions, row.toEntity())
+	}
+	return subscriptions
+}
@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.TeamRepository = (*TeamRepository)(nil)
+
+type teamRow struct {
+	name                    string
+	reviewerStrategy        entity.ReviewerStrategyName
+	minReviewers            int
+	maxReviewers            int
+	fallbackTeams           []string // в порядке приоритета
+	requiredApprovals       int
+	blockOnChangesRequested bool
+	codeOwners              []string // в порядке добавления
+	createdAt               time.Time
+	updatedAt               time.Time
+}
+
+func teamRowFromEntity(t *entity.Team) teamRow {
+	return teamRow{
+		name:                    t.Name(),
+		reviewerStrategy:        t.ReviewerStrategy(),
+		minReviewers:            t.ReviewerLimits().Min(),
+		maxReviewers:            t.ReviewerLimits().Max(),
+		fallbackTeams:           slices.Clone(t.FallbackTeams()),
+		requiredApprovals:       t.MergePolicy().RequiredApprovals(),
+		blockOnChangesRequested: t.MergePolicy().BlockOnChangesRequested(),
+		codeOwners:              slices.Clone(t.MergePolicy().CodeOwners()),
+		createdAt:               t.CreatedAt(),
+		updatedAt:               t.UpdatedAt(),
+	}
+}
+
+func (r teamRow) toEntity() *entity.Team {
+	return entity.NewTeamFromRepository(
+		r.name,
+		r.reviewerStrategy,
+		entity.NewReviewerLimitsFromRepository(r.minReviewers, r.maxReviewers),
+		slices.Clone(r.fallbackTeams),
+		entity.NewMergePolicyFromRepository(r.requiredApprovals, r.blockOnChangesRequested, slices.Clone(r.codeOwners)),
+		r.createdAt,
+		r.updatedAt,
+	)
+}
+
+type TeamRepository struct {
+	store *Store
+}
+
+func NewTeamRepository(store *Store) *TeamRepository {
+	return &TeamRepository{store: store}
+}
+
+func (r *TeamRepository) Create(ctx context.Context, team *entity.Team) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if _, exists := r.store.teams[team.Name()]; exists {
+			return fmt.Errorf("failed to create team: team already exists: %s", team.Name())
+		}
+		r.store.teams.put(tx, team.Name(), teamRowFromEntity(team))
+		return nil
+	})
+}
+
+func (r *TeamRepository) FindByName(ctx context.Context, name string) (*entity.Team, error) {
+	var team *entity.Team
+	err := r.store.read(ctx, func() error {
+		row, ok := r.store.teams[name]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		team = row.toEntity()
+		return nil
+	})
+	return team, err
+}
+
+func (r *TeamRepository) Update(ctx context.Context, team *entity.Team) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.teams[team.Name()]
+		if !ok {
+			return fmt.Errorf("team not found: %s", team.Name())
+		}
+
+		updated := teamRowFromEntity(team)
+		updated.createdAt = row.createdAt
+		r.store.teams.put(tx, team.Name(), updated)
+		return nil
+	})
+}
+
+func (r *TeamRepository) Delete(ctx context.Context, name string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if !r.store.teams.remove(tx, name) {
+			return fmt.Errorf("team not found: %s", name)
+		}
+		r.store.reviewerCursors.remove(tx, name)
+		return nil
+	})
+}
+
+func (r *TeamRepository) Exists(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	_ = r.store.read(ctx, func() error {
+		_, exists = r.store.teams[name]
+		return nil
+	})
+	return exists, nil
+}
+
+// CountActiveMembers возвращает число активных участников каждой команды, включая команды без активных
+func (r *TeamRepository) CountActiveMembers(ctx context.Context) (map[string]int, error) {
+	counts := make(map[string]int)
+	err := r.store.read(ctx, func() error {
+		for name := range r.store.teams {
+			counts[name] = 0
+		}
+		for _, user := range r.store.users {
+			if _, ok := counts[user.teamName]; ok && user.isActive {
+				counts[user.teamName]++
+			}
+		}
+		return nil
+	})
+	return counts, err
+}
@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+)
+
+var _ transaction.Manager = (*TransactionManager)(nil)
+
+type txKey struct{}
+
+// tx журнал отката транзакции
+type tx struct {
+	store *Store
+	undo  []func()
+	done  bool // контекст завершенной транзакции больше не дает доступа к хранилищу без блокировки
+}
+
+func (t *tx) onRollback(undo func()) {
+	t.undo = append(t.undo, undo)
+}
+
+// rollback отменяет изменения в обратном порядке
+func (t *tx) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+	t.undo = nil
+}
+
+// txFrom возвращает транзакцию этого хранилища из контекста
+func (s *Store) txFrom(ctx context.Context) (*tx, bool) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok || t.store != s || t.done {
+		return nil, false
+	}
+	return t, true
+}
+
+// TransactionManager менеджер транзакций хранилища в памяти.
+// Транзакция держит блокировку записи всего хранилища до завершения, поэтому транзакции выполняются
+// последовательно. Это строже построчных блокировок PostgreSQL (SELECT FOR UPDATE): прочитанное
+// в транзакции не изменится до ее конца. При ошибке или панике изменения откатываются
+type TransactionManager struct {
+	store *Store
+}
+
+// NewTransactionManager создает менеджер транзакций хранилища в памяти
+func NewTransactionManager(store *Store) *TransactionManager {
+	return &TransactionManager{store: store}
+}
+
+// Do выполняет fn в транзакции. Вложенный вызов присоединяется к внешней транзакции
+func (m *TransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := m.store.txFrom(ctx); ok {
+		return fn(ctx)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+
+	t := &tx{store: m.store}
+	committed := false
+	defer func() {
+		if !committed {
+			t.rollback()
+		}
+		t.done = true
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, t)); err != nil {
+		return err
+	}
+
+	committed = true
+	return nil
+}
@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.UserRepository = (*UserRepository)(nil)
+
+type userRow struct {
+	id        string
+	username  string
+	teamName  string
+	isActive  bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func userRowFromEntity(u *entity.User) userRow {
+	return userRow{
+		id:        u.ID(),
+		username:  u.Username(),
+		teamName:  u.TeamName(),
+		isActive:  u.IsActive(),
+		createdAt: u.CreatedAt(),
+		updatedAt: u.UpdatedAt(),
+	}
+}
+
+func (r userRow) toEntity() *entity.User {
+	return entity.NewUserFromRepository(r.id, r.username, r.teamName, r.isActive, r.createdAt, r.updatedAt)
+}
+
+type UserRepository struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{store: store}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if _, exists := r.store.users[user.ID()]; exists {
+			return fmt.Errorf("failed to create user: user already exists: %s", user.ID())
+		}
+		r.store.users.put(tx, user.ID(), userRowFromEntity(user))
+		return nil
+	})
+}
+
+func (r *UserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	var user *entity.User
+	err := r.store.read(ctx, func() error {
+		row, ok := r.store.users[id]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		user = row.toEntity()
+		return nil
+	})
+	return user, err
+}
+
+// FindByIDs находит пользователей по списку ID (отсутствующие ID пропускаются)
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	var rows []userRow
+	_ = r.store.read(ctx, func() error {
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			if row, ok := r.store.users[id]; ok && !seen[id] {
+				seen[id] = true
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b userRow) int { return strings.Compare(a.id, b.id) })
+	return toUserEntities(rows), nil
+}
+
+func (r *UserRepository) FindByTeamName(ctx context.Context, teamName string) ([]*entity.User, error) {
+	return r.findByTeam(ctx, teamName, false), nil
+}
+
+func (r *UserRepository) FindActiveByTeamName(ctx context.Context, teamName string) ([]*entity.User, error) {
+	return r.findByTeam(ctx, teamName, true), nil
+}
+
+// findByTeam возвращает участников команды, отсортированных по username
+func (r *UserRepository) findByTeam(ctx context.Context, teamName string, activeOnly bool) []*entity.User {
+	var rows []userRow
+	_ = r.store.read(ctx, func() error {
+		for _, row := range r.store.users {
+			if row.teamName == teamName && (row.isActive || !activeOnly) {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+
+	slices.SortFunc(rows, func(a, b userRow) int {
+		if c := strings.Compare(a.username, b.username); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+	return toUserEntities(rows)
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		row, ok := r.store.users[user.ID()]
+		if !ok {
+			return fmt.Errorf("user not found: %s", user.ID())
+		}
+
+		updated := userRowFromEntity(user)
+		updated.createdAt = row.createdAt
+		r.store.users.put(tx, user.ID(), updated)
+		return nil
+	})
+}
+
+// BatchDeactivateByTeamName деактивирует всех активных пользователей команды
+func (r *UserRepository) BatchDeactivateByTeamName(ctx context.Context, teamName string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		now := time.Now()
+		for id, row := range r.store.users {
+			if row.teamName != teamName || !row.isActive {
+				continue
+			}
+			row.isActive = false
+			row.updatedAt = now
+			r.store.users.put(tx, id, row)
+		}
+		return nil
+	})
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		if !r.store.users.remove(tx, id) {
+			return fmt.Errorf("user not found: %s", id)
+		}
+		return nil
+	})
+}
+
+func (r *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	_ = r.store.read(ctx, func() error {
+		_, exists = r.store.users[id]
+		return nil
+	})
+	return exists, nil
+}
+
+func toUserEntities(rows []userRow) []*entity.User {
+	users := make([]*entity.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, row.toEntity())
+	}
+	return users
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.WebhookRepository = (*WebhookRepository)(nil)
+
+// gitProviderKey ключ логина или доставки вебхука в пределах git-хостинга
+type gitProviderKey struct {
+	provider entity.GitProvider
+	value    string
+}
+
+type WebhookRepository struct {
+	store *Store
+}
+
+func NewWebhookRepository(store *Store) *WebhookRepository {
+	return &WebhookRepository{store: store}
+}
+
+func (r *WebhookRepository) FindUserIDByLogin(ctx context.Context, provider entity.GitProvider, login string) (string, error) {
+	var userID string
+	err := r.store.read(ctx, func() error {
+		found, ok := r.store.gitLogins[gitProviderKey{provider: provider, value: login}]
+		if !ok {
+			return repository.ErrNotFound
+		}
+		userID = found
+		return nil
+	})
+	return userID, err
+}
+
+func (r *WebhookRepository) SaveLogin(ctx context.Context, provider entity.GitProvider, login, userID string) error {
+	return r.store.write(ctx, func(tx *tx) error {
+		r.store.gitLogins.put(tx, gitProviderKey{provider: provider, value: login}, userID)
+		return nil
+	})
+}
+
+// RegisterDelivery запоминает доставку и возвращает false, если она уже была принята
+func (r *WebhookRepository) RegisterDelivery(ctx context.Context, provider entity.GitProvider, deliveryID string) (bool, error) {
+	var registered bool
+	err := r.store.write(ctx, func(tx *tx) error {
+		key := gitProviderKey{provider: provider, value: deliveryID}
+		if _, exists := r.store.webhookDeliveries[key]; exists {
+			return nil
+		}
+		r.store.webhookDeliveries.put(tx, key, time.Now())
+		registered = true
+		return nil
+	})
+	return registered, err
+}
@@ -0,0 +1,209 @@
+// Package migration применяет миграции схемы, встроенные в бинарник.
+// Формат файлов и таблица версий schema_migrations совпадают с утилитой golang-migrate,
+// поэтому базу, размеченную контейнером migrate/migrate, можно обслуживать и из сервиса
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// ErrSchemaMismatch версия схемы базы не совпадает с версией, которую ожидает бинарник
+var ErrSchemaMismatch = errors.New("schema version mismatch")
+
+// Status состояние схемы базы
+type Status struct {
+	Version  uint // 0 - ни одна миграция не применена
+	Expected uint // последняя миграция, встроенная в бинарник
+	Dirty    bool // миграция Version упала на середине
+}
+
+type Migrator struct {
+	m        *migrate.Migrate
+	source   source.Driver
+	release  func() error
+	expected uint
+}
+
+// NewPostgres создает мигратор для PostgreSQL. Мигратор занимает одно соединение из пула до Close
+func NewPostgres(db *sql.DB, migrations fs.FS) (*Migrator, error) {
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to init migration driver: %w", err)
+	}
+
+	return newMigrator(migrations, "postgres", driver, conn.Close)
+}
+
+// NewSQLite создает мигратор для SQLite
+func NewSQLite(db *sql.DB, migrations fs.FS) (*Migrator, error) {
+	driver, err := sqlite.WithInstance(db, &sqlite.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to init migration driver: %w", err)
+	}
+
+	return newMigrator(migrations, "sqlite", driver, nil)
+}
+
+func newMigrator(migrations fs.FS, databaseName string, driver database.Driver, release func() error) (*Migrator, error) {
+	src, err := iofs.New(migrations, ".")
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read migrations: %w", err), releaseConn(release))
+	}
+
+	expected, err := lastVersion(src)
+	if err != nil {
+		return nil, errors.Join(err, src.Close(), releaseConn(release))
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, databaseName, driver)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to init migrations: %w", err), src.Close(), releaseConn(release))
+	}
+
+	return &Migrator{m: m, source: src, release: release, expected: expected}, nil
+}
+
+// lastVersion возвращает версию последней миграции источника
+func lastVersion(src source.Driver) (uint, error) {
+	version, err := src.First()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read first migration: %w", err)
+	}
+
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read migration after %d: %w", version, err)
+		}
+		version = next
+	}
+}
+
+// Up применяет все непримененные миграции
+func (m *Migrator) Up() error {
+	if err := m.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	return nil
+}
+
+// Down откатывает steps последних миграций. Если примененных миграций меньше, откатываются все
+func (m *Migrator) Down(steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
+	}
+
+	var shortLimit migrate.ErrShortLimit
+	err := m.m.Steps(-steps)
+	switch {
+	case err == nil, errors.As(err, &shortLimit):
+		return nil
+	case errors.Is(err, os.ErrNotExist):
+		// Схема уже пуста
+		return nil
+	default:
+		return fmt.Errorf("failed to roll back migrations: %w", err)
+	}
+}
+
+func (m *Migrator) Status() (Status, error) {
+	version, dirty, err := m.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return Status{}, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	return Status{Version: version, Expected: m.expected, Dirty: dirty}, nil
+}
+
+// Check возвращает ErrSchemaMismatch, если схема базы не совпадает с ожидаемой бинарником
+func (m *Migrator) Check() error {
+	status, err := m.Status()
+	if err != nil {
+		return err
+	}
+
+	return status.Check()
+}
+
+// Check возвращает ErrSchemaMismatch, если миграция упала на середине или версия схемы
+// отличается от ожидаемой
+func (s Status) Check() error {
+	if s.Dirty {
+		return fmt.Errorf("%w: migration %d failed halfway, fix the schema and force the version", ErrSchemaMismatch, s.Version)
+	}
+	if s.Version != s.Expected {
+		return fmt.Errorf("%w: database has version %d, binary expects %d", ErrSchemaMismatch, s.Version, s.Expected)
+	}
+
+	return nil
+}
+
+// ExpectedVersion возвращает версию последней миграции из migrations
+func ExpectedVersion(migrations fs.FS) (uint, error) {
+	src, err := iofs.New(migrations, ".")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	//nolint:errcheck // Источник только читает встроенную файловую систему
+	defer src.Close()
+
+	return lastVersion(src)
+}
+
+// ReadStatus читает версию схемы одним запросом к schema_migrations. В отличие от мигратора
+// не занимает отдельное соединение и не берет блокировку, поэтому подходит для частых проверок
+func ReadStatus(ctx context.Context, db *sql.DB, expected uint) (Status, error) {
+	status := Status{Expected: expected}
+
+	var version int64
+	err := db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &status.Dirty)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// Ни одна миграция не применена
+		return status, nil
+	case err != nil:
+		return Status{}, fmt.Errorf("failed to read schema version: %w", err)
+	case version < 0:
+		// golang-migrate пишет -1 после отката всех миграций
+		return status, nil
+	}
+
+	status.Version = uint(version)
+	return status, nil
+}
+
+// Close освобождает источник миграций и соединение мигратора. Сама база остается открытой:
+// migrate.Migrate.Close закрыл бы переданный *sql.DB, поэтому здесь не вызывается
+func (m *Migrator) Close() error {
+	return errors.Join(m.source.Close(), releaseConn(m.release))
+}
+
+func releaseConn(release func() error) error {
+	if release == nil {
+		return nil
+	}
+	return release()
+}
@@ -0,0 +1,228 @@
+package migration_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/migration"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/sqlite"
+	"github.com/exPriceD/pr-reviewer-service/migrations"
+)
+
+var testMigrations = fstest.MapFS{
+	"000001_create_a.up.sql":   {Data: []byte(`CREATE TABLE a (id INTEGER PRIMARY KEY);`)},
+	"000001_create_a.down.sql": {Data: []byte(`DROP TABLE a;`)},
+	"000002_create_b.up.sql":   {Data: []byte(`CREATE TABLE b (id INTEGER PRIMARY KEY);`)},
+	"000002_create_b.down.sql": {Data: []byte(`DROP TABLE b;`)},
+}
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sqlite.NewSQLiteDB(config.SQLiteConfig{
+		Path:        filepath.Join(t.TempDir(), "test.db"),
+		BusyTimeout: config.DefaultSQLiteBusyTimeout,
+	})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db.DB()
+}
+
+func newMigrator(t *testing.T, db *sql.DB, migrations fs.FS) *migration.Migrator {
+	t.Helper()
+
+	m, err := migration.NewSQLite(db, migrations)
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	t.Cleanup(func() { _ = m.Close() })
+
+	return m
+}
+
+func assertStatus(t *testing.T, m *migration.Migrator, expected migration.Status) {
+	t.Helper()
+
+	status, err := m.Status()
+	if err != nil {
+		t.Fatalf("failed to read status: %v", err)
+	}
+	if status != expected {
+		t.Errorf("expected status %+v, got %+v", expected, status)
+	}
+}
+
+func TestMigrator_UpDown(t *testing.T) {
+	db := openDB(t)
+	m := newMigrator(t, db, testMigrations)
+
+	assertStatus(t, m, migration.Status{Version: 0, Expected: 2})
+	if err := m.Check(); !errors.Is(err, migration.ErrSchemaMismatch) {
+		t.Errorf("expected ErrSchemaMismatch for empty schema, got %v", err)
+	}
+
+	if err := m.Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	assertStatus(t, m, migration.Status{Version: 2, Expected: 2})
+	if err := m.Check(); err != nil {
+		t.Errorf("expected schema to match, got %v", err)
+	}
+
+	// Повторный Up ничего не меняет
+	if err := m.Up(); err != nil {
+		t.Fatalf("second Up failed: %v", err)
+	}
+
+	if err := m.Down(1); err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+	assertStatus(t, m, migration.Status{Version: 1, Expected: 2})
+	if _, err := db.Exec(`SELECT 1 FROM b`); err == nil {
+		t.Error("expected table b to be dropped")
+	}
+
+	if err := m.Down(1); err != nil {
+		t.Fatalf("Down to empty schema failed: %v", err)
+	}
+	assertStatus(t, m, migration.Status{Version: 0, Expected: 2})
+
+	if err := m.Down(1); err != nil {
+		t.Errorf("Down on empty schema should be a no-op, got %v", err)
+	}
+
+	// Шагов больше, чем примененных миграций: откатывается все
+	if err := m.Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	if err := m.Down(5); err != nil {
+		t.Fatalf("Down past the first migration failed: %v", err)
+	}
+	assertStatus(t, m, migration.Status{Version: 0, Expected: 2})
+}
+
+func TestMigrator_DownValidatesSteps(t *testing.T) {
+	m := newMigrator(t, openDB(t), testMigrations)
+
+	if err := m.Down(0); err == nil {
+		t.Error("expected error for zero steps")
+	}
+}
+
+func TestMigrator_CheckNewerSchema(t *testing.T) {
+	db := openDB(t)
+	if err := newMigrator(t, db, testMigrations).Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+
+	// Бинарник знает только первую миграцию, а база уже на второй
+	older := fstest.MapFS{
+		"000001_create_a.up.sql":   testMigrations["000001_create_a.up.sql"],
+		"000001_create_a.down.sql": testMigrations["000001_create_a.down.sql"],
+	}
+	m := newMigrator(t, db, older)
+
+	if err := m.Check(); !errors.Is(err, migration.ErrSchemaMismatch) {
+		t.Errorf("expected ErrSchemaMismatch, got %v", err)
+	}
+}
+
+func TestMigrator_CheckDirtySchema(t *testing.T) {
+	db := openDB(t)
+	broken := fstest.MapFS{
+		"000001_create_a.up.sql":   testMigrations["000001_create_a.up.sql"],
+		"000001_create_a.down.sql": testMigrations["000001_create_a.down.sql"],
+		"000002_broken.up.sql":     {Data: []byte(`CREATE TABLE;`)},
+		"000002_broken.down.sql":   {Data: []byte(``)},
+	}
+	m := newMigrator(t, db, broken)
+
+	if err := m.Up(); err == nil {
+		t.Fatal("expected Up to fail on broken migration")
+	}
+	if err := m.Check(); !errors.Is(err, migration.ErrSchemaMismatch) {
+		t.Errorf("expected ErrSchemaMismatch for dirty schema, got %v", err)
+	}
+}
+
+func TestReadStatus(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+
+	expected, err := migration.ExpectedVersion(testMigrations)
+	if err != nil {
+		t.Fatalf("ExpectedVersion failed: %v", err)
+	}
+	if expected != 2 {
+		t.Fatalf("expected version 2, got %d", expected)
+	}
+
+	// До первой миграции таблицы версий нет
+	if _, err := migration.ReadStatus(ctx, db, expected); err == nil {
+		t.Error("expected error without schema_migrations table")
+	}
+
+	m := newMigrator(t, db, testMigrations)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	if err := m.Down(1); err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+
+	status, err := migration.ReadStatus(ctx, db, expected)
+	if err != nil {
+		t.Fatalf("ReadStatus failed: %v", err)
+	}
+	if want := (migration.Status{Version: 1, Expected: 2}); status != want {
+		t.Errorf("expected status %+v, got %+v", want, status)
+	}
+	if err := status.Check(); !errors.Is(err, migration.ErrSchemaMismatch) {
+		t.Errorf("expected ErrSchemaMismatch, got %v", err)
+	}
+
+	if err := m.Down(1); err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+	status, err = migration.ReadStatus(ctx, db, expected)
+	if err != nil {
+		t.Fatalf("ReadStatus on empty schema failed: %v", err)
+	}
+	if status.Version != 0 {
+		t.Errorf("expected version 0 on empty schema, got %d", status.Version)
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	db := openDB(t)
+
+	t.Run("SQLite", func(t *testing.T) {
+		m := newMigrator(t, db, sqlite.Migrations())
+		if err := m.Up(); err != nil {
+			t.Fatalf("Up failed: %v", err)
+		}
+		if err := m.Check(); err != nil {
+			t.Errorf("expected schema to match, got %v", err)
+		}
+	})
+
+	// Миграции PostgreSQL нельзя применить к SQLite, но источник должен читаться
+	t.Run("PostgresSource", func(t *testing.T) {
+		status, err := newMigrator(t, openDB(t), migrations.FS).Status()
+		if err != nil {
+			t.Fatalf("failed to read status: %v", err)
+		}
+		if status.Expected == 0 {
+			t.Error("expected embedded PostgreSQL migrations")
+		}
+	})
+}
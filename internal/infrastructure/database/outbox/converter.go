@@ -0,0 +1,23 @@
+package outbox
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model) *entity.OutboxMessage {
+	return entity.NewOutboxMessageFromRepository(
+		m.ID,
+		entity.EventType(m.EventType),
+		m.Payload,
+		m.OccurredAt,
+		m.SentAt,
+	)
+}
+
+func FromEntity(message *entity.OutboxMessage) *Model {
+	return &Model{
+		ID:         message.ID(),
+		EventType:  string(message.EventType()),
+		Payload:    message.Payload(),
+		OccurredAt: message.OccurredAt(),
+		SentAt:     message.SentAt(),
+	}
+}
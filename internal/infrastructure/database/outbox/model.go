@@ -0,0 +1,11 @@
+package outbox
+
+import "time"
+
+type Model struct {
+	ID         string     `db:"outbox_id"`
+	EventType  string     `db:"event_type"`
+	Payload    []byte     `db:"payload"`
+	OccurredAt time.Time  `db:"occurred_at"`
+	SentAt     *time.Time `db:"sent_at"`
+}
@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.OutboxRepository = (*Repository)(nil)
+
+const messageParamsCount = 4
+
+type Repository struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func NewRepository(db *sql.DB, getter *trmsql.CtxGetter) *Repository {
+	return &Repository{
+		db:     db,
+		getter: getter,
+	}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (r *Repository) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *Repository) Add(ctx context.Context, messages []*entity.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(messages))
+	valueArgs := make([]interface{}, 0, len(messages)*messageParamsCount)
+	for i, message := range messages {
+		model := FromEntity(message)
+		paramOffset := i * messageParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d)",
+			paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4,
+		))
+		valueArgs = append(valueArgs, model.ID, model.EventType, model.Payload, model.OccurredAt)
+	}
+
+	// seq назначается в порядке VALUES, поэтому события одной транзакции публикуются в порядке Emit
+	query := fmt.Sprintf(`
+		INSERT INTO outbox (outbox_id, event_type, payload, occurred_at)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to add outbox messages: %w", err)
+	}
+
+	return nil
+}
+
+// FindPendingForUpdate блокирует выбранные строки до конца транзакции. SKIP LOCKED позволяет
+// нескольким экземплярам relay работать параллельно, не публикуя одно сообщение дважды
+func (r *Repository) FindPendingForUpdate(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	query := `
+		SELECT outbox_id, event_type, payload, occurred_at, sent_at
+		FROM outbox
+		WHERE sent_at IS NULL
+		ORDER BY seq
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending outbox messages: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var messages []*entity.OutboxMessage
+	for rows.Next() {
+		var model Model
+		if err := rows.Scan(
+			&model.ID,
+			&model.EventType,
+			&model.Payload,
+			&model.OccurredAt,
+			&model.SentAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		messages = append(messages, ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *Repository) MarkSent(ctx context.Context, ids []string, sentAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, sentAt)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, id)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE outbox
+		SET sent_at = $1
+		WHERE outbox_id IN (%s)
+	`, strings.Join(placeholders, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to mark outbox messages sent: %w", err)
+	}
+
+	return nil
+}
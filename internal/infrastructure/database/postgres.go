@@ -8,8 +8,10 @@ import (
 
 	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
 	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/tracing"
 )
 
 type PostgresDB struct {
@@ -23,7 +25,7 @@ func NewPostgresDB(cfg config.DatabaseConfig) (*PostgresDB, error) {
 		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode,
 	)
 
-	db, err := sql.Open("postgres", dsn)
+	db, err := tracing.OpenDB("postgres", dsn, semconv.DBSystemNamePostgreSQL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -7,20 +7,39 @@ import (
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 )
 
-func ToEntity(m *Model, reviewers []string) *entity.PullRequest {
+func ToEntity(m *Model, reviewers []ReviewerModel) *entity.PullRequest {
 	var mergedAtPtr *time.Time
 	if m.MergedAt.Valid {
 		mergedAtPtr = &m.MergedAt.Time
 	}
 
+	reviewerIDs := make([]string, 0, len(reviewers))
+	var fallbackReviewerIDs []string
+	var reviews []entity.Review
+	for _, reviewer := range reviewers {
+		reviewerIDs = append(reviewerIDs, reviewer.UserID)
+		if reviewer.IsFallback {
+			fallbackReviewerIDs = append(fallbackReviewerIDs, reviewer.UserID)
+		}
+		if reviewer.ReviewedAt.Valid {
+			reviewedAt := reviewer.ReviewedAt.Time
+			reviews = append(reviews, entity.NewReviewFromRepository(reviewer.UserID, entity.ReviewState(reviewer.ReviewState), &reviewedAt))
+		}
+	}
+
 	return entity.NewPullRequestFromRepository(
 		m.ID,
 		m.Name,
 		m.AuthorID,
+		m.TeamName,
 		entity.PRStatus(m.Status),
-		reviewers,
+		reviewerIDs,
+		fallbackReviewerIDs,
+		reviews,
+		entity.NewReviewerLimitsFromRepository(m.MinReviewers, m.MaxReviewers),
 		m.CreatedAt,
 		mergedAtPtr,
+		m.MergeForced,
 	)
 }
 
@@ -34,11 +53,15 @@ func FromEntity(pr *entity.PullRequest) *Model {
 	}
 
 	return &Model{
-		ID:        pr.ID(),
-		Name:      pr.Name(),
-		AuthorID:  pr.AuthorID(),
-		Status:    string(pr.Status()),
-		CreatedAt: pr.CreatedAt(),
-		MergedAt:  mergedAt,
+		ID:           pr.ID(),
+		Name:         pr.Name(),
+		AuthorID:     pr.AuthorID(),
+		TeamName:     pr.TeamName(),
+		Status:       string(pr.Status()),
+		MinReviewers: pr.ReviewerLimits().Min(),
+		MaxReviewers: pr.ReviewerLimits().Max(),
+		CreatedAt:    pr.CreatedAt(),
+		MergedAt:     mergedAt,
+		MergeForced:  pr.MergeForced(),
 	}
 }
@@ -6,10 +6,22 @@ import (
 )
 
 type Model struct {
-	ID        string       `db:"pull_request_id"`
-	Name      string       `db:"pull_request_name"`
-	AuthorID  string       `db:"author_id"`
-	Status    string       `db:"status"`
-	CreatedAt time.Time    `db:"created_at"`
-	MergedAt  sql.NullTime `db:"merged_at"`
+	ID           string       `db:"pull_request_id"`
+	Name         string       `db:"pull_request_name"`
+	AuthorID     string       `db:"author_id"`
+	TeamName     string       `db:"team_name"`
+	Status       string       `db:"status"`
+	MinReviewers int          `db:"min_reviewers"`
+	MaxReviewers int          `db:"max_reviewers"`
+	CreatedAt    time.Time    `db:"created_at"`
+	MergedAt     sql.NullTime `db:"merged_at"`
+	MergeForced  bool         `db:"merge_forced"`
+}
+
+// ReviewerModel строка pr_reviewers
+type ReviewerModel struct {
+	UserID      string       `db:"user_id"`
+	IsFallback  bool         `db:"is_fallback"`
+	ReviewState string       `db:"review_state"`
+	ReviewedAt  sql.NullTime `db:"reviewed_at"`
 }
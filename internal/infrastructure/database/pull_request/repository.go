@@ -16,7 +16,10 @@ import (
 var _ repository.PullRequestRepository = (*Repository)(nil)
 
 const (
-	reviewerParamsCount = 2
+	reviewerParamsCount       = 2
+	reviewerInsertParamsCount = 5
+	reviewerChangeParamsCount = 4
+	reviewerChangesBatchSize  = 500
 )
 
 type Repository struct {
@@ -40,12 +43,39 @@ func (r *Repository) getDB(ctx context.Context) interface {
 	return r.getter.DefaultTrOrDB(ctx, r.db)
 }
 
+// pullRequestColumns колонки PR (алиас pr) в порядке, который ожидает scanModel
+const pullRequestColumns = `pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.team_name, pr.status,
+		pr.min_reviewers, pr.max_reviewers, pr.created_at, pr.merged_at, pr.merge_forced`
+
+// scanModel читает строку, выбранную по pullRequestColumns
+func scanModel(row interface {
+	Scan(dest ...interface{}) error
+}) (Model, error) {
+	var model Model
+	err := row.Scan(
+		&model.ID,
+		&model.Name,
+		&model.AuthorID,
+		&model.TeamName,
+		&model.Status,
+		&model.MinReviewers,
+		&model.MaxReviewers,
+		&model.CreatedAt,
+		&model.MergedAt,
+		&model.MergeForced,
+	)
+	return model, err
+}
+
 func (r *Repository) Create(ctx context.Context, pr *entity.PullRequest) error {
 	model := FromEntity(pr)
 
 	query := `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO pull_requests (
+			pull_request_id, pull_request_name, author_id, team_name, status,
+			min_reviewers, max_reviewers, created_at, merged_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
 	_, err := r.getDB(ctx).ExecContext(
@@ -54,7 +84,10 @@ func (r *Repository) Create(ctx context.Context, pr *entity.PullRequest) error {
 		model.ID,
 		model.Name,
 		model.AuthorID,
+		model.TeamName,
 		model.Status,
+		model.MinReviewers,
+		model.MaxReviewers,
 		model.CreatedAt,
 		model.MergedAt,
 	)
@@ -62,10 +95,8 @@ func (r *Repository) Create(ctx context.Context, pr *entity.PullRequest) error {
 		return fmt.Errorf("failed to create pull request: %w", err)
 	}
 
-	if len(pr.AssignedReviewers()) > 0 {
-		if err := r.insertReviewers(ctx, pr.ID(), pr.AssignedReviewers()); err != nil {
-			return fmt.Errorf("failed to insert reviewers: %w", err)
-		}
+	if err := r.insertReviewers(ctx, pr); err != nil {
+		return fmt.Errorf("failed to insert reviewers: %w", err)
 	}
 
 	return nil
@@ -73,20 +104,12 @@ func (r *Repository) Create(ctx context.Context, pr *entity.PullRequest) error {
 
 func (r *Repository) FindByID(ctx context.Context, id string) (*entity.PullRequest, error) {
 	query := `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
-		FROM pull_requests
-		WHERE pull_request_id = $1
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		WHERE pr.pull_request_id = $1
 	`
 
-	var model Model
-	err := r.getDB(ctx).QueryRowContext(ctx, query, id).Scan(
-		&model.ID,
-		&model.Name,
-		&model.AuthorID,
-		&model.Status,
-		&model.CreatedAt,
-		&model.MergedAt,
-	)
+	model, err := scanModel(r.getDB(ctx).QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrNotFound
@@ -106,21 +129,13 @@ func (r *Repository) FindByID(ctx context.Context, id string) (*entity.PullReque
 // ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
 func (r *Repository) FindByIDForUpdate(ctx context.Context, id string) (*entity.PullRequest, error) {
 	query := `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
-		FROM pull_requests
-		WHERE pull_request_id = $1
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		WHERE pr.pull_request_id = $1
 		FOR UPDATE
 	`
 
-	var model Model
-	err := r.getDB(ctx).QueryRowContext(ctx, query, id).Scan(
-		&model.ID,
-		&model.Name,
-		&model.AuthorID,
-		&model.Status,
-		&model.CreatedAt,
-		&model.MergedAt,
-	)
+	model, err := scanModel(r.getDB(ctx).QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrNotFound
@@ -138,7 +153,7 @@ func (r *Repository) FindByIDForUpdate(ctx context.Context, id string) (*entity.
 
 func (r *Repository) FindByReviewerID(ctx context.Context, reviewerID string) ([]*entity.PullRequest, error) {
 	query := `
-		SELECT DISTINCT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		SELECT DISTINCT ` + pullRequestColumns + `
 		FROM pull_requests pr
 		INNER JOIN pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id
 		WHERE prr.user_id = $1
@@ -159,10 +174,10 @@ func (r *Repository) FindByReviewerID(ctx context.Context, reviewerID string) ([
 
 func (r *Repository) FindByAuthorID(ctx context.Context, authorID string) ([]*entity.PullRequest, error) {
 	query := `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
-		FROM pull_requests
-		WHERE author_id = $1
-		ORDER BY created_at DESC
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		WHERE pr.author_id = $1
+		ORDER BY pr.created_at DESC
 	`
 
 	rows, err := r.getDB(ctx).QueryContext(ctx, query, authorID)
@@ -177,11 +192,80 @@ func (r *Repository) FindByAuthorID(ctx context.Context, authorID string) ([]*en
 	return r.scanPullRequestsFromRows(ctx, rows)
 }
 
+// FindOpenByReviewerIDsForUpdate находит открытые PR, где ревьювером назначен кто-то из reviewerIDs,
+// и блокирует их строки (SELECT FOR UPDATE). Ревьюверы подгружаются одним запросом для всех PR
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *Repository) FindOpenByReviewerIDsForUpdate(ctx context.Context, reviewerIDs []string) ([]*entity.PullRequest, error) {
+	if len(reviewerIDs) == 0 {
+		return []*entity.PullRequest{}, nil
+	}
+
+	placeholders := make([]string, len(reviewerIDs))
+	args := make([]interface{}, len(reviewerIDs)+1)
+	for i, reviewerID := range reviewerIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = reviewerID
+	}
+	args[len(reviewerIDs)] = string(entity.PRStatusOpen)
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM pull_requests pr
+		WHERE pr.status = $%d
+			AND EXISTS (
+				SELECT 1
+				FROM pr_reviewers prr
+				WHERE prr.pull_request_id = pr.pull_request_id AND prr.user_id IN (%s)
+			)
+		ORDER BY pr.created_at, pr.pull_request_id
+		FOR UPDATE
+	`, pullRequestColumns, len(reviewerIDs)+1, strings.Join(placeholders, ","))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open pull requests by reviewers: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var models []Model
+	for rows.Next() {
+		model, err := scanModel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+		models = append(models, model)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	prIDs := make([]string, len(models))
+	for i := range models {
+		prIDs[i] = models[i].ID
+	}
+
+	reviewersByPR, err := r.findReviewersByPRIDs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+
+	pullRequests := make([]*entity.PullRequest, 0, len(models))
+	for i := range models {
+		pullRequests = append(pullRequests, ToEntity(&models[i], reviewersByPR[models[i].ID]))
+	}
+
+	return pullRequests, nil
+}
+
 func (r *Repository) scanPullRequestsFromRows(ctx context.Context, rows *sql.Rows) ([]*entity.PullRequest, error) {
 	var pullRequests []*entity.PullRequest
 	for rows.Next() {
-		var model Model
-		if err := rows.Scan(&model.ID, &model.Name, &model.AuthorID, &model.Status, &model.CreatedAt, &model.MergedAt); err != nil {
+		model, err := scanModel(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan pull request: %w", err)
 		}
 
@@ -235,26 +319,63 @@ func (r *Repository) Update(ctx context.Context, pr *entity.PullRequest) error {
 		return fmt.Errorf("failed to delete reviewers: %w", err)
 	}
 
-	if len(pr.AssignedReviewers()) > 0 {
-		if err := r.insertReviewers(ctx, pr.ID(), pr.AssignedReviewers()); err != nil {
-			return fmt.Errorf("failed to insert reviewers: %w", err)
-		}
+	if err := r.insertReviewers(ctx, pr); err != nil {
+		return fmt.Errorf("failed to insert reviewers: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus сохраняет только статус PR и данные мержа, не трогая ревьюверов
+func (r *Repository) UpdateStatus(ctx context.Context, pr *entity.PullRequest) error {
+	query := `
+		UPDATE pull_requests
+		SET status = $2, merged_at = $3, merge_forced = $4
+		WHERE pull_request_id = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, pr.ID(), string(pr.Status()), pr.MergedAt(), pr.MergeForced())
+	if err != nil {
+		return fmt.Errorf("failed to update pull request status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pull request not found: %s", pr.ID())
+	}
+
+	return nil
+}
+
+// AddReviewer добавляет одного ревьювера к PR, не трогая остальных
+func (r *Repository) AddReviewer(ctx context.Context, prID, reviewerID string, isFallback bool) error {
+	query := `
+		INSERT INTO pr_reviewers (pull_request_id, user_id, is_fallback)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, prID, reviewerID, isFallback); err != nil {
+		return fmt.Errorf("failed to add reviewer: %w", err)
 	}
 
 	return nil
 }
 
-// ReplaceReviewer заменяет одного ревьювера на другого одним запросом (оптимизация для ReassignReviewer)
-func (r *Repository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+// SaveReview сохраняет последнее ревью ревьювера
+func (r *Repository) SaveReview(ctx context.Context, prID string, review entity.Review) error {
 	query := `
 		UPDATE pr_reviewers
-		SET user_id = $3
+		SET review_state = $3, reviewed_at = $4
 		WHERE pull_request_id = $1 AND user_id = $2
 	`
 
-	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, oldReviewerID, newReviewerID)
+	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, review.ReviewerID(), string(review.State()), review.SubmittedAt())
 	if err != nil {
-		return fmt.Errorf("failed to replace reviewer: %w", err)
+		return fmt.Errorf("failed to save review: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -263,18 +384,19 @@ func (r *Repository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, n
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("reviewer not found or already replaced: pr_id=%s, old_reviewer_id=%s", prID, oldReviewerID)
+		return fmt.Errorf("reviewer not found: pr_id=%s, reviewer_id=%s", prID, review.ReviewerID())
 	}
 
 	return nil
 }
 
-func (r *Repository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM pull_requests WHERE pull_request_id = $1`
+// RemoveReviewer снимает одного ревьювера с PR
+func (r *Repository) RemoveReviewer(ctx context.Context, prID, reviewerID string) error {
+	query := `DELETE FROM pr_reviewers WHERE pull_request_id = $1 AND user_id = $2`
 
-	result, err := r.getDB(ctx).ExecContext(ctx, query, id)
+	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, reviewerID)
 	if err != nil {
-		return fmt.Errorf("failed to delete pull request: %w", err)
+		return fmt.Errorf("failed to remove reviewer: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -283,36 +405,147 @@ func (r *Repository) Delete(ctx context.Context, id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("pull request not found: %s", id)
+		return fmt.Errorf("reviewer not found or already removed: pr_id=%s, reviewer_id=%s", prID, reviewerID)
 	}
 
 	return nil
 }
 
-// MergePR атомарно меняет статус PR на MERGED
-func (r *Repository) MergePR(ctx context.Context, prID string) error {
+// ReplaceReviewer заменяет одного ревьювера на другого одним запросом (оптимизация для ReassignReviewer).
+// Ревью старого ревьювера сбрасывается, новый начинает с PENDING
+func (r *Repository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, isFallback bool) error {
 	query := `
-		WITH check_status AS (
-			SELECT pull_request_id
-			FROM pull_requests
-			WHERE pull_request_id = $1 AND status = $2
-		)
-		UPDATE pull_requests
-		SET 
-			status = $3,
-			merged_at = NOW()
-		FROM check_status
-		WHERE pull_requests.pull_request_id = check_status.pull_request_id
-		RETURNING pull_requests.pull_request_id
+		UPDATE pr_reviewers
+		SET user_id = $3, is_fallback = $4, review_state = $5, reviewed_at = NULL
+		WHERE pull_request_id = $1 AND user_id = $2
 	`
 
-	var returnedID string
-	err := r.getDB(ctx).QueryRowContext(ctx, query, prID, string(entity.PRStatusOpen), string(entity.PRStatusMerged)).Scan(&returnedID)
+	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, oldReviewerID, newReviewerID, isFallback, string(entity.ReviewStatePending))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return repository.ErrNotFound
+		return fmt.Errorf("failed to replace reviewer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("reviewer not found or already replaced: pr_id=%s, old_reviewer_id=%s", prID, oldReviewerID)
+	}
+
+	return nil
+}
+
+// ReassignReviewers применяет пачку переносов слотов ревьюверов:
+// замены выполняются одним UPDATE ... FROM (VALUES ...), освобождаемые слоты - одним DELETE
+func (r *Repository) ReassignReviewers(ctx context.Context, changes []repository.ReviewerChange) error {
+	var replacements, removals []repository.ReviewerChange
+	for _, change := range changes {
+		if change.NewReviewerID == "" {
+			removals = append(removals, change)
+		} else {
+			replacements = append(replacements, change)
+		}
+	}
+
+	for start := 0; start < len(replacements); start += reviewerChangesBatchSize {
+		end := min(start+reviewerChangesBatchSize, len(replacements))
+		if err := r.replaceReviewersBatch(ctx, replacements[start:end]); err != nil {
+			return err
 		}
-		return fmt.Errorf("failed to merge pull request: %w", err)
+	}
+
+	for start := 0; start < len(removals); start += reviewerChangesBatchSize {
+		end := min(start+reviewerChangesBatchSize, len(removals))
+		if err := r.removeReviewersBatch(ctx, removals[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) replaceReviewersBatch(ctx context.Context, changes []repository.ReviewerChange) error {
+	valueStrings := make([]string, 0, len(changes))
+	valueArgs := make([]interface{}, 0, len(changes)*reviewerChangeParamsCount+1)
+	valueArgs = append(valueArgs, string(entity.ReviewStatePending))
+	for i, change := range changes {
+		paramOffset := i*reviewerChangeParamsCount + 1
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d::boolean)", paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4))
+		valueArgs = append(valueArgs, change.PullRequestID, change.OldReviewerID, change.NewReviewerID, change.IsFallback)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE pr_reviewers AS prr
+		SET user_id = v.new_user_id, is_fallback = v.is_fallback, review_state = $1, reviewed_at = NULL
+		FROM (VALUES %s) AS v(pull_request_id, old_user_id, new_user_id, is_fallback)
+		WHERE prr.pull_request_id = v.pull_request_id AND prr.user_id = v.old_user_id
+	`, strings.Join(valueStrings, ","))
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to reassign reviewers: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected != int64(len(changes)) {
+		return fmt.Errorf("reviewers not found or already replaced: expected %d, replaced %d", len(changes), rowsAffected)
+	}
+
+	return nil
+}
+
+func (r *Repository) removeReviewersBatch(ctx context.Context, changes []repository.ReviewerChange) error {
+	valueStrings := make([]string, 0, len(changes))
+	valueArgs := make([]interface{}, 0, len(changes)*reviewerParamsCount)
+	for i, change := range changes {
+		paramOffset := i * reviewerParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d)", paramOffset+1, paramOffset+2))
+		valueArgs = append(valueArgs, change.PullRequestID, change.OldReviewerID)
+	}
+
+	query := fmt.Sprintf(`
+		DELETE FROM pr_reviewers
+		WHERE (pull_request_id, user_id) IN (%s)
+	`, strings.Join(valueStrings, ","))
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to remove reviewers: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected != int64(len(changes)) {
+		return fmt.Errorf("reviewers not found or already removed: expected %d, removed %d", len(changes), rowsAffected)
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM pull_requests WHERE pull_request_id = $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete pull request: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pull request not found: %s", id)
 	}
 
 	return nil
@@ -330,9 +563,9 @@ func (r *Repository) Exists(ctx context.Context, id string) (bool, error) {
 	return exists, nil
 }
 
-func (r *Repository) findReviewersByPRID(ctx context.Context, prID string) ([]string, error) {
+func (r *Repository) findReviewersByPRID(ctx context.Context, prID string) ([]ReviewerModel, error) {
 	query := `
-		SELECT user_id
+		SELECT user_id, is_fallback, review_state, reviewed_at
 		FROM pr_reviewers
 		WHERE pull_request_id = $1
 		ORDER BY assigned_at
@@ -347,13 +580,13 @@ func (r *Repository) findReviewersByPRID(ctx context.Context, prID string) ([]st
 		_ = rows.Close()
 	}(rows)
 
-	var reviewers []string
+	var reviewers []ReviewerModel
 	for rows.Next() {
-		var userID string
-		if err := rows.Scan(&userID); err != nil {
+		var reviewer ReviewerModel
+		if err := rows.Scan(&reviewer.UserID, &reviewer.IsFallback, &reviewer.ReviewState, &reviewer.ReviewedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
 		}
-		reviewers = append(reviewers, userID)
+		reviewers = append(reviewers, reviewer)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -363,21 +596,72 @@ func (r *Repository) findReviewersByPRID(ctx context.Context, prID string) ([]st
 	return reviewers, nil
 }
 
-func (r *Repository) insertReviewers(ctx context.Context, prID string, reviewers []string) error {
+// findReviewersByPRIDs загружает ревьюверов сразу для нескольких PR
+func (r *Repository) findReviewersByPRIDs(ctx context.Context, prIDs []string) (map[string][]ReviewerModel, error) {
+	result := make(map[string][]ReviewerModel, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	placeholders := make([]string, len(prIDs))
+	args := make([]interface{}, len(prIDs))
+	for i, prID := range prIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = prID
+	}
+
+	query := fmt.Sprintf(`
+		SELECT pull_request_id, user_id, is_fallback, review_state, reviewed_at
+		FROM pr_reviewers
+		WHERE pull_request_id IN (%s)
+		ORDER BY pull_request_id, assigned_at
+	`, strings.Join(placeholders, ","))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var prID string
+		var reviewer ReviewerModel
+		if err := rows.Scan(&prID, &reviewer.UserID, &reviewer.IsFallback, &reviewer.ReviewState, &reviewer.ReviewedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		result[prID] = append(result[prID], reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *Repository) insertReviewers(ctx context.Context, pr *entity.PullRequest) error {
+	reviewers := pr.AssignedReviewers()
 	if len(reviewers) == 0 {
 		return nil
 	}
 
 	valueStrings := make([]string, 0, len(reviewers))
-	valueArgs := make([]interface{}, 0, len(reviewers)*reviewerParamsCount)
+	valueArgs := make([]interface{}, 0, len(reviewers)*reviewerInsertParamsCount)
 	for i, reviewer := range reviewers {
-		paramOffset := i * reviewerParamsCount
-		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d)", paramOffset+1, paramOffset+2))
-		valueArgs = append(valueArgs, prID, reviewer)
+		paramOffset := i * reviewerInsertParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d, $%d)",
+			paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5,
+		))
+		review := pr.ReviewOf(reviewer)
+		valueArgs = append(valueArgs, pr.ID(), reviewer, pr.IsFallbackReviewer(reviewer), string(review.State()), review.SubmittedAt())
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO pr_reviewers (pull_request_id, user_id)
+		INSERT INTO pr_reviewers (pull_request_id, user_id, is_fallback, review_state, reviewed_at)
 		VALUES %s
 	`, strings.Join(valueStrings, ","))
 
@@ -400,6 +684,8 @@ func (r *Repository) deleteReviewers(ctx context.Context, prID string) error {
 	return nil
 }
 
+// CountActiveReviewsByUserIDs возвращает текущую загрузку пользователей - число открытых PR на ревью.
+// Черновики и закрытые PR в загрузку не входят
 func (r *Repository) CountActiveReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
 	if len(userIDs) == 0 {
 		return make(map[string]int), nil
@@ -451,71 +737,30 @@ func (r *Repository) CountActiveReviewsByUserIDs(ctx context.Context, userIDs []
 	return result, nil
 }
 
-// GetStats возвращает статистику по PR (общее количество, открытые, смерженные)
-func (r *Repository) GetStats(ctx context.Context) (total, open, merged int, err error) {
+// GetStats возвращает количество PR по статусам
+func (r *Repository) GetStats(ctx context.Context) (repository.PRStats, error) {
 	query := `
 		SELECT 
 			COUNT(*) as total,
-			COUNT(*) FILTER (WHERE status = $1) as open,
-			COUNT(*) FILTER (WHERE status = $2) as merged
+			COUNT(*) FILTER (WHERE status = $1) as draft,
+			COUNT(*) FILTER (WHERE status = $2) as open,
+			COUNT(*) FILTER (WHERE status = $3) as merged,
+			COUNT(*) FILTER (WHERE status = $4) as closed
 		FROM pull_requests
 	`
 
-	err = r.getDB(ctx).QueryRowContext(ctx, query, string(entity.PRStatusOpen), string(entity.PRStatusMerged)).Scan(&total, &open, &merged)
-	if err != nil {
-		return 0, 0, 0, fmt.Errorf("failed to get PR stats: %w", err)
-	}
-
-	return total, open, merged, nil
-}
-
-// CountReviewsByUserIDs возвращает общее количество назначений для каждого пользователя
-// (включая как открытые, так и смерженные PR)
-func (r *Repository) CountReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
-	if len(userIDs) == 0 {
-		return make(map[string]int), nil
-	}
-
-	placeholders := make([]string, len(userIDs))
-	args := make([]interface{}, len(userIDs))
-	for i, userID := range userIDs {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = userID
-	}
-
-	query := fmt.Sprintf(`
-		SELECT pr.user_id, COUNT(DISTINCT pr.pull_request_id) as review_count
-		FROM pr_reviewers pr
-		WHERE pr.user_id IN (%s)
-		GROUP BY pr.user_id
-	`, strings.Join(placeholders, ","))
-
-	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	var stats repository.PRStats
+	err := r.getDB(ctx).QueryRowContext(
+		ctx,
+		query,
+		string(entity.PRStatusDraft),
+		string(entity.PRStatusOpen),
+		string(entity.PRStatusMerged),
+		string(entity.PRStatusClosed),
+	).Scan(&stats.Total, &stats.Draft, &stats.Open, &stats.Merged, &stats.Closed)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query reviews count: %w", err)
-	}
-	//nolint:gosec
-	defer func(rows *sql.Rows) {
-		_ = rows.Close()
-	}(rows)
-
-	result := make(map[string]int)
-	for _, userID := range userIDs {
-		result[userID] = 0
+		return repository.PRStats{}, fmt.Errorf("failed to get PR stats: %w", err)
 	}
 
-	for rows.Next() {
-		var userID string
-		var count int
-		if err := rows.Scan(&userID, &count); err != nil {
-			return nil, fmt.Errorf("failed to scan review count: %w", err)
-		}
-		result[userID] = count
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating rows: %w", err)
-	}
-
-	return result, nil
+	return stats, nil
 }
@@ -0,0 +1,79 @@
+package reviewer_cursor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.ReviewerCursorRepository = (*Repository)(nil)
+
+type Repository struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func NewRepository(db *sql.DB, getter *trmsql.CtxGetter) *Repository {
+	return &Repository{
+		db:     db,
+		getter: getter,
+	}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (r *Repository) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+// LockCursor возвращает курсор команды с блокировкой строки (SELECT FOR UPDATE).
+// Строка курсора создается при первом обращении, чтобы блокировка работала и для новых команд
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *Repository) LockCursor(ctx context.Context, teamName string) (string, error) {
+	insertQuery := `
+		INSERT INTO team_reviewer_cursors (team_name)
+		VALUES ($1)
+		ON CONFLICT (team_name) DO NOTHING
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, insertQuery, teamName); err != nil {
+		return "", fmt.Errorf("failed to init reviewer cursor: %w", err)
+	}
+
+	selectQuery := `
+		SELECT last_user_id
+		FROM team_reviewer_cursors
+		WHERE team_name = $1
+		FOR UPDATE
+	`
+
+	var lastUserID string
+	if err := r.getDB(ctx).QueryRowContext(ctx, selectQuery, teamName).Scan(&lastUserID); err != nil {
+		return "", fmt.Errorf("failed to lock reviewer cursor: %w", err)
+	}
+
+	return lastUserID, nil
+}
+
+// SaveCursor сохраняет последнего назначенного ревьювера команды
+func (r *Repository) SaveCursor(ctx context.Context, teamName, lastUserID string) error {
+	query := `
+		INSERT INTO team_reviewer_cursors (team_name, last_user_id, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (team_name) DO UPDATE
+		SET last_user_id = EXCLUDED.last_user_id, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, teamName, lastUserID); err != nil {
+		return fmt.Errorf("failed to save reviewer cursor: %w", err)
+	}
+
+	return nil
+}
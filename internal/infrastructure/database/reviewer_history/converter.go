@@ -0,0 +1,23 @@
+package reviewer_history
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model) *entity.ReviewerHistoryEntry {
+	return entity.NewReviewerHistoryEntryFromRepository(
+		m.PullRequestID,
+		m.UserID,
+		entity.ReviewerHistoryAction(m.Action),
+		entity.ReviewerHistoryReason(m.Reason),
+		m.OccurredAt,
+	)
+}
+
+func FromEntity(entry *entity.ReviewerHistoryEntry) *Model {
+	return &Model{
+		PullRequestID: entry.PullRequestID(),
+		UserID:        entry.UserID(),
+		Action:        string(entry.Action()),
+		Reason:        string(entry.Reason()),
+		OccurredAt:    entry.OccurredAt(),
+	}
+}
@@ -0,0 +1,11 @@
+package reviewer_history
+
+import "time"
+
+type Model struct {
+	PullRequestID string    `db:"pull_request_id"`
+	UserID        string    `db:"user_id"`
+	Action        string    `db:"action"`
+	Reason        string    `db:"reason"`
+	OccurredAt    time.Time `db:"occurred_at"`
+}
@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	apiTokenRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/api_token"
+)
+
+var _ repository.APITokenRepository = (*APITokenRepository)(nil)
+
+const tokenColumns = `token_id, subject, role, token_hash, created_at, revoked_at`
+
+type APITokenRepository struct {
+	querier
+}
+
+func NewAPITokenRepository(db *SQLiteDB) *APITokenRepository {
+	return &APITokenRepository{querier: newQuerier(db)}
+}
+
+func (r *APITokenRepository) Create(ctx context.Context, token *entity.APIToken) error {
+	model := apiTokenRepo.FromEntity(token)
+
+	query := `
+		INSERT INTO api_tokens (token_id, subject, role, token_hash, created_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.Subject,
+		model.Role,
+		model.TokenHash,
+		model.CreatedAt,
+		model.RevokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create api token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *APITokenRepository) FindByID(ctx context.Context, id string) (*entity.APIToken, error) {
+	query := `SELECT ` + tokenColumns + ` FROM api_tokens WHERE token_id = $1`
+
+	return r.findOne(ctx, query, id)
+}
+
+func (r *APITokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entity.APIToken, error) {
+	query := `SELECT ` + tokenColumns + ` FROM api_tokens WHERE token_hash = $1`
+
+	return r.findOne(ctx, query, tokenHash)
+}
+
+func (r *APITokenRepository) FindAll(ctx context.Context) ([]*entity.APIToken, error) {
+	query := `SELECT ` + tokenColumns + ` FROM api_tokens ORDER BY created_at, token_id`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api tokens: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var tokens []*entity.APIToken
+	for rows.Next() {
+		var model apiTokenRepo.Model
+		if err := rows.Scan(
+			&model.ID,
+			&model.Subject,
+			&model.Role,
+			&model.TokenHash,
+			&model.CreatedAt,
+			&model.RevokedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan api token: %w", err)
+		}
+		tokens = append(tokens, apiTokenRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *APITokenRepository) Update(ctx context.Context, token *entity.APIToken) error {
+	model := apiTokenRepo.FromEntity(token)
+
+	query := `
+		UPDATE api_tokens
+		SET subject = $2, role = $3, revoked_at = $4
+		WHERE token_id = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, model.ID, model.Subject, model.Role, model.RevokedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update api token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *APITokenRepository) findOne(ctx context.Context, query string, arg string) (*entity.APIToken, error) {
+	var model apiTokenRepo.Model
+	err := r.getDB(ctx).QueryRowContext(ctx, query, arg).Scan(
+		&model.ID,
+		&model.Subject,
+		&model.Role,
+		&model.TokenHash,
+		&model.CreatedAt,
+		&model.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find api token: %w", err)
+	}
+
+	return apiTokenRepo.ToEntity(&model), nil
+}
@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	auditRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/audit"
+)
+
+var _ repository.AuditRepository = (*AuditRepository)(nil)
+
+const auditEntryParamsCount = 10
+
+const auditEntryColumns = `seq, entry_id, action, entity_type, entity_id, actor, request_id, before_state, after_state, reason, occurred_at`
+
+type AuditRepository struct {
+	querier
+}
+
+func NewAuditRepository(db *SQLiteDB) *AuditRepository {
+	return &AuditRepository{querier: newQuerier(db)}
+}
+
+func (r *AuditRepository) Add(ctx context.Context, entries []*entity.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(entries))
+	valueArgs := make([]interface{}, 0, len(entries)*auditEntryParamsCount)
+	for i, entry := range entries {
+		model := auditRepo.FromEntity(entry)
+		paramOffset := i * auditEntryParamsCount
+		placeholders := make([]string, auditEntryParamsCount)
+		for j := range placeholders {
+			placeholders[j] = fmt.Sprintf("$%d", paramOffset+j+1)
+		}
+		valueStrings = append(valueStrings, "("+strings.Join(placeholders, ", ")+")")
+		valueArgs = append(valueArgs,
+			model.ID,
+			model.Action,
+			model.EntityType,
+			model.EntityID,
+			model.Actor,
+			model.RequestID,
+			jsonOrNull(model.Before),
+			jsonOrNull(model.After),
+			model.Reason,
+			model.OccurredAt,
+		)
+	}
+
+	// seq назначается в порядке VALUES, поэтому записи одной операции читаются в порядке Record
+	query := fmt.Sprintf(`
+		INSERT INTO audit_log (entry_id, action, entity_type, entity_id, actor, request_id, before_state, after_state, reason, occurred_at)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to add audit entries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuditRepository) Find(ctx context.Context, filter repository.AuditFilter) ([]*entity.AuditEntry, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.EntityType != "" {
+		addCondition("entity_type = $%d", string(filter.EntityType))
+	}
+	if filter.EntityID != "" {
+		addCondition("entity_id = $%d", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.From != nil {
+		addCondition("occurred_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("occurred_at < $%d", *filter.To)
+	}
+	if filter.BeforeSeq > 0 {
+		addCondition("seq < $%d", filter.BeforeSeq)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM audit_log
+		%s
+		ORDER BY seq DESC
+		LIMIT $%d
+	`, auditEntryColumns, where, len(args))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find audit entries: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var entries []*entity.AuditEntry
+	for rows.Next() {
+		var model auditRepo.Model
+		if err := rows.Scan(
+			&model.Seq,
+			&model.ID,
+			&model.Action,
+			&model.EntityType,
+			&model.EntityID,
+			&model.Actor,
+			&model.RequestID,
+			&model.Before,
+			&model.After,
+			&model.Reason,
+			&model.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		entries = append(entries, auditRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// jsonOrNull передает снимок как текст: JSON хранится в TEXT, а []byte попал бы в колонку как BLOB
+func jsonOrNull(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
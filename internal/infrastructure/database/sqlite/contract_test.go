@@ -0,0 +1,41 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/migration"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/sqlite"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/storagetest"
+)
+
+func TestStorageContract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Backend {
+		db, err := sqlite.NewSQLiteDB(config.SQLiteConfig{
+			Path:        filepath.Join(t.TempDir(), "test.db"),
+			BusyTimeout: config.DefaultSQLiteBusyTimeout,
+		})
+		if err != nil {
+			t.Fatalf("failed to open database: %v", err)
+		}
+		t.Cleanup(func() { _ = db.Close() })
+
+		migrator, err := migration.NewSQLite(db.DB(), sqlite.Migrations())
+		if err != nil {
+			t.Fatalf("failed to create migrator: %v", err)
+		}
+		defer func() { _ = migrator.Close() }()
+		if err := migrator.Up(); err != nil {
+			t.Fatalf("failed to migrate database: %v", err)
+		}
+
+		return storagetest.Backend{
+			TxManager:    sqlite.NewTransactionManager(db),
+			Users:        sqlite.NewUserRepository(db),
+			Teams:        sqlite.NewTeamRepository(db),
+			PullRequests: sqlite.NewPullRequestRepository(db),
+			Idempotency:  sqlite.NewIdempotencyRepository(db),
+		}
+	})
+}
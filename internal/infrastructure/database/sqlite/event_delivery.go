@@ -0,0 +1,223 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	eventDeliveryRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/event_delivery"
+)
+
+var _ repository.EventDeliveryRepository = (*EventDeliveryRepository)(nil)
+
+const deliveryParamsCount = 7
+
+type EventDeliveryRepository struct {
+	querier
+}
+
+func NewEventDeliveryRepository(db *SQLiteDB) *EventDeliveryRepository {
+	return &EventDeliveryRepository{querier: newQuerier(db)}
+}
+
+func (r *EventDeliveryRepository) Enqueue(ctx context.Context, deliveries []*entity.EventDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(deliveries))
+	valueArgs := make([]interface{}, 0, len(deliveries)*deliveryParamsCount)
+	for i, delivery := range deliveries {
+		model := eventDeliveryRepo.FromEntity(delivery)
+		paramOffset := i * deliveryParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5, paramOffset+6, paramOffset+7,
+		))
+		valueArgs = append(
+			valueArgs,
+			model.ID,
+			model.SubscriptionID,
+			model.EventID,
+			model.EventType,
+			model.Payload,
+			model.NextAttemptAt,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO event_deliveries (
+			delivery_id, subscription_id, event_id, event_type, payload, next_attempt_at, created_at
+		)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to enqueue event deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDue сдвигает next_attempt_at забранных доставок на lease. Выборка и сдвиг выполняются
+// одним UPDATE под блокировкой записи базы, поэтому два процесса не заберут одну доставку,
+// а lease возвращает ее в очередь, если процесс упал, не успев записать результат
+func (r *EventDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.EventDelivery, error) {
+	query := `
+		UPDATE event_deliveries
+		SET next_attempt_at = $2
+		WHERE delivery_id IN (
+			SELECT delivery_id
+			FROM event_deliveries
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at, delivery_id
+			LIMIT $3
+		)
+		RETURNING delivery_id, subscription_id, event_id, event_type, payload,
+			attempts, next_attempt_at, last_error, created_at
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim event deliveries: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var deliveries []*entity.EventDelivery
+	for rows.Next() {
+		var model eventDeliveryRepo.Model
+		if err := rows.Scan(
+			&model.ID,
+			&model.SubscriptionID,
+			&model.EventID,
+			&model.EventType,
+			&model.Payload,
+			&model.Attempts,
+			&model.NextAttemptAt,
+			&model.LastError,
+			&model.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event delivery: %w", err)
+		}
+		deliveries = append(deliveries, eventDeliveryRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r *EventDeliveryRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM event_deliveries WHERE delivery_id = $1`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to delete event delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EventDeliveryRepository) Reschedule(ctx context.Context, delivery *entity.EventDelivery) error {
+	model := eventDeliveryRepo.FromEntity(delivery)
+
+	query := `
+		UPDATE event_deliveries
+		SET attempts = $2, next_attempt_at = $3, last_error = $4
+		WHERE delivery_id = $1
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, model.ID, model.Attempts, model.NextAttemptAt, model.LastError); err != nil {
+		return fmt.Errorf("failed to reschedule event delivery: %w", err)
+	}
+
+	return nil
+}
+
+// MoveToDeadLetter вставляет доставку в event_dead_letters и удаляет из очереди.
+// Вызывается внутри транзакции, чтобы доставка не потерялась и не задвоилась
+func (r *EventDeliveryRepository) MoveToDeadLetter(ctx context.Context, delivery *entity.EventDelivery) error {
+	model := eventDeliveryRepo.FromEntity(delivery)
+
+	query := `
+		INSERT INTO event_dead_letters (
+			delivery_id, subscription_id, event_id, event_type, payload,
+			attempts, last_error, created_at, failed_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9, $10))
+		ON CONFLICT (delivery_id) DO NOTHING
+	`
+
+	_, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.SubscriptionID,
+		model.EventID,
+		model.EventType,
+		model.Payload,
+		model.Attempts,
+		model.LastError,
+		model.CreatedAt,
+		model.FailedAt,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert dead letter: %w", err)
+	}
+
+	return r.Delete(ctx, model.ID)
+}
+
+func (r *EventDeliveryRepository) FindDeadLetters(ctx context.Context, subscriptionID string) ([]*entity.EventDelivery, error) {
+	query := `
+		SELECT delivery_id, subscription_id, event_id, event_type, payload,
+			attempts, last_error, created_at, failed_at
+		FROM event_dead_letters
+		WHERE subscription_id = $1
+		ORDER BY failed_at DESC, delivery_id
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dead letters: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var deliveries []*entity.EventDelivery
+	for rows.Next() {
+		var model eventDeliveryRepo.Model
+		if err := rows.Scan(
+			&model.ID,
+			&model.SubscriptionID,
+			&model.EventID,
+			&model.EventType,
+			&model.Payload,
+			&model.Attempts,
+			&model.LastError,
+			&model.CreatedAt,
+			&model.FailedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan dead letter: %w", err)
+		}
+		deliveries = append(deliveries, eventDeliveryRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return deliveries, nil
+}
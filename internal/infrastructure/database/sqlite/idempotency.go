@@ -0,0 +1,134 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	idempotencyRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/idempotency"
+)
+
+var _ repository.IdempotencyRepository = (*IdempotencyRepository)(nil)
+
+type IdempotencyRepository struct {
+	querier
+}
+
+func NewIdempotencyRepository(db *SQLiteDB) *IdempotencyRepository {
+	return &IdempotencyRepository{querier: newQuerier(db)}
+}
+
+// Reserve вставляет запись или заменяет истекшую. Занятый ключ не изменяется, и вставка
+// не затрагивает строк
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *entity.IdempotencyRecord) (bool, error) {
+	model := idempotencyRepo.FromEntity(record)
+
+	query := `
+		INSERT INTO idempotency_keys (scope, idempotency_key, request_hash, status_code, content_type, response_body, created_at, expires_at)
+		VALUES ($1, $2, $3, 0, '', NULL, $4, $5)
+		ON CONFLICT (scope, idempotency_key) DO UPDATE
+		SET request_hash = excluded.request_hash,
+			status_code = 0,
+			content_type = '',
+			response_body = NULL,
+			created_at = excluded.created_at,
+			expires_at = excluded.expires_at
+		WHERE idempotency_keys.expires_at <= excluded.created_at
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, model.Scope, model.Key, model.RequestHash, model.CreatedAt, model.ExpiresAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *IdempotencyRepository) Find(ctx context.Context, scope, key string) (*entity.IdempotencyRecord, error) {
+	query := `
+		SELECT scope, idempotency_key, request_hash, status_code, content_type, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE scope = $1 AND idempotency_key = $2
+	`
+
+	var model idempotencyRepo.Model
+	err := r.getDB(ctx).QueryRowContext(ctx, query, scope, key).Scan(
+		&model.Scope,
+		&model.Key,
+		&model.RequestHash,
+		&model.StatusCode,
+		&model.ContentType,
+		&model.ResponseBody,
+		&model.CreatedAt,
+		&model.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	return idempotencyRepo.ToEntity(&model), nil
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, record *entity.IdempotencyRecord) error {
+	model := idempotencyRepo.FromEntity(record)
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, response_body = $5, expires_at = $6
+		WHERE scope = $1 AND idempotency_key = $2 AND status_code = 0
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query,
+		model.Scope, model.Key, model.StatusCode, model.ContentType, model.ResponseBody, model.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, scope, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, scope, key); err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
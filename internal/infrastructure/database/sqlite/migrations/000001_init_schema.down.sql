@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS pr_reviewer_history;
+DROP TABLE IF EXISTS audit_log;
+DROP TABLE IF EXISTS api_tokens;
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS event_dead_letters;
+DROP TABLE IF EXISTS event_deliveries;
+DROP TABLE IF EXISTS event_subscription_types;
+DROP TABLE IF EXISTS event_subscriptions;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS git_user_logins;
+DROP TABLE IF EXISTS team_code_owners;
+DROP TABLE IF EXISTS team_fallback_teams;
+DROP TABLE IF EXISTS team_reviewer_cursors;
+DROP TABLE IF EXISTS pr_reviewers;
+DROP TABLE IF EXISTS pull_requests;
+DROP TABLE IF EXISTS pr_statuses;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS teams;
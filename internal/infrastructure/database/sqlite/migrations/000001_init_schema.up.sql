@@ -0,0 +1,245 @@
+-- Схема SQLite повторяет итоговую схему миграций PostgreSQL (migrations/).
+-- Время хранится в наносекундах Unix (INTEGER) в колонках с типом TIMESTAMP: по объявленному типу
+-- драйвер читает их как time.Time. Значение по умолчанию - текущее время с долями секунды
+
+CREATE TABLE teams (
+    team_name TEXT PRIMARY KEY,
+    reviewer_strategy TEXT NOT NULL DEFAULT '',
+    min_reviewers INTEGER NOT NULL DEFAULT 0,
+    max_reviewers INTEGER NOT NULL DEFAULT 2,
+    required_approvals INTEGER NOT NULL DEFAULT 0,
+    block_on_changes_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    CONSTRAINT chk_teams_reviewer_strategy
+        CHECK (reviewer_strategy IN ('', 'least_loaded', 'random', 'weighted_random', 'round_robin')),
+    CONSTRAINT chk_teams_reviewer_limits
+        CHECK (min_reviewers >= 0 AND max_reviewers BETWEEN 1 AND 10 AND min_reviewers <= max_reviewers),
+    CONSTRAINT chk_teams_required_approvals CHECK (required_approvals BETWEEN 0 AND 10)
+);
+
+CREATE TABLE users (
+    user_id TEXT PRIMARY KEY,
+    username TEXT NOT NULL,
+    team_name TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    CONSTRAINT fk_users_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE RESTRICT ON UPDATE CASCADE
+);
+
+CREATE INDEX idx_users_team_name ON users(team_name);
+CREATE INDEX idx_users_team_active ON users(team_name) WHERE is_active = TRUE;
+
+CREATE TABLE pr_statuses (
+    status TEXT PRIMARY KEY
+);
+
+INSERT INTO pr_statuses (status) VALUES ('OPEN'), ('MERGED'), ('DRAFT'), ('CLOSED');
+
+CREATE TABLE pull_requests (
+    pull_request_id TEXT PRIMARY KEY,
+    pull_request_name TEXT NOT NULL,
+    author_id TEXT NOT NULL,
+    team_name TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'OPEN',
+    min_reviewers INTEGER NOT NULL DEFAULT 0,
+    max_reviewers INTEGER NOT NULL DEFAULT 2,
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    merged_at TIMESTAMP,
+    merge_forced BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_pr_author FOREIGN KEY (author_id) REFERENCES users(user_id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    CONSTRAINT fk_pr_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE RESTRICT ON UPDATE CASCADE,
+    CONSTRAINT fk_pr_status FOREIGN KEY (status) REFERENCES pr_statuses(status) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
+
+CREATE INDEX idx_pr_author ON pull_requests(author_id);
+CREATE INDEX idx_pr_created_at ON pull_requests(created_at DESC);
+CREATE INDEX idx_pr_status ON pull_requests(status);
+CREATE INDEX idx_pr_team_name ON pull_requests(team_name);
+
+-- Порядок назначения ревьюверов - порядок вставки (rowid): assigned_at одинаков у ревьюверов,
+-- назначенных одним запросом
+CREATE TABLE pr_reviewers (
+    pull_request_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    assigned_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    is_fallback BOOLEAN NOT NULL DEFAULT FALSE,
+    review_state TEXT NOT NULL DEFAULT 'PENDING',
+    reviewed_at TIMESTAMP,
+    PRIMARY KEY (pull_request_id, user_id),
+    CONSTRAINT fk_pr_reviewers_pr FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_pr_reviewers_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    CONSTRAINT chk_pr_reviewers_review_state
+        CHECK (review_state IN ('PENDING', 'APPROVED', 'CHANGES_REQUESTED', 'COMMENTED'))
+);
+
+CREATE INDEX idx_pr_reviewers_user ON pr_reviewers(user_id);
+
+CREATE TABLE team_reviewer_cursors (
+    team_name TEXT PRIMARY KEY,
+    last_user_id TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    CONSTRAINT fk_team_reviewer_cursors_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE team_fallback_teams (
+    team_name TEXT NOT NULL,
+    fallback_team_name TEXT NOT NULL,
+    priority INTEGER NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CONSTRAINT uq_team_fallback_priority UNIQUE (team_name, priority),
+    CONSTRAINT chk_team_fallback_not_self CHECK (team_name <> fallback_team_name),
+    CONSTRAINT fk_team_fallback_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_team_fallback_fallback FOREIGN KEY (fallback_team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE team_code_owners (
+    team_name TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (team_name, user_id),
+    CONSTRAINT fk_team_code_owners_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_team_code_owners_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE git_user_logins (
+    provider TEXT NOT NULL,
+    login TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    PRIMARY KEY (provider, login),
+    CONSTRAINT fk_git_user_logins_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT chk_git_user_logins_provider CHECK (provider IN ('github', 'gitlab'))
+);
+
+CREATE INDEX idx_git_user_logins_user_id ON git_user_logins(user_id);
+
+CREATE TABLE webhook_deliveries (
+    provider TEXT NOT NULL,
+    delivery_id TEXT NOT NULL,
+    received_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    PRIMARY KEY (provider, delivery_id)
+);
+
+CREATE TABLE event_subscriptions (
+    subscription_id TEXT PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER))
+);
+
+CREATE TABLE event_subscription_types (
+    subscription_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (subscription_id, event_type),
+    CONSTRAINT fk_event_subscription_types_subscription FOREIGN KEY (subscription_id) REFERENCES event_subscriptions(subscription_id) ON DELETE CASCADE,
+    CONSTRAINT chk_event_subscription_types_event_type CHECK (event_type IN ('reviewer.assigned', 'reviewer.reassigned', 'pr.merged', 'user.deactivated'))
+);
+
+CREATE INDEX idx_event_subscription_types_event_type ON event_subscription_types(event_type);
+
+CREATE TABLE event_deliveries (
+    delivery_id TEXT PRIMARY KEY,
+    subscription_id TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload BLOB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    CONSTRAINT fk_event_deliveries_subscription FOREIGN KEY (subscription_id) REFERENCES event_subscriptions(subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_event_deliveries_next_attempt_at ON event_deliveries(next_attempt_at);
+
+CREATE TABLE event_dead_letters (
+    delivery_id TEXT PRIMARY KEY,
+    subscription_id TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload BLOB NOT NULL,
+    attempts INTEGER NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    failed_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    CONSTRAINT fk_event_dead_letters_subscription FOREIGN KEY (subscription_id) REFERENCES event_subscriptions(subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_event_dead_letters_subscription_id ON event_dead_letters(subscription_id, failed_at DESC);
+
+-- seq задает порядок публикации: AUTOINCREMENT не выдает номера повторно
+CREATE TABLE outbox (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    outbox_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload BLOB NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP,
+    CONSTRAINT uq_outbox_outbox_id UNIQUE (outbox_id)
+);
+
+CREATE INDEX idx_outbox_pending ON outbox(seq) WHERE sent_at IS NULL;
+
+CREATE TABLE api_tokens (
+    token_id TEXT PRIMARY KEY,
+    subject TEXT NOT NULL,
+    role TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    revoked_at TIMESTAMP,
+    CONSTRAINT uq_api_tokens_token_hash UNIQUE (token_hash),
+    CONSTRAINT chk_api_tokens_role CHECK (role IN ('admin', 'team-lead', 'member', 'service'))
+);
+
+-- Журнал аудита только дополняется: UPDATE и DELETE запрещены триггерами
+CREATE TABLE audit_log (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    entry_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    request_id TEXT NOT NULL DEFAULT '',
+    before_state TEXT,
+    after_state TEXT,
+    reason TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP NOT NULL,
+    CONSTRAINT uq_audit_log_entry_id UNIQUE (entry_id),
+    CONSTRAINT chk_audit_log_entity_type CHECK (entity_type IN ('team', 'user', 'pull_request'))
+);
+
+CREATE INDEX idx_audit_log_entity ON audit_log(entity_type, entity_id, seq DESC);
+CREATE INDEX idx_audit_log_actor ON audit_log(actor, seq DESC);
+CREATE INDEX idx_audit_log_occurred_at ON audit_log(occurred_at);
+
+CREATE TRIGGER trg_audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TRIGGER trg_audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TABLE pr_reviewer_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pull_request_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    occurred_at TIMESTAMP NOT NULL DEFAULT (CAST(unixepoch('subsec') * 1000000000 AS INTEGER)),
+    CONSTRAINT fk_pr_reviewer_history_pr FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_pr_reviewer_history_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    CONSTRAINT chk_pr_reviewer_history_action CHECK (action IN ('assigned', 'unassigned')),
+    CONSTRAINT chk_pr_reviewer_history_reason CHECK (reason IN ('auto', 'reassign', 'manual', 'deactivation'))
+);
+
+CREATE INDEX idx_pr_reviewer_history_pr ON pr_reviewer_history(pull_request_id, id);
+CREATE INDEX idx_pr_reviewer_history_user ON pr_reviewer_history(user_id) WHERE action = 'assigned';
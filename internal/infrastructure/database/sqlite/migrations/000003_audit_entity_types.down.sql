@@ -0,0 +1,35 @@
+-- Записи новых типов сущностей не переносятся: прежнее ограничение их не допускает
+CREATE TABLE audit_log_old (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    entry_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    request_id TEXT NOT NULL DEFAULT '',
+    before_state TEXT,
+    after_state TEXT,
+    reason TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP NOT NULL,
+    CONSTRAINT uq_audit_log_entry_id UNIQUE (entry_id),
+    CONSTRAINT chk_audit_log_entity_type CHECK (entity_type IN ('team', 'user', 'pull_request'))
+);
+
+INSERT INTO audit_log_old SELECT * FROM audit_log WHERE entity_type IN ('team', 'user', 'pull_request');
+
+DROP TABLE audit_log;
+ALTER TABLE audit_log_old RENAME TO audit_log;
+
+CREATE INDEX idx_audit_log_entity ON audit_log(entity_type, entity_id, seq DESC);
+CREATE INDEX idx_audit_log_actor ON audit_log(actor, seq DESC);
+CREATE INDEX idx_audit_log_occurred_at ON audit_log(occurred_at);
+
+CREATE TRIGGER trg_audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TRIGGER trg_audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	outboxRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/outbox"
+)
+
+var _ repository.OutboxRepository = (*OutboxRepository)(nil)
+
+const messageParamsCount = 4
+
+type OutboxRepository struct {
+	querier
+}
+
+func NewOutboxRepository(db *SQLiteDB) *OutboxRepository {
+	return &OutboxRepository{querier: newQuerier(db)}
+}
+
+func (r *OutboxRepository) Add(ctx context.Context, messages []*entity.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(messages))
+	valueArgs := make([]interface{}, 0, len(messages)*messageParamsCount)
+	for i, message := range messages {
+		model := outboxRepo.FromEntity(message)
+		paramOffset := i * messageParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d)",
+			paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4,
+		))
+		valueArgs = append(valueArgs, model.ID, model.EventType, model.Payload, model.OccurredAt)
+	}
+
+	// seq назначается в порядке VALUES, поэтому события одной транзакции публикуются в порядке Emit
+	query := fmt.Sprintf(`
+		INSERT INTO outbox (outbox_id, event_type, payload, occurred_at)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to add outbox messages: %w", err)
+	}
+
+	return nil
+}
+
+// FindPendingForUpdate выбирает неопубликованные сообщения. Вместо блокировки строк
+// (FOR UPDATE SKIP LOCKED) транзакция держит блокировку записи базы, поэтому relay
+// другого процесса ждет ее коммита и не публикует сообщения повторно
+func (r *OutboxRepository) FindPendingForUpdate(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	query := `
+		SELECT outbox_id, event_type, payload, occurred_at, sent_at
+		FROM outbox
+		WHERE sent_at IS NULL
+		ORDER BY seq
+		LIMIT $1
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending outbox messages: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var messages []*entity.OutboxMessage
+	for rows.Next() {
+		var model outboxRepo.Model
+		if err := rows.Scan(
+			&model.ID,
+			&model.EventType,
+			&model.Payload,
+			&model.OccurredAt,
+			&model.SentAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		messages = append(messages, outboxRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkSent(ctx context.Context, ids []string, sentAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, sentAt)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE outbox
+		SET sent_at = $1
+		WHERE outbox_id IN (%s)
+	`, paramList(2, len(ids)))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to mark outbox messages sent: %w", err)
+	}
+
+	return nil
+}
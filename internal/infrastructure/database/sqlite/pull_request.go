@@ -0,0 +1,753 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	prRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/pull_request"
+)
+
+var _ repository.PullRequestRepository = (*PullRequestRepository)(nil)
+
+const (
+	reviewerParamsCount       = 2
+	reviewerInsertParamsCount = 5
+	reviewerChangeParamsCount = 4
+	reviewerChangesBatchSize  = 500
+)
+
+type PullRequestRepository struct {
+	querier
+}
+
+func NewPullRequestRepository(db *SQLiteDB) *PullRequestRepository {
+	return &PullRequestRepository{querier: newQuerier(db)}
+}
+
+// pullRequestColumns колонки PR (алиас pr) в порядке, который ожидает scanPullRequest
+const pullRequestColumns = `pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.team_name, pr.status,
+		pr.min_reviewers, pr.max_reviewers, pr.created_at, pr.merged_at, pr.merge_forced`
+
+// scanPullRequest читает строку, выбранную по pullRequestColumns
+func scanPullRequest(row interface {
+	Scan(dest ...interface{}) error
+}) (prRepo.Model, error) {
+	var model prRepo.Model
+	err := row.Scan(
+		&model.ID,
+		&model.Name,
+		&model.AuthorID,
+		&model.TeamName,
+		&model.Status,
+		&model.MinReviewers,
+		&model.MaxReviewers,
+		&model.CreatedAt,
+		&model.MergedAt,
+		&model.MergeForced,
+	)
+	return model, err
+}
+
+func (r *PullRequestRepository) Create(ctx context.Context, pr *entity.PullRequest) error {
+	model := prRepo.FromEntity(pr)
+
+	query := `
+		INSERT INTO pull_requests (
+			pull_request_id, pull_request_name, author_id, team_name, status,
+			min_reviewers, max_reviewers, created_at, merged_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.Name,
+		model.AuthorID,
+		model.TeamName,
+		model.Status,
+		model.MinReviewers,
+		model.MaxReviewers,
+		model.CreatedAt,
+		model.MergedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	if err := r.insertReviewers(ctx, pr); err != nil {
+		return fmt.Errorf("failed to insert reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PullRequestRepository) FindByID(ctx context.Context, id string) (*entity.PullRequest, error) {
+	query := `
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		WHERE pr.pull_request_id = $1
+	`
+
+	model, err := scanPullRequest(r.getDB(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	reviewers, err := r.findReviewersByPRID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+
+	return prRepo.ToEntity(&model, reviewers), nil
+}
+
+// FindByIDForUpdate находит PR для изменения. SQLite не блокирует строки: транзакция
+// BEGIN IMMEDIATE уже держит блокировку записи базы, и PR не изменится до ее конца
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *PullRequestRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.PullRequest, error) {
+	query := `
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		WHERE pr.pull_request_id = $1
+	`
+
+	model, err := scanPullRequest(r.getDB(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find pull request for update: %w", err)
+	}
+
+	reviewers, err := r.findReviewersByPRID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+
+	return prRepo.ToEntity(&model, reviewers), nil
+}
+
+func (r *PullRequestRepository) FindByReviewerID(ctx context.Context, reviewerID string) ([]*entity.PullRequest, error) {
+	query := `
+		SELECT DISTINCT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		INNER JOIN pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id
+		WHERE prr.user_id = $1
+		ORDER BY pr.created_at DESC
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, reviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull requests by reviewer: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	return r.scanPullRequestsFromRows(ctx, rows)
+}
+
+func (r *PullRequestRepository) FindByAuthorID(ctx context.Context, authorID string) ([]*entity.PullRequest, error) {
+	query := `
+		SELECT ` + pullRequestColumns + `
+		FROM pull_requests pr
+		WHERE pr.author_id = $1
+		ORDER BY pr.created_at DESC
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull requests by author: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	return r.scanPullRequestsFromRows(ctx, rows)
+}
+
+// FindOpenByReviewerIDsForUpdate находит открытые PR, где ревьювером назначен кто-то из reviewerIDs,
+// для изменения (см. FindByIDForUpdate). Ревьюверы подгружаются одним запросом для всех PR
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *PullRequestRepository) FindOpenByReviewerIDsForUpdate(ctx context.Context, reviewerIDs []string) ([]*entity.PullRequest, error) {
+	if len(reviewerIDs) == 0 {
+		return []*entity.PullRequest{}, nil
+	}
+
+	placeholders := make([]string, len(reviewerIDs))
+	args := make([]interface{}, len(reviewerIDs)+1)
+	for i, reviewerID := range reviewerIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = reviewerID
+	}
+	args[len(reviewerIDs)] = string(entity.PRStatusOpen)
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM pull_requests pr
+		WHERE pr.status = $%d
+			AND EXISTS (
+				SELECT 1
+				FROM pr_reviewers prr
+				WHERE prr.pull_request_id = pr.pull_request_id AND prr.user_id IN (%s)
+			)
+		ORDER BY pr.created_at, pr.pull_request_id
+	`, pullRequestColumns, len(reviewerIDs)+1, strings.Join(placeholders, ","))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open pull requests by reviewers: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var models []prRepo.Model
+	for rows.Next() {
+		model, err := scanPullRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+		models = append(models, model)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	prIDs := make([]string, len(models))
+	for i := range models {
+		prIDs[i] = models[i].ID
+	}
+
+	reviewersByPR, err := r.findReviewersByPRIDs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+
+	pullRequests := make([]*entity.PullRequest, 0, len(models))
+	for i := range models {
+		pullRequests = append(pullRequests, prRepo.ToEntity(&models[i], reviewersByPR[models[i].ID]))
+	}
+
+	return pullRequests, nil
+}
+
+func (r *PullRequestRepository) scanPullRequestsFromRows(ctx context.Context, rows *sql.Rows) ([]*entity.PullRequest, error) {
+	var pullRequests []*entity.PullRequest
+	for rows.Next() {
+		model, err := scanPullRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+
+		reviewers, err := r.findReviewersByPRID(ctx, model.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find reviewers: %w", err)
+		}
+
+		pullRequests = append(pullRequests, prRepo.ToEntity(&model, reviewers))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return pullRequests, nil
+}
+
+func (r *PullRequestRepository) Update(ctx context.Context, pr *entity.PullRequest) error {
+	model := prRepo.FromEntity(pr)
+
+	query := `
+		UPDATE pull_requests
+		SET pull_request_name = $2, author_id = $3, status = $4, merged_at = $5
+		WHERE pull_request_id = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.Name,
+		model.AuthorID,
+		model.Status,
+		model.MergedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update pull request: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pull request not found: %s", model.ID)
+	}
+
+	if err := r.deleteReviewers(ctx, pr.ID()); err != nil {
+		return fmt.Errorf("failed to delete reviewers: %w", err)
+	}
+
+	if err := r.insertReviewers(ctx, pr); err != nil {
+		return fmt.Errorf("failed to insert reviewers: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus сохраняет только статус PR и данные мержа, не трогая ревьюверов
+func (r *PullRequestRepository) UpdateStatus(ctx context.Context, pr *entity.PullRequest) error {
+	query := `
+		UPDATE pull_requests
+		SET status = $2, merged_at = $3, merge_forced = $4
+		WHERE pull_request_id = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, pr.ID(), string(pr.Status()), pr.MergedAt(), pr.MergeForced())
+	if err != nil {
+		return fmt.Errorf("failed to update pull request status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pull request not found: %s", pr.ID())
+	}
+
+	return nil
+}
+
+// AddReviewer добавляет одного ревьювера к PR, не трогая остальных
+func (r *PullRequestRepository) AddReviewer(ctx context.Context, prID, reviewerID string, isFallback bool) error {
+	query := `
+		INSERT INTO pr_reviewers (pull_request_id, user_id, is_fallback)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, prID, reviewerID, isFallback); err != nil {
+		return fmt.Errorf("failed to add reviewer: %w", err)
+	}
+
+	return nil
+}
+
+// SaveReview сохраняет последнее ревью ревьювера
+func (r *PullRequestRepository) SaveReview(ctx context.Context, prID string, review entity.Review) error {
+	query := `
+		UPDATE pr_reviewers
+		SET review_state = $3, reviewed_at = $4
+		WHERE pull_request_id = $1 AND user_id = $2
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, review.ReviewerID(), string(review.State()), review.SubmittedAt())
+	if err != nil {
+		return fmt.Errorf("failed to save review: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("reviewer not found: pr_id=%s, reviewer_id=%s", prID, review.ReviewerID())
+	}
+
+	return nil
+}
+
+// RemoveReviewer снимает одного ревьювера с PR
+func (r *PullRequestRepository) RemoveReviewer(ctx context.Context, prID, reviewerID string) error {
+	query := `DELETE FROM pr_reviewers WHERE pull_request_id = $1 AND user_id = $2`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to remove reviewer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("reviewer not found or already removed: pr_id=%s, reviewer_id=%s", prID, reviewerID)
+	}
+
+	return nil
+}
+
+// ReplaceReviewer заменяет одного ревьювера на другого одним запросом (оптимизация для ReassignReviewer).
+// Ревью старого ревьювера сбрасывается, новый начинает с PENDING
+func (r *PullRequestRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, isFallback bool) error {
+	query := `
+		UPDATE pr_reviewers
+		SET user_id = $3, is_fallback = $4, review_state = $5, reviewed_at = NULL
+		WHERE pull_request_id = $1 AND user_id = $2
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, prID, oldReviewerID, newReviewerID, isFallback, string(entity.ReviewStatePending))
+	if err != nil {
+		return fmt.Errorf("failed to replace reviewer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("reviewer not found or already replaced: pr_id=%s, old_reviewer_id=%s", prID, oldReviewerID)
+	}
+
+	return nil
+}
+
+// ReassignReviewers применяет пачку переносов слотов ревьюверов:
+// замены выполняются одним UPDATE ... FROM по списку VALUES, освобождаемые слоты - одним DELETE
+func (r *PullRequestRepository) ReassignReviewers(ctx context.Context, changes []repository.ReviewerChange) error {
+	var replacements, removals []repository.ReviewerChange
+	for _, change := range changes {
+		if change.NewReviewerID == "" {
+			removals = append(removals, change)
+		} else {
+			replacements = append(replacements, change)
+		}
+	}
+
+	for start := 0; start < len(replacements); start += reviewerChangesBatchSize {
+		end := min(start+reviewerChangesBatchSize, len(replacements))
+		if err := r.replaceReviewersBatch(ctx, replacements[start:end]); err != nil {
+			return err
+		}
+	}
+
+	for start := 0; start < len(removals); start += reviewerChangesBatchSize {
+		end := min(start+reviewerChangesBatchSize, len(removals))
+		if err := r.removeReviewersBatch(ctx, removals[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PullRequestRepository) replaceReviewersBatch(ctx context.Context, changes []repository.ReviewerChange) error {
+	valueStrings := make([]string, 0, len(changes))
+	valueArgs := make([]interface{}, 0, len(changes)*reviewerChangeParamsCount+1)
+	valueArgs = append(valueArgs, string(entity.ReviewStatePending))
+	for i, change := range changes {
+		paramOffset := i*reviewerChangeParamsCount + 1
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d)", paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4))
+		valueArgs = append(valueArgs, change.PullRequestID, change.OldReviewerID, change.NewReviewerID, change.IsFallback)
+	}
+
+	// SQLite не поддерживает имена колонок в алиасе VALUES, поэтому список задается через CTE
+	query := fmt.Sprintf(`
+		WITH v(pull_request_id, old_user_id, new_user_id, is_fallback) AS (VALUES %s)
+		UPDATE pr_reviewers AS prr
+		SET user_id = v.new_user_id, is_fallback = v.is_fallback, review_state = $1, reviewed_at = NULL
+		FROM v
+		WHERE prr.pull_request_id = v.pull_request_id AND prr.user_id = v.old_user_id
+	`, strings.Join(valueStrings, ","))
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to reassign reviewers: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected != int64(len(changes)) {
+		return fmt.Errorf("reviewers not found or already replaced: expected %d, replaced %d", len(changes), rowsAffected)
+	}
+
+	return nil
+}
+
+func (r *PullRequestRepository) removeReviewersBatch(ctx context.Context, changes []repository.ReviewerChange) error {
+	valueStrings := make([]string, 0, len(changes))
+	valueArgs := make([]interface{}, 0, len(changes)*reviewerParamsCount)
+	for i, change := range changes {
+		paramOffset := i * reviewerParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d)", paramOffset+1, paramOffset+2))
+		valueArgs = append(valueArgs, change.PullRequestID, change.OldReviewerID)
+	}
+
+	query := fmt.Sprintf(`
+		DELETE FROM pr_reviewers
+		WHERE (pull_request_id, user_id) IN (VALUES %s)
+	`, strings.Join(valueStrings, ","))
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to remove reviewers: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected != int64(len(changes)) {
+		return fmt.Errorf("reviewers not found or already removed: expected %d, removed %d", len(changes), rowsAffected)
+	}
+
+	return nil
+}
+
+func (r *PullRequestRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM pull_requests WHERE pull_request_id = $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete pull request: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pull request not found: %s", id)
+	}
+
+	return nil
+}
+
+func (r *PullRequestRepository) Exists(ctx context.Context, id string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1)`
+
+	var exists bool
+	err := r.getDB(ctx).QueryRowContext(ctx, query, id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pull request existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *PullRequestRepository) findReviewersByPRID(ctx context.Context, prID string) ([]prRepo.ReviewerModel, error) {
+	query := `
+		SELECT user_id, is_fallback, review_state, reviewed_at
+		FROM pr_reviewers
+		WHERE pull_request_id = $1
+		ORDER BY assigned_at, rowid
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var reviewers []prRepo.ReviewerModel
+	for rows.Next() {
+		var reviewer prRepo.ReviewerModel
+		if err := rows.Scan(&reviewer.UserID, &reviewer.IsFallback, &reviewer.ReviewState, &reviewer.ReviewedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return reviewers, nil
+}
+
+// findReviewersByPRIDs загружает ревьюверов сразу для нескольких PR
+func (r *PullRequestRepository) findReviewersByPRIDs(ctx context.Context, prIDs []string) (map[string][]prRepo.ReviewerModel, error) {
+	result := make(map[string][]prRepo.ReviewerModel, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	placeholders := make([]string, len(prIDs))
+	args := make([]interface{}, len(prIDs))
+	for i, prID := range prIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = prID
+	}
+
+	query := fmt.Sprintf(`
+		SELECT pull_request_id, user_id, is_fallback, review_state, reviewed_at
+		FROM pr_reviewers
+		WHERE pull_request_id IN (%s)
+		ORDER BY pull_request_id, assigned_at, rowid
+	`, strings.Join(placeholders, ","))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var prID string
+		var reviewer prRepo.ReviewerModel
+		if err := rows.Scan(&prID, &reviewer.UserID, &reviewer.IsFallback, &reviewer.ReviewState, &reviewer.ReviewedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		result[prID] = append(result[prID], reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *PullRequestRepository) insertReviewers(ctx context.Context, pr *entity.PullRequest) error {
+	reviewers := pr.AssignedReviewers()
+	if len(reviewers) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(reviewers))
+	valueArgs := make([]interface{}, 0, len(reviewers)*reviewerInsertParamsCount)
+	for i, reviewer := range reviewers {
+		paramOffset := i * reviewerInsertParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d, $%d)",
+			paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5,
+		))
+		review := pr.ReviewOf(reviewer)
+		valueArgs = append(valueArgs, pr.ID(), reviewer, pr.IsFallbackReviewer(reviewer), string(review.State()), review.SubmittedAt())
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO pr_reviewers (pull_request_id, user_id, is_fallback, review_state, reviewed_at)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	_, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to insert reviewers: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PullRequestRepository) deleteReviewers(ctx context.Context, prID string) error {
+	query := `DELETE FROM pr_reviewers WHERE pull_request_id = $1`
+
+	_, err := r.getDB(ctx).ExecContext(ctx, query, prID)
+	if err != nil {
+		return fmt.Errorf("failed to delete reviewers: %w", err)
+	}
+
+	return nil
+}
+
+// CountActiveReviewsByUserIDs возвращает текущую загрузку пользователей - число открытых PR на ревью.
+// Черновики и закрытые PR в загрузку не входят
+func (r *PullRequestRepository) CountActiveReviewsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	if len(userIDs) == 0 {
+		return make(map[string]int), nil
+	}
+
+	placeholders := make([]string, len(userIDs))
+	args := make([]interface{}, len(userIDs)+1)
+	for i, userID := range userIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = userID
+	}
+	args[len(userIDs)] = string(entity.PRStatusOpen)
+
+	query := fmt.Sprintf(`
+		SELECT pr.user_id, COUNT(DISTINCT pr.pull_request_id) as review_count
+		FROM pr_reviewers pr
+		INNER JOIN pull_requests p ON pr.pull_request_id = p.pull_request_id
+		WHERE pr.user_id IN (%s) AND p.status = $%d
+		GROUP BY pr.user_id
+	`, strings.Join(placeholders, ","), len(userIDs)+1)
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query active reviews count: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	result := make(map[string]int)
+	for _, userID := range userIDs {
+		result[userID] = 0
+	}
+
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan review count: %w", err)
+		}
+		result[userID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetStats возвращает количество PR по статусам
+func (r *PullRequestRepository) GetStats(ctx context.Context) (repository.PRStats, error) {
+	query := `
+		SELECT 
+			COUNT(*) as total,
+			COUNT(*) FILTER (WHERE status = $1) as draft,
+			COUNT(*) FILTER (WHERE status = $2) as open,
+			COUNT(*) FILTER (WHERE status = $3) as merged,
+			COUNT(*) FILTER (WHERE status = $4) as closed
+		FROM pull_requests
+	`
+
+	var stats repository.PRStats
+	err := r.getDB(ctx).QueryRowContext(
+		ctx,
+		query,
+		string(entity.PRStatusDraft),
+		string(entity.PRStatusOpen),
+		string(entity.PRStatusMerged),
+		string(entity.PRStatusClosed),
+	).Scan(&stats.Total, &stats.Draft, &stats.Open, &stats.Merged, &stats.Closed)
+	if err != nil {
+		return repository.PRStats{}, fmt.Errorf("failed to get PR stats: %w", err)
+	}
+
+	return stats, nil
+}
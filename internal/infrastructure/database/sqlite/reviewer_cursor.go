@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.ReviewerCursorRepository = (*ReviewerCursorRepository)(nil)
+
+type ReviewerCursorRepository struct {
+	querier
+}
+
+func NewReviewerCursorRepository(db *SQLiteDB) *ReviewerCursorRepository {
+	return &ReviewerCursorRepository{querier: newQuerier(db)}
+}
+
+// LockCursor возвращает курсор команды. Блокировку заменяет транзакция BEGIN IMMEDIATE:
+// она уже держит блокировку записи базы, и курсор не изменится до ее конца
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (r *ReviewerCursorRepository) LockCursor(ctx context.Context, teamName string) (string, error) {
+	insertQuery := `
+		INSERT INTO team_reviewer_cursors (team_name)
+		VALUES ($1)
+		ON CONFLICT (team_name) DO NOTHING
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, insertQuery, teamName); err != nil {
+		return "", fmt.Errorf("failed to init reviewer cursor: %w", err)
+	}
+
+	selectQuery := `
+		SELECT last_user_id
+		FROM team_reviewer_cursors
+		WHERE team_name = $1
+	`
+
+	var lastUserID string
+	if err := r.getDB(ctx).QueryRowContext(ctx, selectQuery, teamName).Scan(&lastUserID); err != nil {
+		return "", fmt.Errorf("failed to lock reviewer cursor: %w", err)
+	}
+
+	return lastUserID, nil
+}
+
+// SaveCursor сохраняет последнего назначенного ревьювера команды
+func (r *ReviewerCursorRepository) SaveCursor(ctx context.Context, teamName, lastUserID string) error {
+	query := `
+		INSERT INTO team_reviewer_cursors (team_name, last_user_id, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_name) DO UPDATE
+		SET last_user_id = EXCLUDED.last_user_id, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, teamName, lastUserID, time.Now()); err != nil {
+		return fmt.Errorf("failed to save reviewer cursor: %w", err)
+	}
+
+	return nil
+}
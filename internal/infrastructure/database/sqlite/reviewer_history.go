@@ -0,0 +1,138 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	reviewerHistoryRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/reviewer_history"
+)
+
+var _ repository.ReviewerHistoryRepository = (*ReviewerHistoryRepository)(nil)
+
+const historyEntryParamsCount = 5
+
+type ReviewerHistoryRepository struct {
+	querier
+}
+
+func NewReviewerHistoryRepository(db *SQLiteDB) *ReviewerHistoryRepository {
+	return &ReviewerHistoryRepository{querier: newQuerier(db)}
+}
+
+func (r *ReviewerHistoryRepository) Add(ctx context.Context, entries []*entity.ReviewerHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(entries))
+	valueArgs := make([]interface{}, 0, len(entries)*historyEntryParamsCount)
+	for i, entry := range entries {
+		model := reviewerHistoryRepo.FromEntity(entry)
+		paramOffset := i * historyEntryParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf(
+			"($%d, $%d, $%d, $%d, $%d)",
+			paramOffset+1, paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5,
+		))
+		valueArgs = append(valueArgs, model.PullRequestID, model.UserID, model.Action, model.Reason, model.OccurredAt)
+	}
+
+	// id назначается в порядке VALUES: снятие старого ревьювера остается перед назначением нового
+	query := fmt.Sprintf(`
+		INSERT INTO pr_reviewer_history (pull_request_id, user_id, action, reason, occurred_at)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to add reviewer history: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReviewerHistoryRepository) FindByPRID(ctx context.Context, prID string) ([]*entity.ReviewerHistoryEntry, error) {
+	query := `
+		SELECT pull_request_id, user_id, action, reason, occurred_at
+		FROM pr_reviewer_history
+		WHERE pull_request_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewer history: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	entries := make([]*entity.ReviewerHistoryEntry, 0)
+	for rows.Next() {
+		var model reviewerHistoryRepo.Model
+		if err := rows.Scan(&model.PullRequestID, &model.UserID, &model.Action, &model.Reason, &model.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer history: %w", err)
+		}
+		entries = append(entries, reviewerHistoryRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// CountAssignedPRsByUserIDs возвращает число PR, в которых пользователь когда-либо был назначен ревьювером
+func (r *ReviewerHistoryRepository) CountAssignedPRsByUserIDs(ctx context.Context, userIDs []string) (map[string]int, error) {
+	if len(userIDs) == 0 {
+		return make(map[string]int), nil
+	}
+
+	placeholders := make([]string, len(userIDs))
+	args := make([]interface{}, len(userIDs)+1)
+	for i, userID := range userIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = userID
+	}
+	args[len(userIDs)] = string(entity.ReviewerAssigned)
+
+	query := fmt.Sprintf(`
+		SELECT user_id, COUNT(DISTINCT pull_request_id) as review_count
+		FROM pr_reviewer_history
+		WHERE user_id IN (%s) AND action = $%d
+		GROUP BY user_id
+	`, strings.Join(placeholders, ","), len(userIDs)+1)
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query assigned reviews count: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	result := make(map[string]int)
+	for _, userID := range userIDs {
+		result[userID] = 0
+	}
+
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan review count: %w", err)
+		}
+		result[userID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
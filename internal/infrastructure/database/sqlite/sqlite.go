@@ -0,0 +1,126 @@
+// Package sqlite хранилище в файле SQLite для небольших команд и развертываний без сервера PostgreSQL.
+// Транзакции открываются через BEGIN IMMEDIATE и сразу берут блокировку записи базы, поэтому
+// пишущие транзакции выполняются последовательно. Это заменяет построчные блокировки PostgreSQL
+// (SELECT FOR UPDATE, SKIP LOCKED): строка, прочитанная в транзакции, не изменится до ее конца
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	_ "modernc.org/sqlite"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/tracing"
+)
+
+// MemoryPath путь базы в памяти процесса
+const MemoryPath = ":memory:"
+
+const pingTimeout = 5 * time.Second
+
+type SQLiteDB struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+// NewSQLiteDB открывает базу. Схема применяется отдельно (см. Migrations)
+func NewSQLiteDB(cfg config.SQLiteConfig) (*SQLiteDB, error) {
+	if cfg.Path != MemoryPath {
+		if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+	}
+
+	db, err := tracing.OpenDB("sqlite", dsn(cfg), semconv.DBSystemNameSQLite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// У каждого соединения с :memory: своя база, поэтому соединение должно быть одно
+	if cfg.Path == MemoryPath {
+		db.SetMaxOpenConns(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &SQLiteDB{
+		db:     db,
+		getter: trmsql.DefaultCtxGetter,
+	}, nil
+}
+
+// dsn задает параметры каждого соединения:
+//   - внешние ключи (в SQLite выключены по умолчанию) и WAL, чтобы чтение не ждало записи;
+//   - busy_timeout - сколько ждать блокировку записи, занятую другой транзакцией;
+//   - _txlock=immediate - транзакции берут блокировку записи сразу при BEGIN;
+//   - время хранится в наносекундах Unix, чтобы сравнение и сортировка в SQL не зависели
+//     от часового пояса и формата строки
+func dsn(cfg config.SQLiteConfig) string {
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", cfg.BusyTimeout*int(time.Second/time.Millisecond)))
+	if cfg.Path != MemoryPath {
+		params.Add("_pragma", "journal_mode(WAL)")
+	}
+	params.Set("_txlock", "immediate")
+	params.Set("_time_integer_format", "unix_nano")
+	params.Set("_inttotime", "true")
+
+	return "file:" + cfg.Path + "?" + params.Encode()
+}
+
+func (s *SQLiteDB) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLiteDB) DB() *sql.DB {
+	return s.db
+}
+
+// Getter возвращает CtxGetter для получения транзакции из контекста
+func (s *SQLiteDB) Getter() *trmsql.CtxGetter {
+	return s.getter
+}
+
+// querier общая часть репозиториев: запросы идут в транзакцию из контекста или напрямую в базу
+type querier struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func newQuerier(db *SQLiteDB) querier {
+	return querier{db: db.DB(), getter: db.Getter()}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (q querier) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return q.getter.DefaultTrOrDB(ctx, q.db)
+}
+
+// paramList возвращает список параметров $from, $from+1, ... для IN (...)
+func paramList(from, count int) string {
+	list := make([]string, count)
+	for i := range list {
+		list[i] = fmt.Sprintf("$%d", from+i)
+	}
+	return strings.Join(list, ",")
+}
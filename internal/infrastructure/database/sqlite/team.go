@@ -0,0 +1,364 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	teamRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/team"
+)
+
+var _ repository.TeamRepository = (*TeamRepository)(nil)
+
+const (
+	fallbackTeamParamsCount = 3
+	codeOwnerParamsCount    = 2
+)
+
+type TeamRepository struct {
+	querier
+}
+
+func NewTeamRepository(db *SQLiteDB) *TeamRepository {
+	return &TeamRepository{querier: newQuerier(db)}
+}
+
+func (r *TeamRepository) Create(ctx context.Context, team *entity.Team) error {
+	model := teamRepo.FromEntity(team)
+
+	query := `
+		INSERT INTO teams (
+			team_name, reviewer_strategy, min_reviewers, max_reviewers,
+			required_approvals, block_on_changes_requested, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.Name,
+		model.ReviewerStrategy,
+		model.MinReviewers,
+		model.MaxReviewers,
+		model.RequiredApprovals,
+		model.BlockOnChangesRequested,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create team: %w", err)
+	}
+
+	if err := r.insertFallbackTeams(ctx, team.Name(), team.FallbackTeams()); err != nil {
+		return fmt.Errorf("failed to insert fallback teams: %w", err)
+	}
+
+	if err := r.insertCodeOwners(ctx, team.Name(), team.MergePolicy().CodeOwners()); err != nil {
+		return fmt.Errorf("failed to insert code owners: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) FindByName(ctx context.Context, name string) (*entity.Team, error) {
+	query := `
+		SELECT team_name, reviewer_strategy, min_reviewers, max_reviewers,
+			required_approvals, block_on_changes_requested, created_at, updated_at
+		FROM teams
+		WHERE team_name = $1
+	`
+
+	var model teamRepo.Model
+	err := r.getDB(ctx).QueryRowContext(ctx, query, name).Scan(
+		&model.Name,
+		&model.ReviewerStrategy,
+		&model.MinReviewers,
+		&model.MaxReviewers,
+		&model.RequiredApprovals,
+		&model.BlockOnChangesRequested,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find team: %w", err)
+	}
+
+	fallbackTeams, err := r.findFallbackTeams(ctx, model.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fallback teams: %w", err)
+	}
+
+	codeOwners, err := r.findCodeOwners(ctx, model.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find code owners: %w", err)
+	}
+
+	return teamRepo.ToEntity(&model, fallbackTeams, codeOwners), nil
+}
+
+func (r *TeamRepository) Update(ctx context.Context, team *entity.Team) error {
+	model := teamRepo.FromEntity(team)
+
+	query := `
+		UPDATE teams
+		SET reviewer_strategy = $2, min_reviewers = $3, max_reviewers = $4,
+			required_approvals = $5, block_on_changes_requested = $6, updated_at = $7
+		WHERE team_name = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.Name,
+		model.ReviewerStrategy,
+		model.MinReviewers,
+		model.MaxReviewers,
+		model.RequiredApprovals,
+		model.BlockOnChangesRequested,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update team: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("team not found: %s", model.Name)
+	}
+
+	if err := r.deleteFallbackTeams(ctx, team.Name()); err != nil {
+		return fmt.Errorf("failed to delete fallback teams: %w", err)
+	}
+
+	if err := r.insertFallbackTeams(ctx, team.Name(), team.FallbackTeams()); err != nil {
+		return fmt.Errorf("failed to insert fallback teams: %w", err)
+	}
+
+	if err := r.deleteCodeOwners(ctx, team.Name()); err != nil {
+		return fmt.Errorf("failed to delete code owners: %w", err)
+	}
+
+	if err := r.insertCodeOwners(ctx, team.Name(), team.MergePolicy().CodeOwners()); err != nil {
+		return fmt.Errorf("failed to insert code owners: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) Delete(ctx context.Context, name string) error {
+	query := `DELETE FROM teams WHERE team_name = $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("team not found: %s", name)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) Exists(ctx context.Context, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`
+
+	var exists bool
+	err := r.getDB(ctx).QueryRowContext(ctx, query, name).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check team existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CountActiveMembers возвращает число активных участников каждой команды, включая команды без активных
+func (r *TeamRepository) CountActiveMembers(ctx context.Context) (map[string]int, error) {
+	query := `
+		SELECT t.team_name, COUNT(u.user_id)
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.team_name AND u.is_active = TRUE
+		GROUP BY t.team_name
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count active team members: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var teamName string
+		var count int
+		if err := rows.Scan(&teamName, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan active team members: %w", err)
+		}
+		counts[teamName] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// findFallbackTeams возвращает запасные команды в порядке приоритета
+func (r *TeamRepository) findFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	query := `
+		SELECT fallback_team_name
+		FROM team_fallback_teams
+		WHERE team_name = $1
+		ORDER BY priority
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fallback teams: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var fallbackTeams []string
+	for rows.Next() {
+		var fallbackTeam string
+		if err := rows.Scan(&fallbackTeam); err != nil {
+			return nil, fmt.Errorf("failed to scan fallback team: %w", err)
+		}
+		fallbackTeams = append(fallbackTeams, fallbackTeam)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return fallbackTeams, nil
+}
+
+func (r *TeamRepository) insertFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(fallbackTeams))
+	valueArgs := make([]interface{}, 0, len(fallbackTeams)*fallbackTeamParamsCount)
+	for i, fallbackTeam := range fallbackTeams {
+		paramOffset := i * fallbackTeamParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d)", paramOffset+1, paramOffset+2, paramOffset+3))
+		valueArgs = append(valueArgs, teamName, fallbackTeam, i)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO team_fallback_teams (team_name, fallback_team_name, priority)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to insert fallback teams: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) deleteFallbackTeams(ctx context.Context, teamName string) error {
+	query := `DELETE FROM team_fallback_teams WHERE team_name = $1`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, teamName); err != nil {
+		return fmt.Errorf("failed to delete fallback teams: %w", err)
+	}
+
+	return nil
+}
+
+// findCodeOwners возвращает владельцев кода команды в порядке добавления
+func (r *TeamRepository) findCodeOwners(ctx context.Context, teamName string) ([]string, error) {
+	query := `
+		SELECT user_id
+		FROM team_code_owners
+		WHERE team_name = $1
+		ORDER BY position
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query code owners: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var codeOwners []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan code owner: %w", err)
+		}
+		codeOwners = append(codeOwners, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return codeOwners, nil
+}
+
+func (r *TeamRepository) insertCodeOwners(ctx context.Context, teamName string, codeOwners []string) error {
+	if len(codeOwners) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(codeOwners))
+	valueArgs := make([]interface{}, 0, len(codeOwners)*codeOwnerParamsCount+1)
+	valueArgs = append(valueArgs, teamName)
+	for i, userID := range codeOwners {
+		paramOffset := i*codeOwnerParamsCount + 1
+		valueStrings = append(valueStrings, fmt.Sprintf("($1, $%d, $%d)", paramOffset+1, paramOffset+2))
+		valueArgs = append(valueArgs, userID, i)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO team_code_owners (team_name, user_id, position)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to insert code owners: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) deleteCodeOwners(ctx context.Context, teamName string) error {
+	query := `DELETE FROM team_code_owners WHERE team_name = $1`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, teamName); err != nil {
+		return fmt.Errorf("failed to delete code owners: %w", err)
+	}
+
+	return nil
+}
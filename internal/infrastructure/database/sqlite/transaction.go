@@ -0,0 +1,16 @@
+package sqlite
+
+import (
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+)
+
+// NewTransactionManager создает менеджер транзакций для SQLite.
+// Транзакции открываются через BEGIN IMMEDIATE (см. dsn)
+func NewTransactionManager(db *SQLiteDB) transaction.Manager {
+	return manager.Must(
+		trmsql.NewDefaultFactory(db.DB()),
+	)
+}
@@ -0,0 +1,243 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	userRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/user"
+)
+
+var _ repository.UserRepository = (*UserRepository)(nil)
+
+type UserRepository struct {
+	querier
+}
+
+func NewUserRepository(db *SQLiteDB) *UserRepository {
+	return &UserRepository{querier: newQuerier(db)}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
+	model := userRepo.FromEntity(user)
+
+	query := `
+		INSERT INTO users (user_id, username, team_name, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.Username,
+		model.TeamName,
+		model.IsActive,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	query := `
+		SELECT user_id, username, team_name, is_active, created_at, updated_at
+		FROM users
+		WHERE user_id = $1
+	`
+
+	var model userRepo.Model
+	err := r.getDB(ctx).QueryRowContext(ctx, query, id).Scan(
+		&model.ID,
+		&model.Username,
+		&model.TeamName,
+		&model.IsActive,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	return userRepo.ToEntity(&model), nil
+}
+
+// FindByIDs находит пользователей по списку ID одним запросом (отсутствующие ID пропускаются)
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`
+		SELECT user_id, username, team_name, is_active, created_at, updated_at
+		FROM users
+		WHERE user_id IN (%s)
+		ORDER BY user_id
+	`, paramList(1, len(ids)))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users by ids: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	return scanUsers(rows)
+}
+
+func (r *UserRepository) FindByTeamName(ctx context.Context, teamName string) ([]*entity.User, error) {
+	query := `
+		SELECT user_id, username, team_name, is_active, created_at, updated_at
+		FROM users
+		WHERE team_name = $1
+		ORDER BY username
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users by team: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	return scanUsers(rows)
+}
+
+func (r *UserRepository) FindActiveByTeamName(ctx context.Context, teamName string) ([]*entity.User, error) {
+	query := `
+		SELECT user_id, username, team_name, is_active, created_at, updated_at
+		FROM users
+		WHERE team_name = $1 AND is_active = TRUE
+		ORDER BY username
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active users by team: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	return scanUsers(rows)
+}
+
+func scanUsers(rows *sql.Rows) ([]*entity.User, error) {
+	var users []*entity.User
+	for rows.Next() {
+		var model userRepo.Model
+		if err := rows.Scan(&model.ID, &model.Username, &model.TeamName, &model.IsActive, &model.CreatedAt, &model.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, userRepo.ToEntity(&model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
+	model := userRepo.FromEntity(user)
+
+	query := `
+		UPDATE users
+		SET username = $2, team_name = $3, is_active = $4, updated_at = $5
+		WHERE user_id = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.Username,
+		model.TeamName,
+		model.IsActive,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found: %s", model.ID)
+	}
+
+	return nil
+}
+
+// BatchDeactivateByTeamName массово деактивирует всех активных пользователей команды одним запросом
+func (r *UserRepository) BatchDeactivateByTeamName(ctx context.Context, teamName string) error {
+	query := `
+		UPDATE users
+		SET is_active = FALSE, updated_at = $2
+		WHERE team_name = $1 AND is_active = TRUE
+	`
+
+	_, err := r.getDB(ctx).ExecContext(ctx, query, teamName, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to batch deactivate team members: %w", err)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM users WHERE user_id = $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("user not found: %s", id)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)`
+
+	var exists bool
+	err := r.getDB(ctx).QueryRowContext(ctx, query, id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check user existence: %w", err)
+	}
+
+	return exists, nil
+}
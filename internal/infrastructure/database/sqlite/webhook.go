@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.WebhookRepository = (*WebhookRepository)(nil)
+
+type WebhookRepository struct {
+	querier
+}
+
+func NewWebhookRepository(db *SQLiteDB) *WebhookRepository {
+	return &WebhookRepository{querier: newQuerier(db)}
+}
+
+func (r *WebhookRepository) FindUserIDByLogin(ctx context.Context, provider entity.GitProvider, login string) (string, error) {
+	query := `
+		SELECT user_id
+		FROM git_user_logins
+		WHERE provider = $1 AND login = $2
+	`
+
+	var userID string
+	if err := r.getDB(ctx).QueryRowContext(ctx, query, string(provider), login).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", repository.ErrNotFound
+		}
+		return "", fmt.Errorf("failed to find git login: %w", err)
+	}
+
+	return userID, nil
+}
+
+func (r *WebhookRepository) SaveLogin(ctx context.Context, provider entity.GitProvider, login, userID string) error {
+	query := `
+		INSERT INTO git_user_logins (provider, login, user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (provider, login) DO UPDATE
+		SET user_id = EXCLUDED.user_id, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, string(provider), login, userID, time.Now()); err != nil {
+		return fmt.Errorf("failed to save git login: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterDelivery вставляет доставку с ON CONFLICT DO NOTHING.
+// Повторная доставка получает false: пишущие транзакции SQLite выполняются по очереди
+func (r *WebhookRepository) RegisterDelivery(ctx context.Context, provider entity.GitProvider, deliveryID string) (bool, error) {
+	query := `
+		INSERT INTO webhook_deliveries (provider, delivery_id, received_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (provider, delivery_id) DO NOTHING
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, string(provider), deliveryID, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to register webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,647 @@
+// Package storagetest общий набор контрактных тестов, который должны проходить все хранилища:
+// PostgreSQL и хранилище в памяти
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+)
+
+// Backend проверяемое хранилище
+type Backend struct {
+	TxManager    transaction.Manager
+	Users        repository.UserRepository
+	Teams        repository.TeamRepository
+	PullRequests repository.PullRequestRepository
+	Idempotency  repository.IdempotencyRepository
+}
+
+// Run запускает контрактные тесты. Хранилище может быть общим для нескольких тестов
+// (как база интеграционных тестов), поэтому тесты создают данные с уникальными ID
+// и не опираются на общее количество записей
+func Run(t *testing.T, newBackend func(t *testing.T) Backend) {
+	t.Helper()
+
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, b Backend, ids *idGenerator)
+	}{
+		{"Users", testUsers},
+		{"Teams", testTeams},
+		{"PullRequests", testPullRequests},
+		{"Reviewers", testReviewers},
+		{"ReassignReviewers", testReassignReviewers},
+		{"TransactionCommit", testTransactionCommit},
+		{"TransactionRollback", testTransactionRollback},
+		{"NestedTransaction", testNestedTransaction},
+		{"ConcurrentReplaceReviewer", testConcurrentReplaceReviewer},
+		{"IdempotencyKeys", testIdempotencyKeys},
+	}
+
+	ids := &idGenerator{prefix: "st" + strconv.FormatInt(time.Now().UnixNano(), 36)}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newBackend(t), ids)
+		})
+	}
+}
+
+// idGenerator выдает ID, уникальные в пределах запуска
+type idGenerator struct {
+	prefix  string
+	counter atomic.Int64
+}
+
+func (g *idGenerator) next(kind string) string {
+	return fmt.Sprintf("%s-%s-%06d", g.prefix, kind, g.counter.Add(1))
+}
+
+// fixture команда с участниками и PR автора members[0]
+type fixture struct {
+	team    *entity.Team
+	members []*entity.User
+}
+
+func createTeam(t *testing.T, b Backend, ids *idGenerator, membersCount int) fixture {
+	t.Helper()
+	ctx := context.Background()
+
+	team, err := entity.NewTeam(ids.next("team"))
+	if err != nil {
+		t.Fatalf("NewTeam() error = %v", err)
+	}
+	if err := b.Teams.Create(ctx, team); err != nil {
+		t.Fatalf("Teams.Create() error = %v", err)
+	}
+
+	f := fixture{team: team}
+	for i := range membersCount {
+		user, err := entity.NewUser(ids.next("user"), fmt.Sprintf("member-%d", i), team.Name())
+		if err != nil {
+			t.Fatalf("NewUser() error = %v", err)
+		}
+		if err := b.Users.Create(ctx, user); err != nil {
+			t.Fatalf("Users.Create() error = %v", err)
+		}
+		f.members = append(f.members, user)
+	}
+	return f
+}
+
+func createPullRequest(t *testing.T, b Backend, ids *idGenerator, f fixture, reviewers ...*entity.User) *entity.PullRequest {
+	t.Helper()
+
+	pr, err := entity.NewPullRequest(ids.next("pr"), "Contract test PR", f.members[0].ID(), f.team)
+	if err != nil {
+		t.Fatalf("NewPullRequest() error = %v", err)
+	}
+	for _, reviewer := range reviewers {
+		if err := pr.AddReviewer(reviewer.ID()); err != nil {
+			t.Fatalf("AddReviewer() error = %v", err)
+		}
+	}
+	if err := b.PullRequests.Create(context.Background(), pr); err != nil {
+		t.Fatalf("PullRequests.Create() error = %v", err)
+	}
+	return pr
+}
+
+func findPullRequest(t *testing.T, b Backend, id string) *entity.PullRequest {
+	t.Helper()
+
+	pr, err := b.PullRequests.FindByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("PullRequests.FindByID() error = %v", err)
+	}
+	return pr
+}
+
+func assertReviewers(t *testing.T, pr *entity.PullRequest, want ...string) {
+	t.Helper()
+
+	got := slices.Sorted(slices.Values(pr.AssignedReviewers()))
+	want = slices.Sorted(slices.Values(want))
+	if !slices.Equal(got, want) {
+		t.Errorf("AssignedReviewers() = %v, want %v", got, want)
+	}
+}
+
+func userIDs(users []*entity.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID())
+	}
+	return ids
+}
+
+func testUsers(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 3)
+
+	found, err := b.Users.FindByID(ctx, f.members[0].ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.Username() != "member-0" || found.TeamName() != f.team.Name() || !found.IsActive() {
+		t.Errorf("FindByID() = %s/%s/%v, want member-0/%s/true", found.Username(), found.TeamName(), found.IsActive(), f.team.Name())
+	}
+
+	if _, err := b.Users.FindByID(ctx, ids.next("missing")); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("FindByID(missing) error = %v, want ErrNotFound", err)
+	}
+
+	if err := b.Users.Create(ctx, f.members[0]); err == nil {
+		t.Error("Create(duplicate) error = nil, want error")
+	}
+
+	byIDs, err := b.Users.FindByIDs(ctx, []string{f.members[2].ID(), ids.next("missing"), f.members[0].ID()})
+	if err != nil {
+		t.Fatalf("FindByIDs() error = %v", err)
+	}
+	if got, want := userIDs(byIDs), []string{f.members[0].ID(), f.members[2].ID()}; !slices.Equal(got, want) {
+		t.Errorf("FindByIDs() = %v, want %v", got, want)
+	}
+
+	if !f.members[1].Deactivate() {
+		t.Fatal("Deactivate() = false, want true")
+	}
+	if err := b.Users.Update(ctx, f.members[1]); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	all, err := b.Users.FindByTeamName(ctx, f.team.Name())
+	if err != nil {
+		t.Fatalf("FindByTeamName() error = %v", err)
+	}
+	if got, want := userIDs(all), userIDs(f.members); !slices.Equal(got, want) {
+		t.Errorf("FindByTeamName() = %v, want %v", got, want)
+	}
+
+	active, err := b.Users.FindActiveByTeamName(ctx, f.team.Name())
+	if err != nil {
+		t.Fatalf("FindActiveByTeamName() error = %v", err)
+	}
+	if got, want := userIDs(active), []string{f.members[0].ID(), f.members[2].ID()}; !slices.Equal(got, want) {
+		t.Errorf("FindActiveByTeamName() = %v, want %v", got, want)
+	}
+
+	if err := b.Users.BatchDeactivateByTeamName(ctx, f.team.Name()); err != nil {
+		t.Fatalf("BatchDeactivateByTeamName() error = %v", err)
+	}
+	active, err = b.Users.FindActiveByTeamName(ctx, f.team.Name())
+	if err != nil {
+		t.Fatalf("FindActiveByTeamName() error = %v", err)
+	}
+	if len(active) != 0 {
+		t.Errorf("FindActiveByTeamName() after batch deactivation = %v, want empty", userIDs(active))
+	}
+}
+
+func testTeams(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	fallback := createTeam(t, b, ids, 1)
+	f := createTeam(t, b, ids, 2)
+
+	if err := f.team.ChangeFallbackTeams([]string{fallback.team.Name()}); err != nil {
+		t.Fatalf("ChangeFallbackTeams() error = %v", err)
+	}
+	if err := b.Teams.Update(ctx, f.team); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	found, err := b.Teams.FindByName(ctx, f.team.Name())
+	if err != nil {
+		t.Fatalf("FindByName() error = %v", err)
+	}
+	if got := found.FallbackTeams(); !slices.Equal(got, []string{fallback.team.Name()}) {
+		t.Errorf("FallbackTeams() = %v, want [%s]", got, fallback.team.Name())
+	}
+	if found.ReviewerLimits() != f.team.ReviewerLimits() {
+		t.Errorf("ReviewerLimits() = %v, want %v", found.ReviewerLimits(), f.team.ReviewerLimits())
+	}
+
+	if _, err := b.Teams.FindByName(ctx, ids.next("missing")); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("FindByName(missing) error = %v, want ErrNotFound", err)
+	}
+
+	exists, err := b.Teams.Exists(ctx, f.team.Name())
+	if err != nil || !exists {
+		t.Errorf("Exists() = %v, %v, want true, nil", exists, err)
+	}
+
+	if !fallback.members[0].Deactivate() {
+		t.Fatal("Deactivate() = false, want true")
+	}
+	if err := b.Users.Update(ctx, fallback.members[0]); err != nil {
+		t.Fatalf("Users.Update() error = %v", err)
+	}
+
+	counts, err := b.Teams.CountActiveMembers(ctx)
+	if err != nil {
+		t.Fatalf("CountActiveMembers() error = %v", err)
+	}
+	if got, ok := counts[f.team.Name()]; !ok || got != 2 {
+		t.Errorf("CountActiveMembers()[%s] = %d, %v, want 2, true", f.team.Name(), got, ok)
+	}
+	// Команда без активных участников тоже попадает в результат
+	if got, ok := counts[fallback.team.Name()]; !ok || got != 0 {
+		t.Errorf("CountActiveMembers()[%s] = %d, %v, want 0, true", fallback.team.Name(), got, ok)
+	}
+}
+
+func testPullRequests(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 3)
+	pr := createPullRequest(t, b, ids, f, f.members[1], f.members[2])
+
+	found := findPullRequest(t, b, pr.ID())
+	if found.Name() != pr.Name() || found.AuthorID() != pr.AuthorID() || found.Status() != entity.PRStatusOpen {
+		t.Errorf("FindByID() = %s/%s/%s, want %s/%s/%s", found.Name(), found.AuthorID(), found.Status(), pr.Name(), pr.AuthorID(), entity.PRStatusOpen)
+	}
+	assertReviewers(t, found, f.members[1].ID(), f.members[2].ID())
+
+	if _, err := b.PullRequests.FindByID(ctx, ids.next("missing")); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("FindByID(missing) error = %v, want ErrNotFound", err)
+	}
+
+	byReviewer, err := b.PullRequests.FindByReviewerID(ctx, f.members[1].ID())
+	if err != nil {
+		t.Fatalf("FindByReviewerID() error = %v", err)
+	}
+	if len(byReviewer) != 1 || byReviewer[0].ID() != pr.ID() {
+		t.Errorf("FindByReviewerID() returned %d PRs, want [%s]", len(byReviewer), pr.ID())
+	}
+
+	counts, err := b.PullRequests.CountActiveReviewsByUserIDs(ctx, userIDs(f.members))
+	if err != nil {
+		t.Fatalf("CountActiveReviewsByUserIDs() error = %v", err)
+	}
+	if counts[f.members[0].ID()] != 0 || counts[f.members[1].ID()] != 1 || counts[f.members[2].ID()] != 1 {
+		t.Errorf("CountActiveReviewsByUserIDs() = %v, want 0/1/1", counts)
+	}
+
+	if !found.Merge() {
+		t.Fatal("Merge() = false, want true")
+	}
+	if err := b.PullRequests.UpdateStatus(ctx, found); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+
+	merged := findPullRequest(t, b, pr.ID())
+	if merged.Status() != entity.PRStatusMerged || merged.MergedAt() == nil {
+		t.Errorf("after merge Status() = %s, MergedAt() = %v, want MERGED with time", merged.Status(), merged.MergedAt())
+	}
+
+	counts, err = b.PullRequests.CountActiveReviewsByUserIDs(ctx, []string{f.members[1].ID()})
+	if err != nil {
+		t.Fatalf("CountActiveReviewsByUserIDs() error = %v", err)
+	}
+	if counts[f.members[1].ID()] != 0 {
+		t.Errorf("CountActiveReviewsByUserIDs() after merge = %v, want 0", counts)
+	}
+}
+
+func testReviewers(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 4)
+	pr := createPullRequest(t, b, ids, f, f.members[1])
+
+	if err := b.PullRequests.AddReviewer(ctx, pr.ID(), f.members[2].ID(), false); err != nil {
+		t.Fatalf("AddReviewer() error = %v", err)
+	}
+
+	found := findPullRequest(t, b, pr.ID())
+	if err := found.SubmitReview(f.members[1].ID(), entity.ReviewStateApproved); err != nil {
+		t.Fatalf("SubmitReview() error = %v", err)
+	}
+	if err := b.PullRequests.SaveReview(ctx, pr.ID(), found.ReviewOf(f.members[1].ID())); err != nil {
+		t.Fatalf("SaveReview() error = %v", err)
+	}
+
+	found = findPullRequest(t, b, pr.ID())
+	if state := found.ReviewOf(f.members[1].ID()).State(); state != entity.ReviewStateApproved {
+		t.Errorf("ReviewOf().State() = %s, want %s", state, entity.ReviewStateApproved)
+	}
+
+	if err := b.PullRequests.ReplaceReviewer(ctx, pr.ID(), f.members[1].ID(), f.members[3].ID(), true); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+	found = findPullRequest(t, b, pr.ID())
+	assertReviewers(t, found, f.members[2].ID(), f.members[3].ID())
+	if state := found.ReviewOf(f.members[3].ID()).State(); state != entity.ReviewStatePending {
+		t.Errorf("replacement ReviewOf().State() = %s, want %s", state, entity.ReviewStatePending)
+	}
+	if !found.IsFallbackReviewer(f.members[3].ID()) {
+		t.Error("IsFallbackReviewer(fallback replacement) = false, want true")
+	}
+
+	if err := b.PullRequests.ReplaceReviewer(ctx, pr.ID(), f.members[3].ID(), f.members[1].ID(), false); err != nil {
+		t.Fatalf("ReplaceReviewer() error = %v", err)
+	}
+	found = findPullRequest(t, b, pr.ID())
+	assertReviewers(t, found, f.members[1].ID(), f.members[2].ID())
+	if found.IsFallbackReviewer(f.members[1].ID()) {
+		t.Error("IsFallbackReviewer(home team replacement) = true, want false")
+	}
+
+	if err := b.PullRequests.ReplaceReviewer(ctx, pr.ID(), f.members[3].ID(), f.members[0].ID(), false); err == nil {
+		t.Error("ReplaceReviewer(not assigned) error = nil, want error")
+	}
+
+	if err := b.PullRequests.RemoveReviewer(ctx, pr.ID(), f.members[2].ID()); err != nil {
+		t.Fatalf("RemoveReviewer() error = %v", err)
+	}
+	if err := b.PullRequests.RemoveReviewer(ctx, pr.ID(), f.members[2].ID()); err == nil {
+		t.Error("RemoveReviewer(already removed) error = nil, want error")
+	}
+	assertReviewers(t, findPullRequest(t, b, pr.ID()), f.members[1].ID())
+}
+
+func testReassignReviewers(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 4)
+	first := createPullRequest(t, b, ids, f, f.members[1], f.members[2])
+	second := createPullRequest(t, b, ids, f, f.members[1])
+
+	err := b.PullRequests.ReassignReviewers(ctx, []repository.ReviewerChange{
+		{PullRequestID: first.ID(), OldReviewerID: f.members[1].ID(), NewReviewerID: f.members[3].ID(), IsFallback: true},
+		{PullRequestID: second.ID(), OldReviewerID: f.members[1].ID()},
+	})
+	if err != nil {
+		t.Fatalf("ReassignReviewers() error = %v", err)
+	}
+	found := findPullRequest(t, b, first.ID())
+	assertReviewers(t, found, f.members[2].ID(), f.members[3].ID())
+	if !found.IsFallbackReviewer(f.members[3].ID()) {
+		t.Error("IsFallbackReviewer(fallback replacement) = false, want true")
+	}
+	assertReviewers(t, findPullRequest(t, b, second.ID()))
+
+	// Пачка с отсутствующим ревьювером внутри транзакции не применяется целиком
+	err = b.TxManager.Do(ctx, func(ctx context.Context) error {
+		return b.PullRequests.ReassignReviewers(ctx, []repository.ReviewerChange{
+			{PullRequestID: first.ID(), OldReviewerID: f.members[2].ID(), NewReviewerID: f.members[1].ID()},
+			{PullRequestID: second.ID(), OldReviewerID: f.members[2].ID(), NewReviewerID: f.members[3].ID()},
+		})
+	})
+	if err == nil {
+		t.Fatal("ReassignReviewers(missing reviewer) error = nil, want error")
+	}
+	assertReviewers(t, findPullRequest(t, b, first.ID()), f.members[2].ID(), f.members[3].ID())
+}
+
+func testTransactionCommit(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 1)
+
+	user, err := entity.NewUser(ids.next("user"), "committed", f.team.Name())
+	if err != nil {
+		t.Fatalf("NewUser() error = %v", err)
+	}
+	err = b.TxManager.Do(ctx, func(ctx context.Context) error {
+		if err := b.Users.Create(ctx, user); err != nil {
+			return err
+		}
+		// Внутри транзакции видны ее собственные изменения
+		exists, err := b.Users.Exists(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.New("created user is not visible inside transaction")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	if exists, _ := b.Users.Exists(ctx, user.ID()); !exists {
+		t.Error("user does not exist after commit")
+	}
+}
+
+func testTransactionRollback(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 3)
+	pr := createPullRequest(t, b, ids, f, f.members[1])
+
+	user, err := entity.NewUser(ids.next("user"), "rolled-back", f.team.Name())
+	if err != nil {
+		t.Fatalf("NewUser() error = %v", err)
+	}
+	errAbort := errors.New("abort")
+	err = b.TxManager.Do(ctx, func(ctx context.Context) error {
+		if err := b.Users.Create(ctx, user); err != nil {
+			return err
+		}
+		if err := b.PullRequests.ReplaceReviewer(ctx, pr.ID(), f.members[1].ID(), f.members[2].ID(), false); err != nil {
+			return err
+		}
+		if err := b.Users.BatchDeactivateByTeamName(ctx, f.team.Name()); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("Do() error = %v, want %v", err, errAbort)
+	}
+
+	if exists, _ := b.Users.Exists(ctx, user.ID()); exists {
+		t.Error("user created in rolled back transaction exists")
+	}
+	assertReviewers(t, findPullRequest(t, b, pr.ID()), f.members[1].ID())
+
+	active, err := b.Users.FindActiveByTeamName(ctx, f.team.Name())
+	if err != nil {
+		t.Fatalf("FindActiveByTeamName() error = %v", err)
+	}
+	if len(active) != len(f.members) {
+		t.Errorf("FindActiveByTeamName() = %d users after rollback, want %d", len(active), len(f.members))
+	}
+}
+
+func testNestedTransaction(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	f := createTeam(t, b, ids, 1)
+
+	user, err := entity.NewUser(ids.next("user"), "nested", f.team.Name())
+	if err != nil {
+		t.Fatalf("NewUser() error = %v", err)
+	}
+	errAbort := errors.New("abort")
+	err = b.TxManager.Do(ctx, func(ctx context.Context) error {
+		// Вложенный вызов присоединяется к внешней транзакции и откатывается вместе с ней
+		if err := b.TxManager.Do(ctx, func(ctx context.Context) error {
+			return b.Users.Create(ctx, user)
+		}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("Do() error = %v, want %v", err, errAbort)
+	}
+
+	if exists, _ := b.Users.Exists(ctx, user.ID()); exists {
+		t.Error("user created in nested transaction exists after outer rollback")
+	}
+}
+
+// testConcurrentReplaceReviewer повторяет сценарий ReassignReviewer: блокировка PR,
+// чтение текущего ревьювера и его замена. Без блокировки строки параллельные транзакции
+// прочитали бы одного и того же ревьювера и все, кроме одной, упали бы на замене
+func testConcurrentReplaceReviewer(t *testing.T, b Backend, ids *idGenerator) {
+	const workers = 5
+
+	ctx := context.Background()
+	f := createTeam(t, b, ids, workers+2)
+	pr := createPullRequest(t, b, ids, f, f.members[1])
+
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			newReviewer := f.members[i+2].ID()
+			errs[i] = b.TxManager.Do(ctx, func(ctx context.Context) error {
+				locked, err := b.PullRequests.FindByIDForUpdate(ctx, pr.ID())
+				if err != nil {
+					return err
+				}
+				current := locked.AssignedReviewers()
+				if len(current) != 1 {
+					return fmt.Errorf("expected one reviewer, got %v", current)
+				}
+				return b.PullRequests.ReplaceReviewer(ctx, pr.ID(), current[0], newReviewer, false)
+			})
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("worker %d error = %v", i, err)
+		}
+	}
+
+	reviewers := findPullRequest(t, b, pr.ID()).AssignedReviewers()
+	if len(reviewers) != 1 || reviewers[0] == f.members[1].ID() {
+		t.Errorf("AssignedReviewers() = %v, want one of the replacements", reviewers)
+	}
+}
+
+func testIdempotencyKeys(t *testing.T, b Backend, ids *idGenerator) {
+	ctx := context.Background()
+	scope := ids.next("scope")
+	key := ids.next("key")
+	// Секунды: PostgreSQL хранит время с точностью до микросекунд
+	now := time.Now().Truncate(time.Second)
+
+	newRecord := func(hash string, createdAt time.Time) *entity.IdempotencyRecord {
+		t.Helper()
+		record, err := entity.NewIdempotencyRecord(scope, key, hash, createdAt, time.Minute)
+		if err != nil {
+			t.Fatalf("NewIdempotencyRecord() error = %v", err)
+		}
+		return record
+	}
+
+	if _, err := b.Idempotency.Find(ctx, scope, key); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("Find() error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	if reserved, err := b.Idempotency.Reserve(ctx, newRecord("hash-1", now)); err != nil || !reserved {
+		t.Fatalf("Reserve() = %v, %v, want true", reserved, err)
+	}
+	// Действующий ключ не занимается повторно, даже с другим запросом
+	if reserved, err := b.Idempotency.Reserve(ctx, newRecord("hash-2", now.Add(30*time.Second))); err != nil || reserved {
+		t.Fatalf("Reserve() of taken key = %v, %v, want false", reserved, err)
+	}
+	// Запрос, не сохранивший ответ за время аренды, уступает ключ
+	record := newRecord("hash-2", now.Add(time.Minute))
+	if reserved, err := b.Idempotency.Reserve(ctx, record); err != nil || !reserved {
+		t.Fatalf("Reserve() of stale in-progress key = %v, %v, want true", reserved, err)
+	}
+
+	record.Complete(201, "application/json", []byte(`{"ok":true}`), now.Add(time.Hour))
+	if err := b.Idempotency.SaveResponse(ctx, record); err != nil {
+		t.Fatalf("SaveResponse() error = %v", err)
+	}
+
+	found, err := b.Idempotency.Find(ctx, scope, key)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if !found.Matches("hash-2") || found.StatusCode() != 201 || found.ContentType() != "application/json" ||
+		string(found.Body()) != `{"ok":true}` || !found.ExpiresAt().Equal(now.Add(time.Hour)) {
+		t.Errorf("Find() = %+v, want completed record with hash-2", found)
+	}
+
+	// Сохраненный ответ действует весь срок хранения, а не только аренду
+	if reserved, err := b.Idempotency.Reserve(ctx, newRecord("hash-3", now.Add(2*time.Minute))); err != nil || reserved {
+		t.Fatalf("Reserve() of completed key = %v, %v, want false", reserved, err)
+	}
+	// Сохраненный ответ не перезаписывается
+	if err := b.Idempotency.SaveResponse(ctx, record); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("SaveResponse() of completed key error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	// Истекший ключ занимается заново, сохраненный ответ сбрасывается
+	expiredAt := now.Add(2 * time.Hour)
+	if reserved, err := b.Idempotency.Reserve(ctx, newRecord("hash-3", expiredAt)); err != nil || !reserved {
+		t.Fatalf("Reserve() of expired key = %v, %v, want true", reserved, err)
+	}
+	found, err = b.Idempotency.Find(ctx, scope, key)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if !found.Matches("hash-3") || found.IsCompleted() {
+		t.Errorf("Find() after re-reserve = %+v, want in-progress record with hash-3", found)
+	}
+
+	if err := b.Idempotency.Delete(ctx, scope, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := b.Idempotency.SaveResponse(ctx, found); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("SaveResponse() of deleted key error = %v, want %v", err, repository.ErrNotFound)
+	}
+
+	// DeleteExpired удаляет только истекшие записи
+	expired := newRecord("hash-expired", now.Add(-2*time.Hour))
+	active, err := entity.NewIdempotencyRecord(scope, ids.next("key"), "hash-active", now, time.Hour)
+	if err != nil {
+		t.Fatalf("NewIdempotencyRecord() error = %v", err)
+	}
+	for _, r := range []*entity.IdempotencyRecord{expired, active} {
+		if _, err := b.Idempotency.Reserve(ctx, r); err != nil {
+			t.Fatalf("Reserve() error = %v", err)
+		}
+	}
+
+	deleted, err := b.Idempotency.DeleteExpired(ctx, now)
+	if err != nil {
+		t.Fatalf("DeleteExpired() error = %v", err)
+	}
+	if deleted < 1 {
+		t.Errorf("DeleteExpired() = %d, want at least 1", deleted)
+	}
+	if _, err := b.Idempotency.Find(ctx, scope, expired.Key()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Find() of expired key error = %v, want %v", err, repository.ErrNotFound)
+	}
+	if _, err := b.Idempotency.Find(ctx, scope, active.Key()); err != nil {
+		t.Errorf("Find() of active key error = %v", err)
+	}
+}
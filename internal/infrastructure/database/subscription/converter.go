@@ -0,0 +1,26 @@
+package subscription
+
+import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+
+func ToEntity(m *Model, eventTypes []entity.EventType) *entity.Subscription {
+	return entity.NewSubscriptionFromRepository(
+		m.ID,
+		m.URL,
+		m.Secret,
+		eventTypes,
+		m.IsActive,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+func FromEntity(s *entity.Subscription) *Model {
+	return &Model{
+		ID:        s.ID(),
+		URL:       s.URL(),
+		Secret:    s.Secret(),
+		IsActive:  s.IsActive(),
+		CreatedAt: s.CreatedAt(),
+		UpdatedAt: s.UpdatedAt(),
+	}
+}
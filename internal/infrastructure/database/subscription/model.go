@@ -0,0 +1,12 @@
+package subscription
+
+import "time"
+
+type Model struct {
+	ID        string    `db:"subscription_id"`
+	URL       string    `db:"url"`
+	Secret    string    `db:"secret"`
+	IsActive  bool      `db:"is_active"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
@@ -0,0 +1,296 @@
+package subscription
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.SubscriptionRepository = (*Repository)(nil)
+
+const eventTypeParamsCount = 2
+
+type Repository struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func NewRepository(db *sql.DB, getter *trmsql.CtxGetter) *Repository {
+	return &Repository{
+		db:     db,
+		getter: getter,
+	}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (r *Repository) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *Repository) Create(ctx context.Context, subscription *entity.Subscription) error {
+	model := FromEntity(subscription)
+
+	query := `
+		INSERT INTO event_subscriptions (subscription_id, url, secret, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.URL,
+		model.Secret,
+		model.IsActive,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	if err := r.insertEventTypes(ctx, subscription.ID(), subscription.EventTypes()); err != nil {
+		return fmt.Errorf("failed to insert event types: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id string) (*entity.Subscription, error) {
+	query := `
+		SELECT subscription_id, url, secret, is_active, created_at, updated_at
+		FROM event_subscriptions
+		WHERE subscription_id = $1
+	`
+
+	var model Model
+	err := r.getDB(ctx).QueryRowContext(ctx, query, id).Scan(
+		&model.ID,
+		&model.URL,
+		&model.Secret,
+		&model.IsActive,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find subscription: %w", err)
+	}
+
+	eventTypes, err := r.findEventTypes(ctx, model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event types: %w", err)
+	}
+
+	return ToEntity(&model, eventTypes), nil
+}
+
+func (r *Repository) FindAll(ctx context.Context) ([]*entity.Subscription, error) {
+	query := `
+		SELECT subscription_id, url, secret, is_active, created_at, updated_at
+		FROM event_subscriptions
+		ORDER BY created_at, subscription_id
+	`
+
+	return r.findSubscriptions(ctx, query)
+}
+
+func (r *Repository) FindActiveByEventType(ctx context.Context, eventType entity.EventType) ([]*entity.Subscription, error) {
+	query := `
+		SELECT s.subscription_id, s.url, s.secret, s.is_active, s.created_at, s.updated_at
+		FROM event_subscriptions s
+		JOIN event_subscription_types t ON t.subscription_id = s.subscription_id
+		WHERE s.is_active = TRUE AND t.event_type = $1
+		ORDER BY s.created_at, s.subscription_id
+	`
+
+	return r.findSubscriptions(ctx, query, string(eventType))
+}
+
+func (r *Repository) Update(ctx context.Context, subscription *entity.Subscription) error {
+	model := FromEntity(subscription)
+
+	query := `
+		UPDATE event_subscriptions
+		SET url = $2, secret = $3, is_active = $4, updated_at = $5
+		WHERE subscription_id = $1
+	`
+
+	result, err := r.getDB(ctx).ExecContext(
+		ctx,
+		query,
+		model.ID,
+		model.URL,
+		model.Secret,
+		model.IsActive,
+		model.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	if err := r.deleteEventTypes(ctx, subscription.ID()); err != nil {
+		return fmt.Errorf("failed to delete event types: %w", err)
+	}
+
+	if err := r.insertEventTypes(ctx, subscription.ID(), subscription.EventTypes()); err != nil {
+		return fmt.Errorf("failed to insert event types: %w", err)
+	}
+
+	return nil
+}
+
+// Delete удаляет подписку вместе с ее очередью доставок и dead letter (ON DELETE CASCADE)
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM event_subscriptions WHERE subscription_id = $1`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) findSubscriptions(ctx context.Context, query string, args ...interface{}) ([]*entity.Subscription, error) {
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subscriptions: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var models []Model
+	for rows.Next() {
+		var model Model
+		if err := rows.Scan(
+			&model.ID,
+			&model.URL,
+			&model.Secret,
+			&model.IsActive,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+		models = append(models, model)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	subscriptions := make([]*entity.Subscription, 0, len(models))
+	for i := range models {
+		eventTypes, err := r.findEventTypes(ctx, models[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find event types: %w", err)
+		}
+		subscriptions = append(subscriptions, ToEntity(&models[i], eventTypes))
+	}
+
+	return subscriptions, nil
+}
+
+// findEventTypes возвращает типы событий подписки в порядке добавления
+func (r *Repository) findEventTypes(ctx context.Context, subscriptionID string) ([]entity.EventType, error) {
+	query := `
+		SELECT event_type
+		FROM event_subscription_types
+		WHERE subscription_id = $1
+		ORDER BY position
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event types: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var eventTypes []entity.EventType
+	for rows.Next() {
+		var eventType string
+		if err := rows.Scan(&eventType); err != nil {
+			return nil, fmt.Errorf("failed to scan event type: %w", err)
+		}
+		eventTypes = append(eventTypes, entity.EventType(eventType))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return eventTypes, nil
+}
+
+func (r *Repository) insertEventTypes(ctx context.Context, subscriptionID string, eventTypes []entity.EventType) error {
+	if len(eventTypes) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(eventTypes))
+	valueArgs := make([]interface{}, 0, len(eventTypes)*eventTypeParamsCount+1)
+	valueArgs = append(valueArgs, subscriptionID)
+	for i, eventType := range eventTypes {
+		paramOffset := i*eventTypeParamsCount + 1
+		valueStrings = append(valueStrings, fmt.Sprintf("($1, $%d, $%d)", paramOffset+1, paramOffset+2))
+		valueArgs = append(valueArgs, string(eventType), i)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO event_subscription_types (subscription_id, event_type, position)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to insert event types: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) deleteEventTypes(ctx context.Context, subscriptionID string) error {
+	query := `DELETE FROM event_subscription_types WHERE subscription_id = $1`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, subscriptionID); err != nil {
+		return fmt.Errorf("failed to delete event types: %w", err)
+	}
+
+	return nil
+}
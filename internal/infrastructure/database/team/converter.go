@@ -2,9 +2,13 @@ package team
 
 import "github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 
-func ToEntity(m *Model) *entity.Team {
+func ToEntity(m *Model, fallbackTeams, codeOwners []string) *entity.Team {
 	return entity.NewTeamFromRepository(
 		m.Name,
+		entity.ReviewerStrategyName(m.ReviewerStrategy),
+		entity.NewReviewerLimitsFromRepository(m.MinReviewers, m.MaxReviewers),
+		fallbackTeams,
+		entity.NewMergePolicyFromRepository(m.RequiredApprovals, m.BlockOnChangesRequested, codeOwners),
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -12,8 +16,14 @@ func ToEntity(m *Model) *entity.Team {
 
 func FromEntity(t *entity.Team) *Model {
 	return &Model{
-		Name:      t.Name(),
-		CreatedAt: t.CreatedAt(),
-		UpdatedAt: t.UpdatedAt(),
+		Name:             t.Name(),
+		ReviewerStrategy: string(t.ReviewerStrategy()),
+		MinReviewers:     t.ReviewerLimits().Min(),
+		MaxReviewers:     t.ReviewerLimits().Max(),
+
+		RequiredApprovals:       t.MergePolicy().RequiredApprovals(),
+		BlockOnChangesRequested: t.MergePolicy().BlockOnChangesRequested(),
+		CreatedAt:               t.CreatedAt(),
+		UpdatedAt:               t.UpdatedAt(),
 	}
 }
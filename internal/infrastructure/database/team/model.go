@@ -3,7 +3,12 @@ package team
 import "time"
 
 type Model struct {
-	Name      string    `db:"team_name"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	Name                    string    `db:"team_name"`
+	ReviewerStrategy        string    `db:"reviewer_strategy"`
+	MinReviewers            int       `db:"min_reviewers"`
+	MaxReviewers            int       `db:"max_reviewers"`
+	RequiredApprovals       int       `db:"required_approvals"`
+	BlockOnChangesRequested bool      `db:"block_on_changes_requested"`
+	CreatedAt               time.Time `db:"created_at"`
+	UpdatedAt               time.Time `db:"updated_at"`
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
 
@@ -14,6 +15,11 @@ import (
 
 var _ repository.TeamRepository = (*Repository)(nil)
 
+const (
+	fallbackTeamParamsCount = 3
+	codeOwnerParamsCount    = 2
+)
+
 type Repository struct {
 	db     *sql.DB
 	getter *trmsql.CtxGetter
@@ -39,14 +45,22 @@ func (r *Repository) Create(ctx context.Context, team *entity.Team) error {
 	model := FromEntity(team)
 
 	query := `
-		INSERT INTO teams (team_name, created_at, updated_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO teams (
+			team_name, reviewer_strategy, min_reviewers, max_reviewers,
+			required_approvals, block_on_changes_requested, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
 	_, err := r.getDB(ctx).ExecContext(
 		ctx,
 		query,
 		model.Name,
+		model.ReviewerStrategy,
+		model.MinReviewers,
+		model.MaxReviewers,
+		model.RequiredApprovals,
+		model.BlockOnChangesRequested,
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
@@ -54,12 +68,21 @@ func (r *Repository) Create(ctx context.Context, team *entity.Team) error {
 		return fmt.Errorf("failed to create team: %w", err)
 	}
 
+	if err := r.insertFallbackTeams(ctx, team.Name(), team.FallbackTeams()); err != nil {
+		return fmt.Errorf("failed to insert fallback teams: %w", err)
+	}
+
+	if err := r.insertCodeOwners(ctx, team.Name(), team.MergePolicy().CodeOwners()); err != nil {
+		return fmt.Errorf("failed to insert code owners: %w", err)
+	}
+
 	return nil
 }
 
 func (r *Repository) FindByName(ctx context.Context, name string) (*entity.Team, error) {
 	query := `
-		SELECT team_name, created_at, updated_at
+		SELECT team_name, reviewer_strategy, min_reviewers, max_reviewers,
+			required_approvals, block_on_changes_requested, created_at, updated_at
 		FROM teams
 		WHERE team_name = $1
 	`
@@ -67,6 +90,11 @@ func (r *Repository) FindByName(ctx context.Context, name string) (*entity.Team,
 	var model Model
 	err := r.getDB(ctx).QueryRowContext(ctx, query, name).Scan(
 		&model.Name,
+		&model.ReviewerStrategy,
+		&model.MinReviewers,
+		&model.MaxReviewers,
+		&model.RequiredApprovals,
+		&model.BlockOnChangesRequested,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 	)
@@ -77,7 +105,17 @@ func (r *Repository) FindByName(ctx context.Context, name string) (*entity.Team,
 		return nil, fmt.Errorf("failed to find team: %w", err)
 	}
 
-	return ToEntity(&model), nil
+	fallbackTeams, err := r.findFallbackTeams(ctx, model.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fallback teams: %w", err)
+	}
+
+	codeOwners, err := r.findCodeOwners(ctx, model.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find code owners: %w", err)
+	}
+
+	return ToEntity(&model, fallbackTeams, codeOwners), nil
 }
 
 func (r *Repository) Update(ctx context.Context, team *entity.Team) error {
@@ -85,7 +123,8 @@ func (r *Repository) Update(ctx context.Context, team *entity.Team) error {
 
 	query := `
 		UPDATE teams
-		SET updated_at = $2
+		SET reviewer_strategy = $2, min_reviewers = $3, max_reviewers = $4,
+			required_approvals = $5, block_on_changes_requested = $6, updated_at = $7
 		WHERE team_name = $1
 	`
 
@@ -93,6 +132,11 @@ func (r *Repository) Update(ctx context.Context, team *entity.Team) error {
 		ctx,
 		query,
 		model.Name,
+		model.ReviewerStrategy,
+		model.MinReviewers,
+		model.MaxReviewers,
+		model.RequiredApprovals,
+		model.BlockOnChangesRequested,
 		model.UpdatedAt,
 	)
 	if err != nil {
@@ -108,6 +152,22 @@ func (r *Repository) Update(ctx context.Context, team *entity.Team) error {
 		return fmt.Errorf("team not found: %s", model.Name)
 	}
 
+	if err := r.deleteFallbackTeams(ctx, team.Name()); err != nil {
+		return fmt.Errorf("failed to delete fallback teams: %w", err)
+	}
+
+	if err := r.insertFallbackTeams(ctx, team.Name(), team.FallbackTeams()); err != nil {
+		return fmt.Errorf("failed to insert fallback teams: %w", err)
+	}
+
+	if err := r.deleteCodeOwners(ctx, team.Name()); err != nil {
+		return fmt.Errorf("failed to delete code owners: %w", err)
+	}
+
+	if err := r.insertCodeOwners(ctx, team.Name(), team.MergePolicy().CodeOwners()); err != nil {
+		return fmt.Errorf("failed to insert code owners: %w", err)
+	}
+
 	return nil
 }
 
@@ -142,3 +202,177 @@ func (r *Repository) Exists(ctx context.Context, name string) (bool, error) {
 
 	return exists, nil
 }
+
+// CountActiveMembers возвращает число активных участников каждой команды, включая команды без активных
+func (r *Repository) CountActiveMembers(ctx context.Context) (map[string]int, error) {
+	query := `
+		SELECT t.team_name, COUNT(u.user_id)
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.team_name AND u.is_active = TRUE
+		GROUP BY t.team_name
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count active team members: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var teamName string
+		var count int
+		if err := rows.Scan(&teamName, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan active team members: %w", err)
+		}
+		counts[teamName] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// findFallbackTeams возвращает запасные команды в порядке приоритета
+func (r *Repository) findFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	query := `
+		SELECT fallback_team_name
+		FROM team_fallback_teams
+		WHERE team_name = $1
+		ORDER BY priority
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fallback teams: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var fallbackTeams []string
+	for rows.Next() {
+		var fallbackTeam string
+		if err := rows.Scan(&fallbackTeam); err != nil {
+			return nil, fmt.Errorf("failed to scan fallback team: %w", err)
+		}
+		fallbackTeams = append(fallbackTeams, fallbackTeam)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return fallbackTeams, nil
+}
+
+func (r *Repository) insertFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(fallbackTeams))
+	valueArgs := make([]interface{}, 0, len(fallbackTeams)*fallbackTeamParamsCount)
+	for i, fallbackTeam := range fallbackTeams {
+		paramOffset := i * fallbackTeamParamsCount
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d)", paramOffset+1, paramOffset+2, paramOffset+3))
+		valueArgs = append(valueArgs, teamName, fallbackTeam, i)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO team_fallback_teams (team_name, fallback_team_name, priority)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to insert fallback teams: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) deleteFallbackTeams(ctx context.Context, teamName string) error {
+	query := `DELETE FROM team_fallback_teams WHERE team_name = $1`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, teamName); err != nil {
+		return fmt.Errorf("failed to delete fallback teams: %w", err)
+	}
+
+	return nil
+}
+
+// findCodeOwners возвращает владельцев кода команды в порядке добавления
+func (r *Repository) findCodeOwners(ctx context.Context, teamName string) ([]string, error) {
+	query := `
+		SELECT user_id
+		FROM team_code_owners
+		WHERE team_name = $1
+		ORDER BY position
+	`
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query code owners: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	var codeOwners []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan code owner: %w", err)
+		}
+		codeOwners = append(codeOwners, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return codeOwners, nil
+}
+
+func (r *Repository) insertCodeOwners(ctx context.Context, teamName string, codeOwners []string) error {
+	if len(codeOwners) == 0 {
+		return nil
+	}
+
+	valueStrings := make([]string, 0, len(codeOwners))
+	valueArgs := make([]interface{}, 0, len(codeOwners)*codeOwnerParamsCount+1)
+	valueArgs = append(valueArgs, teamName)
+	for i, userID := range codeOwners {
+		paramOffset := i*codeOwnerParamsCount + 1
+		valueStrings = append(valueStrings, fmt.Sprintf("($1, $%d, $%d)", paramOffset+1, paramOffset+2))
+		valueArgs = append(valueArgs, userID, i)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO team_code_owners (team_name, user_id, position)
+		VALUES %s
+	`, strings.Join(valueStrings, ","))
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, valueArgs...); err != nil {
+		return fmt.Errorf("failed to insert code owners: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) deleteCodeOwners(ctx context.Context, teamName string) error {
+	query := `DELETE FROM team_code_owners WHERE team_name = $1`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, teamName); err != nil {
+		return fmt.Errorf("failed to delete code owners: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
 
@@ -86,6 +87,38 @@ func (r *Repository) FindByID(ctx context.Context, id string) (*entity.User, err
 	return ToEntity(&model), nil
 }
 
+// FindByIDs находит пользователей по списку ID одним запросом (отсутствующие ID пропускаются)
+func (r *Repository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`
+		SELECT user_id, username, team_name, is_active, created_at, updated_at
+		FROM users
+		WHERE user_id IN (%s)
+		ORDER BY user_id
+	`, strings.Join(placeholders, ","))
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users by ids: %w", err)
+	}
+	//nolint:gosec
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	return r.scanUsersFromRows(rows)
+}
+
 func (r *Repository) FindByTeamName(ctx context.Context, teamName string) ([]*entity.User, error) {
 	query := `
 		SELECT user_id, username, team_name, is_active, created_at, updated_at
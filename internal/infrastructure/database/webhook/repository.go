@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	trmsql "github.com/avito-tech/go-transaction-manager/drivers/sql/v2"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ repository.WebhookRepository = (*Repository)(nil)
+
+type Repository struct {
+	db     *sql.DB
+	getter *trmsql.CtxGetter
+}
+
+func NewRepository(db *sql.DB, getter *trmsql.CtxGetter) *Repository {
+	return &Repository{
+		db:     db,
+		getter: getter,
+	}
+}
+
+// getDB возвращает *sql.DB или *sql.Tx в зависимости от контекста
+func (r *Repository) getDB(ctx context.Context) interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	return r.getter.DefaultTrOrDB(ctx, r.db)
+}
+
+func (r *Repository) FindUserIDByLogin(ctx context.Context, provider entity.GitProvider, login string) (string, error) {
+	query := `
+		SELECT user_id
+		FROM git_user_logins
+		WHERE provider = $1 AND login = $2
+	`
+
+	var userID string
+	if err := r.getDB(ctx).QueryRowContext(ctx, query, string(provider), login).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", repository.ErrNotFound
+		}
+		return "", fmt.Errorf("failed to find git login: %w", err)
+	}
+
+	return userID, nil
+}
+
+func (r *Repository) SaveLogin(ctx context.Context, provider entity.GitProvider, login, userID string) error {
+	query := `
+		INSERT INTO git_user_logins (provider, login, user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, NOW(), NOW())
+		ON CONFLICT (provider, login) DO UPDATE
+		SET user_id = EXCLUDED.user_id, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.getDB(ctx).ExecContext(ctx, query, string(provider), login, userID); err != nil {
+		return fmt.Errorf("failed to save git login: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterDelivery вставляет доставку с ON CONFLICT DO NOTHING.
+// Параллельная вставка той же доставки ждет коммита первой транзакции и получает false
+func (r *Repository) RegisterDelivery(ctx context.Context, provider entity.GitProvider, deliveryID string) (bool, error) {
+	query := `
+		INSERT INTO webhook_deliveries (provider, delivery_id, received_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (provider, delivery_id) DO NOTHING
+	`
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, string(provider), deliveryID)
+	if err != nil {
+		return false, fmt.Errorf("failed to register webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,112 @@
+// Package health проверки зависимостей для пробы готовности /readyz
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/migration"
+)
+
+// ErrPoolSaturated все соединения пула заняты, и запросы ждут свободного
+var ErrPoolSaturated = errors.New("connection pool is saturated")
+
+// DatabaseCheck проверяет, что база отвечает на ping
+type DatabaseCheck struct {
+	db *sql.DB
+}
+
+// NewDatabaseCheck создает новый DatabaseCheck
+func NewDatabaseCheck(db *sql.DB) *DatabaseCheck {
+	return &DatabaseCheck{db: db}
+}
+
+func (c *DatabaseCheck) Name() string {
+	return "database"
+}
+
+func (c *DatabaseCheck) Check(ctx context.Context) (map[string]any, error) {
+	if err := c.db.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return nil, nil
+}
+
+// SchemaCheck проверяет, что версия схемы базы совпадает с ожидаемой бинарником.
+// Схема могла разойтись после старта, например при откате миграций соседним релизом
+type SchemaCheck struct {
+	db       *sql.DB
+	expected uint
+}
+
+// NewSchemaCheck создает новый SchemaCheck. expected - версия последней встроенной миграции
+func NewSchemaCheck(db *sql.DB, expected uint) *SchemaCheck {
+	return &SchemaCheck{db: db, expected: expected}
+}
+
+func (c *SchemaCheck) Name() string {
+	return "schema"
+}
+
+func (c *SchemaCheck) Check(ctx context.Context) (map[string]any, error) {
+	status, err := migration.ReadStatus(ctx, c.db, c.expected)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]any{
+		"version":  status.Version,
+		"expected": status.Expected,
+	}
+	return details, status.Check()
+}
+
+// PoolCheck сообщает занятость пула соединений. Пул считается исчерпанным, если заняты
+// все соединения и со времени прошлой проверки появились запросы, ждавшие соединения.
+// Одни лишь занятые соединения - нормальная нагрузка, а не отказ
+type PoolCheck struct {
+	db *sql.DB
+
+	mu            sync.Mutex
+	lastWaitCount int64
+}
+
+// NewPoolCheck создает новый PoolCheck
+func NewPoolCheck(db *sql.DB) *PoolCheck {
+	return &PoolCheck{
+		db:            db,
+		lastWaitCount: db.Stats().WaitCount,
+	}
+}
+
+func (c *PoolCheck) Name() string {
+	return "connection_pool"
+}
+
+func (c *PoolCheck) Check(context.Context) (map[string]any, error) {
+	stats := c.db.Stats()
+
+	c.mu.Lock()
+	waited := stats.WaitCount - c.lastWaitCount
+	c.lastWaitCount = stats.WaitCount
+	c.mu.Unlock()
+
+	details := map[string]any{
+		"in_use":           stats.InUse,
+		"idle":             stats.Idle,
+		"open":             stats.OpenConnections,
+		"max_open":         stats.MaxOpenConnections,
+		"wait_count":       stats.WaitCount,
+		"wait_duration_ms": stats.WaitDuration.Milliseconds(),
+	}
+
+	// Без ограничения пула (max_open = 0) исчерпать его нельзя
+	if stats.MaxOpenConnections > 0 && stats.InUse >= stats.MaxOpenConnections && waited > 0 {
+		return details, fmt.Errorf("%w: %d of %d connections in use, %d requests waited since last check",
+			ErrPoolSaturated, stats.InUse, stats.MaxOpenConnections, waited)
+	}
+	return details, nil
+}
@@ -0,0 +1,124 @@
+package health_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/migration"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/sqlite"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/health"
+)
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sqlite.NewSQLiteDB(config.SQLiteConfig{
+		Path:        filepath.Join(t.TempDir(), "test.db"),
+		BusyTimeout: config.DefaultSQLiteBusyTimeout,
+	})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db.DB()
+}
+
+func TestDatabaseCheck(t *testing.T) {
+	db := openDB(t)
+	check := health.NewDatabaseCheck(db)
+
+	if _, err := check.Check(context.Background()); err != nil {
+		t.Fatalf("expected ping to succeed, got %v", err)
+	}
+
+	_ = db.Close()
+	if _, err := check.Check(context.Background()); err == nil {
+		t.Error("expected error for closed database")
+	}
+}
+
+func TestSchemaCheck(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+
+	expected, err := migration.ExpectedVersion(sqlite.Migrations())
+	if err != nil {
+		t.Fatalf("ExpectedVersion failed: %v", err)
+	}
+	check := health.NewSchemaCheck(db, expected)
+
+	if _, err := check.Check(ctx); err == nil {
+		t.Error("expected error before migrations")
+	}
+
+	m, err := migration.NewSQLite(db, sqlite.Migrations())
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	t.Cleanup(func() { _ = m.Close() })
+	if err := m.Up(); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+
+	details, err := check.Check(ctx)
+	if err != nil {
+		t.Fatalf("expected schema to match, got %v", err)
+	}
+	if details["version"] != expected {
+		t.Errorf("expected version %d in details, got %v", expected, details["version"])
+	}
+
+	if err := m.Down(1); err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+	if _, err := check.Check(ctx); !errors.Is(err, migration.ErrSchemaMismatch) {
+		t.Errorf("expected ErrSchemaMismatch after rollback, got %v", err)
+	}
+}
+
+func TestPoolCheck(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	db.SetMaxOpenConns(1)
+	check := health.NewPoolCheck(db)
+
+	details, err := check.Check(ctx)
+	if err != nil {
+		t.Fatalf("expected idle pool to be healthy, got %v", err)
+	}
+	if details["max_open"] != 1 {
+		t.Errorf("expected max_open 1, got %v", details["max_open"])
+	}
+
+	// Единственное соединение занято: это нагрузка, но еще не исчерпание
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("failed to get connection: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := check.Check(ctx); err != nil {
+		t.Fatalf("expected busy pool without waiters to be healthy, got %v", err)
+	}
+
+	// Запрос ждет соединения и уходит по таймауту
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := db.Conn(waitCtx); err == nil {
+		t.Fatal("expected waiting for connection to time out")
+	}
+
+	if _, err := check.Check(ctx); !errors.Is(err, health.ErrPoolSaturated) {
+		t.Errorf("expected ErrPoolSaturated, got %v", err)
+	}
+	// Новых ожиданий не было
+	if _, err := check.Check(ctx); err != nil {
+		t.Errorf("expected pool to recover without new waiters, got %v", err)
+	}
+}
@@ -1,6 +1,12 @@
 package logger
 
-import "context"
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+)
 
 type contextKey string
 
@@ -22,18 +28,40 @@ func GetRequestID(ctx context.Context) string {
 	return ""
 }
 
-// GetUserID извлекает user ID из контекста
+// WithPrincipal добавляет аутентифицированного субъекта в контекст под ключом UserIDKey
+func WithPrincipal(ctx context.Context, principal *entity.Principal) context.Context {
+	return context.WithValue(ctx, UserIDKey, principal)
+}
+
+// GetPrincipal извлекает аутентифицированного субъекта из контекста
+func GetPrincipal(ctx context.Context) (*entity.Principal, bool) {
+	principal, ok := ctx.Value(UserIDKey).(*entity.Principal)
+	return principal, ok && principal != nil
+}
+
+// GetUserID извлекает user ID из контекста: идентификатор субъекта или строку под ключом UserIDKey
 func GetUserID(ctx context.Context) string {
+	if principal, ok := GetPrincipal(ctx); ok {
+		return principal.ID()
+	}
 	if id, ok := ctx.Value(UserIDKey).(string); ok {
 		return id
 	}
 	return ""
 }
 
-// ExtractFields извлекает поля для логирования из контекста
+// ExtractFields извлекает поля для логирования из контекста. trace_id и span_id
+// связывают строку лога с трассировкой запроса
 func ExtractFields(ctx context.Context) []any {
 	var fields []any
 
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields,
+			"trace_id", spanContext.TraceID().String(),
+			"span_id", spanContext.SpanID().String(),
+		)
+	}
+
 	if requestID := GetRequestID(ctx); requestID != "" {
 		fields = append(fields, "request_id", requestID)
 	}
@@ -0,0 +1,32 @@
+package logger
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExtractFields_Trace(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	ctx = WithRequestID(ctx, "req-1")
+
+	want := []any{
+		"trace_id", "4bf92f3577b34da6a3ce929d0e0e4736",
+		"span_id", "00f067aa0ba902b7",
+		"request_id", "req-1",
+	}
+	if got := ExtractFields(ctx); !slices.Equal(got, want) {
+		t.Errorf("ExtractFields() = %v, want %v", got, want)
+	}
+
+	if got := ExtractFields(WithRequestID(context.Background(), "req-1")); !slices.Equal(got, []any{"request_id", "req-1"}) {
+		t.Errorf("ExtractFields() without span = %v, want only request_id", got)
+	}
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+// businessScrapeTimeout сколько ждать запросов к хранилищу при сборе бизнес-метрик
+const businessScrapeTimeout = 5 * time.Second
+
+// businessCollector считает состояние PR и команд из хранилища в момент сбора метрик.
+// Значения не кешируются: между сборами они могут меняться в других экземплярах сервиса
+type businessCollector struct {
+	pullRequests repository.PullRequestRepository
+	teams        repository.TeamRepository
+
+	pullRequestsDesc    *prometheus.Desc
+	activeReviewersDesc *prometheus.Desc
+}
+
+// RegisterBusinessMetrics добавляет метрики количества PR по статусам
+// и активных ревьюверов по командам
+func (m *Metrics) RegisterBusinessMetrics(pullRequests repository.PullRequestRepository, teams repository.TeamRepository) error {
+	return m.Register(&businessCollector{
+		pullRequests: pullRequests,
+		teams:        teams,
+		pullRequestsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", "pull_requests"),
+			"Pull requests by status.",
+			[]string{"status"}, nil,
+		),
+		activeReviewersDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", "team_active_reviewers"),
+			"Active team members available for review.",
+			[]string{"team"}, nil,
+		),
+	})
+}
+
+func (c *businessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.pullRequestsDesc
+	ch <- c.activeReviewersDesc
+}
+
+func (c *businessCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), businessScrapeTimeout)
+	defer cancel()
+
+	stats, err := c.pullRequests.GetStats(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.pullRequestsDesc, err)
+	} else {
+		for status, count := range map[string]int{
+			"draft":  stats.Draft,
+			"open":   stats.Open,
+			"merged": stats.Merged,
+			"closed": stats.Closed,
+		} {
+			ch <- prometheus.MustNewConstMetric(c.pullRequestsDesc, prometheus.GaugeValue, float64(count), status)
+		}
+	}
+
+	counts, err := c.teams.CountActiveMembers(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.activeReviewersDesc, err)
+		return
+	}
+	for team, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.activeReviewersDesc, prometheus.GaugeValue, float64(count), team)
+	}
+}
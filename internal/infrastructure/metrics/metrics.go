@@ -0,0 +1,114 @@
+// Package metrics метрики сервиса в формате Prometheus
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	domainMetrics "github.com/exPriceD/pr-reviewer-service/internal/domain/metrics"
+)
+
+// Namespace префикс метрик сервиса
+const Namespace = "pr_reviewer"
+
+// latencyBuckets границы гистограмм длительности в секундах. Граница 0.3 соответствует
+// SLI времени ответа 300 мс
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.2, 0.3, 0.5, 1, 2.5, 5}
+
+var _ domainMetrics.Recorder = (*Metrics)(nil)
+
+// Metrics собственный реестр метрик сервиса. Реестр не глобальный,
+// чтобы несколько экземпляров (например, в тестах) не конфликтовали
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests        *prometheus.CounterVec
+	httpRequestDuration *prometheus.HistogramVec
+	transactionDuration *prometheus.HistogramVec
+	reviewerAssignments *prometheus.CounterVec
+	reassignNoCandidate prometheus.Counter
+}
+
+// New создает реестр с метриками сервиса, рантайма Go и процесса
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, chi route pattern and status code.",
+		}, []string{"method", "route", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and chi route pattern.",
+			Buckets:   latencyBuckets,
+		}, []string{"method", "route"}),
+		transactionDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "transaction_duration_seconds",
+			Help:      "Duration of top-level storage transactions by outcome (commit or rollback).",
+			Buckets:   latencyBuckets,
+		}, []string{"outcome"}),
+		reviewerAssignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "reviewer_assignments_total",
+			Help:      "Pull requests opened with automatic assignment by number of assigned reviewers.",
+		}, []string{"reviewers"}),
+		reassignNoCandidate: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "reviewer_reassign_no_candidate_total",
+			Help:      "Reviewer reassignments that failed because no active candidate was found.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.transactionDuration,
+		m.reviewerAssignments,
+		m.reassignNoCandidate,
+	)
+
+	return m
+}
+
+// Handler отдает метрики реестра для /metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Register добавляет в реестр дополнительный сборщик
+func (m *Metrics) Register(collector prometheus.Collector) error {
+	return m.registry.Register(collector)
+}
+
+// RegisterDB добавляет статистику пула соединений sql.DB с меткой db_name
+func (m *Metrics) RegisterDB(db *sql.DB, name string) error {
+	return m.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// ObserveHTTPRequest учитывает завершенный HTTP запрос. route - шаблон маршрута chi,
+// а не путь запроса, чтобы число серий не зависело от параметров
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpRequestDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// ReviewersAssigned учитывает PR, открытый с count автоматически назначенными ревьюверами
+func (m *Metrics) ReviewersAssigned(count int) {
+	m.reviewerAssignments.WithLabelValues(strconv.Itoa(count)).Inc()
+}
+
+// ReassignNoCandidate учитывает переназначение, не нашедшее замену
+func (m *Metrics) ReassignNoCandidate() {
+	m.reassignNoCandidate.Inc()
+}
@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repomocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+)
+
+// immediateManager менеджер транзакций без хранилища: просто вызывает fn
+type immediateManager struct{}
+
+func (immediateManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// scrape возвращает ответ /metrics, как его видит Prometheus
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 from /metrics, got %d", w.Code)
+	}
+	return w.Body.String()
+}
+
+func TestMetrics_HTTPRequests(t *testing.T) {
+	m := New()
+
+	m.ObserveHTTPRequest(http.MethodGet, "/team/get", http.StatusOK, 120*time.Millisecond)
+	m.ObserveHTTPRequest(http.MethodGet, "/team/get", http.StatusOK, 400*time.Millisecond)
+	m.ObserveHTTPRequest(http.MethodGet, "/team/get", http.StatusNotFound, 10*time.Millisecond)
+
+	if got := testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/team/get", "200")); got != 2 {
+		t.Errorf("expected 2 requests with status 200, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m.httpRequestDuration); got != 1 {
+		t.Errorf("expected one latency series per route, got %d", got)
+	}
+
+	// Бакет 0.3 нужен для SLI 300 мс: два запроса из трех в него укладываются
+	want := `pr_reviewer_http_request_duration_seconds_bucket{method="GET",route="/team/get",le="0.3"} 2`
+	if !strings.Contains(scrape(t, m), want) {
+		t.Errorf("expected %q in /metrics output", want)
+	}
+}
+
+func TestMetrics_ReviewerAssignments(t *testing.T) {
+	m := New()
+
+	m.ReviewersAssigned(2)
+	m.ReviewersAssigned(2)
+	m.ReviewersAssigned(0)
+	m.ReassignNoCandidate()
+
+	if got := testutil.ToFloat64(m.reviewerAssignments.WithLabelValues("2")); got != 2 {
+		t.Errorf("expected 2 assignments with 2 reviewers, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.reviewerAssignments.WithLabelValues("0")); got != 1 {
+		t.Errorf("expected 1 assignment without reviewers, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.reassignNoCandidate); got != 1 {
+		t.Errorf("expected 1 reassignment without candidate, got %v", got)
+	}
+}
+
+func TestMetrics_InstrumentTransactions(t *testing.T) {
+	m := New()
+	txManager := m.InstrumentTransactions(immediateManager{})
+	errFailed := errors.New("failed")
+
+	err := txManager.Do(context.Background(), func(ctx context.Context) error {
+		// Вложенная транзакция не учитывается отдельно
+		return txManager.Do(ctx, func(context.Context) error { return nil })
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if err := txManager.Do(context.Background(), func(context.Context) error { return errFailed }); !errors.Is(err, errFailed) {
+		t.Fatalf("Do() error = %v, want %v", err, errFailed)
+	}
+
+	if got := testutil.CollectAndCount(m.transactionDuration); got != 2 {
+		t.Fatalf("expected commit and rollback series, got %d", got)
+	}
+
+	output := scrape(t, m)
+	for _, outcome := range []string{outcomeCommit, outcomeRollback} {
+		want := `pr_reviewer_transaction_duration_seconds_count{outcome="` + outcome + `"} 1`
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in /metrics output", want)
+		}
+	}
+}
+
+func TestMetrics_BusinessMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pullRequests := repomocks.NewMockPullRequestRepository(ctrl)
+	teams := repomocks.NewMockTeamRepository(ctrl)
+
+	pullRequests.EXPECT().GetStats(gomock.Any()).Return(repository.PRStats{Total: 6, Draft: 1, Open: 3, Merged: 2}, nil)
+	teams.EXPECT().CountActiveMembers(gomock.Any()).Return(map[string]int{"backend": 4, "frontend": 0}, nil)
+
+	m := New()
+	if err := m.RegisterBusinessMetrics(pullRequests, teams); err != nil {
+		t.Fatalf("RegisterBusinessMetrics() error = %v", err)
+	}
+
+	output := scrape(t, m)
+	for _, want := range []string{
+		`pr_reviewer_pull_requests{status="open"} 3`,
+		`pr_reviewer_pull_requests{status="closed"} 0`,
+		`pr_reviewer_team_active_reviewers{team="backend"} 4`,
+		`pr_reviewer_team_active_reviewers{team="frontend"} 0`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in /metrics output", want)
+		}
+	}
+}
+
+func TestMetrics_BusinessMetricsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pullRequests := repomocks.NewMockPullRequestRepository(ctrl)
+	teams := repomocks.NewMockTeamRepository(ctrl)
+
+	pullRequests.EXPECT().GetStats(gomock.Any()).Return(repository.PRStats{}, errors.New("connection refused"))
+	teams.EXPECT().CountActiveMembers(gomock.Any()).Return(map[string]int{"backend": 4}, nil)
+
+	m := New()
+	if err := m.RegisterBusinessMetrics(pullRequests, teams); err != nil {
+		t.Fatalf("RegisterBusinessMetrics() error = %v", err)
+	}
+
+	// Ошибка хранилища не прячется: сбор метрик отвечает 500, как того ждет Prometheus
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", w.Code)
+	}
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+)
+
+// Исход транзакции в метке outcome
+const (
+	outcomeCommit   = "commit"
+	outcomeRollback = "rollback"
+)
+
+// transactionKey отмечает контекст, в котором уже идет измеряемая транзакция
+type transactionKey struct{}
+
+// transactionManager измеряет длительность транзакций вложенного менеджера
+type transactionManager struct {
+	next    transaction.Manager
+	metrics *Metrics
+}
+
+// InstrumentTransactions оборачивает менеджер транзакций измерением длительности.
+// Учитываются только транзакции верхнего уровня: вложенный Do выполняется
+// в уже открытой транзакции и отдельно не измеряется
+func (m *Metrics) InstrumentTransactions(next transaction.Manager) transaction.Manager {
+	return &transactionManager{next: next, metrics: m}
+}
+
+func (t *transactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(transactionKey{}) != nil {
+		return t.next.Do(ctx, fn)
+	}
+
+	start := time.Now()
+	err := t.next.Do(context.WithValue(ctx, transactionKey{}, struct{}{}), fn)
+
+	outcome := outcomeCommit
+	if err != nil {
+		outcome = outcomeRollback
+	}
+	t.metrics.transactionDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+
+	return err
+}
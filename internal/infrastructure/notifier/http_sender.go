@@ -0,0 +1,92 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+)
+
+// Заголовки доставки события
+const (
+	HeaderEvent     = "X-PR-Reviewer-Event"
+	HeaderDelivery  = "X-PR-Reviewer-Delivery"
+	HeaderSignature = "X-PR-Reviewer-Signature"
+)
+
+// maxErrorBodySize сколько байт ответа подписчика попадает в текст ошибки
+const maxErrorBodySize = 512
+
+// tracerName имя инструментирования для спанов доставки
+const tracerName = "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/notifier"
+
+var _ usecase.EventSender = (*HTTPSender)(nil)
+
+// HTTPSender отправляет доставки событий POST-запросом с подписью тела
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender создает новый HTTPSender. Таймаут запроса задается контекстом Send
+func NewHTTPSender(client *http.Client) *HTTPSender {
+	return &HTTPSender{client: client}
+}
+
+// Send отправляет тело доставки на адрес подписки. Любой ответ, кроме 2xx, считается неудачной попыткой.
+// Каждая попытка - клиентский спан, его контекст передается подписчику в заголовке traceparent
+func (s *HTTPSender) Send(ctx context.Context, subscription *entity.Subscription, delivery *entity.EventDelivery) (err error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "POST subscription",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(http.MethodPost),
+			attribute.String("subscription.id", subscription.ID()),
+			attribute.String("delivery.id", delivery.ID()),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL(), bytes.NewReader(delivery.Payload()))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.EventType()))
+	req.Header.Set(HeaderDelivery, delivery.ID())
+	req.Header.Set(HeaderSignature, subscription.Sign(delivery.Payload()))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("subscriber responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,49 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
+)
+
+var _ usecase.EventPublisher = (*InProcessPublisher)(nil)
+
+// EventHandler получатель событий InProcessPublisher
+type EventHandler func(ctx context.Context, e event.Event) error
+
+// InProcessPublisher публикует события обработчикам в том же процессе, без брокера.
+// Обработчики вызываются по порядку подписки; ошибка любого из них оставляет событие
+// неопубликованным, и relay повторит его вместе со всей пачкой
+type InProcessPublisher struct {
+	mu       sync.RWMutex
+	handlers []EventHandler
+}
+
+// NewInProcessPublisher создает новый InProcessPublisher с начальными обработчиками
+func NewInProcessPublisher(handlers ...EventHandler) *InProcessPublisher {
+	return &InProcessPublisher{handlers: handlers}
+}
+
+// Subscribe добавляет обработчик событий
+func (p *InProcessPublisher) Subscribe(handler EventHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = append(p.handlers, handler)
+}
+
+// Publish передает событие всем обработчикам
+func (p *InProcessPublisher) Publish(ctx context.Context, e event.Event) error {
+	p.mu.RLock()
+	handlers := p.handlers
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
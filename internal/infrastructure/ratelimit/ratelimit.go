@@ -0,0 +1,132 @@
+// Package ratelimit ограничение частоты запросов клиентов алгоритмом token bucket
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval как часто удаляются корзины неактивных клиентов
+const sweepInterval = time.Minute
+
+// Limit лимит маршрута. Корзина вмещает Burst запросов и пополняется со скоростью Rate
+// запросов в секунду. Нулевой Rate - маршрут не ограничивается
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Limiter хранит корзины токенов по паре маршрут-клиент в памяти процесса.
+// Маршруты без собственного лимита делят одну корзину клиента с лимитом по умолчанию
+type Limiter struct {
+	defaultLimit Limit
+	routes       map[string]Limit
+	now          func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	route  string
+	client string
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// New создает Limiter с лимитом по умолчанию и лимитами отдельных маршрутов
+func New(defaultLimit Limit, routes map[string]Limit) *Limiter {
+	return newLimiter(defaultLimit, routes, time.Now)
+}
+
+func newLimiter(defaultLimit Limit, routes map[string]Limit, now func() time.Time) *Limiter {
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		routes:       routes,
+		now:          now,
+		buckets:      make(map[bucketKey]*bucket),
+		lastSweep:    now(),
+	}
+}
+
+// Allow забирает токен из корзины клиента client для маршрута route. Если токенов нет,
+// возвращает false и время до появления следующего
+func (l *Limiter) Allow(route, client string) (bool, time.Duration) {
+	return l.take(route, client, true)
+}
+
+// Check отвечает, как Allow, но не забирает токен. Нужен, когда расходуются только
+// неудачные запросы, например попытки аутентификации с неверным токеном
+func (l *Limiter) Check(route, client string) (bool, time.Duration) {
+	return l.take(route, client, false)
+}
+
+func (l *Limiter) take(route, client string, consume bool) (bool, time.Duration) {
+	limit, ok := l.routes[route]
+	if !ok {
+		limit = l.defaultLimit
+		route = ""
+	}
+	if limit.Rate <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	key := bucketKey{route: route, client: client}
+	b, ok := l.buckets[key]
+	if !ok {
+		if !consume {
+			// Отсутствующая корзина равна полной
+			return limit.Burst >= 1, 0
+		}
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(now)
+	if b.tokens >= 1 {
+		if consume {
+			b.tokens--
+		}
+		return true, 0
+	}
+
+	wait := (1 - b.tokens) / limit.Rate
+	return false, time.Duration(math.Ceil(wait * float64(time.Second)))
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+	b.last = now
+}
+
+// sweep удаляет корзины, которые успели наполниться: полная корзина ничем не отличается
+// от отсутствующей, а без удаления память росла бы с числом клиентов
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock часы, которые двигаются только вручную
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestLimiter(defaultLimit Limit, routes map[string]Limit) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	return newLimiter(defaultLimit, routes, clock.Now), clock
+}
+
+func TestLimiter_Burst(t *testing.T) {
+	limiter, clock := newTestLimiter(Limit{Rate: 2, Burst: 3}, nil)
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := limiter.Allow("/team/get", "alice"); !ok {
+			t.Fatalf("request %d within burst was rejected", i+1)
+		}
+	}
+
+	ok, retryAfter := limiter.Allow("/team/get", "alice")
+	if ok {
+		t.Fatal("expected request over burst to be rejected")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Errorf("expected retry after 500ms at 2 rps, got %v", retryAfter)
+	}
+
+	// Корзина пополняется со временем
+	clock.Advance(retryAfter)
+	if ok, _ := limiter.Allow("/team/get", "alice"); !ok {
+		t.Error("expected request after refill to be allowed")
+	}
+	if ok, _ := limiter.Allow("/team/get", "alice"); ok {
+		t.Error("expected refill to add a single token")
+	}
+}
+
+func TestLimiter_Isolation(t *testing.T) {
+	limiter, _ := newTestLimiter(Limit{Rate: 1, Burst: 1}, map[string]Limit{
+		"/pullRequest/create": {Rate: 1, Burst: 1},
+		"/statistics":         {},
+	})
+
+	if ok, _ := limiter.Allow("/team/get", "alice"); !ok {
+		t.Fatal("expected first request to be allowed")
+	}
+
+	// Маршруты без своего лимита делят корзину клиента
+	if ok, _ := limiter.Allow("/users/getReview", "alice"); ok {
+		t.Error("expected routes with default limit to share the client bucket")
+	}
+	// У маршрута со своим лимитом отдельная корзина
+	if ok, _ := limiter.Allow("/pullRequest/create", "alice"); !ok {
+		t.Error("expected route limit to have its own bucket")
+	}
+	// Корзины разных клиентов независимы
+	if ok, _ := limiter.Allow("/team/get", "bob"); !ok {
+		t.Error("expected another client to have its own bucket")
+	}
+	// Нулевой лимит маршрута снимает ограничение
+	for i := 0; i < 10; i++ {
+		if ok, _ := limiter.Allow("/statistics", "alice"); !ok {
+			t.Fatal("expected route with zero rate to be unlimited")
+		}
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	limiter, clock := newTestLimiter(Limit{Rate: 1, Burst: 5}, nil)
+
+	limiter.Allow("/team/get", "alice")
+	clock.Advance(sweepInterval - 2*time.Second)
+	for i := 0; i < 5; i++ {
+		limiter.Allow("/team/get", "bob")
+	}
+
+	// Через минуту корзина alice полная и удаляется, корзина bob еще нет
+	clock.Advance(2 * time.Second)
+	limiter.Allow("/team/get", "carol")
+
+	if _, ok := limiter.buckets[bucketKey{client: "alice"}]; ok {
+		t.Error("expected full bucket to be swept")
+	}
+	if len(limiter.buckets) != 2 {
+		t.Errorf("expected buckets of bob and carol to remain, got %d", len(limiter.buckets))
+	}
+}
+
+func TestLimiter_Check(t *testing.T) {
+	limiter, clock := newTestLimiter(Limit{Rate: 1, Burst: 2}, nil)
+
+	// Проверка не расходует токены и не создает корзину
+	for i := 0; i < 5; i++ {
+		if ok, _ := limiter.Check("", "ip:10.0.0.1"); !ok {
+			t.Fatal("expected check of a new client to pass")
+		}
+	}
+	if len(limiter.buckets) != 0 {
+		t.Errorf("expected check not to create buckets, got %d", len(limiter.buckets))
+	}
+
+	limiter.Allow("", "ip:10.0.0.1")
+	limiter.Allow("", "ip:10.0.0.1")
+
+	ok, retryAfter := limiter.Check("", "ip:10.0.0.1")
+	if ok {
+		t.Fatal("expected check of an empty bucket to fail")
+	}
+	if retryAfter != time.Second {
+		t.Errorf("expected retry after 1s at 1 rps, got %v", retryAfter)
+	}
+
+	clock.Advance(retryAfter)
+	if ok, _ := limiter.Check("", "ip:10.0.0.1"); !ok {
+		t.Error("expected check after refill to pass")
+	}
+	if ok, _ := limiter.Check("", "ip:10.0.0.1"); !ok {
+		t.Error("expected repeated check not to consume the refilled token")
+	}
+}
@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenDB открывает базу через драйвер, который создает спан на каждый запрос.
+// system - атрибут db.system.name, например semconv.DBSystemNamePostgreSQL.
+// Запросы вне трассировки (опрос outbox и очереди доставок фоновыми процессами)
+// спанов не создают, чтобы не плодить трассировки из одного запроса
+func OpenDB(driverName, dsn string, system attribute.KeyValue) (*sql.DB, error) {
+	return otelsql.Open(driverName, dsn,
+		otelsql.WithAttributes(system),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitConnectorConnect: true,
+			OmitRows:             true,
+			SpanFilter:           inTrace,
+		}),
+	)
+}
+
+func inTrace(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+	_ "modernc.org/sqlite"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+)
+
+// useInMemoryProvider устанавливает глобальный провайдер с записью спанов в память на время теста
+func useInMemoryProvider(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+	return exporter
+}
+
+func TestOpenDB_SpansOnlyInsideTrace(t *testing.T) {
+	exporter := useInMemoryProvider(t)
+
+	db, err := OpenDB("sqlite", ":memory:", semconv.DBSystemNameSQLite)
+	if err != nil {
+		t.Fatalf("OpenDB() error = %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if _, err := db.ExecContext(context.Background(), "SELECT 1"); err != nil {
+		t.Fatalf("ExecContext() error = %v", err)
+	}
+	if spans := exporter.GetSpans(); len(spans) != 0 {
+		t.Fatalf("expected no spans outside trace, got %d", len(spans))
+	}
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	if _, err := db.ExecContext(ctx, "SELECT 1"); err != nil {
+		t.Fatalf("ExecContext() error = %v", err)
+	}
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) < 2 {
+		t.Fatalf("expected query span and parent span, got %d", len(spans))
+	}
+	query := spans[0]
+	if query.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("expected query span to be child of parent span")
+	}
+
+	found := false
+	for _, attr := range query.Attributes {
+		if attr == semconv.DBSystemNameSQLite {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected %s attribute in %v", semconv.DBSystemNameSQLite.Key, query.Attributes)
+	}
+}
+
+func TestSetup(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	shutdown, err := Setup(context.Background(), config.TracingConfig{Enabled: false})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+
+	// Propagator устанавливается и при выключенной трассировке
+	carrier := propagation.MapCarrier{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		t.Error("expected traceparent to be extracted")
+	}
+
+	if _, err := Setup(context.Background(), config.TracingConfig{Enabled: true, Exporter: "zipkin"}); err == nil {
+		t.Error("expected error for unknown exporter")
+	}
+}
+
+func TestSetup_Stdout(t *testing.T) {
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	shutdown, err := Setup(context.Background(), config.TracingConfig{
+		Enabled:     true,
+		Exporter:    config.TracingExporterStdout,
+		SampleRatio: 1,
+		ServiceName: "test",
+	})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	_, span := otel.Tracer("test").Start(context.Background(), "operation")
+	if !span.SpanContext().IsSampled() {
+		t.Error("expected span to be sampled with ratio 1")
+	}
+	span.End()
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+var _ audit.Recorder = (*AuditTrail)(nil)
+
+// AuditContext возвращает автора изменения и request ID запроса из контекста
+type AuditContext func(ctx context.Context) (actor, requestID string)
+
+// AuditTrail записывает изменения в журнал аудита в транзакции изменения.
+// Автор и request ID берутся из контекста через AuditContext
+type AuditTrail struct {
+	auditRepo    repository.AuditRepository
+	auditContext AuditContext
+}
+
+// NewAuditTrail создает новый AuditTrail. nil auditContext - автор всегда анонимный
+func NewAuditTrail(auditRepo repository.AuditRepository, auditContext AuditContext) *AuditTrail {
+	return &AuditTrail{
+		auditRepo:    auditRepo,
+		auditContext: auditContext,
+	}
+}
+
+// Record записывает изменения в журнал, присваивая каждой записи ID
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (t *AuditTrail) Record(ctx context.Context, records ...audit.Record) error {
+	var actor, requestID string
+	if t.auditContext != nil {
+		actor, requestID = t.auditContext(ctx)
+	}
+	occurredAt := time.Now().UTC()
+
+	entries := make([]*entity.AuditEntry, 0, len(records))
+	for _, record := range records {
+		before, err := marshalSnapshot(record.Before)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s snapshot: %w", record.Action, err)
+		}
+		after, err := marshalSnapshot(record.After)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s snapshot: %w", record.Action, err)
+		}
+
+		entry, err := entity.NewAuditEntry(
+			newID(),
+			record.Action,
+			record.EntityType,
+			record.EntityID,
+			actor,
+			requestID,
+			before,
+			after,
+			record.Reason,
+			occurredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create audit entry %s: %w", record.Action, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := t.auditRepo.Add(ctx, entries); err != nil {
+		return fmt.Errorf("failed to write audit entries: %w", err)
+	}
+
+	return nil
+}
+
+// marshalSnapshot сериализует снимок состояния; nil означает отсутствие состояния
+func marshalSnapshot(snapshot any) ([]byte, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	return json.Marshal(snapshot)
+}
+
+// recordAudit записывает изменения в журнал аудита в транзакции, в которой записано изменение
+func recordAudit(ctx context.Context, recorder audit.Recorder, records ...audit.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := recorder.Record(ctx, records...); err != nil {
+		return fmt.Errorf("failed to record audit: %w", err)
+	}
+	return nil
+}
+
+// teamCreatedRecord запись о создании команды вместе с участниками
+func teamCreatedRecord(team dto.TeamDTO) audit.Record {
+	return audit.Record{
+		Action:     entity.AuditTeamCreated,
+		EntityType: entity.AuditEntityTeam,
+		EntityID:   team.TeamName,
+		After:      team,
+	}
+}
+
+// teamUpdatedRecord запись об изменении настроек команды
+func teamUpdatedRecord(before, after dto.TeamDTO) audit.Record {
+	return audit.Record{
+		Action:     entity.AuditTeamUpdated,
+		EntityType: entity.AuditEntityTeam,
+		EntityID:   after.TeamName,
+		Before:     before,
+		After:      after,
+	}
+}
+
+// userActivityRecord запись об активации или деактивации пользователя
+func userActivityRecord(before, after dto.UserDTO, reason string) audit.Record {
+	action := entity.AuditUserDeactivated
+	if after.IsActive {
+		action = entity.AuditUserActivated
+	}
+
+	return audit.Record{
+		Action:     action,
+		EntityType: entity.AuditEntityUser,
+		EntityID:   after.UserID,
+		Before:     before,
+		After:      after,
+		Reason:     reason,
+	}
+}
+
+// prCreatedRecord запись о создании PR с назначенными ревьюверами
+func prCreatedRecord(pr dto.PullRequestDTO) audit.Record {
+	return audit.Record{
+		Action:     entity.AuditPRCreated,
+		EntityType: entity.AuditEntityPullRequest,
+		EntityID:   pr.PullRequestID,
+		After:      pr,
+	}
+}
+
+// prMergedRecord запись о мерже PR. bypassed - условия политики мержа, обойденные принудительным мержем
+func prMergedRecord(before, after dto.PullRequestDTO, bypassed []string) audit.Record {
+	reason := ""
+	if len(bypassed) > 0 {
+		reason = "forced merge bypassing: " + strings.Join(bypassed, "; ")
+	}
+
+	return audit.Record{
+		Action:     entity.AuditPRMerged,
+		EntityType: entity.AuditEntityPullRequest,
+		EntityID:   after.PullRequestID,
+		Before:     before,
+		After:      after,
+		Reason:     reason,
+	}
+}
+
+// prChangedRecord запись об изменении PR: смене статуса или ручном изменении состава ревьюверов
+func prChangedRecord(action entity.AuditAction, before, after dto.PullRequestDTO) audit.Record {
+	return audit.Record{
+		Action:     action,
+		EntityType: entity.AuditEntityPullRequest,
+		EntityID:   after.PullRequestID,
+		Before:     before,
+		After:      after,
+	}
+}
+
+// reviewerReassignedRecords возвращает запись о переназначении для каждого перенесенного слота
+func reviewerReassignedRecords(changes []repository.ReviewerChange) []audit.Record {
+	records := make([]audit.Record, 0, len(changes))
+	for _, change := range changes {
+		records = append(records, reviewerReassignedRecord(change))
+	}
+	return records
+}
+
+// reviewerReassignedRecord запись о переносе слота ревьювера: старый и новый ревьювер и причина выбора
+func reviewerReassignedRecord(change repository.ReviewerChange) audit.Record {
+	oldReviewerID := change.OldReviewerID
+	after := dto.ReviewerSlotDTO{PullRequestID: change.PullRequestID}
+	if change.NewReviewerID != "" {
+		newReviewerID := change.NewReviewerID
+		after.ReviewerID = &newReviewerID
+	}
+
+	return audit.Record{
+		Action:     entity.AuditReviewerReassigned,
+		EntityType: entity.AuditEntityPullRequest,
+		EntityID:   change.PullRequestID,
+		Before:     dto.ReviewerSlotDTO{PullRequestID: change.PullRequestID, ReviewerID: &oldReviewerID},
+		After:      after,
+		Reason:     change.Reason,
+	}
+}
+
+// subscriptionRecord запись о создании, изменении или удалении подписки. Снимки строятся
+// dto.ToSubscriptionDTO и не содержат секрет; nil снимок означает отсутствие состояния
+func subscriptionRecord(action entity.AuditAction, before, after *dto.SubscriptionDTO, reason string) audit.Record {
+	record := audit.Record{
+		Action:     action,
+		EntityType: entity.AuditEntitySubscription,
+		Reason:     reason,
+	}
+	if before != nil {
+		record.EntityID = before.SubscriptionID
+		record.Before = *before
+	}
+	if after != nil {
+		record.EntityID = after.SubscriptionID
+		record.After = *after
+	}
+	return record
+}
+
+// apiTokenRecord запись о выпуске или отзыве API токена. Снимки не содержат открытое значение токена
+func apiTokenRecord(action entity.AuditAction, before *dto.APITokenDTO, after dto.APITokenDTO) audit.Record {
+	record := audit.Record{
+		Action:     action,
+		EntityType: entity.AuditEntityAPIToken,
+		EntityID:   after.TokenID,
+		After:      after,
+	}
+	if before != nil {
+		record.Before = *before
+	}
+	return record
+}
+
+// gitLoginLinkedRecord запись о сопоставлении логина git-хостинга пользователю.
+// before - прежнее сопоставление логина, nil если логин сопоставляется впервые
+func gitLoginLinkedRecord(before *dto.GitLoginDTO, after dto.GitLoginDTO) audit.Record {
+	record := audit.Record{
+		Action:     entity.AuditGitLoginLinked,
+		EntityType: entity.AuditEntityGitLogin,
+		EntityID:   after.Provider + ":" + after.Login,
+		After:      after,
+	}
+	if before != nil {
+		record.Before = *before
+	}
+	return record
+}
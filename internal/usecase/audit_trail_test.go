@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+func newTestAuditRecorder(ctrl *gomock.Controller) *auditmocks.MockRecorder {
+	recorder := auditmocks.NewMockRecorder(ctrl)
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return recorder
+}
+
+func TestAuditTrail_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditRepo := repositorymocks.NewMockAuditRepository(ctrl)
+	auditRepo.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entries []*entity.AuditEntry) error {
+		if len(entries) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(entries))
+		}
+
+		created := entries[0]
+		if created.Action() != entity.AuditTeamCreated || created.EntityID() != "backend" {
+			t.Errorf("unexpected first entry %s/%s", created.Action(), created.EntityID())
+		}
+		if created.Actor() != "admin-1" || created.RequestID() != "req-1" {
+			t.Errorf("expected actor admin-1 and request req-1, got %s and %s", created.Actor(), created.RequestID())
+		}
+		if created.Before() != nil {
+			t.Errorf("expected no before snapshot, got %s", created.Before())
+		}
+
+		reassigned := entries[1]
+		var before, after dto.ReviewerSlotDTO
+		if err := json.Unmarshal(reassigned.Before(), &before); err != nil {
+			t.Fatalf("failed to unmarshal before snapshot: %v", err)
+		}
+		if err := json.Unmarshal(reassigned.After(), &after); err != nil {
+			t.Fatalf("failed to unmarshal after snapshot: %v", err)
+		}
+		if before.ReviewerID == nil || *before.ReviewerID != "u1" || after.ReviewerID != nil {
+			t.Errorf("expected slot to move from u1 to nobody, got %v -> %v", before.ReviewerID, after.ReviewerID)
+		}
+		if reassigned.Reason() != "no candidates" {
+			t.Errorf("expected reason to be kept, got %q", reassigned.Reason())
+		}
+		return nil
+	})
+
+	trail := NewAuditTrail(auditRepo, func(ctx context.Context) (string, string) {
+		return "admin-1", "req-1"
+	})
+
+	err := trail.Record(context.Background(),
+		teamCreatedRecord(dto.TeamDTO{TeamName: "backend"}),
+		reviewerReassignedRecord(repository.ReviewerChange{PullRequestID: "pr-1", OldReviewerID: "u1", Reason: "no candidates"}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAuditTrail_RecordAnonymous(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditRepo := repositorymocks.NewMockAuditRepository(ctrl)
+	auditRepo.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entries []*entity.AuditEntry) error {
+		if entries[0].Actor() != entity.AuditActorAnonymous {
+			t.Errorf("expected anonymous actor, got %q", entries[0].Actor())
+		}
+		return nil
+	})
+
+	record := userActivityRecord(dto.UserDTO{UserID: "u1", IsActive: true}, dto.UserDTO{UserID: "u1"}, "")
+	if record.Action != entity.AuditUserDeactivated {
+		t.Errorf("expected %s, got %s", entity.AuditUserDeactivated, record.Action)
+	}
+
+	if err := NewAuditTrail(auditRepo, nil).Record(context.Background(), record); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAuditTrail_RecordError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditRepo := repositorymocks.NewMockAuditRepository(ctrl)
+	auditRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+	err := NewAuditTrail(auditRepo, nil).Record(context.Background(), prCreatedRecord(dto.PullRequestDTO{PullRequestID: "pr-1"}))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Запись без обязательных полей не доходит до хранилища
+	err = NewAuditTrail(auditRepo, nil).Record(context.Background(), audit.Record{Action: entity.AuditPRCreated})
+	if !errors.Is(err, entity.ErrInvalidAuditEntry) {
+		t.Errorf("expected ErrInvalidAuditEntry, got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// AuditUseCase Use Case для чтения журнала аудита
+type AuditUseCase struct {
+	auditRepo repository.AuditRepository
+	logger    logger.Logger
+}
+
+// NewAuditUseCase создает новый AuditUseCase
+func NewAuditUseCase(auditRepo repository.AuditRepository, logger logger.Logger) *AuditUseCase {
+	return &AuditUseCase{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// ListAuditEntries возвращает страницу журнала аудита по фильтрам, новые записи первыми.
+// Курсор непрозрачен для клиента: это seq последней записи страницы
+// GET /audit
+func (uc *AuditUseCase) ListAuditEntries(ctx context.Context, req dto.ListAuditRequest) (*dto.AuditPageDTO, error) {
+	filter, err := toAuditFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Лишняя запись показывает, что за страницей есть продолжение
+	limit := filter.Limit
+	filter.Limit = limit + 1
+
+	entries, err := uc.auditRepo.Find(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to find audit entries", "error", err)
+		return nil, fmt.Errorf("failed to find audit entries: %w", err)
+	}
+
+	page := &dto.AuditPageDTO{}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		cursor := encodeAuditCursor(entries[len(entries)-1].Seq())
+		page.NextCursor = &cursor
+	}
+	page.Entries = dto.ToAuditEntryDTOs(entries)
+
+	return page, nil
+}
+
+// toAuditFilter переводит параметры запроса в фильтр хранилища
+func toAuditFilter(req dto.ListAuditRequest) (repository.AuditFilter, error) {
+	filter := repository.AuditFilter{
+		EntityID: req.EntityID,
+		Actor:    req.Actor,
+		Limit:    req.Limit,
+	}
+
+	if req.EntityType != "" {
+		entityType, err := entity.ParseAuditEntityType(req.EntityType)
+		if err != nil {
+			return repository.AuditFilter{}, fmt.Errorf("%w: %w", ErrInvalidAuditQuery, err)
+		}
+		filter.EntityType = entityType
+	}
+
+	var err error
+	if filter.From, err = parseAuditTime("from", req.From); err != nil {
+		return repository.AuditFilter{}, err
+	}
+	if filter.To, err = parseAuditTime("to", req.To); err != nil {
+		return repository.AuditFilter{}, err
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return repository.AuditFilter{}, fmt.Errorf("%w: from must be before to", ErrInvalidAuditQuery)
+	}
+
+	if req.Cursor != "" {
+		if filter.BeforeSeq, err = decodeAuditCursor(req.Cursor); err != nil {
+			return repository.AuditFilter{}, err
+		}
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = entity.DefaultAuditPageSize
+	case filter.Limit < 0 || filter.Limit > entity.MaxAuditPageSize:
+		return repository.AuditFilter{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidAuditQuery, entity.MaxAuditPageSize)
+	}
+
+	return filter, nil
+}
+
+func parseAuditTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be an RFC 3339 timestamp", ErrInvalidAuditQuery, field)
+	}
+	return &parsed, nil
+}
+
+func encodeAuditCursor(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(seq, 10)))
+}
+
+func decodeAuditCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: malformed cursor", ErrInvalidAuditQuery)
+	}
+	seq, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || seq <= 0 {
+		return 0, fmt.Errorf("%w: malformed cursor", ErrInvalidAuditQuery)
+	}
+	return seq, nil
+}
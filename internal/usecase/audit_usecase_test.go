@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+func testAuditEntries(seqs ...int64) []*entity.AuditEntry {
+	entries := make([]*entity.AuditEntry, len(seqs))
+	for i, seq := range seqs {
+		entries[i] = entity.NewAuditEntryFromRepository(seq, "entry", entity.AuditPRCreated, entity.AuditEntityPullRequest,
+			"pr-1", "u1", "req-1", nil, []byte(`{}`), "", time.Now())
+	}
+	return entries
+}
+
+func TestAuditUseCase_ListAuditEntries(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		req              dto.ListAuditRequest
+		setupMocks       func(*repositorymocks.MockAuditRepository)
+		expectErr        error
+		expectedEntries  int
+		expectNextCursor bool
+	}{
+		{
+			name: "default page size",
+			req:  dto.ListAuditRequest{},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {
+				auditRepo.EXPECT().Find(gomock.Any(), repository.AuditFilter{Limit: entity.DefaultAuditPageSize + 1}).
+					Return(testAuditEntries(3, 2, 1), nil)
+			},
+			expectedEntries: 3,
+		},
+		{
+			name: "filters and next cursor",
+			req: dto.ListAuditRequest{
+				EntityType: "pull_request",
+				EntityID:   "pr-1",
+				Actor:      "u1",
+				From:       from.Format(time.RFC3339),
+				To:         to.Format(time.RFC3339),
+				Cursor:     encodeAuditCursor(10),
+				Limit:      2,
+			},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {
+				auditRepo.EXPECT().Find(gomock.Any(), repository.AuditFilter{
+					EntityType: entity.AuditEntityPullRequest,
+					EntityID:   "pr-1",
+					Actor:      "u1",
+					From:       &from,
+					To:         &to,
+					BeforeSeq:  10,
+					Limit:      3,
+				}).Return(testAuditEntries(9, 8, 7), nil)
+			},
+			expectedEntries:  2,
+			expectNextCursor: true,
+		},
+		{
+			name:       "error - unknown entity type",
+			req:        dto.ListAuditRequest{EntityType: "repository"},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {},
+			expectErr:  ErrInvalidAuditQuery,
+		},
+		{
+			name:       "error - malformed time",
+			req:        dto.ListAuditRequest{From: "yesterday"},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {},
+			expectErr:  ErrInvalidAuditQuery,
+		},
+		{
+			name:       "error - empty time range",
+			req:        dto.ListAuditRequest{From: to.Format(time.RFC3339), To: from.Format(time.RFC3339)},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {},
+			expectErr:  ErrInvalidAuditQuery,
+		},
+		{
+			name:       "error - malformed cursor",
+			req:        dto.ListAuditRequest{Cursor: "not-a-cursor"},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {},
+			expectErr:  ErrInvalidAuditQuery,
+		},
+		{
+			name:       "error - limit too large",
+			req:        dto.ListAuditRequest{Limit: entity.MaxAuditPageSize + 1},
+			setupMocks: func(auditRepo *repositorymocks.MockAuditRepository) {},
+			expectErr:  ErrInvalidAuditQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			auditRepo := repositorymocks.NewMockAuditRepository(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setupMocks(auditRepo)
+
+			page, err := NewAuditUseCase(auditRepo, logger).ListAuditEntries(context.Background(), tt.req)
+
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(page.Entries) != tt.expectedEntries {
+				t.Errorf("expected %d entries, got %d", tt.expectedEntries, len(page.Entries))
+			}
+			if tt.expectNextCursor {
+				if page.NextCursor == nil {
+					t.Fatal("expected next cursor")
+				}
+				seq, err := decodeAuditCursor(*page.NextCursor)
+				if err != nil || seq != 8 {
+					t.Errorf("expected cursor to point at seq 8, got %d (%v)", seq, err)
+				}
+			} else if page.NextCursor != nil {
+				t.Errorf("expected no next cursor, got %q", *page.NextCursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// BootstrapPrincipalID идентификатор субъекта, аутентифицированного bootstrap токеном из конфигурации
+const BootstrapPrincipalID = "bootstrap"
+
+// TokenVerifier проверяет подписанный токен (JWT) и возвращает субъекта из его claims
+type TokenVerifier interface {
+	Verify(token string) (*entity.Principal, error)
+}
+
+// AuthSettings настройки аутентификации
+type AuthSettings struct {
+	// BootstrapToken токен администратора из конфигурации для выпуска первых API токенов.
+	// Пустое значение отключает bootstrap токен
+	BootstrapToken string
+}
+
+// AuthUseCase Use Case для аутентификации по Bearer токену и управления статическими API токенами
+type AuthUseCase struct {
+	txManager transaction.Manager
+	tokenRepo repository.APITokenRepository
+	verifier  TokenVerifier
+	settings  AuthSettings
+	audit     audit.Recorder
+	logger    logger.Logger
+	now       func() time.Time
+}
+
+// NewAuthUseCase создает новый AuthUseCase. verifier может быть nil, тогда JWT не принимаются
+func NewAuthUseCase(
+	txManager transaction.Manager,
+	tokenRepo repository.APITokenRepository,
+	verifier TokenVerifier,
+	settings AuthSettings,
+	audit audit.Recorder,
+	logger logger.Logger,
+) *AuthUseCase {
+	return &AuthUseCase{
+		txManager: txManager,
+		tokenRepo: tokenRepo,
+		verifier:  verifier,
+		settings:  settings,
+		audit:     audit,
+		logger:    logger,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Authenticate определяет субъекта по значению Bearer токена.
+// Токен с префиксом entity.APITokenPrefix ищется среди статических токенов, остальные проверяются как JWT
+func (uc *AuthUseCase) Authenticate(ctx context.Context, token string) (*entity.Principal, error) {
+	if token == "" {
+		return nil, ErrUnauthenticated
+	}
+
+	if uc.settings.BootstrapToken != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(uc.settings.BootstrapToken)) == 1 {
+		return entity.NewPrincipal(BootstrapPrincipalID, entity.RoleAdmin)
+	}
+
+	if strings.HasPrefix(token, entity.APITokenPrefix) {
+		apiToken, err := uc.tokenRepo.FindByHash(ctx, entity.HashAPIToken(token))
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, ErrUnauthenticated
+			}
+			uc.logger.Error("Failed to find api token", "error", err)
+			return nil, fmt.Errorf("failed to find api token: %w", err)
+		}
+		if apiToken.IsRevoked() {
+			return nil, fmt.Errorf("%w: token is revoked", ErrUnauthenticated)
+		}
+		return apiToken.Principal(), nil
+	}
+
+	if uc.verifier == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	principal, err := uc.verifier.Verify(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	return principal, nil
+}
+
+// CreateAPIToken выпускает статический API токен. Открытое значение возвращается только в этом ответе
+// POST /auth/tokens/create
+func (uc *AuthUseCase) CreateAPIToken(ctx context.Context, req dto.CreateAPITokenRequest) (*dto.APITokenDTO, error) {
+	uc.logger.Info("Creating api token", "subject", req.Subject, "role", req.Role)
+
+	role, err := entity.ParseRole(req.Role)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAPIToken, err)
+	}
+
+	token, plain, err := entity.NewAPIToken(newID(), req.Subject, role)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAPIToken, err)
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.tokenRepo.Create(ctx, token); err != nil {
+			return fmt.Errorf("failed to save api token: %w", err)
+		}
+		return recordAudit(ctx, uc.audit, apiTokenRecord(entity.AuditAPITokenCreated, nil, dto.ToAPITokenDTO(token)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to create api token", "error", err)
+		return nil, err
+	}
+
+	uc.logger.Info("API token created", "token_id", token.ID(), "subject", token.Subject())
+	result := dto.ToAPITokenDTO(token)
+	result.Token = plain
+	return &result, nil
+}
+
+// ListAPITokens возвращает все API токены, включая отозванные, в порядке создания
+// GET /auth/tokens/list
+func (uc *AuthUseCase) ListAPITokens(ctx context.Context) ([]dto.APITokenDTO, error) {
+	uc.logger.Info("Listing api tokens")
+
+	tokens, err := uc.tokenRepo.FindAll(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list api tokens", "error", err)
+		return nil, fmt.Errorf("failed to list api tokens: %w", err)
+	}
+
+	return dto.ToAPITokenDTOs(tokens), nil
+}
+
+// RevokeAPIToken отзывает API токен. Повторный отзыв не меняет время отзыва
+// POST /auth/tokens/revoke
+func (uc *AuthUseCase) RevokeAPIToken(ctx context.Context, tokenID string) (*dto.APITokenDTO, error) {
+	uc.logger.Info("Revoking api token", "token_id", tokenID)
+
+	var token *entity.APIToken
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		token, err = uc.tokenRepo.FindByID(ctx, tokenID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrAPITokenNotFound
+			}
+			return fmt.Errorf("failed to find api token: %w", err)
+		}
+
+		before := dto.ToAPITokenDTO(token)
+		alreadyRevoked := token.IsRevoked()
+		token.Revoke(uc.now())
+
+		if err := uc.tokenRepo.Update(ctx, token); err != nil {
+			return fmt.Errorf("failed to update api token: %w", err)
+		}
+		// Повторный отзыв ничего не меняет и в журнал не пишется
+		if alreadyRevoked {
+			return nil
+		}
+		return recordAudit(ctx, uc.audit, apiTokenRecord(entity.AuditAPITokenRevoked, &before, dto.ToAPITokenDTO(token)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to revoke api token", "error", err, "token_id", tokenID)
+		return nil, err
+	}
+
+	uc.logger.Info("API token revoked", "token_id", tokenID)
+	result := dto.ToAPITokenDTO(token)
+	return &result, nil
+}
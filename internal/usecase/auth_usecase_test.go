@@ -0,0 +1,311 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+type mockTokenVerifier struct {
+	VerifyFunc func(token string) (*entity.Principal, error)
+}
+
+func (m *mockTokenVerifier) Verify(token string) (*entity.Principal, error) {
+	return m.VerifyFunc(token)
+}
+
+func TestAuthUseCase_Authenticate(t *testing.T) {
+	const bootstrapToken = "bootstrap-secret-token"
+	const staticToken = entity.APITokenPrefix + "static-token"
+
+	revokedAt := time.Now()
+	memberToken := entity.NewAPITokenFromRepository("t1", "u1", entity.RoleMember, entity.HashAPIToken(staticToken), time.Now(), nil)
+	revokedToken := entity.NewAPITokenFromRepository("t2", "u2", entity.RoleMember, entity.HashAPIToken(staticToken), time.Now(), &revokedAt)
+
+	verifier := &mockTokenVerifier{
+		VerifyFunc: func(token string) (*entity.Principal, error) {
+			if token == "valid.jwt.token" {
+				return entity.NewPrincipal("ci-bot", entity.RoleService)
+			}
+			return nil, errors.New("invalid signature")
+		},
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		verifier   TokenVerifier
+		setupMocks func(*repositorymocks.MockAPITokenRepository)
+		expectErr  error
+		expectedID string
+		expectRole entity.Role
+	}{
+		{
+			name:       "bootstrap token",
+			token:      bootstrapToken,
+			verifier:   verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectedID: BootstrapPrincipalID,
+			expectRole: entity.RoleAdmin,
+		},
+		{
+			name:     "static token",
+			token:    staticToken,
+			verifier: verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				tokenRepo.EXPECT().FindByHash(gomock.Any(), entity.HashAPIToken(staticToken)).Return(memberToken, nil)
+			},
+			expectedID: "u1",
+			expectRole: entity.RoleMember,
+		},
+		{
+			name:     "static token revoked",
+			token:    staticToken,
+			verifier: verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(revokedToken, nil)
+			},
+			expectErr: ErrUnauthenticated,
+		},
+		{
+			name:     "static token unknown",
+			token:    staticToken,
+			verifier: verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				tokenRepo.EXPECT().FindByHash(gomock.Any(), gomock.Any()).Return(nil, repository.ErrNotFound)
+			},
+			expectErr: ErrUnauthenticated,
+		},
+		{
+			name:       "jwt",
+			token:      "valid.jwt.token",
+			verifier:   verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectedID: "ci-bot",
+			expectRole: entity.RoleService,
+		},
+		{
+			name:       "jwt invalid",
+			token:      "forged.jwt.token",
+			verifier:   verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectErr:  ErrUnauthenticated,
+		},
+		{
+			name:       "jwt not configured",
+			token:      "valid.jwt.token",
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectErr:  ErrUnauthenticated,
+		},
+		{
+			name:       "empty token",
+			token:      "",
+			verifier:   verifier,
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectErr:  ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := repositorymocks.NewMockAPITokenRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setupMocks(tokenRepo)
+
+			uc := NewAuthUseCase(txManager, tokenRepo, tt.verifier, AuthSettings{BootstrapToken: bootstrapToken}, newTestAuditRecorder(ctrl), logger)
+			principal, err := uc.Authenticate(context.Background(), tt.token)
+
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if principal.ID() != tt.expectedID || principal.Role() != tt.expectRole {
+				t.Errorf("expected principal %s/%s, got %s/%s", tt.expectedID, tt.expectRole, principal.ID(), principal.Role())
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_CreateAPIToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        dto.CreateAPITokenRequest
+		setupMocks func(*repositorymocks.MockAPITokenRepository)
+		expectErr  error
+	}{
+		{
+			name: "success",
+			req:  dto.CreateAPITokenRequest{Subject: "ci-bot", Role: "service"},
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				tokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:       "error - invalid role",
+			req:        dto.CreateAPITokenRequest{Subject: "ci-bot", Role: "root"},
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectErr:  ErrInvalidAPIToken,
+		},
+		{
+			name:       "error - empty subject",
+			req:        dto.CreateAPITokenRequest{Subject: " ", Role: "member"},
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {},
+			expectErr:  ErrInvalidAPIToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := repositorymocks.NewMockAPITokenRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+
+			tt.setupMocks(tokenRepo)
+
+			uc := NewAuthUseCase(txManager, tokenRepo, nil, AuthSettings{}, newTestAuditRecorder(ctrl), logger)
+			result, err := uc.CreateAPIToken(context.Background(), tt.req)
+
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasPrefix(result.Token, entity.APITokenPrefix) {
+				t.Errorf("expected plain token with prefix %q, got %q", entity.APITokenPrefix, result.Token)
+			}
+			if result.Subject != tt.req.Subject || result.Role != tt.req.Role {
+				t.Errorf("expected %s/%s, got %s/%s", tt.req.Subject, tt.req.Role, result.Subject, result.Role)
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_RevokeAPIToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		tokenID    string
+		setupMocks func(*repositorymocks.MockAPITokenRepository)
+		expectErr  error
+		// expectAudit отзыв пишется в журнал аудита, повторный - нет
+		expectAudit bool
+	}{
+		{
+			name:    "success",
+			tokenID: "t1",
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				token := entity.NewAPITokenFromRepository("t1", "u1", entity.RoleMember, "hash", time.Now(), nil)
+				tokenRepo.EXPECT().FindByID(gomock.Any(), "t1").Return(token, nil)
+				tokenRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *entity.APIToken) error {
+					if !token.IsRevoked() {
+						t.Error("expected token to be revoked before update")
+					}
+					return nil
+				})
+			},
+			expectAudit: true,
+		},
+		{
+			name:    "success - already revoked token is not audited again",
+			tokenID: "t1",
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				revokedAt := time.Now().Add(-time.Hour)
+				token := entity.NewAPITokenFromRepository("t1", "u1", entity.RoleMember, "hash", time.Now(), &revokedAt)
+				tokenRepo.EXPECT().FindByID(gomock.Any(), "t1").Return(token, nil)
+				tokenRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "error - token not found",
+			tokenID: "missing",
+			setupMocks: func(tokenRepo *repositorymocks.MockAPITokenRepository) {
+				tokenRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, repository.ErrNotFound)
+			},
+			expectErr: ErrAPITokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := repositorymocks.NewMockAPITokenRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			recorder := auditmocks.NewMockRecorder(ctrl)
+			if tt.expectAudit {
+				recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+					if len(records) != 1 || records[0].Action != entity.AuditAPITokenRevoked || records[0].EntityID != tt.tokenID {
+						t.Fatalf("expected single api_token.revoked record, got %+v", records)
+					}
+					before, beforeOK := records[0].Before.(dto.APITokenDTO)
+					after, afterOK := records[0].After.(dto.APITokenDTO)
+					if !beforeOK || !afterOK || before.RevokedAt != nil || after.RevokedAt == nil {
+						t.Errorf("expected snapshots before and after revocation, got %+v / %+v", records[0].Before, records[0].After)
+					}
+					return nil
+				})
+			}
+
+			tt.setupMocks(tokenRepo)
+
+			uc := NewAuthUseCase(txManager, tokenRepo, nil, AuthSettings{}, recorder, logger)
+			result, err := uc.RevokeAPIToken(context.Background(), tt.tokenID)
+
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.RevokedAt == nil {
+				t.Error("expected revoked_at to be set")
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ListAuditRequest фильтры журнала аудита. Пустые поля не фильтруют.
+// From и To в формате RFC 3339, Cursor - next_cursor предыдущей страницы
+type ListAuditRequest struct {
+	EntityType string
+	EntityID   string
+	Actor      string
+	From       string
+	To         string
+	Cursor     string
+	Limit      int
+}
+
+// AuditEntryDTO запись журнала аудита. Before и After - снимки сущности в формате API, null если состояния нет
+type AuditEntryDTO struct {
+	EntryID    string          `json:"entry_id"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Actor      string          `json:"actor"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Reason     string          `json:"reason,omitempty"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+// AuditPageDTO страница журнала аудита. NextCursor nil, если записей больше нет
+type AuditPageDTO struct {
+	Entries    []AuditEntryDTO `json:"entries"`
+	NextCursor *string         `json:"next_cursor"`
+}
+
+// ReviewerSlotDTO снимок слота ревьювера в PR для записей о переназначении. ReviewerID nil, если слот свободен
+type ReviewerSlotDTO struct {
+	PullRequestID string  `json:"pull_request_id"`
+	ReviewerID    *string `json:"reviewer_id"`
+}
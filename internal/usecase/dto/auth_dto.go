@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+// CreateAPITokenRequest входные данные для выпуска статического API токена
+type CreateAPITokenRequest struct {
+	Subject string `json:"subject"`
+	Role    string `json:"role"`
+}
+
+// RevokeAPITokenRequest входные данные для отзыва API токена
+type RevokeAPITokenRequest struct {
+	TokenID string `json:"token_id"`
+}
+
+// APITokenDTO API токен. Token (открытое значение) возвращается только при создании
+type APITokenDTO struct {
+	TokenID   string     `json:"token_id"`
+	Subject   string     `json:"subject"`
+	Role      string     `json:"role"`
+	Token     string     `json:"token,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// PrincipalDTO аутентифицированный субъект запроса
+type PrincipalDTO struct {
+	ID   string `json:"id"`
+	Role string `json:"role"`
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 )
 
 // ToPullRequestDTO конвертирует entity.PullRequest в PullRequestDTO
@@ -12,11 +13,28 @@ func ToPullRequestDTO(pr *entity.PullRequest) PullRequestDTO {
 		AuthorID:          pr.AuthorID(),
 		Status:            string(pr.Status()),
 		AssignedReviewers: pr.AssignedReviewers(),
+		FallbackReviewers: pr.FallbackReviewers(),
+		Reviews:           ToReviewDTOs(pr.Reviews()),
+		ReviewDecision:    string(pr.ReviewDecision()),
 		CreatedAt:         pr.CreatedAt(),
 		MergedAt:          pr.MergedAt(),
+		MergeForced:       pr.MergeForced(),
 	}
 }
 
+// ToReviewDTOs конвертирует слайс entity.Review в слайс ReviewDTO
+func ToReviewDTOs(reviews []entity.Review) []ReviewDTO {
+	result := make([]ReviewDTO, len(reviews))
+	for i, review := range reviews {
+		result[i] = ReviewDTO{
+			UserID:      review.ReviewerID(),
+			State:       string(review.State()),
+			SubmittedAt: review.SubmittedAt(),
+		}
+	}
+	return result
+}
+
 // ToPullRequestShortDTO конвертирует entity.PullRequest в PullRequestShortDTO
 func ToPullRequestShortDTO(pr *entity.PullRequest) PullRequestShortDTO {
 	return PullRequestShortDTO{
@@ -67,7 +85,150 @@ func ToTeamMemberDTOs(users []*entity.User) []TeamMemberDTO {
 // ToTeamDTO конвертирует entity.Team и слайс entity.User в TeamDTO
 func ToTeamDTO(team *entity.Team, members []*entity.User) TeamDTO {
 	return TeamDTO{
-		TeamName: team.Name(),
-		Members:  ToTeamMemberDTOs(members),
+		TeamName:         team.Name(),
+		ReviewerStrategy: string(team.ReviewerStrategy()),
+		MinReviewers:     team.ReviewerLimits().Min(),
+		MaxReviewers:     team.ReviewerLimits().Max(),
+		FallbackTeams:    team.FallbackTeams(),
+		MergePolicy:      ToMergePolicyDTO(team.MergePolicy()),
+		Members:          ToTeamMemberDTOs(members),
+	}
+}
+
+// ToMergePolicyDTO конвертирует entity.MergePolicy в MergePolicyDTO
+func ToMergePolicyDTO(policy entity.MergePolicy) MergePolicyDTO {
+	return MergePolicyDTO{
+		RequiredApprovals:       policy.RequiredApprovals(),
+		BlockOnChangesRequested: policy.BlockOnChangesRequested(),
+		CodeOwners:              policy.CodeOwners(),
+	}
+}
+
+// ToReviewerReassignmentDTOs конвертирует переносы слотов ревьюверов в слайс ReviewerReassignmentDTO
+func ToReviewerReassignmentDTOs(changes []repository.ReviewerChange) []ReviewerReassignmentDTO {
+	result := make([]ReviewerReassignmentDTO, 0, len(changes))
+	for _, change := range changes {
+		reassignment := ReviewerReassignmentDTO{
+			PullRequestID: change.PullRequestID,
+			OldUserID:     change.OldReviewerID,
+		}
+		if change.NewReviewerID != "" {
+			newUserID := change.NewReviewerID
+			reassignment.NewUserID = &newUserID
+		}
+		result = append(result, reassignment)
+	}
+	return result
+}
+
+// ToSubscriptionDTO конвертирует entity.Subscription в SubscriptionDTO без секрета
+func ToSubscriptionDTO(subscription *entity.Subscription) SubscriptionDTO {
+	eventTypes := subscription.EventTypes()
+	names := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		names[i] = string(eventType)
+	}
+
+	return SubscriptionDTO{
+		SubscriptionID: subscription.ID(),
+		URL:            subscription.URL(),
+		EventTypes:     names,
+		IsActive:       subscription.IsActive(),
+		CreatedAt:      subscription.CreatedAt(),
+		UpdatedAt:      subscription.UpdatedAt(),
+	}
+}
+
+// ToSubscriptionDTOs конвертирует слайс entity.Subscription в слайс SubscriptionDTO
+func ToSubscriptionDTOs(subscriptions []*entity.Subscription) []SubscriptionDTO {
+	result := make([]SubscriptionDTO, len(subscriptions))
+	for i, subscription := range subscriptions {
+		result[i] = ToSubscriptionDTO(subscription)
+	}
+	return result
+}
+
+// ToDeadLetterDTOs конвертирует проваленные доставки в слайс DeadLetterDTO
+func ToDeadLetterDTOs(deliveries []*entity.EventDelivery) []DeadLetterDTO {
+	result := make([]DeadLetterDTO, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = DeadLetterDTO{
+			DeliveryID: delivery.ID(),
+			EventID:    delivery.EventID(),
+			EventType:  string(delivery.EventType()),
+			Payload:    delivery.Payload(),
+			Attempts:   delivery.Attempts(),
+			LastError:  delivery.LastError(),
+			CreatedAt:  delivery.CreatedAt(),
+			FailedAt:   delivery.FailedAt(),
+		}
 	}
+	return result
+}
+
+// ToAPITokenDTO конвертирует entity.APIToken в APITokenDTO без открытого значения
+func ToAPITokenDTO(token *entity.APIToken) APITokenDTO {
+	return APITokenDTO{
+		TokenID:   token.ID(),
+		Subject:   token.Subject(),
+		Role:      string(token.Role()),
+		CreatedAt: token.CreatedAt(),
+		RevokedAt: token.RevokedAt(),
+	}
+}
+
+// ToAPITokenDTOs конвертирует слайс entity.APIToken в слайс APITokenDTO
+func ToAPITokenDTOs(tokens []*entity.APIToken) []APITokenDTO {
+	result := make([]APITokenDTO, len(tokens))
+	for i, token := range tokens {
+		result[i] = ToAPITokenDTO(token)
+	}
+	return result
+}
+
+// ToPrincipalDTO конвертирует entity.Principal в PrincipalDTO
+func ToPrincipalDTO(principal *entity.Principal) PrincipalDTO {
+	return PrincipalDTO{
+		ID:   principal.ID(),
+		Role: string(principal.Role()),
+	}
+}
+
+// ToAuditEntryDTO конвертирует entity.AuditEntry в AuditEntryDTO
+func ToAuditEntryDTO(entry *entity.AuditEntry) AuditEntryDTO {
+	return AuditEntryDTO{
+		EntryID:    entry.ID(),
+		Action:     string(entry.Action()),
+		EntityType: string(entry.EntityType()),
+		EntityID:   entry.EntityID(),
+		Actor:      entry.Actor(),
+		RequestID:  entry.RequestID(),
+		Before:     entry.Before(),
+		After:      entry.After(),
+		Reason:     entry.Reason(),
+		OccurredAt: entry.OccurredAt(),
+	}
+}
+
+// ToAuditEntryDTOs конвертирует слайс entity.AuditEntry в слайс AuditEntryDTO
+func ToAuditEntryDTOs(entries []*entity.AuditEntry) []AuditEntryDTO {
+	result := make([]AuditEntryDTO, len(entries))
+	for i, entry := range entries {
+		result[i] = ToAuditEntryDTO(entry)
+	}
+	return result
+}
+
+// ToReviewerHistoryEntryDTOs конвертирует историю назначений ревьюверов в слайс ReviewerHistoryEntryDTO
+func ToReviewerHistoryEntryDTOs(entries []*entity.ReviewerHistoryEntry) []ReviewerHistoryEntryDTO {
+	result := make([]ReviewerHistoryEntryDTO, len(entries))
+	for i, entry := range entries {
+		result[i] = ReviewerHistoryEntryDTO{
+			UserID:     entry.UserID(),
+			Action:     string(entry.Action()),
+			Reason:     string(entry.Reason()),
+			OccurredAt: entry.OccurredAt(),
+		}
+	}
+	return result
 }
@@ -0,0 +1,21 @@
+package dto
+
+// Статусы проверок /livez и /readyz
+const (
+	HealthStatusOK   = "ok"
+	HealthStatusFail = "fail"
+)
+
+// HealthDTO состояние сервиса. Status равен fail, если не прошла хотя бы одна проверка
+type HealthDTO struct {
+	Status string                    `json:"status"`
+	Checks map[string]HealthCheckDTO `json:"checks,omitempty"`
+}
+
+// HealthCheckDTO результат одной проверки
+type HealthCheckDTO struct {
+	Status     string         `json:"status"`
+	Error      string         `json:"error,omitempty"`
+	DurationMs int64          `json:"duration_ms"`
+	Details    map[string]any `json:"details,omitempty"`
+}
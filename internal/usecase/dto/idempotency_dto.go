@@ -0,0 +1,8 @@
+package dto
+
+// IdempotentResponseDTO сохраненный ответ на запрос с заголовком Idempotency-Key
+type IdempotentResponseDTO struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -4,13 +4,24 @@ import "time"
 
 // PullRequestDTO представляет полный Pull Request для HTTP ответа
 type PullRequestDTO struct {
-	PullRequestID     string     `json:"pull_request_id"`
-	PullRequestName   string     `json:"pull_request_name"`
-	AuthorID          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         time.Time  `json:"createdAt"`
-	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	PullRequestID     string      `json:"pull_request_id"`
+	PullRequestName   string      `json:"pull_request_name"`
+	AuthorID          string      `json:"author_id"`
+	Status            string      `json:"status"`
+	AssignedReviewers []string    `json:"assigned_reviewers"`
+	FallbackReviewers []string    `json:"fallback_reviewers,omitempty"` // ревьюверы из запасных команд
+	Reviews           []ReviewDTO `json:"reviews"`
+	ReviewDecision    string      `json:"review_decision"`
+	CreatedAt         time.Time   `json:"createdAt"`
+	MergedAt          *time.Time  `json:"mergedAt,omitempty"`
+	MergeForced       bool        `json:"merge_forced,omitempty"` // смержен в обход политики мержа
+}
+
+// ReviewDTO состояние ревью назначенного ревьювера
+type ReviewDTO struct {
+	UserID      string     `json:"user_id"`
+	State       string     `json:"state"`
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
 }
 
 // PullRequestShortDTO представляет краткий Pull Request для списков
@@ -20,3 +31,19 @@ type PullRequestShortDTO struct {
 	AuthorID        string `json:"author_id"`
 	Status          string `json:"status"`
 }
+
+// ReviewerReassignmentDTO описывает перенос слота ревьювера при деактивации пользователей.
+// NewUserID равен nil, если замены не нашлось и слот освобожден
+type ReviewerReassignmentDTO struct {
+	PullRequestID string  `json:"pull_request_id"`
+	OldUserID     string  `json:"old_user_id"`
+	NewUserID     *string `json:"new_user_id"`
+}
+
+// ReviewerHistoryEntryDTO событие истории назначений ревьюверов PR
+type ReviewerHistoryEntryDTO struct {
+	UserID     string    `json:"user_id"`
+	Action     string    `json:"action"`
+	Reason     string    `json:"reason"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -5,15 +5,54 @@ type CreatePRRequest struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 	AuthorID        string `json:"author_id"`
+	// Draft создает PR черновиком, ревьюверы назначаются при переводе в OPEN
+	Draft bool `json:"draft,omitempty"`
 }
 
-// ReassignReviewerRequest входные данные для переназначения ревьювера
+// ReassignReviewerRequest входные данные для переназначения ревьювера.
+// ActorID заполняется из аутентифицированного субъекта, кроме администратора:
+// автор PR не может менять ревьюверов своего PR
 type ReassignReviewerRequest struct {
 	PullRequestID string `json:"pull_request_id"`
 	OldUserID     string `json:"old_user_id"`
+	// NewUserID необязательный явный выбор нового ревьювера
+	NewUserID string `json:"new_user_id,omitempty"`
+	ActorID   string `json:"-"`
 }
 
-// MergePRRequest входные данные для мерджа PR
+// AddReviewerRequest входные данные для ручного назначения ревьювера.
+// ActorID - как в ReassignReviewerRequest
+type AddReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id"`
+	ActorID       string `json:"-"`
+}
+
+// RemoveReviewerRequest входные данные для ручного снятия ревьювера.
+// ActorID - как в ReassignReviewerRequest
+type RemoveReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id"`
+	ActorID       string `json:"-"`
+}
+
+// SubmitReviewRequest входные данные для ревью: APPROVED, CHANGES_REQUESTED или COMMENTED
+type SubmitReviewRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id"`
+	State         string `json:"state"`
+}
+
+// MergePRRequest входные данные для мерджа PR.
+// Force мержит PR в обход политики мержа команды. ManagerID заполняется из аутентифицированного
+// руководителя команды: ему доступны только PR своей команды
 type MergePRRequest struct {
 	PullRequestID string `json:"pull_request_id"`
+	Force         bool   `json:"force,omitempty"`
+	ManagerID     string `json:"-"`
+}
+
+// ChangePRStatusRequest входные данные для смены статуса PR (ready, close, reopen)
+type ChangePRStatusRequest struct {
+	PullRequestID string `json:"pull_request_id"`
 }
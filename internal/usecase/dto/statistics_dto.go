@@ -9,8 +9,10 @@ type StatisticsDTO struct {
 // PRStatsDTO статистика по Pull Requests
 type PRStatsDTO struct {
 	Total  int `json:"total"`
+	Draft  int `json:"draft"`
 	Open   int `json:"open"`
 	Merged int `json:"merged"`
+	Closed int `json:"closed"`
 }
 
 // UserStatsDTO статистика по пользователю
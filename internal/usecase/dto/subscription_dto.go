@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CreateSubscriptionRequest входные данные для создания подписки на события.
+// Если secret не задан, сервис генерирует его сам
+type CreateSubscriptionRequest struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret,omitempty"`
+	EventTypes []string `json:"event_types"`
+}
+
+// UpdateSubscriptionRequest входные данные для изменения подписки. Незаданные поля не меняются
+type UpdateSubscriptionRequest struct {
+	SubscriptionID string    `json:"subscription_id"`
+	URL            *string   `json:"url,omitempty"`
+	Secret         *string   `json:"secret,omitempty"`
+	EventTypes     *[]string `json:"event_types,omitempty"`
+	IsActive       *bool     `json:"is_active,omitempty"`
+}
+
+// DeleteSubscriptionRequest входные данные для удаления подписки
+type DeleteSubscriptionRequest struct {
+	SubscriptionID string `json:"subscription_id"`
+}
+
+// SubscriptionDTO подписка на события. Secret возвращается только при создании
+type SubscriptionDTO struct {
+	SubscriptionID string    `json:"subscription_id"`
+	URL            string    `json:"url"`
+	EventTypes     []string  `json:"event_types"`
+	IsActive       bool      `json:"is_active"`
+	Secret         string    `json:"secret,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// DeadLetterDTO доставка события, исчерпавшая попытки
+type DeadLetterDTO struct {
+	DeliveryID string          `json:"delivery_id"`
+	EventID    string          `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	Payload    json.RawMessage `json:"payload"`
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"last_error"`
+	CreatedAt  time.Time       `json:"created_at"`
+	FailedAt   *time.Time      `json:"failed_at"`
+}
@@ -2,8 +2,20 @@ package dto
 
 // TeamDTO представляет команду с участниками для HTTP ответа
 type TeamDTO struct {
-	TeamName string          `json:"team_name"`
-	Members  []TeamMemberDTO `json:"members"`
+	TeamName         string          `json:"team_name"`
+	ReviewerStrategy string          `json:"reviewer_strategy,omitempty"`
+	MinReviewers     int             `json:"min_reviewers"`
+	MaxReviewers     int             `json:"max_reviewers"`
+	FallbackTeams    []string        `json:"fallback_teams,omitempty"`
+	MergePolicy      MergePolicyDTO  `json:"merge_policy"`
+	Members          []TeamMemberDTO `json:"members"`
+}
+
+// MergePolicyDTO политика мержа команды для HTTP ответа
+type MergePolicyDTO struct {
+	RequiredApprovals       int      `json:"required_approvals"`
+	BlockOnChangesRequested bool     `json:"block_on_changes_requested"`
+	CodeOwners              []string `json:"code_owners,omitempty"`
 }
 
 // TeamMemberDTO представляет участника команды для HTTP ответа
@@ -1,9 +1,38 @@
 package dto
 
-// CreateTeamRequest входные данные для создания команды
+// CreateTeamRequest входные данные для создания команды.
+// ManagerID заполняется из аутентифицированного руководителя команды: он должен войти в новую команду
+// и может перевести в нее только пользователей своей команды
 type CreateTeamRequest struct {
-	TeamName string              `json:"team_name"`
-	Members  []TeamMemberRequest `json:"members"`
+	TeamName         string              `json:"team_name"`
+	Members          []TeamMemberRequest `json:"members"`
+	ReviewerStrategy string              `json:"reviewer_strategy,omitempty"`
+	MinReviewers     *int                `json:"min_reviewers,omitempty"`
+	MaxReviewers     *int                `json:"max_reviewers,omitempty"`
+	FallbackTeams    []string            `json:"fallback_teams,omitempty"`
+	ManagerID        string              `json:"-"`
+}
+
+// UpdateTeamRequest входные данные для изменения настроек команды.
+// Незаданные поля не меняются; пустой fallback_teams убирает запасные команды,
+// merge_policy заменяет политику мержа целиком. ManagerID заполняется из аутентифицированного
+// руководителя команды: ему доступна только своя команда
+type UpdateTeamRequest struct {
+	TeamName         string              `json:"team_name"`
+	ReviewerStrategy *string             `json:"reviewer_strategy,omitempty"`
+	MinReviewers     *int                `json:"min_reviewers,omitempty"`
+	MaxReviewers     *int                `json:"max_reviewers,omitempty"`
+	FallbackTeams    *[]string           `json:"fallback_teams,omitempty"`
+	MergePolicy      *MergePolicyRequest `json:"merge_policy,omitempty"`
+	ManagerID        string              `json:"-"`
+}
+
+// MergePolicyRequest политика мержа команды. При заданных RequiredApprovals или CodeOwners
+// запрос изменений блокирует мерж и без BlockOnChangesRequested
+type MergePolicyRequest struct {
+	RequiredApprovals       int      `json:"required_approvals"`
+	BlockOnChangesRequested bool     `json:"block_on_changes_requested"`
+	CodeOwners              []string `json:"code_owners,omitempty"`
 }
 
 // TeamMemberRequest данные участника команды
@@ -13,7 +42,9 @@ type TeamMemberRequest struct {
 	IsActive bool   `json:"is_active"`
 }
 
-// DeactivateTeamMembersRequest входные данные для массовой деактивации пользователей команды
+// DeactivateTeamMembersRequest входные данные для массовой деактивации пользователей команды.
+// ManagerID заполняется из аутентифицированного руководителя команды: ему доступна только своя команда
 type DeactivateTeamMembersRequest struct {
-	TeamName string `json:"team_name"`
+	TeamName  string `json:"team_name"`
+	ManagerID string `json:"-"`
 }
@@ -1,7 +1,11 @@
 package dto
 
 // SetUserActiveRequest входные данные для установки активности пользователя
+// SkipReassign отключает переназначение открытых ревью при деактивации.
+// ManagerID заполняется из аутентифицированного руководителя команды: ему доступны только пользователи своей команды
 type SetUserActiveRequest struct {
-	UserID   string `json:"user_id"`
-	IsActive bool   `json:"is_active"`
+	UserID       string `json:"user_id"`
+	IsActive     bool   `json:"is_active"`
+	SkipReassign bool   `json:"skip_reassign,omitempty"`
+	ManagerID    string `json:"-"`
 }
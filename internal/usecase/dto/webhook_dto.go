@@ -0,0 +1,54 @@
+package dto
+
+// PullRequestEventAction действие над pull request на git-хостинге
+type PullRequestEventAction string
+
+const (
+	PullRequestEventOpened   PullRequestEventAction = "opened"
+	PullRequestEventReady    PullRequestEventAction = "ready_for_review"
+	PullRequestEventClosed   PullRequestEventAction = "closed"
+	PullRequestEventMerged   PullRequestEventAction = "merged"
+	PullRequestEventReopened PullRequestEventAction = "reopened"
+)
+
+// Результаты обработки доставки вебхука
+const (
+	WebhookStatusProcessed = "processed"
+	WebhookStatusDuplicate = "duplicate"
+	WebhookStatusIgnored   = "ignored"
+)
+
+// PullRequestEvent событие pull request, приведенное из payload вебхука git-хостинга
+type PullRequestEvent struct {
+	Provider    string
+	DeliveryID  string
+	Action      PullRequestEventAction
+	Repository  string // полный путь репозитория, например acme/backend
+	Number      int64
+	Title       string
+	AuthorLogin string
+	Draft       bool
+}
+
+// WebhookResultDTO результат обработки доставки вебхука.
+// Status: processed, duplicate (доставка уже была принята) или ignored (событие не применимо, см. Reason)
+type WebhookResultDTO struct {
+	DeliveryID    string `json:"delivery_id"`
+	Status        string `json:"status"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// LinkGitLoginRequest входные данные для сопоставления логина git-хостинга пользователю
+type LinkGitLoginRequest struct {
+	Provider string `json:"provider"`
+	Login    string `json:"login"`
+	UserID   string `json:"user_id"`
+}
+
+// GitLoginDTO сопоставление логина git-хостинга пользователю
+type GitLoginDTO struct {
+	Provider string `json:"provider"`
+	Login    string `json:"login"`
+	UserID   string `json:"user_id"`
+}
@@ -1,17 +1,71 @@
 package usecase
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // Доменные ошибки Use Cases
 var (
 	ErrTeamAlreadyExists = errors.New("team already exists")
 	ErrTeamNotFound      = errors.New("team not found")
 
+	ErrInvalidReviewerLimits = errors.New("invalid reviewer limits")
+	ErrInvalidMergePolicy    = errors.New("invalid merge policy")
+	ErrCodeOwnerNotFound     = errors.New("code owner not found")
+	ErrInvalidFallbackTeams  = errors.New("invalid fallback teams")
+	ErrFallbackTeamNotFound  = errors.New("fallback team not found")
+
 	ErrUserNotFound = errors.New("user not found")
+	ErrUserInactive = errors.New("user is inactive")
+
+	ErrPRAlreadyExists         = errors.New("pull request already exists")
+	ErrPRNotFound              = errors.New("pull request not found")
+	ErrPRAlreadyMerged         = errors.New("pull request already merged")
+	ErrPRClosed                = errors.New("pull request is closed")
+	ErrPRDraft                 = errors.New("pull request is a draft")
+	ErrInvalidStatusTransition = errors.New("invalid pull request status transition")
+	ErrReviewerNotAssigned     = errors.New("reviewer is not assigned to this PR")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer is already assigned to this PR")
+	ErrAuthorCannotReview      = errors.New("author cannot review their own PR")
+	ErrReviewerTeamNotAllowed  = errors.New("reviewer team is not allowed for this PR")
+	ErrTooManyReviewers        = errors.New("PR already has max_reviewers reviewers")
+	ErrTooFewReviewers         = errors.New("PR cannot have fewer than min_reviewers reviewers")
+	ErrInvalidReviewState      = errors.New("invalid review state")
+	ErrMergeBlocked            = errors.New("merge is blocked by merge policy")
+	ErrNoActiveCandidates      = errors.New("no active replacement candidate in team")
+	ErrNotEnoughReviewers      = errors.New("not enough active reviewers in team")
+
+	ErrInvalidGitProvider       = errors.New("invalid git provider")
+	ErrGitLoginNotLinked        = errors.New("git login is not linked to a user")
+	ErrUnsupportedWebhookAction = errors.New("unsupported webhook action")
+
+	ErrInvalidSubscription  = errors.New("invalid subscription")
+	ErrSubscriptionNotFound = errors.New("subscription not found")
 
-	ErrPRAlreadyExists     = errors.New("pull request already exists")
-	ErrPRNotFound          = errors.New("pull request not found")
-	ErrPRAlreadyMerged     = errors.New("pull request already merged")
-	ErrReviewerNotAssigned = errors.New("reviewer is not assigned to this PR")
-	ErrNoActiveCandidates  = errors.New("no active replacement candidate in team")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrForbidden        = errors.New("forbidden")
+	ErrInvalidAPIToken  = errors.New("invalid api token")
+	ErrAPITokenNotFound = errors.New("api token not found")
+
+	ErrInvalidAuditQuery = errors.New("invalid audit query")
+
+	ErrInvalidIdempotencyKey    = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key is reused with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is in progress")
 )
+
+// MergeBlockedError мерж запрещен политикой мержа команды.
+// Conditions содержит невыполненные условия, errors.Is(err, ErrMergeBlocked) возвращает true
+type MergeBlockedError struct {
+	Conditions []string
+}
+
+func (e *MergeBlockedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrMergeBlocked, strings.Join(e.Conditions, "; "))
+}
+
+func (e *MergeBlockedError) Unwrap() error {
+	return ErrMergeBlocked
+}
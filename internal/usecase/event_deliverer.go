@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+)
+
+// EventSender отправляет доставку события на адрес подписки. Ошибка означает неудачную попытку
+type EventSender interface {
+	Send(ctx context.Context, subscription *entity.Subscription, delivery *entity.EventDelivery) error
+}
+
+// EventDeliverySettings настройки отправки событий
+type EventDeliverySettings struct {
+	PollInterval   time.Duration
+	RequestTimeout time.Duration
+	BatchSize      int
+	Retry          entity.RetryPolicy
+}
+
+// EventDeliverer отправляет доставки из очереди подписчикам, повторяя неудачные
+// с экспоненциальной задержкой. Доставка, исчерпавшая попытки, переносится в dead letter
+type EventDeliverer struct {
+	txManager        transaction.Manager
+	subscriptionRepo repository.SubscriptionRepository
+	deliveryRepo     repository.EventDeliveryRepository
+	sender           EventSender
+	settings         EventDeliverySettings
+	logger           logger.Logger
+	now              func() time.Time
+}
+
+// NewEventDeliverer создает новый EventDeliverer
+func NewEventDeliverer(
+	txManager transaction.Manager,
+	subscriptionRepo repository.SubscriptionRepository,
+	deliveryRepo repository.EventDeliveryRepository,
+	sender EventSender,
+	settings EventDeliverySettings,
+	logger logger.Logger,
+) *EventDeliverer {
+	return &EventDeliverer{
+		txManager:        txManager,
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		sender:           sender,
+		settings:         settings,
+		logger:           logger,
+		now:              func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run отправляет доставки каждые PollInterval, пока не отменен ctx.
+// Если пачка заполнена целиком, следующая забирается сразу, не дожидаясь интервала
+func (d *EventDeliverer) Run(ctx context.Context) {
+	d.logger.Info("Event deliverer started", "poll_interval", d.settings.PollInterval)
+
+	ticker := time.NewTicker(d.settings.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		delivered, err := d.DeliverDue(ctx)
+		if err != nil && ctx.Err() == nil {
+			d.logger.Error("Failed to deliver events", "error", err)
+		}
+
+		if err == nil && delivered == d.settings.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Event deliverer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue забирает до BatchSize доставок, время которых наступило, и отправляет их.
+// Доставки забираются на время, за которое пачка гарантированно обработается,
+// поэтому несколько экземпляров сервиса не отправляют одну доставку одновременно.
+// Возвращает число забранных доставок
+func (d *EventDeliverer) DeliverDue(ctx context.Context) (int, error) {
+	lease := d.settings.RequestTimeout*time.Duration(d.settings.BatchSize) + d.settings.PollInterval
+
+	deliveries, err := d.deliveryRepo.ClaimDue(ctx, d.now(), lease, d.settings.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := d.deliver(ctx, delivery); err != nil {
+			return len(deliveries), err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// deliver отправляет одну доставку и записывает результат попытки
+func (d *EventDeliverer) deliver(ctx context.Context, delivery *entity.EventDelivery) error {
+	subscription, err := d.subscriptionRepo.FindByID(ctx, delivery.SubscriptionID())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			// подписку удалили после ClaimDue, ее доставки удалены каскадом
+			return nil
+		}
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, d.settings.RequestTimeout)
+	sendErr := d.sender.Send(sendCtx, subscription, delivery)
+	cancel()
+
+	if sendErr == nil {
+		if err := d.deliveryRepo.Delete(ctx, delivery.ID()); err != nil {
+			return fmt.Errorf("failed to delete delivered event: %w", err)
+		}
+		d.logger.Debug("Event delivered",
+			"delivery_id", delivery.ID(),
+			"subscription_id", subscription.ID(),
+			"event_type", delivery.EventType(),
+		)
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		// сервис останавливается: попытка не засчитывается, доставка вернется в очередь по истечении lease
+		return ctx.Err()
+	}
+
+	if exhausted := delivery.RecordFailure(sendErr.Error(), d.now(), d.settings.Retry); !exhausted {
+		if err := d.deliveryRepo.Reschedule(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to reschedule delivery: %w", err)
+		}
+		d.logger.Warn("Event delivery failed, will retry",
+			"error", sendErr,
+			"delivery_id", delivery.ID(),
+			"subscription_id", subscription.ID(),
+			"attempts", delivery.Attempts(),
+			"next_attempt_at", delivery.NextAttemptAt(),
+		)
+		return nil
+	}
+
+	err = d.txManager.Do(ctx, func(ctx context.Context) error {
+		return d.deliveryRepo.MoveToDeadLetter(ctx, delivery)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to move delivery to dead letter: %w", err)
+	}
+
+	d.logger.Error("Event delivery moved to dead letter",
+		"error", sendErr,
+		"delivery_id", delivery.ID(),
+		"subscription_id", subscription.ID(),
+		"attempts", delivery.Attempts(),
+	)
+	return nil
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
+)
+
+// mockEventSender ручной мок EventSender
+type mockEventSender struct {
+	sendFunc func(ctx context.Context, subscription *entity.Subscription, delivery *entity.EventDelivery) error
+	sent     []string
+}
+
+func (m *mockEventSender) Send(ctx context.Context, subscription *entity.Subscription, delivery *entity.EventDelivery) error {
+	m.sent = append(m.sent, delivery.ID())
+	return m.sendFunc(ctx, subscription, delivery)
+}
+
+func TestEventDeliverer_DeliverDue(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	settings := EventDeliverySettings{
+		PollInterval:   time.Second,
+		RequestTimeout: time.Second,
+		BatchSize:      10,
+		Retry: entity.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     time.Minute,
+		},
+	}
+	subscription := entity.NewSubscriptionFromRepository("sub-1", "https://example.com/hook", "secret-secret-123",
+		[]entity.EventType{entity.EventPRMerged}, true, now, now)
+	newDelivery := func(attempts int) *entity.EventDelivery {
+		return entity.NewEventDeliveryFromRepository("delivery-1", "sub-1", "event-1", entity.EventPRMerged,
+			[]byte(`{}`), attempts, now, "", now, nil)
+	}
+
+	tests := []struct {
+		name       string
+		delivery   *entity.EventDelivery
+		sendErr    error
+		setupMocks func(*repositorymocks.MockSubscriptionRepository, *repositorymocks.MockEventDeliveryRepository, *transactionmocks.MockManager)
+		expectSent bool
+		check      func(*testing.T, *entity.EventDelivery)
+	}{
+		{
+			name:     "success - delivered event is removed from queue",
+			delivery: newDelivery(0),
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository, txManager *transactionmocks.MockManager) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(subscription, nil)
+				deliveryRepo.EXPECT().Delete(gomock.Any(), "delivery-1").Return(nil)
+			},
+			expectSent: true,
+		},
+		{
+			name:     "failure - delivery rescheduled with backoff",
+			delivery: newDelivery(1),
+			sendErr:  errors.New("subscriber responded with status 500"),
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository, txManager *transactionmocks.MockManager) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(subscription, nil)
+				deliveryRepo.EXPECT().Reschedule(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectSent: true,
+			check: func(t *testing.T, delivery *entity.EventDelivery) {
+				if delivery.Attempts() != 2 {
+					t.Errorf("expected 2 attempts, got %d", delivery.Attempts())
+				}
+				if want := now.Add(20 * time.Second); !delivery.NextAttemptAt().Equal(want) {
+					t.Errorf("expected next attempt at %v, got %v", want, delivery.NextAttemptAt())
+				}
+				if delivery.LastError() != "subscriber responded with status 500" {
+					t.Errorf("unexpected last error %q", delivery.LastError())
+				}
+			},
+		},
+		{
+			name:     "failure - exhausted delivery moved to dead letter",
+			delivery: newDelivery(2),
+			sendErr:  errors.New("connection refused"),
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository, txManager *transactionmocks.MockManager) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(subscription, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				deliveryRepo.EXPECT().MoveToDeadLetter(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectSent: true,
+			check: func(t *testing.T, delivery *entity.EventDelivery) {
+				if delivery.FailedAt() == nil || !delivery.FailedAt().Equal(now) {
+					t.Errorf("expected failed_at %v, got %v", now, delivery.FailedAt())
+				}
+			},
+		},
+		{
+			name:     "skip - subscription deleted after claim",
+			delivery: newDelivery(0),
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository, txManager *transactionmocks.MockManager) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(nil, repository.ErrNotFound)
+			},
+			expectSent: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+			deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			deliveryRepo.EXPECT().ClaimDue(gomock.Any(), now, gomock.Any(), settings.BatchSize).
+				Return([]*entity.EventDelivery{tt.delivery}, nil)
+			tt.setupMocks(subscriptionRepo, deliveryRepo, txManager)
+
+			sender := &mockEventSender{
+				sendFunc: func(context.Context, *entity.Subscription, *entity.EventDelivery) error {
+					return tt.sendErr
+				},
+			}
+
+			deliverer := NewEventDeliverer(txManager, subscriptionRepo, deliveryRepo, sender, settings, logger)
+			deliverer.now = func() time.Time { return now }
+
+			claimed, err := deliverer.DeliverDue(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claimed != 1 {
+				t.Errorf("expected 1 claimed delivery, got %d", claimed)
+			}
+			if sent := len(sender.sent) == 1; sent != tt.expectSent {
+				t.Errorf("expected sent=%v, got %v", tt.expectSent, sent)
+			}
+			if tt.check != nil {
+				tt.check(t, tt.delivery)
+			}
+		})
+	}
+}
+
+func TestEventDeliverer_DeliverDueClaimError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+	deliveryRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+
+	deliverer := NewEventDeliverer(
+		transactionmocks.NewMockManager(ctrl),
+		repositorymocks.NewMockSubscriptionRepository(ctrl),
+		deliveryRepo,
+		&mockEventSender{},
+		EventDeliverySettings{PollInterval: time.Second, RequestTimeout: time.Second, BatchSize: 10},
+		loggermocks.NewMockLogger(ctrl),
+	)
+
+	if _, err := deliverer.DeliverDue(context.Background()); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ EventPublisher = (*EventNotifier)(nil)
+
+// eventEnvelope тело доставки события подписчику
+type eventEnvelope struct {
+	ID         string           `json:"id"`
+	Type       entity.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       any              `json:"data"`
+}
+
+// EventNotifier публикует события из outbox подписчикам: ставит в очередь доставки
+// по доставке на каждую активную подписку. Очередь пишется в транзакции OutboxRelay,
+// поэтому событие либо отмечено отправленным вместе с доставками, либо будет опубликовано повторно
+type EventNotifier struct {
+	subscriptionRepo repository.SubscriptionRepository
+	deliveryRepo     repository.EventDeliveryRepository
+	logger           logger.Logger
+}
+
+// NewEventNotifier создает новый EventNotifier
+func NewEventNotifier(
+	subscriptionRepo repository.SubscriptionRepository,
+	deliveryRepo repository.EventDeliveryRepository,
+	logger logger.Logger,
+) *EventNotifier {
+	return &EventNotifier{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		logger:           logger,
+	}
+}
+
+// Publish создает по доставке на каждую активную подписку на тип события
+func (n *EventNotifier) Publish(ctx context.Context, e event.Event) error {
+	subscriptions, err := n.subscriptionRepo.FindActiveByEventType(ctx, e.Type)
+	if err != nil {
+		return fmt.Errorf("failed to find subscriptions for %s: %w", e.Type, err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(eventEnvelope{
+		ID:         e.ID,
+		Type:       e.Type,
+		OccurredAt: e.OccurredAt,
+		Data:       e.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event %s: %w", e.Type, err)
+	}
+
+	deliveries := make([]*entity.EventDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, entity.NewEventDelivery(
+			newID(),
+			subscription.ID(),
+			e.ID,
+			e.Type,
+			payload,
+			e.OccurredAt,
+		))
+	}
+
+	if err := n.deliveryRepo.Enqueue(ctx, deliveries); err != nil {
+		return fmt.Errorf("failed to enqueue deliveries: %w", err)
+	}
+
+	n.logger.Debug("Event enqueued for delivery", "event_id", e.ID, "deliveries_count", len(deliveries))
+	return nil
+}
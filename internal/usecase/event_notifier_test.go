@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	eventmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/event/mocks"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+)
+
+// newTestEmitter возвращает Emitter, принимающий любые события
+func newTestEmitter(ctrl *gomock.Controller) *eventmocks.MockEmitter {
+	emitter := eventmocks.NewMockEmitter(ctrl)
+	emitter.EXPECT().Emit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return emitter
+}
+
+func TestEventNotifier_Publish(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	subscriptionA := entity.NewSubscriptionFromRepository("sub-a", "https://a.example.com/hook", "secret-secret-aaa",
+		[]entity.EventType{entity.EventPRMerged}, true, now, now)
+	subscriptionB := entity.NewSubscriptionFromRepository("sub-b", "https://b.example.com/hook", "secret-secret-bbb",
+		[]entity.EventType{entity.EventPRMerged, entity.EventUserDeactivated}, true, now, now)
+
+	tests := []struct {
+		name           string
+		events         []event.Event
+		setupMocks     func(*repositorymocks.MockSubscriptionRepository, *repositorymocks.MockEventDeliveryRepository)
+		expectErr      bool
+		expectedQueued map[string]int // subscription_id -> число доставок
+	}{
+		{
+			name: "success - delivery per matching subscription",
+			events: []event.Event{
+				{Type: entity.EventPRMerged, OccurredAt: now, Data: event.PRMerged{PullRequestID: "pr-1"}},
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository) {
+				subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), entity.EventPRMerged).
+					Return([]*entity.Subscription{subscriptionA, subscriptionB}, nil)
+			},
+			expectedQueued: map[string]int{"sub-a": 1, "sub-b": 1},
+		},
+		{
+			name: "success - delivery per event",
+			events: []event.Event{
+				event.NewUserDeactivated("user-1", "team-1"),
+				event.NewUserDeactivated("user-2", "team-1"),
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository) {
+				subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), entity.EventUserDeactivated).
+					Return([]*entity.Subscription{subscriptionB}, nil).Times(2)
+			},
+			expectedQueued: map[string]int{"sub-b": 2},
+		},
+		{
+			name: "success - no subscribers, nothing enqueued",
+			events: []event.Event{
+				event.NewReviewerReassigned("pr-1", "user-1", ""),
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository) {
+				subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), entity.EventReviewerReassigned).Return(nil, nil)
+			},
+			expectedQueued: map[string]int{},
+		},
+		{
+			name: "error - subscription lookup fails",
+			events: []event.Event{
+				event.NewUserDeactivated("user-1", "team-1"),
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, deliveryRepo *repositorymocks.MockEventDeliveryRepository) {
+				subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), entity.EventUserDeactivated).
+					Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+			deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setupMocks(subscriptionRepo, deliveryRepo)
+
+			queued := make(map[string]int)
+			deliveryRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, deliveries []*entity.EventDelivery) error {
+					for _, delivery := range deliveries {
+						queued[delivery.SubscriptionID()]++
+					}
+					return nil
+				},
+			).AnyTimes()
+
+			notifier := NewEventNotifier(subscriptionRepo, deliveryRepo, logger)
+			var err error
+			for _, e := range tt.events {
+				if err = notifier.Publish(context.Background(), e); err != nil {
+					break
+				}
+			}
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(queued) != len(tt.expectedQueued) {
+				t.Fatalf("expected deliveries %v, got %v", tt.expectedQueued, queued)
+			}
+			for subscriptionID, count := range tt.expectedQueued {
+				if queued[subscriptionID] != count {
+					t.Errorf("expected %d deliveries for %s, got %d", count, subscriptionID, queued[subscriptionID])
+				}
+			}
+		})
+	}
+}
+
+func TestEventNotifier_PublishPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	subscription := entity.NewSubscriptionFromRepository("sub-a", "https://a.example.com/hook", "secret-secret-aaa",
+		[]entity.EventType{entity.EventReviewerReassigned}, true, now, now)
+
+	subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+	deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+	logger := loggermocks.NewMockLogger(ctrl)
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	subscriptionRepo.EXPECT().FindActiveByEventType(gomock.Any(), entity.EventReviewerReassigned).
+		Return([]*entity.Subscription{subscription}, nil)
+
+	var delivery *entity.EventDelivery
+	deliveryRepo.EXPECT().Enqueue(gomock.Any(), gomock.Len(1)).DoAndReturn(
+		func(_ context.Context, deliveries []*entity.EventDelivery) error {
+			delivery = deliveries[0]
+			return nil
+		},
+	)
+
+	// так событие приходит из outbox: данные уже сериализованы
+	e := event.Event{
+		ID:         "event-1",
+		Type:       entity.EventReviewerReassigned,
+		OccurredAt: now,
+		Data:       json.RawMessage(`{"pull_request_id":"pr-1","old_user_id":"user-1","new_user_id":"user-2"}`),
+	}
+
+	notifier := NewEventNotifier(subscriptionRepo, deliveryRepo, logger)
+	if err := notifier.Publish(context.Background(), e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var envelope struct {
+		ID         string    `json:"id"`
+		Type       string    `json:"type"`
+		OccurredAt time.Time `json:"occurred_at"`
+		Data       struct {
+			PullRequestID string  `json:"pull_request_id"`
+			OldUserID     string  `json:"old_user_id"`
+			NewUserID     *string `json:"new_user_id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(delivery.Payload(), &envelope); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+
+	if envelope.ID != "event-1" || delivery.EventID() != "event-1" {
+		t.Errorf("expected event id event-1 in envelope and delivery, got %q and %q", envelope.ID, delivery.EventID())
+	}
+	if envelope.Type != "reviewer.reassigned" {
+		t.Errorf("expected type reviewer.reassigned, got %s", envelope.Type)
+	}
+	if !envelope.OccurredAt.Equal(now) {
+		t.Errorf("expected occurred_at %v, got %v", now, envelope.OccurredAt)
+	}
+	if envelope.Data.PullRequestID != "pr-1" || envelope.Data.OldUserID != "user-1" ||
+		envelope.Data.NewUserID == nil || *envelope.Data.NewUserID != "user-2" {
+		t.Errorf("unexpected data: %+v", envelope.Data)
+	}
+	if !delivery.NextAttemptAt().Equal(now) || delivery.Attempts() != 0 {
+		t.Errorf("expected delivery due immediately with no attempts, got %v/%d", delivery.NextAttemptAt(), delivery.Attempts())
+	}
+}
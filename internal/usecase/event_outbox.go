@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+var _ event.Emitter = (*EventOutbox)(nil)
+
+// EventOutbox записывает доменные события в outbox в транзакции изменения.
+// Публикацию выполняет OutboxRelay после коммита
+type EventOutbox struct {
+	outboxRepo repository.OutboxRepository
+}
+
+// NewEventOutbox создает новый EventOutbox
+func NewEventOutbox(outboxRepo repository.OutboxRepository) *EventOutbox {
+	return &EventOutbox{outboxRepo: outboxRepo}
+}
+
+// Emit записывает события в outbox, присваивая каждому ID
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (o *EventOutbox) Emit(ctx context.Context, events ...event.Event) error {
+	messages := make([]*entity.OutboxMessage, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e.Data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event %s: %w", e.Type, err)
+		}
+		messages = append(messages, entity.NewOutboxMessage(newID(), e.Type, payload, e.OccurredAt))
+	}
+
+	if err := o.outboxRepo.Add(ctx, messages); err != nil {
+		return fmt.Errorf("failed to write events to outbox: %w", err)
+	}
+
+	return nil
+}
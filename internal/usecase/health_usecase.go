@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// ShutdownCheckName имя проверки, которая не проходит во время остановки сервиса
+const ShutdownCheckName = "shutdown"
+
+// ErrShuttingDown сервис останавливается и не должен получать новый трафик
+var ErrShuttingDown = errors.New("service is shutting down")
+
+// HealthCheck проверка зависимости, без которой сервис не готов принимать трафик
+type HealthCheck interface {
+	// Name имя проверки в ответе /readyz
+	Name() string
+	// Check возвращает подробности состояния зависимости. Ошибка означает, что сервис не готов
+	Check(ctx context.Context) (map[string]any, error)
+}
+
+// HealthUseCase проверки живости и готовности сервиса
+type HealthUseCase struct {
+	checks   []HealthCheck
+	timeout  time.Duration
+	draining atomic.Bool
+	ready    atomic.Bool
+	logger   logger.Logger
+}
+
+// NewHealthUseCase создает новый HealthUseCase. Проверки выполняются по порядку,
+// каждая ограничена timeout
+func NewHealthUseCase(checks []HealthCheck, timeout time.Duration, logger logger.Logger) *HealthUseCase {
+	uc := &HealthUseCase{
+		checks:  checks,
+		timeout: timeout,
+		logger:  logger,
+	}
+	uc.ready.Store(true)
+	return uc
+}
+
+// Liveness сообщает, что процесс жив и обслуживает запросы. Зависимости не проверяются:
+// их отказ не лечится перезапуском процесса
+// GET /livez
+func (uc *HealthUseCase) Liveness() *dto.HealthDTO {
+	return &dto.HealthDTO{Status: dto.HealthStatusOK}
+}
+
+// Readiness проверяет, готов ли сервис принимать трафик. Проверки выполняются последовательно:
+// параллельные проверки заняли бы несколько соединений и исказили бы занятость пула
+// GET /readyz
+func (uc *HealthUseCase) Readiness(ctx context.Context) *dto.HealthDTO {
+	result := &dto.HealthDTO{
+		Status: dto.HealthStatusOK,
+		Checks: make(map[string]dto.HealthCheckDTO, len(uc.checks)+1),
+	}
+
+	shutdown := dto.HealthCheckDTO{Status: dto.HealthStatusOK}
+	if uc.draining.Load() {
+		shutdown = dto.HealthCheckDTO{Status: dto.HealthStatusFail, Error: ErrShuttingDown.Error()}
+		result.Status = dto.HealthStatusFail
+	}
+	result.Checks[ShutdownCheckName] = shutdown
+
+	for _, check := range uc.checks {
+		checkResult := uc.runCheck(ctx, check)
+		if checkResult.Status != dto.HealthStatusOK {
+			result.Status = dto.HealthStatusFail
+		}
+		result.Checks[check.Name()] = checkResult
+	}
+
+	uc.logTransition(result)
+	return result
+}
+
+// StartDraining переводит сервис в состояние "не готов" перед остановкой, чтобы балансировщик
+// перестал направлять на него запросы, пока сервер еще обслуживает начатые
+func (uc *HealthUseCase) StartDraining() {
+	uc.draining.Store(true)
+}
+
+func (uc *HealthUseCase) runCheck(ctx context.Context, check HealthCheck) dto.HealthCheckDTO {
+	ctx, cancel := context.WithTimeout(ctx, uc.timeout)
+	defer cancel()
+
+	start := time.Now()
+	details, err := check.Check(ctx)
+	result := dto.HealthCheckDTO{
+		Status:     dto.HealthStatusOK,
+		DurationMs: time.Since(start).Milliseconds(),
+		Details:    details,
+	}
+	if err != nil {
+		result.Status = dto.HealthStatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// logTransition пишет в лог только смену готовности, чтобы частые пробы не засоряли лог
+func (uc *HealthUseCase) logTransition(result *dto.HealthDTO) {
+	ready := result.Status == dto.HealthStatusOK
+	if uc.ready.Swap(ready) == ready {
+		return
+	}
+
+	if ready {
+		uc.logger.Info("Service is ready")
+		return
+	}
+
+	failed := make([]string, 0, len(result.Checks))
+	for name, check := range result.Checks {
+		if check.Status != dto.HealthStatusOK {
+			failed = append(failed, name+": "+check.Error)
+		}
+	}
+	uc.logger.Warn("Service is not ready", "failed_checks", failed)
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+type stubHealthCheck struct {
+	name  string
+	check func(ctx context.Context) (map[string]any, error)
+}
+
+func (c stubHealthCheck) Name() string { return c.name }
+
+func (c stubHealthCheck) Check(ctx context.Context) (map[string]any, error) { return c.check(ctx) }
+
+func okCheck(name string) stubHealthCheck {
+	return stubHealthCheck{name: name, check: func(context.Context) (map[string]any, error) {
+		return map[string]any{"in_use": 1}, nil
+	}}
+}
+
+func TestHealthUseCase_Liveness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	failing := stubHealthCheck{name: "database", check: func(context.Context) (map[string]any, error) {
+		t.Error("liveness must not run dependency checks")
+		return nil, nil
+	}}
+	uc := NewHealthUseCase([]HealthCheck{failing}, time.Second, loggermocks.NewMockLogger(ctrl))
+	uc.StartDraining()
+
+	if got := uc.Liveness().Status; got != dto.HealthStatusOK {
+		t.Errorf("expected liveness %q during shutdown, got %q", dto.HealthStatusOK, got)
+	}
+}
+
+func TestHealthUseCase_Readiness(t *testing.T) {
+	errDown := errors.New("connection refused")
+
+	tests := []struct {
+		name       string
+		checks     []HealthCheck
+		draining   bool
+		setupMocks func(logger *loggermocks.MockLogger)
+		wantStatus string
+		wantFailed []string
+	}{
+		{
+			name:       "all checks pass",
+			checks:     []HealthCheck{okCheck("database"), okCheck("schema")},
+			wantStatus: dto.HealthStatusOK,
+		},
+		{
+			name:       "no dependencies",
+			wantStatus: dto.HealthStatusOK,
+		},
+		{
+			name: "failed check",
+			checks: []HealthCheck{okCheck("schema"), stubHealthCheck{name: "database", check: func(context.Context) (map[string]any, error) {
+				return nil, errDown
+			}}},
+			setupMocks: func(logger *loggermocks.MockLogger) {
+				logger.EXPECT().Warn("Service is not ready", "failed_checks", []string{"database: connection refused"})
+			},
+			wantStatus: dto.HealthStatusFail,
+			wantFailed: []string{"database"},
+		},
+		{
+			name: "check exceeds timeout",
+			checks: []HealthCheck{stubHealthCheck{name: "database", check: func(ctx context.Context) (map[string]any, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}}},
+			setupMocks: func(logger *loggermocks.MockLogger) {
+				logger.EXPECT().Warn("Service is not ready", gomock.Any(), gomock.Any())
+			},
+			wantStatus: dto.HealthStatusFail,
+			wantFailed: []string{"database"},
+		},
+		{
+			name:     "draining",
+			checks:   []HealthCheck{okCheck("database")},
+			draining: true,
+			setupMocks: func(logger *loggermocks.MockLogger) {
+				logger.EXPECT().Warn("Service is not ready", gomock.Any(), gomock.Any())
+			},
+			wantStatus: dto.HealthStatusFail,
+			wantFailed: []string{ShutdownCheckName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logger := loggermocks.NewMockLogger(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(logger)
+			}
+
+			uc := NewHealthUseCase(tt.checks, 10*time.Millisecond, logger)
+			if tt.draining {
+				uc.StartDraining()
+			}
+
+			result := uc.Readiness(context.Background())
+			if result.Status != tt.wantStatus {
+				t.Errorf("expected status %q, got %q", tt.wantStatus, result.Status)
+			}
+			if len(result.Checks) != len(tt.checks)+1 {
+				t.Errorf("expected %d checks, got %d", len(tt.checks)+1, len(result.Checks))
+			}
+
+			failed := make(map[string]bool)
+			for _, name := range tt.wantFailed {
+				failed[name] = true
+			}
+			for name, check := range result.Checks {
+				wantCheck := dto.HealthStatusOK
+				if failed[name] {
+					wantCheck = dto.HealthStatusFail
+				}
+				if check.Status != wantCheck {
+					t.Errorf("expected check %s to be %q, got %q (%s)", name, wantCheck, check.Status, check.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestHealthUseCase_ReadinessLogsTransitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermocks.NewMockLogger(ctrl)
+
+	var down bool
+	check := stubHealthCheck{name: "database", check: func(context.Context) (map[string]any, error) {
+		if down {
+			return nil, errors.New("connection refused")
+		}
+		return nil, nil
+	}}
+	uc := NewHealthUseCase([]HealthCheck{check}, time.Second, logger)
+
+	// Повторные пробы в том же состоянии не пишутся в лог
+	gomock.InOrder(
+		logger.EXPECT().Warn("Service is not ready", gomock.Any(), gomock.Any()).Times(1),
+		logger.EXPECT().Info("Service is ready").Times(1),
+	)
+
+	uc.Readiness(context.Background())
+	down = true
+	uc.Readiness(context.Background())
+	uc.Readiness(context.Background())
+	down = false
+	uc.Readiness(context.Background())
+	uc.Readiness(context.Background())
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// IdempotencySettings настройки хранения ответов на запросы с Idempotency-Key
+type IdempotencySettings struct {
+	TTL             time.Duration // сколько хранится ответ
+	Lease           time.Duration // сколько ключ занят запросом, который еще не сохранил ответ
+	CleanupInterval time.Duration // как часто удаляются истекшие записи
+}
+
+// IdempotencyUseCase сохраняет первый ответ на запрос с Idempotency-Key и повторяет его
+// для запросов с тем же ключом. Ключи разных клиентов не пересекаются: scope - ID клиента
+type IdempotencyUseCase struct {
+	idempotencyRepo repository.IdempotencyRepository
+	settings        IdempotencySettings
+	logger          logger.Logger
+	now             func() time.Time
+}
+
+// NewIdempotencyUseCase создает новый IdempotencyUseCase
+func NewIdempotencyUseCase(
+	idempotencyRepo repository.IdempotencyRepository,
+	settings IdempotencySettings,
+	logger logger.Logger,
+) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		idempotencyRepo: idempotencyRepo,
+		settings:        settings,
+		logger:          logger,
+		now:             func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Begin занимает ключ перед выполнением запроса. Возвращает nil, если запрос нужно выполнить,
+// и сохраненный ответ, если запрос с тем же ключом и хешем уже выполнен.
+// Ключ, занятый другим запросом, дает ErrIdempotencyKeyReused, а еще не выполненным - ErrIdempotencyKeyInProgress.
+// Запрос, не сохранивший ответ за Lease (например, из-за падения экземпляра), больше не держит ключ
+func (uc *IdempotencyUseCase) Begin(ctx context.Context, scope, key, requestHash string) (*dto.IdempotentResponseDTO, error) {
+	record, err := entity.NewIdempotencyRecord(scope, key, requestHash, uc.now(), uc.settings.Lease)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIdempotencyKey, err)
+	}
+
+	reserved, err := uc.idempotencyRepo.Reserve(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	existing, err := uc.idempotencyRepo.Find(ctx, scope, key)
+	if err != nil {
+		// Запись удалили между Reserve и Find: выполнявшийся запрос завершился ошибкой
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	if !existing.Matches(requestHash) {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if !existing.IsCompleted() {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+
+	return &dto.IdempotentResponseDTO{
+		StatusCode:  existing.StatusCode(),
+		ContentType: existing.ContentType(),
+		Body:        existing.Body(),
+	}, nil
+}
+
+// Complete сохраняет ответ на запрос, для которого Begin занял ключ, на срок TTL
+func (uc *IdempotencyUseCase) Complete(ctx context.Context, scope, key string, response dto.IdempotentResponseDTO) error {
+	record, err := uc.idempotencyRepo.Find(ctx, scope, key)
+	if err != nil {
+		return fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	record.Complete(response.StatusCode, response.ContentType, response.Body, uc.now().Add(uc.settings.TTL))
+
+	if err := uc.idempotencyRepo.SaveResponse(ctx, record); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+// Release освобождает ключ запроса, который не удалось выполнить, чтобы клиент мог его повторить
+func (uc *IdempotencyUseCase) Release(ctx context.Context, scope, key string) error {
+	if err := uc.idempotencyRepo.Delete(ctx, scope, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Run удаляет истекшие записи каждые CleanupInterval, пока не отменен ctx.
+// Истекшие записи не влияют на ответы, удаление только освобождает место
+func (uc *IdempotencyUseCase) Run(ctx context.Context) {
+	uc.logger.Info("Idempotency keys cleanup started", "interval", uc.settings.CleanupInterval)
+
+	ticker := time.NewTicker(uc.settings.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			uc.logger.Info("Idempotency keys cleanup stopped")
+			return
+		case <-ticker.C:
+		}
+
+		deleted, err := uc.idempotencyRepo.DeleteExpired(ctx, uc.now())
+		if err != nil {
+			if ctx.Err() == nil {
+				uc.logger.Error("Failed to delete expired idempotency keys", "error", err)
+			}
+			continue
+		}
+		if deleted > 0 {
+			uc.logger.Debug("Expired idempotency keys deleted", "count", deleted)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+func TestIdempotencyUseCase_Begin(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	errStorage := errors.New("connection refused")
+	completed := entity.NewIdempotencyRecordFromRepository(
+		"u1", "key-1", "hash-1", 201, "application/json", []byte(`{"ok":true}`), now, now.Add(time.Hour),
+	)
+	inProgress := entity.NewIdempotencyRecordFromRepository(
+		"u1", "key-1", "hash-1", 0, "", nil, now, now.Add(time.Minute),
+	)
+
+	tests := []struct {
+		name       string
+		key        string
+		setupMocks func(repo *repositorymocks.MockIdempotencyRepository)
+		expected   *dto.IdempotentResponseDTO
+		expectErr  error
+	}{
+		{
+			name: "new key",
+			key:  "key-1",
+			setupMocks: func(repo *repositorymocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, r *entity.IdempotencyRecord) (bool, error) {
+						// Пока ответа нет, ключ занят только на время аренды
+						if r.Scope() != "u1" || r.Key() != "key-1" || !r.ExpiresAt().Equal(now.Add(time.Minute)) {
+							t.Errorf("unexpected record: scope=%s key=%s expires_at=%v", r.Scope(), r.Key(), r.ExpiresAt())
+						}
+						return true, nil
+					},
+				)
+			},
+		},
+		{
+			name: "replay completed request",
+			key:  "key-1",
+			setupMocks: func(repo *repositorymocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().Find(gomock.Any(), "u1", "key-1").Return(completed, nil)
+			},
+			expected: &dto.IdempotentResponseDTO{StatusCode: 201, ContentType: "application/json", Body: []byte(`{"ok":true}`)},
+		},
+		{
+			name: "key reused with different request",
+			key:  "key-1",
+			setupMocks: func(repo *repositorymocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().Find(gomock.Any(), "u1", "key-1").Return(
+					entity.NewIdempotencyRecordFromRepository("u1", "key-1", "hash-2", 201, "", nil, now, now.Add(time.Hour)), nil,
+				)
+			},
+			expectErr: ErrIdempotencyKeyReused,
+		},
+		{
+			name: "request in progress",
+			key:  "key-1",
+			setupMocks: func(repo *repositorymocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, nil)
+				repo.EXPECT().Find(gomock.Any(), "u1", "key-1").Return(inProgress, nil)
+			},
+			expectErr: ErrIdempotencyKeyInProgress,
+		},
+		{
+			name:       "invalid key",
+			key:        "key\n1",
+			setupMocks: func(*repositorymocks.MockIdempotencyRepository) {},
+			expectErr:  ErrInvalidIdempotencyKey,
+		},
+		{
+			name: "repository error",
+			key:  "key-1",
+			setupMocks: func(repo *repositorymocks.MockIdempotencyRepository) {
+				repo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(false, errStorage)
+			},
+			expectErr: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := repositorymocks.NewMockIdempotencyRepository(ctrl)
+			tt.setupMocks(repo)
+
+			uc := NewIdempotencyUseCase(repo, IdempotencySettings{TTL: time.Hour, Lease: time.Minute}, loggermocks.NewMockLogger(ctrl))
+			uc.now = func() time.Time { return now }
+
+			result, err := uc.Begin(context.Background(), "u1", tt.key, "hash-1")
+
+			if tt.expectErr != nil {
+				if err == nil {
+					t.Fatalf("expected error %v, got nil", tt.expectErr)
+				}
+				if !errors.Is(err, tt.expectErr) {
+					t.Errorf("expected error %v, got %v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.expected == nil {
+				if result != nil {
+					t.Errorf("expected request to proceed, got replay %+v", result)
+				}
+				return
+			}
+			if result == nil || result.StatusCode != tt.expected.StatusCode ||
+				result.ContentType != tt.expected.ContentType || string(result.Body) != string(tt.expected.Body) {
+				t.Errorf("expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestIdempotencyUseCase_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	repo := repositorymocks.NewMockIdempotencyRepository(ctrl)
+	repo.EXPECT().Find(gomock.Any(), "u1", "key-1").Return(
+		entity.NewIdempotencyRecordFromRepository("u1", "key-1", "hash-1", 0, "", nil, now, now.Add(time.Minute)), nil,
+	)
+	repo.EXPECT().SaveResponse(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *entity.IdempotencyRecord) error {
+			if !r.IsCompleted() || r.StatusCode() != 409 || string(r.Body()) != "conflict" {
+				t.Errorf("unexpected saved response: status=%d body=%s", r.StatusCode(), r.Body())
+			}
+			// Сохраненный ответ хранится TTL от момента сохранения
+			if !r.ExpiresAt().Equal(now.Add(10 * time.Second).Add(time.Hour)) {
+				t.Errorf("expected response to expire after ttl, got %v", r.ExpiresAt())
+			}
+			return nil
+		},
+	)
+
+	uc := NewIdempotencyUseCase(repo, IdempotencySettings{TTL: time.Hour, Lease: time.Minute}, loggermocks.NewMockLogger(ctrl))
+	uc.now = func() time.Time { return now.Add(10 * time.Second) }
+	err := uc.Complete(context.Background(), "u1", "key-1", dto.IdempotentResponseDTO{StatusCode: 409, ContentType: "text/plain", Body: []byte("conflict")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Ключ удален, пока выполнялся запрос: ответ не сохраняется
+	repo.EXPECT().Find(gomock.Any(), "u1", "key-2").Return(nil, repository.ErrNotFound)
+	if err := uc.Complete(context.Background(), "u1", "key-2", dto.IdempotentResponseDTO{StatusCode: 200}); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("expected error %v, got %v", repository.ErrNotFound, err)
+	}
+}
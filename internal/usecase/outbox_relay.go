@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+)
+
+// EventPublisher публикует доменное событие из outbox. Ошибка оставляет событие неопубликованным.
+// Publish вызывается внутри транзакции relay: запись в ту же БД коммитится вместе с отметкой об отправке
+type EventPublisher interface {
+	Publish(ctx context.Context, e event.Event) error
+}
+
+// OutboxRelaySettings настройки публикации событий из outbox
+type OutboxRelaySettings struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// OutboxRelay публикует события из outbox и отмечает их отправленными.
+// Гарантия - at-least-once: если публикация пачки прервалась, пачка публикуется повторно целиком
+type OutboxRelay struct {
+	txManager  transaction.Manager
+	outboxRepo repository.OutboxRepository
+	publisher  EventPublisher
+	settings   OutboxRelaySettings
+	logger     logger.Logger
+	now        func() time.Time
+}
+
+// NewOutboxRelay создает новый OutboxRelay
+func NewOutboxRelay(
+	txManager transaction.Manager,
+	outboxRepo repository.OutboxRepository,
+	publisher EventPublisher,
+	settings OutboxRelaySettings,
+	logger logger.Logger,
+) *OutboxRelay {
+	return &OutboxRelay{
+		txManager:  txManager,
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		settings:   settings,
+		logger:     logger,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run публикует события каждые PollInterval, пока не отменен ctx.
+// Если пачка заполнена целиком, следующая забирается сразу, не дожидаясь интервала.
+// Незавершенная при остановке пачка откатывается и публикуется после перезапуска
+func (r *OutboxRelay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay started", "poll_interval", r.settings.PollInterval)
+
+	ticker := time.NewTicker(r.settings.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.RelayPending(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Error("Failed to relay outbox events", "error", err)
+		}
+
+		if err == nil && published == r.settings.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending публикует до BatchSize неопубликованных событий в порядке записи
+// и отмечает их отправленными в одной транзакции. Возвращает число опубликованных событий
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	var published int
+
+	err := r.txManager.Do(ctx, func(ctx context.Context) error {
+		messages, err := r.outboxRepo.FindPendingForUpdate(ctx, r.settings.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to find pending outbox messages: %w", err)
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(messages))
+		for _, message := range messages {
+			e := event.Event{
+				ID:         message.ID(),
+				Type:       message.EventType(),
+				OccurredAt: message.OccurredAt(),
+				Data:       json.RawMessage(message.Payload()),
+			}
+			if err := r.publisher.Publish(ctx, e); err != nil {
+				return fmt.Errorf("failed to publish event %s: %w", message.ID(), err)
+			}
+			ids = append(ids, message.ID())
+		}
+
+		if err := r.outboxRepo.MarkSent(ctx, ids, r.now()); err != nil {
+			return fmt.Errorf("failed to mark outbox messages sent: %w", err)
+		}
+
+		published = len(ids)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if published > 0 {
+		r.logger.Debug("Outbox events published", "count", published)
+	}
+	return published, nil
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
+)
+
+// mockEventPublisher ручной мок EventPublisher
+type mockEventPublisher struct {
+	publishFunc func(ctx context.Context, e event.Event) error
+	published   []event.Event
+}
+
+func (m *mockEventPublisher) Publish(ctx context.Context, e event.Event) error {
+	m.published = append(m.published, e)
+	return m.publishFunc(ctx, e)
+}
+
+func TestEventOutbox_Emit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := repositorymocks.NewMockOutboxRepository(ctrl)
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	assigned := event.Event{Type: entity.EventReviewerAssigned, OccurredAt: now, Data: event.ReviewerAssigned{PullRequestID: "pr-1", ReviewerIDs: []string{"u2"}}}
+	deactivated := event.Event{Type: entity.EventUserDeactivated, OccurredAt: now, Data: event.UserDeactivated{UserID: "u2", TeamName: "backend"}}
+
+	var messages []*entity.OutboxMessage
+	outboxRepo.EXPECT().Add(gomock.Any(), gomock.Len(2)).DoAndReturn(
+		func(_ context.Context, m []*entity.OutboxMessage) error {
+			messages = m
+			return nil
+		},
+	)
+
+	if err := NewEventOutbox(outboxRepo).Emit(context.Background(), assigned, deactivated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if messages[0].EventType() != entity.EventReviewerAssigned || messages[1].EventType() != entity.EventUserDeactivated {
+		t.Errorf("expected messages in emit order, got %s, %s", messages[0].EventType(), messages[1].EventType())
+	}
+	if messages[0].ID() == "" || messages[0].ID() == messages[1].ID() {
+		t.Errorf("expected unique message ids, got %q and %q", messages[0].ID(), messages[1].ID())
+	}
+	if !messages[0].OccurredAt().Equal(now) || messages[0].SentAt() != nil {
+		t.Errorf("unexpected message state: occurred_at=%v sent_at=%v", messages[0].OccurredAt(), messages[0].SentAt())
+	}
+	if string(messages[1].Payload()) != `{"user_id":"u2","team_name":"backend"}` {
+		t.Errorf("unexpected payload: %s", messages[1].Payload())
+	}
+}
+
+func TestEventOutbox_EmitError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := repositorymocks.NewMockOutboxRepository(ctrl)
+	outboxRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+
+	if err := NewEventOutbox(outboxRepo).Emit(context.Background(), event.NewUserDeactivated("u1", "backend")); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	settings := OutboxRelaySettings{PollInterval: time.Second, BatchSize: 10}
+	pending := []*entity.OutboxMessage{
+		entity.NewOutboxMessageFromRepository("msg-1", entity.EventReviewerAssigned, []byte(`{"pull_request_id":"pr-1"}`), now, nil),
+		entity.NewOutboxMessageFromRepository("msg-2", entity.EventPRMerged, []byte(`{"pull_request_id":"pr-1"}`), now, nil),
+	}
+
+	tests := []struct {
+		name              string
+		setupMocks        func(*repositorymocks.MockOutboxRepository)
+		publishErr        error
+		expectErr         bool
+		expectedCount     int
+		expectedPublished []string
+	}{
+		{
+			name: "success - messages published in order and marked sent",
+			setupMocks: func(outboxRepo *repositorymocks.MockOutboxRepository) {
+				outboxRepo.EXPECT().FindPendingForUpdate(gomock.Any(), 10).Return(pending, nil)
+				outboxRepo.EXPECT().MarkSent(gomock.Any(), []string{"msg-1", "msg-2"}, now).Return(nil)
+			},
+			expectedCount:     2,
+			expectedPublished: []string{"msg-1", "msg-2"},
+		},
+		{
+			name: "success - empty outbox",
+			setupMocks: func(outboxRepo *repositorymocks.MockOutboxRepository) {
+				outboxRepo.EXPECT().FindPendingForUpdate(gomock.Any(), 10).Return(nil, nil)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "error - publish failure leaves batch unsent",
+			setupMocks: func(outboxRepo *repositorymocks.MockOutboxRepository) {
+				outboxRepo.EXPECT().FindPendingForUpdate(gomock.Any(), 10).Return(pending, nil)
+			},
+			publishErr:        errors.New("broker unavailable"),
+			expectErr:         true,
+			expectedPublished: []string{"msg-1"},
+		},
+		{
+			name: "error - find pending fails",
+			setupMocks: func(outboxRepo *repositorymocks.MockOutboxRepository) {
+				outboxRepo.EXPECT().FindPendingForUpdate(gomock.Any(), 10).Return(nil, errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			outboxRepo := repositorymocks.NewMockOutboxRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			tt.setupMocks(outboxRepo)
+
+			publisher := &mockEventPublisher{
+				publishFunc: func(ctx context.Context, e event.Event) error {
+					return tt.publishErr
+				},
+			}
+
+			relay := NewOutboxRelay(txManager, outboxRepo, publisher, settings, logger)
+			relay.now = func() time.Time { return now }
+
+			count, err := relay.RelayPending(context.Background())
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("expected %d published, got %d", tt.expectedCount, count)
+			}
+
+			var published []string
+			for _, e := range publisher.published {
+				published = append(published, e.ID)
+			}
+			if !reflect.DeepEqual(published, tt.expectedPublished) {
+				t.Errorf("expected published %v, got %v", tt.expectedPublished, published)
+			}
+		})
+	}
+}
+
+func TestOutboxRelay_PublishedEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	outboxRepo := repositorymocks.NewMockOutboxRepository(ctrl)
+	txManager := transactionmocks.NewMockManager(ctrl)
+	logger := loggermocks.NewMockLogger(ctrl)
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	outboxRepo.EXPECT().FindPendingForUpdate(gomock.Any(), gomock.Any()).Return([]*entity.OutboxMessage{
+		entity.NewOutboxMessageFromRepository("msg-1", entity.EventUserDeactivated, []byte(`{"user_id":"u1","team_name":"backend"}`), now, nil),
+	}, nil)
+	outboxRepo.EXPECT().MarkSent(gomock.Any(), []string{"msg-1"}, gomock.Any()).Return(nil)
+
+	publisher := &mockEventPublisher{publishFunc: func(ctx context.Context, e event.Event) error { return nil }}
+	relay := NewOutboxRelay(txManager, outboxRepo, publisher, OutboxRelaySettings{PollInterval: time.Second, BatchSize: 10}, logger)
+
+	if _, err := relay.RelayPending(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := publisher.published[0]
+	if e.ID != "msg-1" || e.Type != entity.EventUserDeactivated || !e.OccurredAt.Equal(now) {
+		t.Errorf("unexpected event: %+v", e)
+	}
+
+	var data event.UserDeactivated
+	if err := json.Unmarshal(e.Data.(json.RawMessage), &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if data.UserID != "u1" || data.TeamName != "backend" {
+		t.Errorf("unexpected data: %+v", data)
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/metrics"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
@@ -16,8 +20,13 @@ import (
 type PullRequestUseCase struct {
 	txManager        transaction.Manager
 	prRepo           repository.PullRequestRepository
+	historyRepo      repository.ReviewerHistoryRepository
 	userRepo         repository.UserRepository
+	teamRepo         repository.TeamRepository
 	reviewerSelector *ReviewerSelector
+	events           event.Emitter
+	audit            audit.Recorder
+	metrics          metrics.Recorder
 	logger           logger.Logger
 }
 
@@ -25,22 +34,36 @@ type PullRequestUseCase struct {
 func NewPullRequestUseCase(
 	txManager transaction.Manager,
 	prRepo repository.PullRequestRepository,
+	historyRepo repository.ReviewerHistoryRepository,
 	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
 	reviewerSelector *ReviewerSelector,
+	events event.Emitter,
+	audit audit.Recorder,
+	metrics metrics.Recorder,
 	logger logger.Logger,
 ) *PullRequestUseCase {
 	return &PullRequestUseCase{
 		txManager:        txManager,
 		prRepo:           prRepo,
+		historyRepo:      historyRepo,
 		userRepo:         userRepo,
+		teamRepo:         teamRepo,
 		reviewerSelector: reviewerSelector,
+		events:           events,
+		audit:            audit,
+		metrics:          metrics,
 		logger:           logger,
 	}
 }
 
-// CreatePR создает PR и автоматически назначает до MaxReviewersCount ревьюеров из команды автора
+// CreatePR создает PR и автоматически назначает до max_reviewers ревьюеров из команды автора,
+// недостающих добирает из запасных команд. Если кандидатов меньше min_reviewers команды, PR не создается
 // POST /pullRequest/create
-func (uc *PullRequestUseCase) CreatePR(ctx context.Context, req dto.CreatePRRequest) (*dto.PullRequestDTO, error) {
+func (uc *PullRequestUseCase) CreatePR(ctx context.Context, req dto.CreatePRRequest) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.CreatePR", attrPullRequestID.String(req.PullRequestID))
+	defer func() { endSpan(span, err) }()
+
 	uc.logger.Info("Creating PR", "pr_id", req.PullRequestID, "author_id", req.AuthorID)
 
 	exists, err := uc.prRepo.Exists(ctx, req.PullRequestID)
@@ -61,29 +84,43 @@ func (uc *PullRequestUseCase) CreatePR(ctx context.Context, req dto.CreatePRRequ
 		return nil, fmt.Errorf("failed to find author: %w", err)
 	}
 
+	team, err := uc.teamRepo.FindByName(ctx, author.TeamName())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		uc.logger.Error("Failed to find author team", "error", err, "team_name", author.TeamName())
+		return nil, fmt.Errorf("failed to find author team: %w", err)
+	}
+
 	var pr *entity.PullRequest
 
 	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
-		pr, err = entity.NewPullRequest(req.PullRequestID, req.PullRequestName, req.AuthorID)
-		if err != nil {
-			return fmt.Errorf("failed to create PR entity: %w", err)
+		if req.Draft {
+			pr, err = entity.NewDraftPullRequest(req.PullRequestID, req.PullRequestName, req.AuthorID, team)
+		} else {
+			pr, err = entity.NewPullRequest(req.PullRequestID, req.PullRequestName, req.AuthorID, team)
 		}
-
-		reviewers, err := uc.reviewerSelector.SelectReviewers(ctx, author.TeamName(), req.AuthorID)
 		if err != nil {
-			return fmt.Errorf("failed to select reviewers: %w", err)
+			return fmt.Errorf("failed to create PR entity: %w", err)
 		}
 
-		for _, reviewerID := range reviewers {
-			if err := pr.AddReviewer(reviewerID); err != nil {
-				return fmt.Errorf("failed to add reviewer %s: %w", reviewerID, err)
+		if !pr.IsDraft() {
+			if err := uc.assignReviewers(ctx, pr, team); err != nil {
+				return err
 			}
 		}
 
 		if err := uc.prRepo.Create(ctx, pr); err != nil {
 			return fmt.Errorf("failed to save PR: %w", err)
 		}
-		return nil
+		if err := recordReviewersAssigned(ctx, uc.historyRepo, pr.ID(), pr.AssignedReviewers(), entity.ReviewerReasonAuto); err != nil {
+			return err
+		}
+		if err := uc.emitReviewersAssigned(ctx, pr, pr.AssignedReviewers()); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, prCreatedRecord(dto.ToPullRequestDTO(pr)))
 	})
 	if err != nil {
 		uc.logger.Error("Failed to create PR", "error", err, "pr_id", req.PullRequestID)
@@ -92,78 +129,262 @@ func (uc *PullRequestUseCase) CreatePR(ctx context.Context, req dto.CreatePRRequ
 
 	uc.logger.Info("PR created successfully",
 		"pr_id", req.PullRequestID,
+		"status", pr.Status(),
 		"reviewers_count", len(pr.AssignedReviewers()),
 		"reviewers", pr.AssignedReviewers(),
+		"fallback_reviewers", pr.FallbackReviewers(),
 	)
+	if !pr.IsDraft() {
+		uc.metrics.ReviewersAssigned(len(pr.AssignedReviewers()))
+	}
 	result := dto.ToPullRequestDTO(pr)
 	return &result, nil
 }
 
-// MergePR помечает PR как MERGED (идемпотентная операция)
+// MergePR помечает PR как MERGED (идемпотентная операция).
+// PR должен удовлетворять политике мержа своей команды, иначе возвращается MergeBlockedError
+// с невыполненными условиями. Force мержит в обход политики, такой мерж помечается в PR
 // POST /pullRequest/merge
-func (uc *PullRequestUseCase) MergePR(ctx context.Context, prID string) (*dto.PullRequestDTO, error) {
-	uc.logger.Info("Merging PR", "pr_id", prID)
+func (uc *PullRequestUseCase) MergePR(ctx context.Context, req dto.MergePRRequest) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.MergePR", attrPullRequestID.String(req.PullRequestID))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Merging PR", "pr_id", req.PullRequestID, "force", req.Force)
+
+	alreadyMerged := false
+	var bypassed []string
+
+	pr, err := uc.changeStatus(ctx, req.PullRequestID, func(ctx context.Context, pr *entity.PullRequest) error {
+		if err := checkManagerScope(ctx, uc.userRepo, uc.logger, req.ManagerID, pr.TeamName()); err != nil {
+			return err
+		}
+
+		switch pr.Status() {
+		case entity.PRStatusMerged:
+			alreadyMerged = true
+			return nil
+		case entity.PRStatusClosed:
+			return ErrPRClosed
+		case entity.PRStatusDraft:
+			return ErrPRDraft
+		}
+
+		team, err := uc.teamRepo.FindByName(ctx, pr.TeamName())
+		if err != nil {
+			return fmt.Errorf("failed to find PR team: %w", err)
+		}
+
+		before := dto.ToPullRequestDTO(pr)
+		unmet := team.MergePolicy().UnmetConditions(pr)
+		if len(unmet) > 0 && !req.Force {
+			return &MergeBlockedError{Conditions: unmet}
+		}
+
+		var merged bool
+		if len(unmet) > 0 {
+			bypassed = unmet
+			merged = pr.ForceMerge()
+		} else {
+			merged = pr.Merge()
+		}
+		if !merged {
+			return fmt.Errorf("failed to merge PR: unexpected status %s", pr.Status())
+		}
+
+		if err := uc.prRepo.UpdateStatus(ctx, pr); err != nil {
+			return fmt.Errorf("failed to update PR status: %w", err)
+		}
+		if err := emitEvents(ctx, uc.events, event.NewPRMerged(pr)); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, prMergedRecord(before, dto.ToPullRequestDTO(pr), bypassed))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to merge PR", "error", err, "pr_id", req.PullRequestID)
+		return nil, err
+	}
+
+	switch {
+	case alreadyMerged:
+		uc.logger.Info("PR already merged (idempotent operation)", "pr_id", req.PullRequestID)
+	case len(bypassed) > 0:
+		uc.logger.Warn("PR force merged in bypass of merge policy",
+			"pr_id", req.PullRequestID,
+			"unmet_conditions", bypassed,
+		)
+	default:
+		uc.logger.Info("PR merged successfully", "pr_id", req.PullRequestID)
+	}
+	result := dto.ToPullRequestDTO(pr)
+	return &result, nil
+}
+
+// MarkReady переводит черновик в OPEN и назначает ревьюверов по правилам команды
+// POST /pullRequest/ready
+func (uc *PullRequestUseCase) MarkReady(ctx context.Context, prID string) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.MarkReady", attrPullRequestID.String(prID))
+	defer func() { endSpan(span, err) }()
 
-	err := uc.prRepo.MergePR(ctx, prID)
+	uc.logger.Info("Marking PR as ready", "pr_id", prID)
+
+	pr, err := uc.changeStatus(ctx, prID, func(ctx context.Context, pr *entity.PullRequest) error {
+		before := dto.ToPullRequestDTO(pr)
+		if err := pr.MarkReady(); err != nil {
+			return mapStatusTransitionError(err)
+		}
+		if err := uc.assignReviewersAndSave(ctx, pr); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, prChangedRecord(entity.AuditPRReady, before, dto.ToPullRequestDTO(pr)))
+	})
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			pr, getErr := uc.prRepo.FindByID(ctx, prID)
-			if getErr != nil {
-				if errors.Is(getErr, repository.ErrNotFound) {
-					return nil, ErrPRNotFound
-				}
-				uc.logger.Error("Failed to find PR after merge attempt", "error", getErr, "pr_id", prID)
-				return nil, fmt.Errorf("failed to find PR: %w", getErr)
-			}
+		uc.logger.Error("Failed to mark PR as ready", "error", err, "pr_id", prID)
+		return nil, err
+	}
 
-			if pr.IsMerged() {
-				uc.logger.Info("PR already merged (idempotent operation)", "pr_id", prID)
-				result := dto.ToPullRequestDTO(pr)
-				return &result, nil
-			}
+	uc.logger.Info("PR marked as ready", "pr_id", prID, "reviewers", pr.AssignedReviewers())
+	uc.metrics.ReviewersAssigned(len(pr.AssignedReviewers()))
+	result := dto.ToPullRequestDTO(pr)
+	return &result, nil
+}
+
+// ClosePR закрывает PR без мержа. Ревьюверы остаются назначенными, но в загрузку не входят
+// POST /pullRequest/close
+func (uc *PullRequestUseCase) ClosePR(ctx context.Context, prID string) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.ClosePR", attrPullRequestID.String(prID))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Closing PR", "pr_id", prID)
+
+	pr, err := uc.changeStatus(ctx, prID, func(ctx context.Context, pr *entity.PullRequest) error {
+		before := dto.ToPullRequestDTO(pr)
+		if err := pr.Close(); err != nil {
+			return mapStatusTransitionError(err)
+		}
+		if err := uc.prRepo.UpdateStatus(ctx, pr); err != nil {
+			return fmt.Errorf("failed to update PR status: %w", err)
+		}
+		return recordAudit(ctx, uc.audit, prChangedRecord(entity.AuditPRClosed, before, dto.ToPullRequestDTO(pr)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to close PR", "error", err, "pr_id", prID)
+		return nil, err
+	}
+
+	uc.logger.Info("PR closed", "pr_id", prID)
+	result := dto.ToPullRequestDTO(pr)
+	return &result, nil
+}
+
+// ReopenPR возвращает закрытый PR в OPEN с прежними ревьюверами.
+// Если ревьюверов нет (PR был закрыт черновиком), они назначаются как при создании
+// POST /pullRequest/reopen
+func (uc *PullRequestUseCase) ReopenPR(ctx context.Context, prID string) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.ReopenPR", attrPullRequestID.String(prID))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Reopening PR", "pr_id", prID)
 
-			uc.logger.Error("Unexpected state: PR exists but CTE failed", "pr_id", prID)
-			return nil, fmt.Errorf("failed to merge PR: unexpected state")
+	assigned := false
+	pr, err := uc.changeStatus(ctx, prID, func(ctx context.Context, pr *entity.PullRequest) error {
+		before := dto.ToPullRequestDTO(pr)
+		if err := pr.Reopen(); err != nil {
+			return mapStatusTransitionError(err)
 		}
+		if len(pr.AssignedReviewers()) == 0 {
+			assigned = true
+			if err := uc.assignReviewersAndSave(ctx, pr); err != nil {
+				return err
+			}
+		} else if err := uc.prRepo.UpdateStatus(ctx, pr); err != nil {
+			return fmt.Errorf("failed to update PR status: %w", err)
+		}
+		return recordAudit(ctx, uc.audit, prChangedRecord(entity.AuditPRReopened, before, dto.ToPullRequestDTO(pr)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to reopen PR", "error", err, "pr_id", prID)
+		return nil, err
+	}
 
-		uc.logger.Error("Failed to merge PR", "error", err, "pr_id", prID)
-		return nil, fmt.Errorf("failed to merge PR: %w", err)
+	uc.logger.Info("PR reopened", "pr_id", prID, "reviewers", pr.AssignedReviewers())
+	if assigned {
+		uc.metrics.ReviewersAssigned(len(pr.AssignedReviewers()))
 	}
+	result := dto.ToPullRequestDTO(pr)
+	return &result, nil
+}
 
-	pr, err := uc.prRepo.FindByID(ctx, prID)
+// SubmitReview записывает ревью назначенного ревьювера
+// POST /pullRequest/review
+func (uc *PullRequestUseCase) SubmitReview(ctx context.Context, req dto.SubmitReviewRequest) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.SubmitReview", attrPullRequestID.String(req.PullRequestID), attrUserID.String(req.UserID))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Submitting review", "pr_id", req.PullRequestID, "user_id", req.UserID, "state", req.State)
+
+	state, err := entity.ParseReviewState(req.State)
 	if err != nil {
-		uc.logger.Error("Failed to find PR after merge", "error", err, "pr_id", prID)
-		return nil, fmt.Errorf("failed to find PR after merge: %w", err)
+		return nil, ErrInvalidReviewState
 	}
 
-	uc.logger.Info("PR merged successfully", "pr_id", prID)
+	var pr *entity.PullRequest
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = uc.findOpenPRForUpdate(ctx, req.PullRequestID)
+		if err != nil {
+			return err
+		}
+
+		before := dto.ToPullRequestDTO(pr)
+		if err := pr.SubmitReview(req.UserID, state); err != nil {
+			return mapReviewerChangeError(err)
+		}
+
+		if err := uc.prRepo.SaveReview(ctx, pr.ID(), pr.ReviewOf(req.UserID)); err != nil {
+			return fmt.Errorf("failed to save review: %w", err)
+		}
+		return recordAudit(ctx, uc.audit, prChangedRecord(entity.AuditReviewSubmitted, before, dto.ToPullRequestDTO(pr)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to submit review", "error", err, "pr_id", req.PullRequestID, "user_id", req.UserID)
+		return nil, err
+	}
+
+	uc.logger.Info("Review submitted",
+		"pr_id", req.PullRequestID,
+		"user_id", req.UserID,
+		"state", state,
+		"review_decision", pr.ReviewDecision(),
+	)
 	result := dto.ToPullRequestDTO(pr)
 	return &result, nil
 }
 
 // ReassignReviewer переназначает ревьювера на случайного активного из команды заменяемого
+// либо на пользователя, явно указанного в new_user_id
 // POST /pullRequest/reassign
-func (uc *PullRequestUseCase) ReassignReviewer(ctx context.Context, req dto.ReassignReviewerRequest) (*dto.PullRequestDTO, string, error) {
+func (uc *PullRequestUseCase) ReassignReviewer(ctx context.Context, req dto.ReassignReviewerRequest) (_ *dto.PullRequestDTO, _ string, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.ReassignReviewer", attrPullRequestID.String(req.PullRequestID), attrUserID.String(req.OldUserID))
+	defer func() { endSpan(span, err) }()
+
 	uc.logger.Info("Reassigning reviewer",
 		"pr_id", req.PullRequestID,
 		"old_user_id", req.OldUserID,
+		"new_user_id", req.NewUserID,
 	)
 
 	var pr *entity.PullRequest
 	var newReviewerID string
 
-	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
 		var err error
-		pr, err = uc.prRepo.FindByIDForUpdate(ctx, req.PullRequestID)
+		pr, err = uc.findOpenPRForUpdate(ctx, req.PullRequestID)
 		if err != nil {
-			if errors.Is(err, repository.ErrNotFound) {
-				return ErrPRNotFound
-			}
-			return fmt.Errorf("failed to find PR for update: %w", err)
+			return err
 		}
-
-		if pr.IsMerged() {
-			return ErrPRAlreadyMerged
+		if err := checkNotAuthor(pr, req.ActorID); err != nil {
+			return err
 		}
 
 		isAssigned := false
@@ -177,27 +398,51 @@ func (uc *PullRequestUseCase) ReassignReviewer(ctx context.Context, req dto.Reas
 			return ErrReviewerNotAssigned
 		}
 
-		newReviewerID, err = uc.reviewerSelector.SelectReplacement(
-			ctx,
-			req.OldUserID,
-			pr.AuthorID(),
-			pr.AssignedReviewers(),
-		)
+		reason := "replacement selected from the reviewer's team"
+		// Замена из команды заменяемого ревьювера остается в его слоте: запасной,
+		// если он был из запасной команды
+		isFallback := pr.IsFallbackReviewer(req.OldUserID)
+		if req.NewUserID != "" {
+			reason = "replacement requested explicitly"
+			newReviewerID, isFallback, err = uc.checkReassignTarget(ctx, pr, req.NewUserID)
+		} else {
+			newReviewerID, err = uc.reviewerSelector.SelectReplacement(
+				ctx,
+				req.OldUserID,
+				pr.AuthorID(),
+				pr.AssignedReviewers(),
+			)
+		}
 		if err != nil {
 			return err
 		}
 
-		if err := pr.ReplaceReviewer(req.OldUserID, newReviewerID); err != nil {
-			return fmt.Errorf("failed to replace reviewer in entity: %w", err)
+		if err := pr.ReplaceReviewer(req.OldUserID, newReviewerID, isFallback); err != nil {
+			return mapReviewerChangeError(err)
 		}
 
-		if err := uc.prRepo.ReplaceReviewer(ctx, pr.ID(), req.OldUserID, newReviewerID); err != nil {
+		if err := uc.prRepo.ReplaceReviewer(ctx, pr.ID(), req.OldUserID, newReviewerID, isFallback); err != nil {
 			return fmt.Errorf("failed to replace reviewer in database: %w", err)
 		}
 
-		return nil
+		change := repository.ReviewerChange{
+			PullRequestID: pr.ID(),
+			OldReviewerID: req.OldUserID,
+			NewReviewerID: newReviewerID,
+			Reason:        reason,
+		}
+		if err := recordReviewerChanges(ctx, uc.historyRepo, []repository.ReviewerChange{change}, entity.ReviewerReasonReassign); err != nil {
+			return err
+		}
+		if err := emitEvents(ctx, uc.events, event.NewReviewerReassigned(pr.ID(), req.OldUserID, newReviewerID)); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, reviewerReassignedRecord(change))
 	})
 	if err != nil {
+		if errors.Is(err, ErrNoActiveCandidates) {
+			uc.metrics.ReassignNoCandidate()
+		}
 		uc.logger.Error("Failed to reassign reviewer",
 			"error", err,
 			"pr_id", req.PullRequestID,
@@ -214,3 +459,329 @@ func (uc *PullRequestUseCase) ReassignReviewer(ctx context.Context, req dto.Reas
 	result := dto.ToPullRequestDTO(pr)
 	return &result, newReviewerID, nil
 }
+
+// AddReviewer вручную назначает активного пользователя ревьювером PR.
+// Пользователь не из команды PR помечается как ревьювер из запасной команды
+// POST /pullRequest/addReviewer
+func (uc *PullRequestUseCase) AddReviewer(ctx context.Context, req dto.AddReviewerRequest) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.AddReviewer", attrPullRequestID.String(req.PullRequestID), attrUserID.String(req.UserID))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Adding reviewer", "pr_id", req.PullRequestID, "user_id", req.UserID)
+
+	var pr *entity.PullRequest
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = uc.findOpenPRForUpdate(ctx, req.PullRequestID)
+		if err != nil {
+			return err
+		}
+		if err := checkNotAuthor(pr, req.ActorID); err != nil {
+			return err
+		}
+
+		user, err := uc.userRepo.FindByID(ctx, req.UserID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if !user.IsActive() {
+			return ErrUserInactive
+		}
+
+		before := dto.ToPullRequestDTO(pr)
+		isFallback := user.TeamName() != pr.TeamName()
+		if isFallback {
+			err = pr.AddFallbackReviewer(user.ID())
+		} else {
+			err = pr.AddReviewer(user.ID())
+		}
+		if err != nil {
+			return mapReviewerChangeError(err)
+		}
+
+		if err := uc.prRepo.AddReviewer(ctx, pr.ID(), user.ID(), isFallback); err != nil {
+			return fmt.Errorf("failed to add reviewer in database: %w", err)
+		}
+		if err := recordReviewersAssigned(ctx, uc.historyRepo, pr.ID(), []string{user.ID()}, entity.ReviewerReasonManual); err != nil {
+			return err
+		}
+		if err := uc.emitReviewersAssigned(ctx, pr, []string{user.ID()}); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, prChangedRecord(entity.AuditReviewerAdded, before, dto.ToPullRequestDTO(pr)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to add reviewer", "error", err, "pr_id", req.PullRequestID, "user_id", req.UserID)
+		return nil, err
+	}
+
+	uc.logger.Info("Reviewer added successfully", "pr_id", req.PullRequestID, "user_id", req.UserID)
+	result := dto.ToPullRequestDTO(pr)
+	return &result, nil
+}
+
+// RemoveReviewer вручную снимает ревьювера с PR, не допуская меньше min_reviewers ревьюверов
+// POST /pullRequest/removeReviewer
+func (uc *PullRequestUseCase) RemoveReviewer(ctx context.Context, req dto.RemoveReviewerRequest) (_ *dto.PullRequestDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.RemoveReviewer", attrPullRequestID.String(req.PullRequestID), attrUserID.String(req.UserID))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Removing reviewer", "pr_id", req.PullRequestID, "user_id", req.UserID)
+
+	var pr *entity.PullRequest
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = uc.findOpenPRForUpdate(ctx, req.PullRequestID)
+		if err != nil {
+			return err
+		}
+		if err := checkNotAuthor(pr, req.ActorID); err != nil {
+			return err
+		}
+
+		exists, err := uc.userRepo.Exists(ctx, req.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to check user existence: %w", err)
+		}
+		if !exists {
+			return ErrUserNotFound
+		}
+
+		before := dto.ToPullRequestDTO(pr)
+		if err := pr.RemoveReviewer(req.UserID); err != nil {
+			return mapReviewerChangeError(err)
+		}
+
+		if err := uc.prRepo.RemoveReviewer(ctx, pr.ID(), req.UserID); err != nil {
+			return fmt.Errorf("failed to remove reviewer in database: %w", err)
+		}
+		if err := recordReviewerUnassigned(ctx, uc.historyRepo, pr.ID(), req.UserID, entity.ReviewerReasonManual); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, prChangedRecord(entity.AuditReviewerRemoved, before, dto.ToPullRequestDTO(pr)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to remove reviewer", "error", err, "pr_id", req.PullRequestID, "user_id", req.UserID)
+		return nil, err
+	}
+
+	uc.logger.Info("Reviewer removed successfully", "pr_id", req.PullRequestID, "user_id", req.UserID)
+	result := dto.ToPullRequestDTO(pr)
+	return &result, nil
+}
+
+// GetReviewerHistory возвращает историю назначений ревьюверов PR, старые события первыми.
+// В отличие от assigned_reviewers включает ревьюверов, которых позже сняли или заменили
+// GET /pullRequest/history?pull_request_id=
+func (uc *PullRequestUseCase) GetReviewerHistory(ctx context.Context, prID string) (_ []dto.ReviewerHistoryEntryDTO, err error) {
+	ctx, span := startSpan(ctx, "PullRequestUseCase.GetReviewerHistory", attrPullRequestID.String(prID))
+	defer func() { endSpan(span, err) }()
+
+	exists, err := uc.prRepo.Exists(ctx, prID)
+	if err != nil {
+		uc.logger.Error("Failed to check PR existence", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("failed to check PR existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrPRNotFound
+	}
+
+	entries, err := uc.historyRepo.FindByPRID(ctx, prID)
+	if err != nil {
+		uc.logger.Error("Failed to find reviewer history", "error", err, "pr_id", prID)
+		return nil, fmt.Errorf("failed to find reviewer history: %w", err)
+	}
+
+	return dto.ToReviewerHistoryEntryDTOs(entries), nil
+}
+
+// checkNotAuthor запрещает автору PR менять его ревьюверов: иначе автор мог бы снять
+// запросившего изменения ревьювера или владельца кода и пройти политику мержа.
+// Пустой actorID (администратор) не проверяется
+func checkNotAuthor(pr *entity.PullRequest, actorID string) error {
+	if actorID != "" && actorID == pr.AuthorID() {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// findOpenPRForUpdate блокирует PR и проверяет, что его ревьюверов еще можно менять
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func (uc *PullRequestUseCase) findOpenPRForUpdate(ctx context.Context, prID string) (*entity.PullRequest, error) {
+	pr, err := uc.prRepo.FindByIDForUpdate(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrPRNotFound
+		}
+		return nil, fmt.Errorf("failed to find PR for update: %w", err)
+	}
+
+	switch pr.Status() {
+	case entity.PRStatusMerged:
+		return nil, ErrPRAlreadyMerged
+	case entity.PRStatusClosed:
+		return nil, ErrPRClosed
+	case entity.PRStatusDraft:
+		return nil, ErrPRDraft
+	}
+
+	return pr, nil
+}
+
+// changeStatus блокирует PR и применяет к нему переход статуса в одной транзакции
+func (uc *PullRequestUseCase) changeStatus(
+	ctx context.Context,
+	prID string,
+	transition func(ctx context.Context, pr *entity.PullRequest) error,
+) (*entity.PullRequest, error) {
+	var pr *entity.PullRequest
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pr, err = uc.prRepo.FindByIDForUpdate(ctx, prID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrPRNotFound
+			}
+			return fmt.Errorf("failed to find PR for update: %w", err)
+		}
+
+		return transition(ctx, pr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// assignReviewersAndSave назначает ревьюверов PR, который только что стал открытым, и сохраняет его
+func (uc *PullRequestUseCase) assignReviewersAndSave(ctx context.Context, pr *entity.PullRequest) error {
+	team, err := uc.teamRepo.FindByName(ctx, pr.TeamName())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrTeamNotFound
+		}
+		return fmt.Errorf("failed to find PR team: %w", err)
+	}
+
+	if err := uc.assignReviewers(ctx, pr, team); err != nil {
+		return err
+	}
+
+	if err := uc.prRepo.Update(ctx, pr); err != nil {
+		return fmt.Errorf("failed to save PR: %w", err)
+	}
+	if err := recordReviewersAssigned(ctx, uc.historyRepo, pr.ID(), pr.AssignedReviewers(), entity.ReviewerReasonAuto); err != nil {
+		return err
+	}
+	return uc.emitReviewersAssigned(ctx, pr, pr.AssignedReviewers())
+}
+
+// assignReviewers подбирает ревьюверов для PR по правилам команды и проверяет min_reviewers
+func (uc *PullRequestUseCase) assignReviewers(ctx context.Context, pr *entity.PullRequest, team *entity.Team) error {
+	reviewers, err := uc.reviewerSelector.SelectReviewers(ctx, team, pr.AuthorID(), pr.ReviewerLimits().Max())
+	if err != nil {
+		return fmt.Errorf("failed to select reviewers: %w", err)
+	}
+
+	for _, reviewerID := range reviewers.Home {
+		if err := pr.AddReviewer(reviewerID); err != nil {
+			return fmt.Errorf("failed to add reviewer %s: %w", reviewerID, err)
+		}
+	}
+	for _, reviewerID := range reviewers.Fallback {
+		if err := pr.AddFallbackReviewer(reviewerID); err != nil {
+			return fmt.Errorf("failed to add fallback reviewer %s: %w", reviewerID, err)
+		}
+	}
+
+	if !pr.HasEnoughReviewers() {
+		return ErrNotEnoughReviewers
+	}
+
+	return nil
+}
+
+// emitReviewersAssigned публикует reviewer.assigned, если PR получил ревьюверов
+func (uc *PullRequestUseCase) emitReviewersAssigned(ctx context.Context, pr *entity.PullRequest, reviewerIDs []string) error {
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+	return emitEvents(ctx, uc.events, event.NewReviewerAssigned(pr, reviewerIDs))
+}
+
+// checkReassignTarget проверяет явно указанного нового ревьювера: пользователь должен
+// быть активным и состоять в команде PR или в одной из ее запасных команд.
+// Возвращает ID ревьювера и признак запасной команды.
+// Автора и уже назначенных ревьюверов отсекает PullRequest.ReplaceReviewer
+func (uc *PullRequestUseCase) checkReassignTarget(ctx context.Context, pr *entity.PullRequest, userID string) (string, bool, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", false, ErrUserNotFound
+		}
+		return "", false, fmt.Errorf("failed to find new reviewer: %w", err)
+	}
+	if !user.IsActive() {
+		return "", false, ErrUserInactive
+	}
+
+	if user.TeamName() == pr.TeamName() {
+		return user.ID(), false, nil
+	}
+
+	team, err := uc.teamRepo.FindByName(ctx, pr.TeamName())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", false, ErrReviewerTeamNotAllowed
+		}
+		return "", false, fmt.Errorf("failed to find PR team: %w", err)
+	}
+	if !slices.Contains(team.FallbackTeams(), user.TeamName()) {
+		return "", false, ErrReviewerTeamNotAllowed
+	}
+
+	return user.ID(), true, nil
+}
+
+// mapStatusTransitionError переводит ошибки машины состояний PR в ошибки use case
+func mapStatusTransitionError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrPRMerged):
+		return ErrPRAlreadyMerged
+	case errors.Is(err, entity.ErrInvalidStatusTransition):
+		return fmt.Errorf("%w: %w", ErrInvalidStatusTransition, err)
+	default:
+		return fmt.Errorf("failed to change PR status: %w", err)
+	}
+}
+
+// mapReviewerChangeError переводит ошибки инвариантов PR в ошибки use case
+func mapReviewerChangeError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrPRMerged):
+		return ErrPRAlreadyMerged
+	case errors.Is(err, entity.ErrPRClosed):
+		return ErrPRClosed
+	case errors.Is(err, entity.ErrPRDraft):
+		return ErrPRDraft
+	case errors.Is(err, entity.ErrAuthorCannotReview):
+		return ErrAuthorCannotReview
+	case errors.Is(err, entity.ErrReviewerAlreadyAssigned):
+		return ErrReviewerAlreadyAssigned
+	case errors.Is(err, entity.ErrReviewerNotAssigned):
+		return ErrReviewerNotAssigned
+	case errors.Is(err, entity.ErrTooManyReviewers):
+		return ErrTooManyReviewers
+	case errors.Is(err, entity.ErrTooFewReviewers):
+		return ErrTooFewReviewers
+	default:
+		return fmt.Errorf("failed to change reviewers: %w", err)
+	}
+}
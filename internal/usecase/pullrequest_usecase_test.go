@@ -3,28 +3,69 @@ package usecase
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	eventmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/event/mocks"
 	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	metricsmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/metrics/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
 	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
+func newTestMetricsRecorder(ctrl *gomock.Controller) *metricsmocks.MockRecorder {
+	recorder := metricsmocks.NewMockRecorder(ctrl)
+	recorder.EXPECT().ReviewersAssigned(gomock.Any()).AnyTimes()
+	recorder.EXPECT().ReassignNoCandidate().AnyTimes()
+	return recorder
+}
+
 func TestPullRequestUseCase_CreatePR(t *testing.T) {
 	tests := []struct {
-		name          string
-		req           dto.CreatePRRequest
-		setupMocks    func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
-		expectErr     bool
-		expectedErr   error
-		expectedCount int
+		name             string
+		req              dto.CreatePRRequest
+		setupMocks       func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *repositorymocks.MockTeamRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
+		expectErr        bool
+		expectedErr      error
+		expectedCount    int
+		expectedFallback []string
 	}{
+		{
+			name: "success - draft created without reviewers",
+			req: dto.CreatePRRequest{
+				PullRequestID:   "pr-1",
+				PullRequestName: "Test PR",
+				AuthorID:        "author-1",
+				Draft:           true,
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+					nil,
+				)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.NewReviewerLimitsFromRepository(1, 2), nil, entity.MergePolicy{}, time.Now(), time.Now()),
+					nil,
+				)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				prRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedCount: 0,
+		},
 		{
 			name: "success - PR created with 2 reviewers",
 			req: dto.CreatePRRequest{
@@ -32,12 +73,16 @@ func TestPullRequestUseCase_CreatePR(t *testing.T) {
 				PullRequestName: "Test PR",
 				AuthorID:        "author-1",
 			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
 				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(
 					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
 					nil,
 				)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, time.Now(), time.Now()),
+					nil,
+				)
 				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
 					return fn(ctx)
 				})
@@ -53,7 +98,105 @@ func TestPullRequestUseCase_CreatePR(t *testing.T) {
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectErr:     false,
-			expectedCount: entity.MaxReviewersCount,
+			expectedCount: entity.DefaultMaxReviewers,
+		},
+		{
+			name: "success - team max_reviewers limits assignment",
+			req: dto.CreatePRRequest{
+				PullRequestID:   "pr-1",
+				PullRequestName: "Test PR",
+				AuthorID:        "author-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				limits, _ := entity.NewReviewerLimits(1, 1)
+				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+					nil,
+				)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, limits, nil, entity.MergePolicy{}, time.Now(), time.Now()),
+					nil,
+				)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+					entity.NewUserFromRepository("reviewer-2", "Reviewer 2", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{}, nil)
+				prRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:     false,
+			expectedCount: 1,
+		},
+		{
+			name: "success - fallback team fills remaining slot",
+			req: dto.CreatePRRequest{
+				PullRequestID:   "pr-1",
+				PullRequestName: "Test PR",
+				AuthorID:        "author-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+					nil,
+				)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), []string{"team-2"}, entity.MergePolicy{}, time.Now(), time.Now()),
+					nil,
+				)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-2").Return([]*entity.User{
+					entity.NewUserFromRepository("helper-1", "Helper 1", "team-2", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{}, nil).Times(2)
+				prRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:        false,
+			expectedCount:    2,
+			expectedFallback: []string{"helper-1"},
+		},
+		{
+			name: "error - not enough candidates for team min_reviewers",
+			req: dto.CreatePRRequest{
+				PullRequestID:   "pr-1",
+				PullRequestName: "Test PR",
+				AuthorID:        "author-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				limits, _ := entity.NewReviewerLimits(2, 3)
+				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+					nil,
+				)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, limits, nil, entity.MergePolicy{}, time.Now(), time.Now()),
+					nil,
+				)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{}, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrNotEnoughReviewers,
 		},
 		{
 			name: "error - PR already exists",
@@ -62,7 +205,7 @@ func TestPullRequestUseCase_CreatePR(t *testing.T) {
 				PullRequestName: "Test PR",
 				AuthorID:        "author-1",
 			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(true, nil)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -76,7 +219,7 @@ func TestPullRequestUseCase_CreatePR(t *testing.T) {
 				PullRequestName: "Test PR",
 				AuthorID:        "author-1",
 			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
 				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(nil, repository.ErrNotFound)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -96,11 +239,16 @@ func TestPullRequestUseCase_CreatePR(t *testing.T) {
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
-			reviewerSelector := NewReviewerSelector(userRepo, prRepo)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+			metricsRecorder := metricsmocks.NewMockRecorder(ctrl)
+			if !tt.expectErr && !tt.req.Draft {
+				metricsRecorder.EXPECT().ReviewersAssigned(tt.expectedCount)
+			}
 
-			uc := NewPullRequestUseCase(txManager, prRepo, userRepo, reviewerSelector, logger)
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), metricsRecorder, logger)
 
-			tt.setupMocks(prRepo, userRepo, txManager, logger)
+			tt.setupMocks(prRepo, userRepo, teamRepo, txManager, logger)
 
 			result, err := uc.CreatePR(context.Background(), tt.req)
 
@@ -126,57 +274,129 @@ func TestPullRequestUseCase_CreatePR(t *testing.T) {
 				if len(result.AssignedReviewers) != tt.expectedCount {
 					t.Errorf("expected %d reviewers, got %d", tt.expectedCount, len(result.AssignedReviewers))
 				}
+				if len(result.FallbackReviewers) != len(tt.expectedFallback) {
+					t.Fatalf("expected fallback reviewers %v, got %v", tt.expectedFallback, result.FallbackReviewers)
+				}
+				for i := range tt.expectedFallback {
+					if result.FallbackReviewers[i] != tt.expectedFallback[i] {
+						t.Errorf("expected fallback reviewers %v, got %v", tt.expectedFallback, result.FallbackReviewers)
+					}
+				}
 			}
 		})
 	}
 }
 
 func TestPullRequestUseCase_MergePR(t *testing.T) {
+	submitted := time.Now()
+	openPR := func(reviews ...entity.Review) *entity.PullRequest {
+		return entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1", "reviewer-2"}, nil, reviews, entity.DefaultReviewerLimits(), time.Now(), nil, false)
+	}
+	teamWithPolicy := func(policy entity.MergePolicy) *entity.Team {
+		return entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, policy, time.Now(), time.Now())
+	}
+	strictPolicy := entity.NewMergePolicyFromRepository(2, true, []string{"reviewer-2"})
+
 	tests := []struct {
-		name        string
-		prID        string
-		setupMocks  func(*repositorymocks.MockPullRequestRepository, *loggermocks.MockLogger)
-		expectErr   bool
-		expectedErr error
+		name           string
+		req            dto.MergePRRequest
+		setupMocks     func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockTeamRepository)
+		expectedErr    error
+		expectedUnmet  []string
+		expectedForced bool
 	}{
 		{
-			name: "success - PR merged",
-			prID: "pr-1",
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, logger *loggermocks.MockLogger) {
-				prRepo.EXPECT().MergePR(gomock.Any(), "pr-1").Return(nil)
-				prRepo.EXPECT().FindByID(gomock.Any(), "pr-1").Return(
-					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", entity.PRStatusMerged, []string{}, time.Now(), timePtr(time.Now())),
-					nil,
-				)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			name: "success - PR merged without policy",
+			req:  dto.MergePRRequest{PullRequestID: "pr-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(teamWithPolicy(entity.MergePolicy{}), nil)
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "success - policy satisfied",
+			req:  dto.MergePRRequest{PullRequestID: "pr-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(
+					entity.NewReviewFromRepository("reviewer-1", entity.ReviewStateApproved, &submitted),
+					entity.NewReviewFromRepository("reviewer-2", entity.ReviewStateApproved, &submitted),
+				), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(teamWithPolicy(strictPolicy), nil)
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
 			},
-			expectErr: false,
 		},
 		{
 			name: "success - PR already merged (idempotent)",
-			prID: "pr-1",
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, logger *loggermocks.MockLogger) {
-				prRepo.EXPECT().MergePR(gomock.Any(), "pr-1").Return(repository.ErrNotFound)
-				prRepo.EXPECT().FindByID(gomock.Any(), "pr-1").Return(
-					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", entity.PRStatusMerged, []string{}, time.Now(), timePtr(time.Now())),
+			req:  dto.MergePRRequest{PullRequestID: "pr-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusMerged, []string{}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), timePtr(time.Now()), false),
 					nil,
 				)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
-			expectErr: false,
+		},
+		{
+			name: "error - merge blocked by policy",
+			req:  dto.MergePRRequest{PullRequestID: "pr-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(
+					entity.NewReviewFromRepository("reviewer-1", entity.ReviewStateApproved, &submitted),
+					entity.NewReviewFromRepository("reviewer-2", entity.ReviewStateChangesRequested, &submitted),
+				), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(teamWithPolicy(strictPolicy), nil)
+			},
+			expectedErr: ErrMergeBlocked,
+			expectedUnmet: []string{
+				"2 approvals required, got 1",
+				"changes requested by reviewer-2",
+				"approval from a code owner required (reviewer-2)",
+			},
+		},
+		{
+			name: "success - force merge bypasses policy",
+			req:  dto.MergePRRequest{PullRequestID: "pr-1", Force: true},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(teamWithPolicy(strictPolicy), nil)
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, pr *entity.PullRequest) error {
+						if !pr.MergeForced() {
+							t.Error("expected force merge to be recorded")
+						}
+						return nil
+					})
+			},
+			expectedForced: true,
+		},
+		{
+			name: "success - force without unmet conditions is a regular merge",
+			req:  dto.MergePRRequest{PullRequestID: "pr-1", Force: true},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(teamWithPolicy(entity.MergePolicy{}), nil)
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
 		},
 		{
 			name: "error - PR not found",
-			prID: "pr-1",
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, logger *loggermocks.MockLogger) {
-				prRepo.EXPECT().MergePR(gomock.Any(), "pr-1").Return(repository.ErrNotFound)
-				prRepo.EXPECT().FindByID(gomock.Any(), "pr-1").Return(nil, repository.ErrNotFound)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			req:  dto.MergePRRequest{PullRequestID: "pr-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(nil, repository.ErrNotFound)
 			},
-			expectErr:   true,
 			expectedErr: ErrPRNotFound,
 		},
+		{
+			name: "error - closed PR cannot be merged",
+			req:  dto.MergePRRequest{PullRequestID: "pr-1", Force: true},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusClosed, []string{}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					nil,
+				)
+			},
+			expectedErr: ErrPRClosed,
+		},
 	}
 
 	for _, tt := range tests {
@@ -188,129 +408,658 @@ func TestPullRequestUseCase_MergePR(t *testing.T) {
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
-			reviewerSelector := NewReviewerSelector(userRepo, prRepo)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
 
-			uc := NewPullRequestUseCase(txManager, prRepo, userRepo, reviewerSelector, logger)
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
 
-			tt.setupMocks(prRepo, logger)
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(prRepo, teamRepo)
 
-			result, err := uc.MergePR(context.Background(), tt.prID)
+			result, err := uc.MergePR(context.Background(), tt.req)
 
-			if tt.expectErr {
-				if err == nil {
-					t.Errorf("expected error, got nil")
-				} else if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
 				}
 				if result != nil {
 					t.Errorf("expected nil result, got %v", result)
 				}
-			} else {
-				if err != nil {
-					t.Errorf("unexpected error: %v", err)
-				}
-				if result == nil {
-					t.Fatal("expected result, got nil")
-				}
-				if result.Status != string(entity.PRStatusMerged) {
-					t.Errorf("expected status MERGED, got %s", result.Status)
+				if tt.expectedUnmet != nil {
+					var blocked *MergeBlockedError
+					if !errors.As(err, &blocked) {
+						t.Fatalf("expected MergeBlockedError, got %T", err)
+					}
+					if !reflect.DeepEqual(blocked.Conditions, tt.expectedUnmet) {
+						t.Errorf("expected conditions %v, got %v", tt.expectedUnmet, blocked.Conditions)
+					}
 				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Status != string(entity.PRStatusMerged) {
+				t.Errorf("expected status MERGED, got %s", result.Status)
+			}
+			if result.MergeForced != tt.expectedForced {
+				t.Errorf("expected merge_forced %v, got %v", tt.expectedForced, result.MergeForced)
 			}
 		})
 	}
 }
 
-func TestPullRequestUseCase_ReassignReviewer(t *testing.T) {
+func TestPullRequestUseCase_MergePRTeamLeadScope(t *testing.T) {
 	tests := []struct {
 		name        string
-		req         dto.ReassignReviewerRequest
-		setupMocks  func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
-		expectErr   bool
+		leadTeam    string
 		expectedErr error
 	}{
-		{
-			name: "success - reviewer reassigned",
-			req: dto.ReassignReviewerRequest{
-				PullRequestID: "pr-1",
-				OldUserID:     "reviewer-1",
-			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
-				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-					return fn(ctx)
-				})
-				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
-					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", entity.PRStatusOpen, []string{"reviewer-1"}, time.Now(), nil),
-					nil,
-				)
-				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-1").Return(
-					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+		{name: "team lead merges PR of own team", leadTeam: "team-1"},
+		{name: "team lead of another team", leadTeam: "team-2", expectedErr: ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+				entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+				nil,
+			)
+			userRepo.EXPECT().FindByID(gomock.Any(), "lead-1").Return(
+				entity.NewUserFromRepository("lead-1", "Lead 1", tt.leadTeam, true, time.Now(), time.Now()), nil,
+			)
+			if tt.expectedErr == nil {
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, time.Now(), time.Now()),
 					nil,
 				)
-				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
-					entity.NewUserFromRepository("reviewer-2", "Reviewer 2", "team-1", true, time.Now(), time.Now()),
-				}, nil)
-				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{
-					"reviewer-2": 0,
-				}, nil)
-				prRepo.EXPECT().ReplaceReviewer(gomock.Any(), "pr-1", "reviewer-1", "reviewer-2").Return(nil)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-			},
-			expectErr: false,
-		},
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			_, err := uc.MergePR(context.Background(), dto.MergePRRequest{PullRequestID: "pr-1", ManagerID: "lead-1"})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_MergePREmitsEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         entity.PRStatus
+		expectedEvents []entity.EventType
+	}{
 		{
-			name: "error - PR not found",
-			req: dto.ReassignReviewerRequest{
-				PullRequestID: "pr-1",
-				OldUserID:     "reviewer-1",
-			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
-				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-					return fn(ctx)
-				})
-				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(nil, repository.ErrNotFound)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
-			},
-			expectErr:   true,
-			expectedErr: ErrPRNotFound,
+			name:           "merge emits pr.merged",
+			status:         entity.PRStatusOpen,
+			expectedEvents: []entity.EventType{entity.EventPRMerged},
 		},
 		{
-			name: "error - PR already merged",
-			req: dto.ReassignReviewerRequest{
-				PullRequestID: "pr-1",
-				OldUserID:     "reviewer-1",
-			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
-				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-					return fn(ctx)
-				})
-				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
-					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", entity.PRStatusMerged, []string{"reviewer-1"}, time.Now(), timePtr(time.Now())),
-					nil,
-				)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
-			},
-			expectErr:   true,
-			expectedErr: ErrPRAlreadyMerged,
+			name:           "repeated merge emits nothing",
+			status:         entity.PRStatusMerged,
+			expectedEvents: nil,
 		},
-		{
-			name: "error - reviewer not assigned",
-			req: dto.ReassignReviewerRequest{
-				PullRequestID: "pr-1",
-				OldUserID:     "reviewer-1",
-			},
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
-				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-					return fn(ctx)
-				})
-				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
-					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", entity.PRStatusOpen, []string{"reviewer-2"}, time.Now(), nil),
-					nil,
-				)
-				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
-				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
-			},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			emitter := eventmocks.NewMockEmitter(ctrl)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var mergedAt *time.Time
+			if tt.status == entity.PRStatusMerged {
+				mergedAt = timePtr(time.Now())
+			}
+			prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+				entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", tt.status, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), mergedAt, false),
+				nil,
+			)
+			teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+				entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, time.Now(), time.Now()),
+				nil,
+			).AnyTimes()
+			prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			var emitted []entity.EventType
+			emitter.EXPECT().Emit(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events ...event.Event) error {
+				for _, e := range events {
+					emitted = append(emitted, e.Type)
+				}
+				return nil
+			}).AnyTimes()
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, newTestReviewerSelector(ctrl, userRepo, prRepo), emitter, newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			if _, err := uc.MergePR(context.Background(), dto.MergePRRequest{PullRequestID: "pr-1"}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(emitted, tt.expectedEvents) {
+				t.Errorf("expected events %v, got %v", tt.expectedEvents, emitted)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_MergePRRecordsAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+	userRepo := repositorymocks.NewMockUserRepository(ctrl)
+	teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+	txManager := transactionmocks.NewMockManager(ctrl)
+	logger := loggermocks.NewMockLogger(ctrl)
+	recorder := auditmocks.NewMockRecorder(ctrl)
+
+	txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	policy, err := entity.NewMergePolicy(1, false, nil)
+	if err != nil {
+		t.Fatalf("failed to create merge policy: %v", err)
+	}
+	prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+		entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+		nil,
+	)
+	teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+		entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, policy, time.Now(), time.Now()),
+		nil,
+	)
+	prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+		if len(records) != 1 || records[0].Action != entity.AuditPRMerged {
+			t.Fatalf("expected single pr.merged record, got %+v", records)
+		}
+		before, ok := records[0].Before.(dto.PullRequestDTO)
+		if !ok || before.Status != string(entity.PRStatusOpen) {
+			t.Errorf("expected OPEN before snapshot, got %+v", records[0].Before)
+		}
+		after, ok := records[0].After.(dto.PullRequestDTO)
+		if !ok || after.Status != string(entity.PRStatusMerged) || !after.MergeForced {
+			t.Errorf("expected forced MERGED after snapshot, got %+v", records[0].After)
+		}
+		if !strings.HasPrefix(records[0].Reason, "forced merge bypassing") {
+			t.Errorf("expected forced merge reason, got %q", records[0].Reason)
+		}
+		return nil
+	})
+
+	uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), recorder, newTestMetricsRecorder(ctrl), logger)
+
+	if _, err := uc.MergePR(context.Background(), dto.MergePRRequest{PullRequestID: "pr-1", Force: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPullRequestUseCase_ChangesRecordAudit(t *testing.T) {
+	prWithStatus := func(status entity.PRStatus) *entity.PullRequest {
+		reviewers := []string{"reviewer-1"}
+		if status == entity.PRStatusDraft {
+			reviewers = []string{}
+		}
+		return entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", status, reviewers, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false)
+	}
+
+	tests := []struct {
+		name           string
+		status         entity.PRStatus
+		setupMocks     func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *repositorymocks.MockTeamRepository)
+		action         func(context.Context, *PullRequestUseCase) error
+		expectedAction entity.AuditAction
+		checkSnapshots func(t *testing.T, before, after dto.PullRequestDTO)
+	}{
+		{
+			name:   "close",
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			action: func(ctx context.Context, uc *PullRequestUseCase) error {
+				_, err := uc.ClosePR(ctx, "pr-1")
+				return err
+			},
+			expectedAction: entity.AuditPRClosed,
+			checkSnapshots: func(t *testing.T, before, after dto.PullRequestDTO) {
+				if before.Status != string(entity.PRStatusOpen) || after.Status != string(entity.PRStatusClosed) {
+					t.Errorf("expected OPEN -> CLOSED, got %s -> %s", before.Status, after.Status)
+				}
+			},
+		},
+		{
+			name:   "reopen",
+			status: entity.PRStatusClosed,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			action: func(ctx context.Context, uc *PullRequestUseCase) error {
+				_, err := uc.ReopenPR(ctx, "pr-1")
+				return err
+			},
+			expectedAction: entity.AuditPRReopened,
+			checkSnapshots: func(t *testing.T, before, after dto.PullRequestDTO) {
+				if before.Status != string(entity.PRStatusClosed) || after.Status != string(entity.PRStatusOpen) {
+					t.Errorf("expected CLOSED -> OPEN, got %s -> %s", before.Status, after.Status)
+				}
+			},
+		},
+		{
+			name:   "add reviewer",
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-3").Return(
+					entity.NewUserFromRepository("reviewer-3", "Reviewer 3", "team-1", true, time.Now(), time.Now()), nil)
+				prRepo.EXPECT().AddReviewer(gomock.Any(), "pr-1", "reviewer-3", false).Return(nil)
+			},
+			action: func(ctx context.Context, uc *PullRequestUseCase) error {
+				_, err := uc.AddReviewer(ctx, dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-3"})
+				return err
+			},
+			expectedAction: entity.AuditReviewerAdded,
+			checkSnapshots: func(t *testing.T, before, after dto.PullRequestDTO) {
+				if len(before.AssignedReviewers) != 1 || len(after.AssignedReviewers) != 2 {
+					t.Errorf("expected 1 -> 2 reviewers, got %v -> %v", before.AssignedReviewers, after.AssignedReviewers)
+				}
+			},
+		},
+		{
+			name:   "remove reviewer",
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "reviewer-1").Return(true, nil)
+				prRepo.EXPECT().RemoveReviewer(gomock.Any(), "pr-1", "reviewer-1").Return(nil)
+			},
+			action: func(ctx context.Context, uc *PullRequestUseCase) error {
+				_, err := uc.RemoveReviewer(ctx, dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"})
+				return err
+			},
+			expectedAction: entity.AuditReviewerRemoved,
+			checkSnapshots: func(t *testing.T, before, after dto.PullRequestDTO) {
+				if len(before.AssignedReviewers) != 1 || len(after.AssignedReviewers) != 0 {
+					t.Errorf("expected 1 -> 0 reviewers, got %v -> %v", before.AssignedReviewers, after.AssignedReviewers)
+				}
+			},
+		},
+		{
+			name:   "ready",
+			status: entity.PRStatusDraft,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, time.Now(), time.Now()),
+					nil,
+				)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{"reviewer-1": 0}, nil)
+				prRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			action: func(ctx context.Context, uc *PullRequestUseCase) error {
+				_, err := uc.MarkReady(ctx, "pr-1")
+				return err
+			},
+			expectedAction: entity.AuditPRReady,
+			checkSnapshots: func(t *testing.T, before, after dto.PullRequestDTO) {
+				if before.Status != string(entity.PRStatusDraft) || after.Status != string(entity.PRStatusOpen) || len(after.AssignedReviewers) != 1 {
+					t.Errorf("expected DRAFT -> OPEN with reviewers, got %s -> %s %v", before.Status, after.Status, after.AssignedReviewers)
+				}
+			},
+		},
+		{
+			name:   "review",
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().SaveReview(gomock.Any(), "pr-1", gomock.Any()).Return(nil)
+			},
+			action: func(ctx context.Context, uc *PullRequestUseCase) error {
+				_, err := uc.SubmitReview(ctx, dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "CHANGES_REQUESTED"})
+				return err
+			},
+			expectedAction: entity.AuditReviewSubmitted,
+			checkSnapshots: func(t *testing.T, before, after dto.PullRequestDTO) {
+				if before.ReviewDecision == after.ReviewDecision || after.ReviewDecision != string(entity.ReviewDecisionChangesRequested) {
+					t.Errorf("expected review decision to become CHANGES_REQUESTED, got %s -> %s", before.ReviewDecision, after.ReviewDecision)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			recorder := auditmocks.NewMockRecorder(ctrl)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(tt.status), nil)
+			tt.setupMocks(prRepo, userRepo, teamRepo)
+
+			recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+				if len(records) != 1 || records[0].Action != tt.expectedAction {
+					t.Fatalf("expected single %s record, got %+v", tt.expectedAction, records)
+				}
+				if records[0].EntityType != entity.AuditEntityPullRequest || records[0].EntityID != "pr-1" {
+					t.Errorf("unexpected audit entity %s/%s", records[0].EntityType, records[0].EntityID)
+				}
+				before, beforeOK := records[0].Before.(dto.PullRequestDTO)
+				after, afterOK := records[0].After.(dto.PullRequestDTO)
+				if !beforeOK || !afterOK {
+					t.Fatalf("expected PR snapshots, got %+v / %+v", records[0].Before, records[0].After)
+				}
+				tt.checkSnapshots(t, before, after)
+				return nil
+			})
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), recorder, newTestMetricsRecorder(ctrl), logger)
+
+			if err := tt.action(context.Background(), uc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_StatusTransitions(t *testing.T) {
+	prWithStatus := func(status entity.PRStatus, reviewers []string) *entity.PullRequest {
+		return entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", status, reviewers, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false)
+	}
+	expectReviewerSelection := func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, teamRepo *repositorymocks.MockTeamRepository) {
+		teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+			entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, time.Now(), time.Now()),
+			nil,
+		)
+		userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+			entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+		}, nil)
+		prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{"reviewer-1": 0}, nil)
+	}
+
+	tests := []struct {
+		name              string
+		action            func(*PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error)
+		setupMocks        func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *repositorymocks.MockTeamRepository)
+		expectedErr       error
+		expectedStatus    entity.PRStatus
+		expectedReviewers []string
+	}{
+		{
+			name: "ready - draft gets reviewers",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.MarkReady
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusDraft, []string{}), nil)
+				expectReviewerSelection(userRepo, prRepo, teamRepo)
+				prRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus:    entity.PRStatusOpen,
+			expectedReviewers: []string{"reviewer-1"},
+		},
+		{
+			name: "ready - open PR is not a draft",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.MarkReady
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusOpen, []string{"reviewer-1"}), nil)
+			},
+			expectedErr: ErrInvalidStatusTransition,
+		},
+		{
+			name: "close - reviewers are kept",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.ClosePR
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusOpen, []string{"reviewer-1"}), nil)
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus:    entity.PRStatusClosed,
+			expectedReviewers: []string{"reviewer-1"},
+		},
+		{
+			name: "close - merged PR",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.ClosePR
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusMerged, []string{"reviewer-1"}), nil)
+			},
+			expectedErr: ErrPRAlreadyMerged,
+		},
+		{
+			name: "close - PR not found",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.ClosePR
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(nil, repository.ErrNotFound)
+			},
+			expectedErr: ErrPRNotFound,
+		},
+		{
+			name: "reopen - frozen reviewers restored",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.ReopenPR
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusClosed, []string{"reviewer-2"}), nil)
+				prRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus:    entity.PRStatusOpen,
+			expectedReviewers: []string{"reviewer-2"},
+		},
+		{
+			name: "reopen - closed draft gets reviewers",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.ReopenPR
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusClosed, []string{}), nil)
+				expectReviewerSelection(userRepo, prRepo, teamRepo)
+				prRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus:    entity.PRStatusOpen,
+			expectedReviewers: []string{"reviewer-1"},
+		},
+		{
+			name: "reopen - open PR",
+			action: func(uc *PullRequestUseCase) func(context.Context, string) (*dto.PullRequestDTO, error) {
+				return uc.ReopenPR
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(prWithStatus(entity.PRStatusOpen, []string{"reviewer-1"}), nil)
+			},
+			expectedErr: ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(prRepo, userRepo, teamRepo)
+
+			result, err := tt.action(uc)(context.Background(), "pr-1")
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Status != string(tt.expectedStatus) {
+				t.Errorf("expected status %s, got %s", tt.expectedStatus, result.Status)
+			}
+			if !reflect.DeepEqual(result.AssignedReviewers, tt.expectedReviewers) {
+				t.Errorf("expected reviewers %v, got %v", tt.expectedReviewers, result.AssignedReviewers)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_ReassignReviewer(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.ReassignReviewerRequest
+		setupMocks  func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
+		expectErr   bool
+		expectedErr error
+	}{
+		{
+			name: "success - reviewer reassigned",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					nil,
+				)
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-1").Return(
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+					nil,
+				)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("reviewer-2", "Reviewer 2", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{
+					"reviewer-2": 0,
+				}, nil)
+				prRepo.EXPECT().ReplaceReviewer(gomock.Any(), "pr-1", "reviewer-1", "reviewer-2", false).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - PR not found",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(nil, repository.ErrNotFound)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrPRNotFound,
+		},
+		{
+			name: "error - PR already merged",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusMerged, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), timePtr(time.Now()), false),
+					nil,
+				)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrPRAlreadyMerged,
+		},
+		{
+			name: "error - reviewer not assigned",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-2"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					nil,
+				)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
 			expectErr:   true,
 			expectedErr: ErrReviewerNotAssigned,
 		},
@@ -325,22 +1074,43 @@ func TestPullRequestUseCase_ReassignReviewer(t *testing.T) {
 					return fn(ctx)
 				})
 				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
-					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", entity.PRStatusOpen, []string{"reviewer-1"}, time.Now(), nil),
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
 					nil,
 				)
 				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-1").Return(
 					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
 					nil,
 				)
-				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
-					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
-					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
-				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrNoActiveCandidates,
+		},
+		{
+			name: "error - author cannot reassign reviewers of own PR",
+			req: dto.ReassignReviewerRequest{
+				PullRequestID: "pr-1",
+				OldUserID:     "reviewer-1",
+				ActorID:       "author-1",
+			},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					nil,
+				)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectErr:   true,
-			expectedErr: ErrNoActiveCandidates,
+			expectedErr: ErrForbidden,
 		},
 	}
 
@@ -353,9 +1123,14 @@ func TestPullRequestUseCase_ReassignReviewer(t *testing.T) {
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
-			reviewerSelector := NewReviewerSelector(userRepo, prRepo)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+			metricsRecorder := metricsmocks.NewMockRecorder(ctrl)
+			if errors.Is(tt.expectedErr, ErrNoActiveCandidates) {
+				metricsRecorder.EXPECT().ReassignNoCandidate()
+			}
 
-			uc := NewPullRequestUseCase(txManager, prRepo, userRepo, reviewerSelector, logger)
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), metricsRecorder, logger)
 
 			tt.setupMocks(prRepo, userRepo, txManager, logger)
 
@@ -388,6 +1163,540 @@ func TestPullRequestUseCase_ReassignReviewer(t *testing.T) {
 	}
 }
 
+func TestPullRequestUseCase_ReassignReviewerToUser(t *testing.T) {
+	openPR := func(fallbackReviewers []string) *entity.PullRequest {
+		return entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1", "reviewer-2"}, fallbackReviewers, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false)
+	}
+	prTeam := func() *entity.Team {
+		return entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), []string{"team-2"}, entity.MergePolicy{}, time.Now(), time.Now())
+	}
+	user := func(id, teamName string, isActive bool) *entity.User {
+		return entity.NewUserFromRepository(id, id, teamName, isActive, time.Now(), time.Now())
+	}
+
+	tests := []struct {
+		name        string
+		newUserID   string
+		fallback    []string
+		setupMocks  func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *repositorymocks.MockTeamRepository)
+		expectedErr error
+	}{
+		{
+			name:      "success - teammate",
+			newUserID: "reviewer-3",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-3").Return(user("reviewer-3", "team-1", true), nil)
+				prRepo.EXPECT().ReplaceReviewer(gomock.Any(), "pr-1", "reviewer-1", "reviewer-3", false).Return(nil)
+			},
+		},
+		{
+			name:      "success - teammate takes fallback slot",
+			newUserID: "reviewer-3",
+			fallback:  []string{"reviewer-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-3").Return(user("reviewer-3", "team-1", true), nil)
+				prRepo.EXPECT().ReplaceReviewer(gomock.Any(), "pr-1", "reviewer-1", "reviewer-3", false).Return(nil)
+			},
+		},
+		{
+			name:      "success - member of fallback team",
+			newUserID: "helper-1",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "helper-1").Return(user("helper-1", "team-2", true), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(prTeam(), nil)
+				prRepo.EXPECT().ReplaceReviewer(gomock.Any(), "pr-1", "reviewer-1", "helper-1", true).Return(nil)
+			},
+		},
+		{
+			name:      "error - user not found",
+			newUserID: "ghost",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, repository.ErrNotFound)
+			},
+			expectedErr: ErrUserNotFound,
+		},
+		{
+			name:      "error - user inactive",
+			newUserID: "reviewer-3",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-3").Return(user("reviewer-3", "team-1", false), nil)
+			},
+			expectedErr: ErrUserInactive,
+		},
+		{
+			name:      "error - author",
+			newUserID: "author-1",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(user("author-1", "team-1", true), nil)
+			},
+			expectedErr: ErrAuthorCannotReview,
+		},
+		{
+			name:      "error - already assigned",
+			newUserID: "reviewer-2",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-2").Return(user("reviewer-2", "team-1", true), nil)
+			},
+			expectedErr: ErrReviewerAlreadyAssigned,
+		},
+		{
+			name:      "error - team not allowed",
+			newUserID: "stranger-1",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, teamRepo *repositorymocks.MockTeamRepository) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "stranger-1").Return(user("stranger-1", "team-3", true), nil)
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(prTeam(), nil)
+			},
+			expectedErr: ErrReviewerTeamNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(tt.fallback), nil)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(prRepo, userRepo, teamRepo)
+
+			req := dto.ReassignReviewerRequest{PullRequestID: "pr-1", OldUserID: "reviewer-1", NewUserID: tt.newUserID}
+			result, newReviewerID, err := uc.ReassignReviewer(context.Background(), req)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if newReviewerID != tt.newUserID {
+				t.Errorf("expected new reviewer %s, got %s", tt.newUserID, newReviewerID)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_AddReviewer(t *testing.T) {
+	openPR := func() *entity.PullRequest {
+		return entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false)
+	}
+
+	tests := []struct {
+		name             string
+		req              dto.AddReviewerRequest
+		setupMocks       func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository)
+		expectedErr      error
+		expectedFallback []string
+	}{
+		{
+			name: "success - teammate added",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-2"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-2").Return(
+					entity.NewUserFromRepository("reviewer-2", "Reviewer 2", "team-1", true, time.Now(), time.Now()), nil)
+				prRepo.EXPECT().AddReviewer(gomock.Any(), "pr-1", "reviewer-2", false).Return(nil)
+			},
+			expectedFallback: []string{},
+		},
+		{
+			name: "success - user from another team marked as fallback",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "helper-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "helper-1").Return(
+					entity.NewUserFromRepository("helper-1", "Helper", "team-2", true, time.Now(), time.Now()), nil)
+				prRepo.EXPECT().AddReviewer(gomock.Any(), "pr-1", "helper-1", true).Return(nil)
+			},
+			expectedFallback: []string{"helper-1"},
+		},
+		{
+			name: "error - PR merged",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-2"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusMerged, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), timePtr(time.Now()), false),
+					nil,
+				)
+			},
+			expectedErr: ErrPRAlreadyMerged,
+		},
+		{
+			name: "error - user not found",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "ghost"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "ghost").Return(nil, repository.ErrNotFound)
+			},
+			expectedErr: ErrUserNotFound,
+		},
+		{
+			name: "error - user inactive",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-2"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-2").Return(
+					entity.NewUserFromRepository("reviewer-2", "Reviewer 2", "team-1", false, time.Now(), time.Now()), nil)
+			},
+			expectedErr: ErrUserInactive,
+		},
+		{
+			name: "error - author cannot review",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "author-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "author-1").Return(
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()), nil)
+			},
+			expectedErr: ErrAuthorCannotReview,
+		},
+		{
+			name: "error - already assigned",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-1").Return(
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()), nil)
+			},
+			expectedErr: ErrReviewerAlreadyAssigned,
+		},
+		{
+			name: "error - max_reviewers reached",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-3"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1", "reviewer-2"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					nil,
+				)
+				userRepo.EXPECT().FindByID(gomock.Any(), "reviewer-3").Return(
+					entity.NewUserFromRepository("reviewer-3", "Reviewer 3", "team-1", true, time.Now(), time.Now()), nil)
+			},
+			expectedErr: ErrTooManyReviewers,
+		},
+		{
+			name: "error - author cannot add reviewers to own PR",
+			req:  dto.AddReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-2", ActorID: "author-1"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+			},
+			expectedErr: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(prRepo, userRepo)
+
+			result, err := uc.AddReviewer(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result.AssignedReviewers) != 2 {
+				t.Errorf("expected 2 reviewers, got %v", result.AssignedReviewers)
+			}
+			if len(result.FallbackReviewers) != len(tt.expectedFallback) {
+				t.Errorf("expected fallback reviewers %v, got %v", tt.expectedFallback, result.FallbackReviewers)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_RemoveReviewer(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.RemoveReviewerRequest
+		minimum     int
+		status      entity.PRStatus
+		setupMocks  func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository)
+		expectedErr error
+	}{
+		{
+			name:   "success - reviewer removed",
+			req:    dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"},
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "reviewer-1").Return(true, nil)
+				prRepo.EXPECT().RemoveReviewer(gomock.Any(), "pr-1", "reviewer-1").Return(nil)
+			},
+		},
+		{
+			name:    "error - min_reviewers would be violated",
+			req:     dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"},
+			minimum: 2,
+			status:  entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "reviewer-1").Return(true, nil)
+			},
+			expectedErr: ErrTooFewReviewers,
+		},
+		{
+			name:   "error - reviewer not assigned",
+			req:    dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-3"},
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "reviewer-3").Return(true, nil)
+			},
+			expectedErr: ErrReviewerNotAssigned,
+		},
+		{
+			name:   "error - user not found",
+			req:    dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "ghost"},
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "ghost").Return(false, nil)
+			},
+			expectedErr: ErrUserNotFound,
+		},
+		{
+			name:   "error - PR merged",
+			req:    dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"},
+			status: entity.PRStatusMerged,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+			},
+			expectedErr: ErrPRAlreadyMerged,
+		},
+		{
+			name:   "success - reviewer removed by team lead",
+			req:    dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1", ActorID: "lead-1"},
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "reviewer-1").Return(true, nil)
+				prRepo.EXPECT().RemoveReviewer(gomock.Any(), "pr-1", "reviewer-1").Return(nil)
+			},
+		},
+		{
+			name:   "error - author cannot remove reviewers of own PR",
+			req:    dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1", ActorID: "author-1"},
+			status: entity.PRStatusOpen,
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository) {
+			},
+			expectedErr: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			limits, _ := entity.NewReviewerLimits(tt.minimum, entity.DefaultMaxReviewers)
+			prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+				entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", tt.status, []string{"reviewer-1", "reviewer-2"}, nil, nil, limits, time.Now(), nil, false),
+				nil,
+			)
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(prRepo, userRepo)
+
+			result, err := uc.RemoveReviewer(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result.AssignedReviewers) != 1 || result.AssignedReviewers[0] != "reviewer-2" {
+				t.Errorf("expected reviewers [reviewer-2], got %v", result.AssignedReviewers)
+			}
+		})
+	}
+}
+
+func TestPullRequestUseCase_SubmitReview(t *testing.T) {
+	submitted := time.Now()
+	openPR := func(reviews ...entity.Review) *entity.PullRequest {
+		return entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1", "reviewer-2"}, nil, reviews, entity.DefaultReviewerLimits(), time.Now(), nil, false)
+	}
+	expectTx := func(txManager *transactionmocks.MockManager) {
+		txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	}
+
+	tests := []struct {
+		name             string
+		req              dto.SubmitReviewRequest
+		setupMocks       func(*repositorymocks.MockPullRequestRepository, *transactionmocks.MockManager)
+		expectedErr      error
+		expectedDecision string
+	}{
+		{
+			name: "success - first approval keeps review required",
+			req:  dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager) {
+				expectTx(txManager)
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+				prRepo.EXPECT().SaveReview(gomock.Any(), "pr-1", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, prID string, review entity.Review) error {
+						if review.ReviewerID() != "reviewer-1" || review.State() != entity.ReviewStateApproved || review.SubmittedAt() == nil {
+							t.Errorf("unexpected review saved: %+v", review)
+						}
+						return nil
+					})
+			},
+			expectedDecision: string(entity.ReviewDecisionReviewRequired),
+		},
+		{
+			name: "success - all reviewers approved",
+			req:  dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-2", State: "APPROVED"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager) {
+				expectTx(txManager)
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					openPR(entity.NewReviewFromRepository("reviewer-1", entity.ReviewStateApproved, &submitted)), nil)
+				prRepo.EXPECT().SaveReview(gomock.Any(), "pr-1", gomock.Any()).Return(nil)
+			},
+			expectedDecision: string(entity.ReviewDecisionApproved),
+		},
+		{
+			name: "success - changes requested wins",
+			req:  dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-2", State: "CHANGES_REQUESTED"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager) {
+				expectTx(txManager)
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					openPR(entity.NewReviewFromRepository("reviewer-1", entity.ReviewStateApproved, &submitted)), nil)
+				prRepo.EXPECT().SaveReview(gomock.Any(), "pr-1", gomock.Any()).Return(nil)
+			},
+			expectedDecision: string(entity.ReviewDecisionChangesRequested),
+		},
+		{
+			name:        "error - invalid state",
+			req:         dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "PENDING"},
+			setupMocks:  func(prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager) {},
+			expectedErr: ErrInvalidReviewState,
+		},
+		{
+			name: "error - reviewer not assigned",
+			req:  dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "stranger", State: "COMMENTED"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager) {
+				expectTx(txManager)
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(openPR(), nil)
+			},
+			expectedErr: ErrReviewerNotAssigned,
+		},
+		{
+			name: "error - PR merged",
+			req:  dto.SubmitReviewRequest{PullRequestID: "pr-1", UserID: "reviewer-1", State: "APPROVED"},
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager) {
+				expectTx(txManager)
+				prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+					entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusMerged, []string{"reviewer-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), timePtr(time.Now()), false),
+					nil,
+				)
+			},
+			expectedErr: ErrPRAlreadyMerged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(prRepo, txManager)
+
+			result, err := uc.SubmitReview(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.ReviewDecision != tt.expectedDecision {
+				t.Errorf("expected decision %s, got %s", tt.expectedDecision, result.ReviewDecision)
+			}
+			if len(result.Reviews) != 2 {
+				t.Errorf("expected 2 reviews, got %d", len(result.Reviews))
+			}
+		})
+	}
+}
+
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
@@ -400,9 +1709,10 @@ func TestNewPullRequestUseCase(t *testing.T) {
 	userRepo := repositorymocks.NewMockUserRepository(ctrl)
 	txManager := transactionmocks.NewMockManager(ctrl)
 	logger := loggermocks.NewMockLogger(ctrl)
-	reviewerSelector := NewReviewerSelector(userRepo, prRepo)
+	teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+	reviewerSelector := newTestReviewerSelector(ctrl, userRepo, prRepo)
 
-	uc := NewPullRequestUseCase(txManager, prRepo, userRepo, reviewerSelector, logger)
+	uc := NewPullRequestUseCase(txManager, prRepo, newTestHistoryRepo(ctrl), userRepo, teamRepo, reviewerSelector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
 
 	if uc == nil {
 		t.Fatal("expected non-nil use case")
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+// reassignOpenReviews переносит слоты уходящих ревьюверов в открытых PR:
+// блокирует затронутые PR, подбирает замены через ReviewerSelector и сохраняет изменения пачкой
+// вместе с историей назначений
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func reassignOpenReviews(
+	ctx context.Context,
+	prRepo repository.PullRequestRepository,
+	historyRepo repository.ReviewerHistoryRepository,
+	reviewerSelector *ReviewerSelector,
+	leavingReviewerIDs []string,
+) ([]repository.ReviewerChange, error) {
+	prs, err := prRepo.FindOpenByReviewerIDsForUpdate(ctx, leavingReviewerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open reviews: %w", err)
+	}
+
+	changes, err := reviewerSelector.SelectReplacements(ctx, prs, leavingReviewerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select replacements: %w", err)
+	}
+
+	if err := applyReviewerChanges(prs, changes); err != nil {
+		return nil, err
+	}
+
+	if len(changes) > 0 {
+		if err := prRepo.ReassignReviewers(ctx, changes); err != nil {
+			return nil, fmt.Errorf("failed to reassign reviewers: %w", err)
+		}
+	}
+
+	if err := recordReviewerChanges(ctx, historyRepo, changes, entity.ReviewerReasonDeactivation); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// applyReviewerChanges применяет переносы слотов к сущностям PR, проверяя доменные инварианты
+func applyReviewerChanges(prs []*entity.PullRequest, changes []repository.ReviewerChange) error {
+	byID := make(map[string]*entity.PullRequest, len(prs))
+	for _, pr := range prs {
+		byID[pr.ID()] = pr
+	}
+
+	for _, change := range changes {
+		pr, ok := byID[change.PullRequestID]
+		if !ok {
+			return fmt.Errorf("pull request %s is not loaded", change.PullRequestID)
+		}
+
+		var err error
+		if change.NewReviewerID == "" {
+			err = pr.ReleaseReviewer(change.OldReviewerID)
+		} else {
+			err = pr.ReplaceReviewer(change.OldReviewerID, change.NewReviewerID, change.IsFallback)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to move reviewer %s in PR %s: %w", change.OldReviewerID, change.PullRequestID, err)
+		}
+	}
+
+	return nil
+}
+
+// reviewerReassignedEvents возвращает событие reviewer.reassigned для каждого перенесенного слота
+func reviewerReassignedEvents(changes []repository.ReviewerChange) []event.Event {
+	events := make([]event.Event, 0, len(changes))
+	for _, change := range changes {
+		events = append(events, event.NewReviewerReassigned(change.PullRequestID, change.OldReviewerID, change.NewReviewerID))
+	}
+	return events
+}
+
+// emitEvents публикует события в транзакции, в которой записано изменение
+func emitEvents(ctx context.Context, emitter event.Emitter, events ...event.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if err := emitter.Emit(ctx, events...); err != nil {
+		return fmt.Errorf("failed to emit events: %w", err)
+	}
+	return nil
+}
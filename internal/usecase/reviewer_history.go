@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+// recordReviewersAssigned записывает в историю назначение ревьюверов PR
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func recordReviewersAssigned(
+	ctx context.Context,
+	historyRepo repository.ReviewerHistoryRepository,
+	prID string,
+	reviewerIDs []string,
+	reason entity.ReviewerHistoryReason,
+) error {
+	occurredAt := time.Now().UTC()
+
+	entries := make([]*entity.ReviewerHistoryEntry, 0, len(reviewerIDs))
+	for _, reviewerID := range reviewerIDs {
+		entry, err := entity.NewReviewerHistoryEntry(prID, reviewerID, entity.ReviewerAssigned, reason, occurredAt)
+		if err != nil {
+			return fmt.Errorf("failed to create reviewer history entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return addReviewerHistory(ctx, historyRepo, entries)
+}
+
+// recordReviewerUnassigned записывает в историю снятие ревьювера с PR
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func recordReviewerUnassigned(
+	ctx context.Context,
+	historyRepo repository.ReviewerHistoryRepository,
+	prID string,
+	reviewerID string,
+	reason entity.ReviewerHistoryReason,
+) error {
+	entry, err := entity.NewReviewerHistoryEntry(prID, reviewerID, entity.ReviewerUnassigned, reason, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create reviewer history entry: %w", err)
+	}
+
+	return addReviewerHistory(ctx, historyRepo, []*entity.ReviewerHistoryEntry{entry})
+}
+
+// recordReviewerChanges записывает в историю переносы слотов: снятие старого ревьювера
+// и, если слот не освобожден, назначение нового
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do)
+func recordReviewerChanges(
+	ctx context.Context,
+	historyRepo repository.ReviewerHistoryRepository,
+	changes []repository.ReviewerChange,
+	reason entity.ReviewerHistoryReason,
+) error {
+	occurredAt := time.Now().UTC()
+
+	entries := make([]*entity.ReviewerHistoryEntry, 0, len(changes)*2)
+	for _, change := range changes {
+		unassigned, err := entity.NewReviewerHistoryEntry(
+			change.PullRequestID, change.OldReviewerID, entity.ReviewerUnassigned, reason, occurredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create reviewer history entry: %w", err)
+		}
+		entries = append(entries, unassigned)
+
+		if change.NewReviewerID == "" {
+			continue
+		}
+		assigned, err := entity.NewReviewerHistoryEntry(
+			change.PullRequestID, change.NewReviewerID, entity.ReviewerAssigned, reason, occurredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create reviewer history entry: %w", err)
+		}
+		entries = append(entries, assigned)
+	}
+
+	return addReviewerHistory(ctx, historyRepo, entries)
+}
+
+func addReviewerHistory(ctx context.Context, historyRepo repository.ReviewerHistoryRepository, entries []*entity.ReviewerHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := historyRepo.Add(ctx, entries); err != nil {
+		return fmt.Errorf("failed to record reviewer history: %w", err)
+	}
+	return nil
+}
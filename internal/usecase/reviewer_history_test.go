@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// newTestHistoryRepo возвращает репозиторий истории назначений, принимающий любые записи
+func newTestHistoryRepo(ctrl *gomock.Controller) *repositorymocks.MockReviewerHistoryRepository {
+	historyRepo := repositorymocks.NewMockReviewerHistoryRepository(ctrl)
+	historyRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return historyRepo
+}
+
+// historyMove краткая запись события истории для сравнения в тестах
+type historyMove struct {
+	userID string
+	action entity.ReviewerHistoryAction
+	reason entity.ReviewerHistoryReason
+}
+
+func historyMoves(entries []*entity.ReviewerHistoryEntry) []historyMove {
+	moves := make([]historyMove, len(entries))
+	for i, entry := range entries {
+		moves[i] = historyMove{userID: entry.UserID(), action: entry.Action(), reason: entry.Reason()}
+	}
+	return moves
+}
+
+func TestRecordReviewerChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	historyRepo := repositorymocks.NewMockReviewerHistoryRepository(ctrl)
+	historyRepo.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entries []*entity.ReviewerHistoryEntry) error {
+		expected := []historyMove{
+			{userID: "u1", action: entity.ReviewerUnassigned, reason: entity.ReviewerReasonDeactivation},
+			{userID: "u2", action: entity.ReviewerAssigned, reason: entity.ReviewerReasonDeactivation},
+			{userID: "u3", action: entity.ReviewerUnassigned, reason: entity.ReviewerReasonDeactivation},
+		}
+		moves := historyMoves(entries)
+		if len(moves) != len(expected) {
+			t.Fatalf("expected %d history entries, got %+v", len(expected), moves)
+		}
+		for i := range expected {
+			if moves[i] != expected[i] {
+				t.Errorf("entry %d: expected %+v, got %+v", i, expected[i], moves[i])
+			}
+		}
+		return nil
+	})
+
+	err := recordReviewerChanges(context.Background(), historyRepo, []repository.ReviewerChange{
+		{PullRequestID: "pr-1", OldReviewerID: "u1", NewReviewerID: "u2"},
+		{PullRequestID: "pr-2", OldReviewerID: "u3"},
+	}, entity.ReviewerReasonDeactivation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRecordReviewersAssigned_NoReviewers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Черновик создается без ревьюверов: пустая история не пишется
+	historyRepo := repositorymocks.NewMockReviewerHistoryRepository(ctrl)
+
+	if err := recordReviewersAssigned(context.Background(), historyRepo, "pr-1", nil, entity.ReviewerReasonAuto); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPullRequestUseCase_RemoveReviewerRecordsHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+	userRepo := repositorymocks.NewMockUserRepository(ctrl)
+	teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+	historyRepo := repositorymocks.NewMockReviewerHistoryRepository(ctrl)
+	txManager := transactionmocks.NewMockManager(ctrl)
+	logger := loggermocks.NewMockLogger(ctrl)
+
+	txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	prRepo.EXPECT().FindByIDForUpdate(gomock.Any(), "pr-1").Return(
+		entity.NewPullRequestFromRepository("pr-1", "Test PR", "author-1", "team-1", entity.PRStatusOpen, []string{"reviewer-1", "reviewer-2"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+		nil,
+	)
+	userRepo.EXPECT().Exists(gomock.Any(), "reviewer-1").Return(true, nil)
+	prRepo.EXPECT().RemoveReviewer(gomock.Any(), "pr-1", "reviewer-1").Return(nil)
+	historyRepo.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entries []*entity.ReviewerHistoryEntry) error {
+		moves := historyMoves(entries)
+		expected := historyMove{userID: "reviewer-1", action: entity.ReviewerUnassigned, reason: entity.ReviewerReasonManual}
+		if len(moves) != 1 || moves[0] != expected {
+			t.Errorf("expected %+v, got %+v", expected, moves)
+		}
+		return nil
+	})
+
+	uc := NewPullRequestUseCase(txManager, prRepo, historyRepo, userRepo, teamRepo, newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), newTestAuditRecorder(ctrl), newTestMetricsRecorder(ctrl), logger)
+
+	if _, err := uc.RemoveReviewer(context.Background(), dto.RemoveReviewerRequest{PullRequestID: "pr-1", UserID: "reviewer-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPullRequestUseCase_GetReviewerHistory(t *testing.T) {
+	occurredAt := time.Now()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockReviewerHistoryRepository)
+		expectedErr error
+		expectedLen int
+	}{
+		{
+			name: "success - replaced reviewer stays in history",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, historyRepo *repositorymocks.MockReviewerHistoryRepository) {
+				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(true, nil)
+				historyRepo.EXPECT().FindByPRID(gomock.Any(), "pr-1").Return([]*entity.ReviewerHistoryEntry{
+					entity.NewReviewerHistoryEntryFromRepository("pr-1", "u1", entity.ReviewerAssigned, entity.ReviewerReasonAuto, occurredAt),
+					entity.NewReviewerHistoryEntryFromRepository("pr-1", "u1", entity.ReviewerUnassigned, entity.ReviewerReasonReassign, occurredAt),
+					entity.NewReviewerHistoryEntryFromRepository("pr-1", "u2", entity.ReviewerAssigned, entity.ReviewerReasonReassign, occurredAt),
+				}, nil)
+			},
+			expectedLen: 3,
+		},
+		{
+			name: "error - PR not found",
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, historyRepo *repositorymocks.MockReviewerHistoryRepository) {
+				prRepo.EXPECT().Exists(gomock.Any(), "pr-1").Return(false, nil)
+			},
+			expectedErr: ErrPRNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			historyRepo := repositorymocks.NewMockReviewerHistoryRepository(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			tt.setupMocks(prRepo, historyRepo)
+
+			uc := NewPullRequestUseCase(
+				transactionmocks.NewMockManager(ctrl),
+				prRepo,
+				historyRepo,
+				userRepo,
+				repositorymocks.NewMockTeamRepository(ctrl),
+				newTestReviewerSelector(ctrl, userRepo, prRepo),
+				newTestEmitter(ctrl),
+				newTestAuditRecorder(ctrl),
+				newTestMetricsRecorder(ctrl),
+				logger,
+			)
+
+			history, err := uc.GetReviewerHistory(context.Background(), "pr-1")
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(history) != tt.expectedLen {
+				t.Errorf("expected %d history entries, got %d", tt.expectedLen, len(history))
+			}
+			if history[1].Action != string(entity.ReviewerUnassigned) || history[1].Reason != string(entity.ReviewerReasonReassign) {
+				t.Errorf("expected reassign unassignment, got %+v", history[1])
+			}
+		})
+	}
+}
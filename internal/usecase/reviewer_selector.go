@@ -2,30 +2,94 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 )
 
-// ReviewerSelector сервис для выбора ревьюеров по алгоритму Round-Robin
+// ReviewerSelector сервис для выбора ревьюеров.
+// Фильтрует кандидатов и делегирует сам выбор стратегии команды (или глобальной стратегии по умолчанию)
 type ReviewerSelector struct {
-	userRepo repository.UserRepository
-	prRepo   repository.PullRequestRepository
+	userRepo        repository.UserRepository
+	prRepo          repository.PullRequestRepository
+	teamRepo        repository.TeamRepository
+	strategies      map[entity.ReviewerStrategyName]ReviewerStrategy
+	defaultStrategy entity.ReviewerStrategyName
 }
 
 // NewReviewerSelector создает новый ReviewerSelector
-func NewReviewerSelector(userRepo repository.UserRepository, prRepo repository.PullRequestRepository) *ReviewerSelector {
+func NewReviewerSelector(
+	userRepo repository.UserRepository,
+	prRepo repository.PullRequestRepository,
+	teamRepo repository.TeamRepository,
+	strategies map[entity.ReviewerStrategyName]ReviewerStrategy,
+	defaultStrategy entity.ReviewerStrategyName,
+) *ReviewerSelector {
 	return &ReviewerSelector{
-		userRepo: userRepo,
-		prRepo:   prRepo,
+		userRepo:        userRepo,
+		prRepo:          prRepo,
+		teamRepo:        teamRepo,
+		strategies:      strategies,
+		defaultStrategy: defaultStrategy,
 	}
 }
 
-// SelectReviewers выбирает до MaxReviewersCount активных ревьюеров из команды по алгоритму Round-Robin
-// выбираются те, у кого меньше всего активных (OPEN) PR на ревью
-func (s *ReviewerSelector) SelectReviewers(ctx context.Context, teamName, authorID string) ([]string, error) {
+// SelectedReviewers ревьюверы, подобранные для нового PR
+type SelectedReviewers struct {
+	// Home ревьюверы из команды автора
+	Home []string
+	// Fallback ревьюверы из запасных команд в порядке их приоритета
+	Fallback []string
+}
+
+// Count возвращает общее число подобранных ревьюверов
+func (s SelectedReviewers) Count() int {
+	return len(s.Home) + len(s.Fallback)
+}
+
+// SelectReviewers выбирает до count активных ревьюеров из команды автора её стратегией.
+// Если кандидатов не хватает, оставшиеся слоты заполняются из запасных команд в порядке приоритета,
+// каждая запасная команда выбирает своей стратегией
+func (s *ReviewerSelector) SelectReviewers(ctx context.Context, team *entity.Team, authorID string, count int) (SelectedReviewers, error) {
+	result := SelectedReviewers{Home: []string{}, Fallback: []string{}}
+
+	strategy, _, err := s.strategyForTeam(team)
+	if err != nil {
+		return SelectedReviewers{}, err
+	}
+
+	result.Home, err = s.selectFromTeam(ctx, team.Name(), strategy, authorID, count)
+	if err != nil {
+		return SelectedReviewers{}, err
+	}
+
+	for _, fallbackTeam := range team.FallbackTeams() {
+		remaining := count - result.Count()
+		if remaining <= 0 {
+			break
+		}
+
+		strategy, _, err := s.strategyFor(ctx, fallbackTeam)
+		if err != nil {
+			return SelectedReviewers{}, err
+		}
+
+		selected, err := s.selectFromTeam(ctx, fallbackTeam, strategy, authorID, remaining)
+		if err != nil {
+			return SelectedReviewers{}, err
+		}
+		result.Fallback = append(result.Fallback, selected...)
+	}
+
+	return result, nil
+}
+
+// selectFromTeam выбирает до count активных участников команды (кроме автора) переданной стратегией
+func (s *ReviewerSelector) selectFromTeam(ctx context.Context, teamName string, strategy ReviewerStrategy, authorID string, count int) ([]string, error) {
 	users, err := s.userRepo.FindActiveByTeamName(ctx, teamName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find active team members: %w", err)
@@ -47,10 +111,15 @@ func (s *ReviewerSelector) SelectReviewers(ctx context.Context, teamName, author
 		return nil, fmt.Errorf("failed to get review counts: %w", err)
 	}
 
-	return s.selectByRoundRobin(candidateIDs, reviewCounts, entity.MaxReviewersCount), nil
+	selected, err := strategy.Select(ctx, teamName, toReviewerCandidates(candidateIDs, reviewCounts), count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select reviewers: %w", err)
+	}
+
+	return selected, nil
 }
 
-// SelectReplacement выбирает замену для ревьювера из его команды
+// SelectReplacement выбирает замену для ревьювера из его команды стратегией этой команды
 func (s *ReviewerSelector) SelectReplacement(ctx context.Context, oldReviewerID, authorID string, assignedReviewers []string) (string, error) {
 	oldReviewer, err := s.userRepo.FindByID(ctx, oldReviewerID)
 	if err != nil {
@@ -90,7 +159,15 @@ func (s *ReviewerSelector) SelectReplacement(ctx context.Context, oldReviewerID,
 		return "", fmt.Errorf("failed to get review counts: %w", err)
 	}
 
-	selected := s.selectByRoundRobin(candidateIDs, reviewCounts, 1)
+	strategy, _, err := s.strategyFor(ctx, oldReviewer.TeamName())
+	if err != nil {
+		return "", err
+	}
+
+	selected, err := strategy.Select(ctx, oldReviewer.TeamName(), toReviewerCandidates(candidateIDs, reviewCounts), 1)
+	if err != nil {
+		return "", fmt.Errorf("failed to select replacement: %w", err)
+	}
 	if len(selected) == 0 {
 		return "", ErrNoActiveCandidates
 	}
@@ -98,40 +175,196 @@ func (s *ReviewerSelector) SelectReplacement(ctx context.Context, oldReviewerID,
 	return selected[0], nil
 }
 
-// selectByRoundRobin выбирает до maxCount пользователей с наименьшей загрузкой
-func (s *ReviewerSelector) selectByRoundRobin(candidateIDs []string, reviewCounts map[string]int, maxCount int) []string {
-	if len(candidateIDs) == 0 {
-		return []string{}
+// SelectReplacements подбирает замены для всех слотов, которые уходящие ревьюверы занимают в переданных PR.
+// Кандидаты ищутся сначала в команде уходящего ревьювера, затем в команде автора PR.
+// Загрузка читается одним запросом и учитывает слоты, уже распределенные в рамках вызова.
+// Если подходящего кандидата нет, слот освобождается (NewReviewerID пустой).
+// Reason каждого переноса называет команду и стратегию, которой выбрана замена
+func (s *ReviewerSelector) SelectReplacements(ctx context.Context, prs []*entity.PullRequest, leavingReviewerIDs []string) ([]repository.ReviewerChange, error) {
+	if len(prs) == 0 || len(leavingReviewerIDs) == 0 {
+		return []repository.ReviewerChange{}, nil
+	}
+
+	leaving := make(map[string]bool, len(leavingReviewerIDs))
+	for _, id := range leavingReviewerIDs {
+		leaving[id] = true
+	}
+
+	userIDs := make([]string, 0, len(leavingReviewerIDs)+len(prs))
+	userIDs = append(userIDs, leavingReviewerIDs...)
+	for _, pr := range prs {
+		if !leaving[pr.AuthorID()] {
+			userIDs = append(userIDs, pr.AuthorID())
+		}
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, uniqueStrings(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewers and authors: %w", err)
 	}
 
-	if len(candidateIDs) <= maxCount {
-		return candidateIDs
+	teamByUser := make(map[string]string, len(users))
+	for _, user := range users {
+		teamByUser[user.ID()] = user.TeamName()
 	}
 
-	type candidate struct {
-		userID string
-		count  int
+	activeByTeam := make(map[string][]string)
+	var candidateIDs []string
+	for _, teamName := range uniqueStrings(mapValues(teamByUser)) {
+		members, err := s.userRepo.FindActiveByTeamName(ctx, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find active team members: %w", err)
+		}
+		for _, member := range members {
+			if !leaving[member.ID()] {
+				activeByTeam[teamName] = append(activeByTeam[teamName], member.ID())
+				candidateIDs = append(candidateIDs, member.ID())
+			}
+		}
 	}
 
-	candidates := make([]candidate, 0, len(candidateIDs))
-	for _, userID := range candidateIDs {
-		candidates = append(candidates, candidate{
-			userID: userID,
-			count:  reviewCounts[userID],
-		})
+	reviewCounts, err := s.prRepo.CountActiveReviewsByUserIDs(ctx, uniqueStrings(candidateIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review counts: %w", err)
 	}
+	if reviewCounts == nil {
+		reviewCounts = make(map[string]int)
+	}
+
+	strategies := make(map[string]ReviewerStrategy)
+	strategyNames := make(map[string]entity.ReviewerStrategyName)
 
-	sort.Slice(candidates, func(i, j int) bool {
-		if candidates[i].count == candidates[j].count {
-			return candidates[i].userID < candidates[j].userID
+	var changes []repository.ReviewerChange
+	for _, pr := range prs {
+		assigned := append([]string(nil), pr.AssignedReviewers()...)
+		for i, reviewerID := range assigned {
+			if !leaving[reviewerID] {
+				continue
+			}
+
+			exclude := make(map[string]bool, len(assigned)+1)
+			exclude[pr.AuthorID()] = true
+			for _, id := range assigned {
+				exclude[id] = true
+			}
+
+			newReviewerID := ""
+			isFallback := false
+			reason := ""
+			candidateTeams := uniqueStrings([]string{teamByUser[reviewerID], teamByUser[pr.AuthorID()]})
+			for _, teamName := range candidateTeams {
+				var teamCandidates []string
+				for _, id := range activeByTeam[teamName] {
+					if !exclude[id] {
+						teamCandidates = append(teamCandidates, id)
+					}
+				}
+				if len(teamCandidates) == 0 {
+					continue
+				}
+
+				strategy, ok := strategies[teamName]
+				if !ok {
+					var name entity.ReviewerStrategyName
+					strategy, name, err = s.strategyFor(ctx, teamName)
+					if err != nil {
+						return nil, err
+					}
+					strategies[teamName] = strategy
+					strategyNames[teamName] = name
+				}
+
+				selected, err := strategy.Select(ctx, teamName, toReviewerCandidates(teamCandidates, reviewCounts), 1)
+				if err != nil {
+					return nil, fmt.Errorf("failed to select replacement: %w", err)
+				}
+				if len(selected) > 0 {
+					newReviewerID = selected[0]
+					isFallback = teamName != pr.TeamName()
+					reason = fmt.Sprintf("selected from team %s by %s strategy", teamName, strategyNames[teamName])
+					break
+				}
+			}
+
+			if newReviewerID != "" {
+				reviewCounts[newReviewerID]++
+				assigned[i] = newReviewerID
+			} else {
+				reason = fmt.Sprintf("no active candidates in teams %s, slot released", strings.Join(candidateTeams, ", "))
+			}
+
+			changes = append(changes, repository.ReviewerChange{
+				PullRequestID: pr.ID(),
+				OldReviewerID: reviewerID,
+				NewReviewerID: newReviewerID,
+				IsFallback:    isFallback,
+				Reason:        reason,
+			})
 		}
-		return candidates[i].count < candidates[j].count
-	})
+	}
+
+	return changes, nil
+}
 
-	result := make([]string, maxCount)
-	for i := 0; i < maxCount; i++ {
-		result[i] = candidates[i].userID
+// strategyFor возвращает стратегию команды и ее имя, а если она не задана - глобальную стратегию по умолчанию
+func (s *ReviewerSelector) strategyFor(ctx context.Context, teamName string) (ReviewerStrategy, entity.ReviewerStrategyName, error) {
+	team, err := s.teamRepo.FindByName(ctx, teamName)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, "", fmt.Errorf("failed to find team: %w", err)
 	}
 
+	return s.strategyForTeam(team)
+}
+
+// strategyForTeam возвращает стратегию уже загруженной команды и ее имя (nil - стратегия по умолчанию)
+func (s *ReviewerSelector) strategyForTeam(team *entity.Team) (ReviewerStrategy, entity.ReviewerStrategyName, error) {
+	name := s.defaultStrategy
+	if team != nil && team.ReviewerStrategy() != entity.ReviewerStrategyDefault {
+		name = team.ReviewerStrategy()
+	}
+
+	strategy, ok := s.strategies[name]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown reviewer strategy %q", name)
+	}
+
+	return strategy, name, nil
+}
+
+// toReviewerCandidates собирает кандидатов вместе с их загрузкой
+func toReviewerCandidates(candidateIDs []string, reviewCounts map[string]int) []ReviewerCandidate {
+	candidates := make([]ReviewerCandidate, len(candidateIDs))
+	for i, userID := range candidateIDs {
+		candidates[i] = ReviewerCandidate{UserID: userID, Load: reviewCounts[userID]}
+	}
+	return candidates
+}
+
+// uniqueStrings возвращает значения без повторов и пустых строк, сохраняя порядок
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
 	return result
 }
+
+// mapValues возвращает значения map в детерминированном порядке ключей
+func mapValues(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, m[key])
+	}
+	return values
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -15,16 +16,16 @@ import (
 
 func TestReviewerSelector_SelectReviewers(t *testing.T) {
 	tests := []struct {
-		name          string
-		teamName      string
-		authorID      string
-		setupMocks    func(*repositorymocks.MockUserRepository, *repositorymocks.MockPullRequestRepository)
-		expectErr     bool
-		expectedCount int
+		name             string
+		fallbackTeams    []string
+		authorID         string
+		setupMocks       func(*repositorymocks.MockUserRepository, *repositorymocks.MockPullRequestRepository)
+		expectErr        bool
+		expectedHome     []string
+		expectedFallback []string
 	}{
 		{
 			name:     "success - select 2 reviewers",
-			teamName: "team-1",
 			authorID: "author-1",
 			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository) {
 				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
@@ -37,24 +38,22 @@ func TestReviewerSelector_SelectReviewers(t *testing.T) {
 					"reviewer-2": 0,
 				}, nil)
 			},
-			expectErr:     false,
-			expectedCount: entity.MaxReviewersCount,
+			expectedHome:     []string{"reviewer-1", "reviewer-2"},
+			expectedFallback: []string{},
 		},
 		{
 			name:     "success - only author in team",
-			teamName: "team-1",
 			authorID: "author-1",
 			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository) {
 				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
 					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
 				}, nil)
 			},
-			expectErr:     false,
-			expectedCount: 0,
+			expectedHome:     []string{},
+			expectedFallback: []string{},
 		},
 		{
 			name:     "success - one reviewer available",
-			teamName: "team-1",
 			authorID: "author-1",
 			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository) {
 				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
@@ -65,8 +64,61 @@ func TestReviewerSelector_SelectReviewers(t *testing.T) {
 					"reviewer-1": 0,
 				}, nil)
 			},
-			expectErr:     false,
-			expectedCount: 1,
+			expectedHome:     []string{"reviewer-1"},
+			expectedFallback: []string{},
+		},
+		{
+			name:          "success - remaining slot filled from first fallback team",
+			fallbackTeams: []string{"team-2", "team-3"},
+			authorID:      "author-1",
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository) {
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+					entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), []string{"reviewer-1"}).Return(map[string]int{
+					"reviewer-1": 0,
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-2").Return([]*entity.User{
+					entity.NewUserFromRepository("helper-1", "Helper 1", "team-2", true, time.Now(), time.Now()),
+					entity.NewUserFromRepository("helper-2", "Helper 2", "team-2", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), []string{"helper-1", "helper-2"}).Return(map[string]int{
+					"helper-1": 2,
+					"helper-2": 0,
+				}, nil)
+			},
+			expectedHome:     []string{"reviewer-1"},
+			expectedFallback: []string{"helper-2"},
+		},
+		{
+			name:          "success - empty fallback team is skipped",
+			fallbackTeams: []string{"team-2", "team-3"},
+			authorID:      "author-1",
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository) {
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, time.Now(), time.Now()),
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-2").Return([]*entity.User{}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-3").Return([]*entity.User{
+					entity.NewUserFromRepository("helper-3", "Helper 3", "team-3", true, time.Now(), time.Now()),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), []string{"helper-3"}).Return(map[string]int{
+					"helper-3": 0,
+				}, nil)
+			},
+			expectedHome:     []string{},
+			expectedFallback: []string{"helper-3"},
+		},
+		{
+			name:          "error - fallback team lookup fails",
+			fallbackTeams: []string{"team-2"},
+			authorID:      "author-1",
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository) {
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-2").Return(nil, errors.New("db error"))
+			},
+			expectErr: true,
 		},
 	}
 
@@ -78,34 +130,28 @@ func TestReviewerSelector_SelectReviewers(t *testing.T) {
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
 
-			selector := NewReviewerSelector(userRepo, prRepo)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
 
 			tt.setupMocks(userRepo, prRepo)
 
-			result, err := selector.SelectReviewers(context.Background(), tt.teamName, tt.authorID)
+			team := entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), tt.fallbackTeams, entity.MergePolicy{}, time.Now(), time.Now())
+
+			result, err := selector.SelectReviewers(context.Background(), team, tt.authorID, entity.DefaultMaxReviewers)
 
 			if tt.expectErr {
 				if err == nil {
 					t.Errorf("expected error, got nil")
 				}
-				if result != nil {
-					t.Errorf("expected nil result, got %v", result)
-				}
-			} else {
-				if err != nil {
-					t.Errorf("unexpected error: %v", err)
-				}
-				if result == nil {
-					t.Fatal("expected result, got nil")
-				}
-				if len(result) != tt.expectedCount {
-					t.Errorf("expected %d reviewers, got %d", tt.expectedCount, len(result))
-				}
-				for _, reviewerID := range result {
-					if reviewerID == tt.authorID {
-						t.Errorf("author %s should not be in reviewers list", tt.authorID)
-					}
-				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result.Home, tt.expectedHome) {
+				t.Errorf("expected home reviewers %v, got %v", tt.expectedHome, result.Home)
+			}
+			if !reflect.DeepEqual(result.Fallback, tt.expectedFallback) {
+				t.Errorf("expected fallback reviewers %v, got %v", tt.expectedFallback, result.Fallback)
 			}
 		})
 	}
@@ -223,7 +269,7 @@ func TestReviewerSelector_SelectReplacement(t *testing.T) {
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
 
-			selector := NewReviewerSelector(userRepo, prRepo)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
 
 			tt.setupMocks(userRepo, prRepo)
 
@@ -255,3 +301,91 @@ func TestReviewerSelector_SelectReplacement(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewerSelector_UsesTeamStrategy(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamStrategy   entity.ReviewerStrategyName
+		teamErr        error
+		expectedResult string
+		expectErr      bool
+	}{
+		{
+			name:           "team without strategy uses default",
+			teamStrategy:   entity.ReviewerStrategyDefault,
+			expectedResult: "reviewer-2",
+		},
+		{
+			name:           "missing team uses default",
+			teamErr:        repository.ErrNotFound,
+			expectedResult: "reviewer-2",
+		},
+		{
+			name:           "team strategy overrides default",
+			teamStrategy:   entity.ReviewerStrategyRoundRobin,
+			expectedResult: "reviewer-1",
+		},
+		{
+			name:      "team lookup error",
+			teamErr:   errors.New("db error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			cursorRepo := repositorymocks.NewMockReviewerCursorRepository(ctrl)
+
+			userRepo.EXPECT().FindByID(gomock.Any(), "old-reviewer").Return(
+				entity.NewUserFromRepository("old-reviewer", "Old", "team-1", true, time.Now(), time.Now()), nil)
+			userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+				entity.NewUserFromRepository("reviewer-1", "Reviewer 1", "team-1", true, time.Now(), time.Now()),
+				entity.NewUserFromRepository("reviewer-2", "Reviewer 2", "team-1", true, time.Now(), time.Now()),
+			}, nil)
+			prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{
+				"reviewer-1": 3,
+				"reviewer-2": 0,
+			}, nil)
+
+			if tt.teamErr != nil {
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(nil, tt.teamErr)
+			} else {
+				team := entity.NewTeamFromRepository("team-1", tt.teamStrategy, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, time.Now(), time.Now())
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(team, nil)
+			}
+			cursorRepo.EXPECT().LockCursor(gomock.Any(), "team-1").Return("", nil).AnyTimes()
+			cursorRepo.EXPECT().SaveCursor(gomock.Any(), "team-1", gomock.Any()).Return(nil).AnyTimes()
+
+			selector := NewReviewerSelector(userRepo, prRepo, teamRepo, NewReviewerStrategies(cursorRepo), entity.ReviewerStrategyLeastLoaded)
+
+			result, err := selector.SelectReplacement(context.Background(), "old-reviewer", "author-1", []string{"old-reviewer"})
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expectedResult {
+				t.Errorf("expected %s, got %s", tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+// newTestReviewerSelector создает селектор со стратегией least_loaded и командами без собственной стратегии
+func newTestReviewerSelector(ctrl *gomock.Controller, userRepo repository.UserRepository, prRepo repository.PullRequestRepository) *ReviewerSelector {
+	teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+	teamRepo.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, repository.ErrNotFound).AnyTimes()
+
+	return NewReviewerSelector(userRepo, prRepo, teamRepo, NewReviewerStrategies(nil), entity.ReviewerStrategyLeastLoaded)
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+)
+
+// ReviewerCandidate кандидат в ревьюверы и его текущая загрузка (число открытых PR на ревью)
+type ReviewerCandidate struct {
+	UserID string
+	Load   int
+}
+
+// ReviewerStrategy стратегия выбора ревьюверов.
+// Кандидаты уже отфильтрованы (активны, не автор, не назначены на PR);
+// стратегия выбирает из них не более count пользователей
+type ReviewerStrategy interface {
+	Select(ctx context.Context, teamName string, candidates []ReviewerCandidate, count int) ([]string, error)
+}
+
+// NewReviewerStrategies создает набор встроенных стратегий, доступных по названию
+func NewReviewerStrategies(cursorRepo repository.ReviewerCursorRepository) map[entity.ReviewerStrategyName]ReviewerStrategy {
+	return map[entity.ReviewerStrategyName]ReviewerStrategy{
+		entity.ReviewerStrategyLeastLoaded:    NewLeastLoadedStrategy(),
+		entity.ReviewerStrategyRandom:         NewRandomStrategy(),
+		entity.ReviewerStrategyWeightedRandom: NewWeightedRandomStrategy(),
+		entity.ReviewerStrategyRoundRobin:     NewRoundRobinStrategy(cursorRepo),
+	}
+}
+
+// LeastLoadedStrategy выбирает кандидатов с наименьшей загрузкой, при равенстве - по user_id
+type LeastLoadedStrategy struct{}
+
+// NewLeastLoadedStrategy создает новый LeastLoadedStrategy
+func NewLeastLoadedStrategy() *LeastLoadedStrategy {
+	return &LeastLoadedStrategy{}
+}
+
+// Select выбирает до count наименее загруженных кандидатов
+func (s *LeastLoadedStrategy) Select(_ context.Context, _ string, candidates []ReviewerCandidate, count int) ([]string, error) {
+	if len(candidates) <= count {
+		return candidateIDs(candidates), nil
+	}
+
+	sorted := append([]ReviewerCandidate(nil), candidates...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Load == sorted[j].Load {
+			return sorted[i].UserID < sorted[j].UserID
+		}
+		return sorted[i].Load < sorted[j].Load
+	})
+
+	return candidateIDs(sorted[:min(count, len(sorted))]), nil
+}
+
+// RandomStrategy выбирает кандидатов равновероятно, без учета загрузки
+type RandomStrategy struct {
+	intN func(n int) int
+}
+
+// NewRandomStrategy создает новый RandomStrategy
+func NewRandomStrategy() *RandomStrategy {
+	return &RandomStrategy{intN: rand.IntN}
+}
+
+// Select выбирает до count случайных кандидатов (частичная перетасовка Фишера-Йетса)
+func (s *RandomStrategy) Select(_ context.Context, _ string, candidates []ReviewerCandidate, count int) ([]string, error) {
+	shuffled := append([]ReviewerCandidate(nil), candidates...)
+	count = min(count, len(shuffled))
+
+	for i := 0; i < count; i++ {
+		j := i + s.intN(len(shuffled)-i)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+
+	return candidateIDs(shuffled[:count]), nil
+}
+
+// WeightedRandomStrategy выбирает кандидатов случайно с весом 1/(загрузка+1):
+// свободные ревьюверы выбираются чаще, но загруженные тоже иногда получают ревью
+type WeightedRandomStrategy struct {
+	float64 func() float64
+}
+
+// NewWeightedRandomStrategy создает новый WeightedRandomStrategy
+func NewWeightedRandomStrategy() *WeightedRandomStrategy {
+	return &WeightedRandomStrategy{float64: rand.Float64}
+}
+
+// Select выбирает до count кандидатов без повторов, пропорционально весам
+func (s *WeightedRandomStrategy) Select(_ context.Context, _ string, candidates []ReviewerCandidate, count int) ([]string, error) {
+	remaining := append([]ReviewerCandidate(nil), candidates...)
+	count = min(count, len(remaining))
+
+	selected := make([]string, 0, count)
+	for len(selected) < count {
+		var total float64
+		for _, candidate := range remaining {
+			total += candidateWeight(candidate)
+		}
+
+		point := s.float64() * total
+		picked := len(remaining) - 1
+		for i, candidate := range remaining {
+			point -= candidateWeight(candidate)
+			if point < 0 {
+				picked = i
+				break
+			}
+		}
+
+		selected = append(selected, remaining[picked].UserID)
+		remaining = append(remaining[:picked], remaining[picked+1:]...)
+	}
+
+	return selected, nil
+}
+
+func candidateWeight(candidate ReviewerCandidate) float64 {
+	return 1 / float64(max(candidate.Load, 0)+1)
+}
+
+// RoundRobinStrategy выбирает кандидатов по кругу в порядке user_id,
+// начиная после последнего назначенного ревьювера команды (курсор хранится в БД)
+type RoundRobinStrategy struct {
+	cursorRepo repository.ReviewerCursorRepository
+}
+
+// NewRoundRobinStrategy создает новый RoundRobinStrategy
+func NewRoundRobinStrategy(cursorRepo repository.ReviewerCursorRepository) *RoundRobinStrategy {
+	return &RoundRobinStrategy{cursorRepo: cursorRepo}
+}
+
+// Select выбирает count кандидатов после курсора команды и сдвигает курсор
+// ВАЖНО: Должен вызываться внутри транзакции (txManager.Do), курсор блокируется до ее завершения
+func (s *RoundRobinStrategy) Select(ctx context.Context, teamName string, candidates []ReviewerCandidate, count int) ([]string, error) {
+	count = min(count, len(candidates))
+	if count == 0 {
+		return []string{}, nil
+	}
+
+	ids := candidateIDs(candidates)
+	sort.Strings(ids)
+
+	lastUserID, err := s.cursorRepo.LockCursor(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock reviewer cursor: %w", err)
+	}
+
+	start := sort.SearchStrings(ids, lastUserID)
+	if start < len(ids) && ids[start] == lastUserID {
+		start++
+	}
+
+	selected := make([]string, count)
+	for i := range selected {
+		selected[i] = ids[(start+i)%len(ids)]
+	}
+
+	if err := s.cursorRepo.SaveCursor(ctx, teamName, selected[count-1]); err != nil {
+		return nil, fmt.Errorf("failed to save reviewer cursor: %w", err)
+	}
+
+	return selected, nil
+}
+
+func candidateIDs(candidates []ReviewerCandidate) []string {
+	ids := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.UserID
+	}
+	return ids
+}
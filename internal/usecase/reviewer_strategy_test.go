@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+)
+
+func TestLeastLoadedStrategy_Select(t *testing.T) {
+	candidates := []ReviewerCandidate{
+		{UserID: "u3", Load: 1},
+		{UserID: "u2", Load: 0},
+		{UserID: "u1", Load: 1},
+	}
+
+	result, err := NewLeastLoadedStrategy().Select(context.Background(), "team", candidates, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"u2", "u1"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestRandomStrategy_Select(t *testing.T) {
+	candidates := []ReviewerCandidate{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}
+
+	strategy := &RandomStrategy{intN: func(n int) int { return n - 1 }}
+
+	result, err := strategy.Select(context.Background(), "team", candidates, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"u3", "u1"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+	if candidates[0].UserID != "u1" {
+		t.Error("input candidates must not be modified")
+	}
+}
+
+func TestWeightedRandomStrategy_Select(t *testing.T) {
+	tests := []struct {
+		name     string
+		point    float64
+		count    int
+		expected []string
+	}{
+		{
+			name:     "low point picks first candidate",
+			point:    0.1,
+			count:    1,
+			expected: []string{"busy"},
+		},
+		{
+			name:     "free candidate has larger weight",
+			point:    0.5,
+			count:    1,
+			expected: []string{"free"},
+		},
+		{
+			name:     "selects without repeats",
+			point:    0.99,
+			count:    3,
+			expected: []string{"free", "busy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// веса: busy = 1/4, free = 1
+			candidates := []ReviewerCandidate{
+				{UserID: "busy", Load: 3},
+				{UserID: "free", Load: 0},
+			}
+			strategy := &WeightedRandomStrategy{float64: func() float64 { return tt.point }}
+
+			result, err := strategy.Select(context.Background(), "team", candidates, tt.count)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRoundRobinStrategy_Select(t *testing.T) {
+	candidates := []ReviewerCandidate{{UserID: "u3"}, {UserID: "u1"}, {UserID: "u2"}}
+
+	tests := []struct {
+		name       string
+		count      int
+		setupMocks func(*repositorymocks.MockReviewerCursorRepository)
+		expected   []string
+		expectErr  bool
+	}{
+		{
+			name:  "empty cursor starts from first user",
+			count: 2,
+			setupMocks: func(cursorRepo *repositorymocks.MockReviewerCursorRepository) {
+				cursorRepo.EXPECT().LockCursor(gomock.Any(), "team").Return("", nil)
+				cursorRepo.EXPECT().SaveCursor(gomock.Any(), "team", "u2").Return(nil)
+			},
+			expected: []string{"u1", "u2"},
+		},
+		{
+			name:  "wraps around after last user",
+			count: 2,
+			setupMocks: func(cursorRepo *repositorymocks.MockReviewerCursorRepository) {
+				cursorRepo.EXPECT().LockCursor(gomock.Any(), "team").Return("u2", nil)
+				cursorRepo.EXPECT().SaveCursor(gomock.Any(), "team", "u1").Return(nil)
+			},
+			expected: []string{"u3", "u1"},
+		},
+		{
+			name:  "cursor user is no longer a candidate",
+			count: 1,
+			setupMocks: func(cursorRepo *repositorymocks.MockReviewerCursorRepository) {
+				cursorRepo.EXPECT().LockCursor(gomock.Any(), "team").Return("u15", nil)
+				cursorRepo.EXPECT().SaveCursor(gomock.Any(), "team", "u2").Return(nil)
+			},
+			expected: []string{"u2"},
+		},
+		{
+			name:  "lock error",
+			count: 1,
+			setupMocks: func(cursorRepo *repositorymocks.MockReviewerCursorRepository) {
+				cursorRepo.EXPECT().LockCursor(gomock.Any(), "team").Return("", errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cursorRepo := repositorymocks.NewMockReviewerCursorRepository(ctrl)
+			tt.setupMocks(cursorRepo)
+
+			result, err := NewRoundRobinStrategy(cursorRepo).Select(context.Background(), "team", candidates, tt.count)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -11,34 +11,39 @@ import (
 
 // StatisticsUseCase Use Case для получения статистики
 type StatisticsUseCase struct {
-	prRepo   repository.PullRequestRepository
-	userRepo repository.UserRepository
-	logger   logger.Logger
+	prRepo      repository.PullRequestRepository
+	historyRepo repository.ReviewerHistoryRepository
+	userRepo    repository.UserRepository
+	logger      logger.Logger
 }
 
 // NewStatisticsUseCase создает новый StatisticsUseCase
 func NewStatisticsUseCase(
 	prRepo repository.PullRequestRepository,
+	historyRepo repository.ReviewerHistoryRepository,
 	userRepo repository.UserRepository,
 	logger logger.Logger,
 ) *StatisticsUseCase {
 	return &StatisticsUseCase{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		logger:   logger,
+		prRepo:      prRepo,
+		historyRepo: historyRepo,
+		userRepo:    userRepo,
+		logger:      logger,
 	}
 }
 
 // GetStatistics возвращает статистику по назначениям
 // Включает:
-// - Статистику по PR (общее количество, открытые, смерженные)
-// - Статистику по пользователям (количество назначений, активных назначений)
+//   - Статистику по PR (общее количество и количество по статусам)
+//   - Статистику по пользователям (количество назначений, активных назначений - только в открытых PR).
+//     Количество назначений считается по истории назначений и включает PR, с которых ревьювера позже сняли
+//
 // Если teamName указан, возвращает статистику только для пользователей этой команды
 // Если teamName пустой, возвращает статистику для всех пользователей
 func (uc *StatisticsUseCase) GetStatistics(ctx context.Context, teamName string) (*dto.StatisticsDTO, error) {
 	uc.logger.Info("Getting statistics", "team_name", teamName)
 
-	total, open, merged, err := uc.prRepo.GetStats(ctx)
+	stats, err := uc.prRepo.GetStats(ctx)
 	if err != nil {
 		uc.logger.Error("Failed to get PR stats", "error", err)
 		return nil, fmt.Errorf("failed to get PR stats: %w", err)
@@ -46,9 +51,11 @@ func (uc *StatisticsUseCase) GetStatistics(ctx context.Context, teamName string)
 
 	result := &dto.StatisticsDTO{
 		PRStats: dto.PRStatsDTO{
-			Total:  total,
-			Open:   open,
-			Merged: merged,
+			Total:  stats.Total,
+			Draft:  stats.Draft,
+			Open:   stats.Open,
+			Merged: stats.Merged,
+			Closed: stats.Closed,
 		},
 		UserStats: []dto.UserStatsDTO{},
 	}
@@ -70,7 +77,7 @@ func (uc *StatisticsUseCase) GetStatistics(ctx context.Context, teamName string)
 			userIDs = append(userIDs, user.ID())
 		}
 
-		totalReviews, err := uc.prRepo.CountReviewsByUserIDs(ctx, userIDs)
+		totalReviews, err := uc.historyRepo.CountAssignedPRsByUserIDs(ctx, userIDs)
 		if err != nil {
 			uc.logger.Error("Failed to count total reviews", "error", err)
 			return nil, fmt.Errorf("failed to count total reviews: %w", err)
@@ -95,6 +102,6 @@ func (uc *StatisticsUseCase) GetStatistics(ctx context.Context, teamName string)
 		result.UserStats = userStats
 	}
 
-	uc.logger.Info("Statistics retrieved successfully", "pr_total", total, "pr_open", open, "pr_merged", merged)
+	uc.logger.Info("Statistics retrieved successfully", "pr_total", stats.Total, "pr_open", stats.Open, "pr_merged", stats.Merged)
 	return result, nil
 }
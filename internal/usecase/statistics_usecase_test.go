@@ -9,26 +9,29 @@ import (
 
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
 )
 
+var testPRStats = repository.PRStats{Total: 10, Draft: 1, Open: 4, Merged: 3, Closed: 2}
+
 func TestStatisticsUseCase_GetStatistics(t *testing.T) {
 	tests := []struct {
 		name       string
 		teamName   string
-		setupMocks func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockUserRepository, *loggermocks.MockLogger)
+		setupMocks func(*repositorymocks.MockPullRequestRepository, *repositorymocks.MockReviewerHistoryRepository, *repositorymocks.MockUserRepository, *loggermocks.MockLogger)
 		expectErr  bool
 	}{
 		{
 			name:     "success - get statistics for team",
 			teamName: "team-1",
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
-				prRepo.EXPECT().GetStats(gomock.Any()).Return(10, 5, 5, nil)
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, historyRepo *repositorymocks.MockReviewerHistoryRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
+				prRepo.EXPECT().GetStats(gomock.Any()).Return(testPRStats, nil)
 				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
 					entity.NewUserFromRepository("user-1", "User 1", "team-1", true, time.Now(), time.Now()),
 					entity.NewUserFromRepository("user-2", "User 2", "team-1", true, time.Now(), time.Now()),
 				}, nil)
-				prRepo.EXPECT().CountReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{
+				historyRepo.EXPECT().CountAssignedPRsByUserIDs(gomock.Any(), []string{"user-1", "user-2"}).Return(map[string]int{
 					"user-1": 3,
 					"user-2": 2,
 				}, nil)
@@ -43,8 +46,8 @@ func TestStatisticsUseCase_GetStatistics(t *testing.T) {
 		{
 			name:     "success - get statistics for all teams",
 			teamName: "",
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
-				prRepo.EXPECT().GetStats(gomock.Any()).Return(10, 5, 5, nil)
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, historyRepo *repositorymocks.MockReviewerHistoryRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
+				prRepo.EXPECT().GetStats(gomock.Any()).Return(testPRStats, nil)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectErr: false,
@@ -52,8 +55,8 @@ func TestStatisticsUseCase_GetStatistics(t *testing.T) {
 		{
 			name:     "success - team with no users",
 			teamName: "team-1",
-			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
-				prRepo.EXPECT().GetStats(gomock.Any()).Return(10, 5, 5, nil)
+			setupMocks: func(prRepo *repositorymocks.MockPullRequestRepository, historyRepo *repositorymocks.MockReviewerHistoryRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
+				prRepo.EXPECT().GetStats(gomock.Any()).Return(testPRStats, nil)
 				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
@@ -67,12 +70,13 @@ func TestStatisticsUseCase_GetStatistics(t *testing.T) {
 			defer ctrl.Finish()
 
 			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			historyRepo := repositorymocks.NewMockReviewerHistoryRepository(ctrl)
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
 
-			uc := NewStatisticsUseCase(prRepo, userRepo, logger)
+			uc := NewStatisticsUseCase(prRepo, historyRepo, userRepo, logger)
 
-			tt.setupMocks(prRepo, userRepo, logger)
+			tt.setupMocks(prRepo, historyRepo, userRepo, logger)
 
 			result, err := uc.GetStatistics(context.Background(), tt.teamName)
 
@@ -93,6 +97,9 @@ func TestStatisticsUseCase_GetStatistics(t *testing.T) {
 				if result.PRStats.Total != 10 {
 					t.Errorf("expected total PRs 10, got %d", result.PRStats.Total)
 				}
+				if result.PRStats.Draft != 1 || result.PRStats.Closed != 2 {
+					t.Errorf("expected 1 draft and 2 closed PRs, got %+v", result.PRStats)
+				}
 			}
 		})
 	}
@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// SubscriptionUseCase Use Case для подписок на события
+type SubscriptionUseCase struct {
+	txManager        transaction.Manager
+	subscriptionRepo repository.SubscriptionRepository
+	deliveryRepo     repository.EventDeliveryRepository
+	audit            audit.Recorder
+	logger           logger.Logger
+}
+
+// NewSubscriptionUseCase создает новый SubscriptionUseCase
+func NewSubscriptionUseCase(
+	txManager transaction.Manager,
+	subscriptionRepo repository.SubscriptionRepository,
+	deliveryRepo repository.EventDeliveryRepository,
+	audit audit.Recorder,
+	logger logger.Logger,
+) *SubscriptionUseCase {
+	return &SubscriptionUseCase{
+		txManager:        txManager,
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		audit:            audit,
+		logger:           logger,
+	}
+}
+
+// CreateSubscription создает активную подписку. Если секрет не задан, он генерируется.
+// Секрет возвращается только в ответе на создание
+// POST /subscriptions/create
+func (uc *SubscriptionUseCase) CreateSubscription(ctx context.Context, req dto.CreateSubscriptionRequest) (*dto.SubscriptionDTO, error) {
+	uc.logger.Info("Creating subscription", "url", req.URL, "event_types", req.EventTypes)
+
+	eventTypes, err := parseEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		secret = rand.Text()
+	}
+
+	subscription, err := entity.NewSubscription(newID(), req.URL, secret, eventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.subscriptionRepo.Create(ctx, subscription); err != nil {
+			return fmt.Errorf("failed to save subscription: %w", err)
+		}
+		after := dto.ToSubscriptionDTO(subscription)
+		return recordAudit(ctx, uc.audit, subscriptionRecord(entity.AuditSubscriptionCreated, nil, &after, ""))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to create subscription", "error", err)
+		return nil, err
+	}
+
+	uc.logger.Info("Subscription created", "subscription_id", subscription.ID())
+	result := dto.ToSubscriptionDTO(subscription)
+	result.Secret = subscription.Secret()
+	return &result, nil
+}
+
+// GetSubscription получает подписку
+// GET /subscriptions/get?subscription_id=
+func (uc *SubscriptionUseCase) GetSubscription(ctx context.Context, subscriptionID string) (*dto.SubscriptionDTO, error) {
+	uc.logger.Info("Getting subscription", "subscription_id", subscriptionID)
+
+	subscription, err := uc.findSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := dto.ToSubscriptionDTO(subscription)
+	return &result, nil
+}
+
+// ListSubscriptions возвращает все подписки в порядке создания
+// GET /subscriptions/list
+func (uc *SubscriptionUseCase) ListSubscriptions(ctx context.Context) ([]dto.SubscriptionDTO, error) {
+	uc.logger.Info("Listing subscriptions")
+
+	subscriptions, err := uc.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list subscriptions", "error", err)
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	return dto.ToSubscriptionDTOs(subscriptions), nil
+}
+
+// UpdateSubscription меняет адрес, секрет, список событий или активность подписки.
+// Приостановленная подписка не получает новых событий, уже поставленные доставки отправляются
+// POST /subscriptions/update
+func (uc *SubscriptionUseCase) UpdateSubscription(ctx context.Context, req dto.UpdateSubscriptionRequest) (*dto.SubscriptionDTO, error) {
+	uc.logger.Info("Updating subscription", "subscription_id", req.SubscriptionID)
+
+	var subscription *entity.Subscription
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		subscription, err = uc.findSubscription(ctx, req.SubscriptionID)
+		if err != nil {
+			return err
+		}
+
+		before := dto.ToSubscriptionDTO(subscription)
+		if req.URL != nil {
+			if err := subscription.ChangeURL(*req.URL); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+			}
+		}
+		if req.Secret != nil {
+			if err := subscription.ChangeSecret(*req.Secret); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+			}
+		}
+		if req.EventTypes != nil {
+			eventTypes, err := parseEventTypes(*req.EventTypes)
+			if err != nil {
+				return err
+			}
+			if err := subscription.ChangeEventTypes(eventTypes); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+			}
+		}
+		if req.IsActive != nil {
+			subscription.SetActive(*req.IsActive)
+		}
+
+		if err := uc.subscriptionRepo.Update(ctx, subscription); err != nil {
+			return fmt.Errorf("failed to update subscription: %w", err)
+		}
+
+		// Секрет не попадает в снимки, поэтому его смена отмечается в причине
+		reason := ""
+		if req.Secret != nil {
+			reason = "secret changed"
+		}
+		after := dto.ToSubscriptionDTO(subscription)
+		return recordAudit(ctx, uc.audit, subscriptionRecord(entity.AuditSubscriptionUpdated, &before, &after, reason))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update subscription", "error", err, "subscription_id", req.SubscriptionID)
+		return nil, err
+	}
+
+	uc.logger.Info("Subscription updated", "subscription_id", req.SubscriptionID)
+	result := dto.ToSubscriptionDTO(subscription)
+	return &result, nil
+}
+
+// DeleteSubscription удаляет подписку вместе с неотправленными доставками и dead letter
+// POST /subscriptions/delete
+func (uc *SubscriptionUseCase) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	uc.logger.Info("Deleting subscription", "subscription_id", subscriptionID)
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		subscription, err := uc.findSubscription(ctx, subscriptionID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.subscriptionRepo.Delete(ctx, subscriptionID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrSubscriptionNotFound
+			}
+			return fmt.Errorf("failed to delete subscription: %w", err)
+		}
+		before := dto.ToSubscriptionDTO(subscription)
+		return recordAudit(ctx, uc.audit, subscriptionRecord(entity.AuditSubscriptionDeleted, &before, nil, ""))
+	})
+	if err != nil {
+		if !errors.Is(err, ErrSubscriptionNotFound) {
+			uc.logger.Error("Failed to delete subscription", "error", err, "subscription_id", subscriptionID)
+		}
+		return err
+	}
+
+	uc.logger.Info("Subscription deleted", "subscription_id", subscriptionID)
+	return nil
+}
+
+// GetDeadLetters возвращает доставки подписки, исчерпавшие попытки, новые первыми
+// GET /subscriptions/deadLetters?subscription_id=
+func (uc *SubscriptionUseCase) GetDeadLetters(ctx context.Context, subscriptionID string) ([]dto.DeadLetterDTO, error) {
+	uc.logger.Info("Getting dead letters", "subscription_id", subscriptionID)
+
+	if _, err := uc.findSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.deliveryRepo.FindDeadLetters(ctx, subscriptionID)
+	if err != nil {
+		uc.logger.Error("Failed to find dead letters", "error", err, "subscription_id", subscriptionID)
+		return nil, fmt.Errorf("failed to find dead letters: %w", err)
+	}
+
+	return dto.ToDeadLetterDTOs(deliveries), nil
+}
+
+func (uc *SubscriptionUseCase) findSubscription(ctx context.Context, subscriptionID string) (*entity.Subscription, error) {
+	subscription, err := uc.subscriptionRepo.FindByID(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+// parseEventTypes проверяет имена типов событий
+func parseEventTypes(names []string) ([]entity.EventType, error) {
+	eventTypes := make([]entity.EventType, len(names))
+	for i, name := range names {
+		eventType, err := entity.ParseEventType(name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+		}
+		eventTypes[i] = eventType
+	}
+	return eventTypes, nil
+}
@@ -0,0 +1,325 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+func TestSubscriptionUseCase_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.CreateSubscriptionRequest
+		setupMocks  func(*repositorymocks.MockSubscriptionRepository)
+		expectErr   bool
+		expectedErr error
+		checkSecret func(*testing.T, string)
+	}{
+		{
+			name: "success - with provided secret",
+			req: dto.CreateSubscriptionRequest{
+				URL:        "https://example.com/hook",
+				Secret:     "provided-secret-123",
+				EventTypes: []string{"reviewer.assigned", "pr.merged"},
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {
+				subscriptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			checkSecret: func(t *testing.T, secret string) {
+				if secret != "provided-secret-123" {
+					t.Errorf("expected provided secret, got %q", secret)
+				}
+			},
+		},
+		{
+			name: "success - secret generated when omitted",
+			req: dto.CreateSubscriptionRequest{
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"user.deactivated"},
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {
+				subscriptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			checkSecret: func(t *testing.T, secret string) {
+				if len(secret) < entity.MinSubscriptionSecretLength {
+					t.Errorf("expected generated secret of at least %d characters, got %q", entity.MinSubscriptionSecretLength, secret)
+				}
+			},
+		},
+		{
+			name: "error - unknown event type",
+			req: dto.CreateSubscriptionRequest{
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"pr.created"},
+			},
+			setupMocks:  func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {},
+			expectErr:   true,
+			expectedErr: ErrInvalidSubscription,
+		},
+		{
+			name: "error - invalid url",
+			req: dto.CreateSubscriptionRequest{
+				URL:        "ftp://example.com/hook",
+				EventTypes: []string{"pr.merged"},
+			},
+			setupMocks:  func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {},
+			expectErr:   true,
+			expectedErr: ErrInvalidSubscription,
+		},
+		{
+			name: "error - repository fails",
+			req: dto.CreateSubscriptionRequest{
+				URL:        "https://example.com/hook",
+				EventTypes: []string{"pr.merged"},
+			},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {
+				subscriptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+			deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+
+			tt.setupMocks(subscriptionRepo)
+
+			uc := NewSubscriptionUseCase(txManager, subscriptionRepo, deliveryRepo, newTestAuditRecorder(ctrl), logger)
+			result, err := uc.CreateSubscription(context.Background(), tt.req)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.SubscriptionID == "" {
+				t.Error("expected generated subscription_id")
+			}
+			if !result.IsActive {
+				t.Error("expected new subscription to be active")
+			}
+			if len(result.EventTypes) != len(tt.req.EventTypes) {
+				t.Errorf("expected event types %v, got %v", tt.req.EventTypes, result.EventTypes)
+			}
+			tt.checkSecret(t, result.Secret)
+		})
+	}
+}
+
+func TestSubscriptionUseCase_UpdateSubscription(t *testing.T) {
+	now := time.Now()
+	newSubscription := func() *entity.Subscription {
+		return entity.NewSubscriptionFromRepository("sub-1", "https://example.com/hook", "secret-secret-123",
+			[]entity.EventType{entity.EventPRMerged}, true, now, now)
+	}
+	paused := false
+	newURL := "https://example.com/v2/hook"
+	unknownTypes := []string{"pr.created"}
+
+	tests := []struct {
+		name        string
+		req         dto.UpdateSubscriptionRequest
+		setupMocks  func(*repositorymocks.MockSubscriptionRepository)
+		expectErr   bool
+		expectedErr error
+	}{
+		{
+			name: "success - pause and change url",
+			req:  dto.UpdateSubscriptionRequest{SubscriptionID: "sub-1", URL: &newURL, IsActive: &paused},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(newSubscription(), nil)
+				subscriptionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, subscription *entity.Subscription) error {
+						if subscription.IsActive() || subscription.URL() != newURL {
+							t.Errorf("unexpected subscription saved: active=%v url=%s", subscription.IsActive(), subscription.URL())
+						}
+						return nil
+					},
+				)
+			},
+		},
+		{
+			name: "error - unknown event type",
+			req:  dto.UpdateSubscriptionRequest{SubscriptionID: "sub-1", EventTypes: &unknownTypes},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(newSubscription(), nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrInvalidSubscription,
+		},
+		{
+			name: "error - subscription not found",
+			req:  dto.UpdateSubscriptionRequest{SubscriptionID: "sub-404", IsActive: &paused},
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-404").Return(nil, repository.ErrNotFound)
+			},
+			expectErr:   true,
+			expectedErr: ErrSubscriptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+			deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setupMocks(subscriptionRepo)
+
+			uc := NewSubscriptionUseCase(txManager, subscriptionRepo, deliveryRepo, newTestAuditRecorder(ctrl), logger)
+			result, err := uc.UpdateSubscription(context.Background(), tt.req)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Secret != "" {
+				t.Error("secret must not be returned on update")
+			}
+		})
+	}
+}
+
+func TestSubscriptionUseCase_DeleteSubscription(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*repositorymocks.MockSubscriptionRepository, *auditmocks.MockRecorder)
+		expectedErr error
+	}{
+		{
+			name: "success - deletion recorded in audit",
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, recorder *auditmocks.MockRecorder) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(
+					entity.NewSubscriptionFromRepository("sub-1", "https://example.com/hook", "secret-secret-123",
+						[]entity.EventType{entity.EventPRMerged}, true, now, now),
+					nil,
+				)
+				subscriptionRepo.EXPECT().Delete(gomock.Any(), "sub-1").Return(nil)
+				recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+					if len(records) != 1 || records[0].Action != entity.AuditSubscriptionDeleted || records[0].EntityID != "sub-1" {
+						t.Fatalf("expected single subscription.deleted record, got %+v", records)
+					}
+					before, ok := records[0].Before.(dto.SubscriptionDTO)
+					if !ok || before.URL != "https://example.com/hook" || before.Secret != "" || records[0].After != nil {
+						t.Errorf("expected snapshot before deletion without secret, got %+v / %+v", records[0].Before, records[0].After)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name: "error - subscription not found",
+			setupMocks: func(subscriptionRepo *repositorymocks.MockSubscriptionRepository, recorder *auditmocks.MockRecorder) {
+				subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(nil, repository.ErrNotFound)
+			},
+			expectedErr: ErrSubscriptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			recorder := auditmocks.NewMockRecorder(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+			tt.setupMocks(subscriptionRepo, recorder)
+
+			uc := NewSubscriptionUseCase(txManager, subscriptionRepo, repositorymocks.NewMockEventDeliveryRepository(ctrl), recorder, logger)
+
+			err := uc.DeleteSubscription(context.Background(), "sub-1")
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestSubscriptionUseCase_GetDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	subscriptionRepo := repositorymocks.NewMockSubscriptionRepository(ctrl)
+	deliveryRepo := repositorymocks.NewMockEventDeliveryRepository(ctrl)
+	logger := loggermocks.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	subscriptionRepo.EXPECT().FindByID(gomock.Any(), "sub-1").Return(
+		entity.NewSubscriptionFromRepository("sub-1", "https://example.com/hook", "secret-secret-123",
+			[]entity.EventType{entity.EventPRMerged}, true, now, now),
+		nil,
+	)
+	deliveryRepo.EXPECT().FindDeadLetters(gomock.Any(), "sub-1").Return([]*entity.EventDelivery{
+		entity.NewEventDeliveryFromRepository("delivery-1", "sub-1", "event-1", entity.EventPRMerged,
+			[]byte(`{"id":"event-1"}`), 3, now, "connection refused", now, &now),
+	}, nil)
+
+	uc := NewSubscriptionUseCase(transactionmocks.NewMockManager(ctrl), subscriptionRepo, deliveryRepo, newTestAuditRecorder(ctrl), logger)
+
+	deadLetters, err := uc.GetDeadLetters(context.Background(), "sub-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].DeliveryID != "delivery-1" || deadLetters[0].Attempts != 3 {
+		t.Errorf("unexpected dead letters: %+v", deadLetters)
+	}
+}
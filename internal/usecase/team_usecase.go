@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
@@ -14,10 +17,15 @@ import (
 
 // TeamUseCase Use Case для работы с командами
 type TeamUseCase struct {
-	txManager transaction.Manager
-	teamRepo  repository.TeamRepository
-	userRepo  repository.UserRepository
-	logger    logger.Logger
+	txManager        transaction.Manager
+	teamRepo         repository.TeamRepository
+	userRepo         repository.UserRepository
+	prRepo           repository.PullRequestRepository
+	historyRepo      repository.ReviewerHistoryRepository
+	reviewerSelector *ReviewerSelector
+	events           event.Emitter
+	audit            audit.Recorder
+	logger           logger.Logger
 }
 
 // NewTeamUseCase создает новый TeamUseCase
@@ -25,19 +33,34 @@ func NewTeamUseCase(
 	txManager transaction.Manager,
 	teamRepo repository.TeamRepository,
 	userRepo repository.UserRepository,
+	prRepo repository.PullRequestRepository,
+	historyRepo repository.ReviewerHistoryRepository,
+	reviewerSelector *ReviewerSelector,
+	events event.Emitter,
+	audit audit.Recorder,
 	logger logger.Logger,
 ) *TeamUseCase {
 	return &TeamUseCase{
-		txManager: txManager,
-		teamRepo:  teamRepo,
-		userRepo:  userRepo,
-		logger:    logger,
+		txManager:        txManager,
+		teamRepo:         teamRepo,
+		userRepo:         userRepo,
+		prRepo:           prRepo,
+		historyRepo:      historyRepo,
+		reviewerSelector: reviewerSelector,
+		events:           events,
+		audit:            audit,
+		logger:           logger,
 	}
 }
 
-// CreateTeam создает команду с участниками (создает/обновляет пользователей)
+// CreateTeam создает команду с участниками (создает/обновляет пользователей).
+// Руководитель команды (req.ManagerID) должен войти в новую команду и может перевести в нее
+// только пользователей своей команды
 // POST /team/add
-func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamDTO, error) {
+func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest) (_ *dto.TeamDTO, err error) {
+	ctx, span := startSpan(ctx, "TeamUseCase.CreateTeam", attrTeamName.String(req.TeamName))
+	defer func() { endSpan(span, err) }()
+
 	uc.logger.Info("Creating team", "team_name", req.TeamName, "members_count", len(req.Members))
 
 	exists, err := uc.teamRepo.Exists(ctx, req.TeamName)
@@ -49,15 +72,34 @@ func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest
 		return nil, ErrTeamAlreadyExists
 	}
 
+	managerTeam, err := uc.managerTeam(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	var team *entity.Team
 	var users []*entity.User
 
 	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var deactivated []event.Event
+		var activityRecords []audit.Record
+
 		team, err = entity.NewTeam(req.TeamName)
 		if err != nil {
 			return fmt.Errorf("failed to create team entity: %w", err)
 		}
 
+		settings := teamSettings{minReviewers: req.MinReviewers, maxReviewers: req.MaxReviewers}
+		if req.ReviewerStrategy != "" {
+			settings.strategy = &req.ReviewerStrategy
+		}
+		if len(req.FallbackTeams) > 0 {
+			settings.fallbackTeams = &req.FallbackTeams
+		}
+		if _, err := uc.applyTeamSettings(ctx, team, settings); err != nil {
+			return err
+		}
+
 		if err := uc.teamRepo.Create(ctx, team); err != nil {
 			return fmt.Errorf("failed to save team: %w", err)
 		}
@@ -69,6 +111,12 @@ func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest
 			}
 
 			if existingUser != nil {
+				if req.ManagerID != "" && existingUser.TeamName() != managerTeam {
+					uc.logger.Info("Team lead cannot move users of another team",
+						"manager_id", req.ManagerID, "user_id", existingUser.ID(), "team_name", existingUser.TeamName())
+					return ErrForbidden
+				}
+				before := dto.ToUserDTO(existingUser)
 				if err := existingUser.ChangeTeam(req.TeamName); err != nil {
 					return fmt.Errorf("failed to change team for user %s: %w", memberReq.UserID, err)
 				}
@@ -76,10 +124,16 @@ func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest
 					existingUser.Activate()
 				} else if !memberReq.IsActive && existingUser.IsActive() {
 					existingUser.Deactivate()
+					deactivated = append(deactivated, event.NewUserDeactivated(existingUser.ID(), req.TeamName))
 				}
 				if err := uc.userRepo.Update(ctx, existingUser); err != nil {
 					return fmt.Errorf("failed to update user %s: %w", memberReq.UserID, err)
 				}
+				if before.IsActive != existingUser.IsActive() {
+					activityRecords = append(activityRecords, userActivityRecord(
+						before, dto.ToUserDTO(existingUser), "membership set by team "+req.TeamName,
+					))
+				}
 				users = append(users, existingUser)
 			} else {
 				user, err := entity.NewUser(memberReq.UserID, memberReq.Username, req.TeamName)
@@ -95,7 +149,10 @@ func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest
 				users = append(users, user)
 			}
 		}
-		return nil
+		if err := emitEvents(ctx, uc.events, deactivated...); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, append([]audit.Record{teamCreatedRecord(dto.ToTeamDTO(team, users))}, activityRecords...)...)
 	})
 	if err != nil {
 		uc.logger.Error("Failed to create team", "error", err, "team_name", req.TeamName)
@@ -107,9 +164,33 @@ func (uc *TeamUseCase) CreateTeam(ctx context.Context, req dto.CreateTeamRequest
 	return &result, nil
 }
 
+// managerTeam возвращает текущую команду руководителя, создающего команду. Руководитель должен
+// войти в новую команду; если его еще нет среди пользователей, команда пустая
+func (uc *TeamUseCase) managerTeam(ctx context.Context, req dto.CreateTeamRequest) (string, error) {
+	if req.ManagerID == "" {
+		return "", nil
+	}
+	if !slices.ContainsFunc(req.Members, func(member dto.TeamMemberRequest) bool { return member.UserID == req.ManagerID }) {
+		uc.logger.Info("Team lead is not a member of the new team", "team_name", req.TeamName, "manager_id", req.ManagerID)
+		return "", ErrForbidden
+	}
+
+	manager, err := uc.userRepo.FindByID(ctx, req.ManagerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to find manager: %w", err)
+	}
+	return manager.TeamName(), nil
+}
+
 // GetTeam получает команду с участниками
 // GET /team/get?team_name=
-func (uc *TeamUseCase) GetTeam(ctx context.Context, teamName string) (*dto.TeamDTO, error) {
+func (uc *TeamUseCase) GetTeam(ctx context.Context, teamName string) (_ *dto.TeamDTO, err error) {
+	ctx, span := startSpan(ctx, "TeamUseCase.GetTeam", attrTeamName.String(teamName))
+	defer func() { endSpan(span, err) }()
+
 	uc.logger.Info("Getting team", "team_name", teamName)
 
 	team, err := uc.teamRepo.FindByName(ctx, teamName)
@@ -132,51 +213,268 @@ func (uc *TeamUseCase) GetTeam(ctx context.Context, teamName string) (*dto.TeamD
 	return &result, nil
 }
 
-// DeactivateTeamMembers массово деактивирует всех пользователей команды
-// Примечание: согласно ТЗ, должна быть безопасная переназначаемость открытых PR
-// (стремиться уложиться в 100 мс для средних объёмов данных)
-func (uc *TeamUseCase) DeactivateTeamMembers(ctx context.Context, teamName string) (*dto.TeamDTO, error) {
+// UpdateTeam меняет настройки выбора ревьюверов команды (стратегию, min/max ревьюверов и запасные команды)
+// и политику мержа. Незаданные поля не меняются. Новые ограничения действуют только для PR, созданных
+// после изменения, политика мержа - для всех открытых PR команды.
+// Руководитель команды (req.ManagerID) может менять только свою команду
+// POST /team/update
+func (uc *TeamUseCase) UpdateTeam(ctx context.Context, req dto.UpdateTeamRequest) (_ *dto.TeamDTO, err error) {
+	ctx, span := startSpan(ctx, "TeamUseCase.UpdateTeam", attrTeamName.String(req.TeamName))
+	defer func() { endSpan(span, err) }()
+
+	uc.logger.Info("Updating team", "team_name", req.TeamName)
+
+	var team *entity.Team
+	var users []*entity.User
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		team, err = uc.teamRepo.FindByName(ctx, req.TeamName)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrTeamNotFound
+			}
+			return fmt.Errorf("failed to find team: %w", err)
+		}
+
+		users, err = uc.userRepo.FindByTeamName(ctx, team.Name())
+		if err != nil {
+			return fmt.Errorf("failed to find team users: %w", err)
+		}
+		if req.ManagerID != "" && !isTeamMember(users, req.ManagerID) {
+			uc.logger.Info("Team lead is not a member of the team", "team_name", req.TeamName, "manager_id", req.ManagerID)
+			return ErrForbidden
+		}
+
+		before := dto.ToTeamDTO(team, users)
+		changed, err := uc.applyTeamSettings(ctx, team, teamSettings{
+			strategy:      req.ReviewerStrategy,
+			minReviewers:  req.MinReviewers,
+			maxReviewers:  req.MaxReviewers,
+			fallbackTeams: req.FallbackTeams,
+			mergePolicy:   req.MergePolicy,
+		})
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		if err := uc.teamRepo.Update(ctx, team); err != nil {
+			return fmt.Errorf("failed to update team: %w", err)
+		}
+		return recordAudit(ctx, uc.audit, teamUpdatedRecord(before, dto.ToTeamDTO(team, users)))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to update team", "error", err, "team_name", req.TeamName)
+		return nil, err
+	}
+
+	uc.logger.Info("Team updated successfully",
+		"team_name", req.TeamName,
+		"reviewer_strategy", team.ReviewerStrategy(),
+		"min_reviewers", team.ReviewerLimits().Min(),
+		"max_reviewers", team.ReviewerLimits().Max(),
+		"fallback_teams", team.FallbackTeams(),
+		"required_approvals", team.MergePolicy().RequiredApprovals(),
+	)
+	result := dto.ToTeamDTO(team, users)
+	return &result, nil
+}
+
+// DeactivateTeamMembers массово деактивирует всех пользователей команды и в той же транзакции
+// переназначает их открытые ревью через ReviewerSelector. Слоты, для которых не нашлось
+// кандидата, освобождаются. Все чтения и записи выполняются пачками, поэтому число запросов
+// не зависит от размера команды (цель - до 100 мс на ~200 пользователей).
+// Руководитель команды (req.ManagerID) может деактивировать только свою команду
+func (uc *TeamUseCase) DeactivateTeamMembers(ctx context.Context, req dto.DeactivateTeamMembersRequest) (_ *dto.TeamDTO, _ []dto.ReviewerReassignmentDTO, err error) {
+	teamName := req.TeamName
+	ctx, span := startSpan(ctx, "TeamUseCase.DeactivateTeamMembers", attrTeamName.String(teamName))
+	defer func() { endSpan(span, err) }()
+
 	uc.logger.Info("Deactivating team members", "team_name", teamName)
 
 	team, err := uc.teamRepo.FindByName(ctx, teamName)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrTeamNotFound
+			return nil, nil, ErrTeamNotFound
 		}
 		uc.logger.Error("Failed to find team", "error", err, "team_name", teamName)
-		return nil, fmt.Errorf("failed to find team: %w", err)
+		return nil, nil, fmt.Errorf("failed to find team: %w", err)
 	}
 
 	users, err := uc.userRepo.FindByTeamName(ctx, teamName)
 	if err != nil {
 		uc.logger.Error("Failed to find team users", "error", err, "team_name", teamName)
-		return nil, fmt.Errorf("failed to find team users: %w", err)
+		return nil, nil, fmt.Errorf("failed to find team users: %w", err)
+	}
+
+	if req.ManagerID != "" && !isTeamMember(users, req.ManagerID) {
+		uc.logger.Info("Team lead is not a member of the team", "team_name", teamName, "manager_id", req.ManagerID)
+		return nil, nil, ErrForbidden
 	}
 
 	if len(users) == 0 {
 		uc.logger.Info("No users to deactivate", "team_name", teamName)
 		result := dto.ToTeamDTO(team, users)
-		return &result, nil
+		return &result, []dto.ReviewerReassignmentDTO{}, nil
 	}
 
+	memberIDs := make([]string, len(users))
+	for i, user := range users {
+		memberIDs[i] = user.ID()
+	}
+
+	var changes []repository.ReviewerChange
+
 	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
 		if err := uc.userRepo.BatchDeactivateByTeamName(ctx, teamName); err != nil {
 			return fmt.Errorf("failed to batch deactivate team members: %w", err)
 		}
-		return nil
+
+		changes, err = reassignOpenReviews(ctx, uc.prRepo, uc.historyRepo, uc.reviewerSelector, memberIDs)
+		if err != nil {
+			return err
+		}
+
+		events := make([]event.Event, 0, len(users)+len(changes))
+		records := make([]audit.Record, 0, len(users)+len(changes))
+		for _, user := range users {
+			if user.IsActive() {
+				events = append(events, event.NewUserDeactivated(user.ID(), teamName))
+
+				before := dto.ToUserDTO(user)
+				after := before
+				after.IsActive = false
+				records = append(records, userActivityRecord(before, after, "team "+teamName+" deactivated"))
+			}
+		}
+		events = append(events, reviewerReassignedEvents(changes)...)
+		records = append(records, reviewerReassignedRecords(changes)...)
+
+		if err := emitEvents(ctx, uc.events, events...); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, records...)
 	})
 	if err != nil {
 		uc.logger.Error("Failed to deactivate team members", "error", err, "team_name", teamName)
-		return nil, err
+		return nil, nil, err
 	}
 
 	users, err = uc.userRepo.FindByTeamName(ctx, teamName)
 	if err != nil {
 		uc.logger.Error("Failed to reload team users", "error", err, "team_name", teamName)
-		return nil, fmt.Errorf("failed to reload team users: %w", err)
+		return nil, nil, fmt.Errorf("failed to reload team users: %w", err)
 	}
 
-	uc.logger.Info("Team members deactivated successfully", "team_name", teamName, "members_count", len(users))
+	uc.logger.Info("Team members deactivated successfully",
+		"team_name", teamName,
+		"members_count", len(users),
+		"reassigned_slots", len(changes),
+	)
 	result := dto.ToTeamDTO(team, users)
-	return &result, nil
+	return &result, dto.ToReviewerReassignmentDTOs(changes), nil
+}
+
+// teamSettings настройки команды; nil-поля не меняются
+type teamSettings struct {
+	strategy      *string
+	minReviewers  *int
+	maxReviewers  *int
+	fallbackTeams *[]string
+	mergePolicy   *dto.MergePolicyRequest
+}
+
+// applyTeamSettings применяет к команде заданные настройки.
+// Запасные команды и владельцы кода должны существовать. Возвращает true, если команда изменилась
+func (uc *TeamUseCase) applyTeamSettings(ctx context.Context, team *entity.Team, settings teamSettings) (bool, error) {
+	changed := false
+
+	if settings.strategy != nil {
+		err := team.ChangeReviewerStrategy(entity.ReviewerStrategyName(*settings.strategy))
+		switch {
+		case err == nil:
+			changed = true
+		case !errors.Is(err, entity.ErrNoChange):
+			return false, fmt.Errorf("failed to change reviewer strategy: %w", err)
+		}
+	}
+
+	if settings.minReviewers != nil || settings.maxReviewers != nil {
+		current := team.ReviewerLimits()
+		newMin, newMax := current.Min(), current.Max()
+		if settings.minReviewers != nil {
+			newMin = *settings.minReviewers
+		}
+		if settings.maxReviewers != nil {
+			newMax = *settings.maxReviewers
+		}
+
+		limits, err := entity.NewReviewerLimits(newMin, newMax)
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", ErrInvalidReviewerLimits, err)
+		}
+
+		err = team.ChangeReviewerLimits(limits)
+		switch {
+		case err == nil:
+			changed = true
+		case !errors.Is(err, entity.ErrNoChange):
+			return false, fmt.Errorf("failed to change reviewer limits: %w", err)
+		}
+	}
+
+	if settings.mergePolicy != nil {
+		policy, err := entity.NewMergePolicy(
+			settings.mergePolicy.RequiredApprovals,
+			settings.mergePolicy.BlockOnChangesRequested,
+			settings.mergePolicy.CodeOwners,
+		)
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", ErrInvalidMergePolicy, err)
+		}
+
+		err = team.ChangeMergePolicy(policy)
+		switch {
+		case err == nil:
+			changed = true
+			for _, codeOwner := range policy.CodeOwners() {
+				exists, err := uc.userRepo.Exists(ctx, codeOwner)
+				if err != nil {
+					return false, fmt.Errorf("failed to check code owner existence: %w", err)
+				}
+				if !exists {
+					return false, fmt.Errorf("%w: %s", ErrCodeOwnerNotFound, codeOwner)
+				}
+			}
+		case !errors.Is(err, entity.ErrNoChange):
+			return false, fmt.Errorf("failed to change merge policy: %w", err)
+		}
+	}
+
+	if settings.fallbackTeams != nil {
+		err := team.ChangeFallbackTeams(*settings.fallbackTeams)
+		switch {
+		case err == nil:
+			changed = true
+		case errors.Is(err, entity.ErrNoChange):
+			return changed, nil
+		default:
+			return false, fmt.Errorf("%w: %w", ErrInvalidFallbackTeams, err)
+		}
+
+		for _, fallbackTeam := range team.FallbackTeams() {
+			exists, err := uc.teamRepo.Exists(ctx, fallbackTeam)
+			if err != nil {
+				return false, fmt.Errorf("failed to check fallback team existence: %w", err)
+			}
+			if !exists {
+				return false, fmt.Errorf("%w: %s", ErrFallbackTeamNotFound, fallbackTeam)
+			}
+		}
+	}
+
+	return changed, nil
 }
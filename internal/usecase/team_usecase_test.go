@@ -3,11 +3,14 @@ package usecase
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
@@ -69,6 +72,32 @@ func TestTeamUseCase_CreateTeam(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "success - create team with reviewer strategy",
+			req: dto.CreateTeamRequest{
+				TeamName:         "team-1",
+				ReviewerStrategy: "round_robin",
+				Members: []dto.TeamMemberRequest{
+					{UserID: "user-1", Username: "User 1", IsActive: true},
+				},
+			},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				teamRepo.EXPECT().Exists(gomock.Any(), "team-1").Return(false, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				teamRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, team *entity.Team) error {
+					if team.ReviewerStrategy() != entity.ReviewerStrategyRoundRobin {
+						t.Errorf("expected round_robin strategy, got %q", team.ReviewerStrategy())
+					}
+					return nil
+				})
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(nil, repository.ErrNotFound)
+				userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr: false,
+		},
 		{
 			name: "error - team already exists",
 			req: dto.CreateTeamRequest{
@@ -82,6 +111,77 @@ func TestTeamUseCase_CreateTeam(t *testing.T) {
 			expectErr:   true,
 			expectedErr: ErrTeamAlreadyExists,
 		},
+		{
+			name: "success - team lead creates team with own teammates",
+			req: dto.CreateTeamRequest{
+				TeamName:  "team-1",
+				ManagerID: "lead-1",
+				Members: []dto.TeamMemberRequest{
+					{UserID: "lead-1", Username: "Lead 1", IsActive: true},
+					{UserID: "user-2", Username: "User 2", IsActive: true},
+				},
+			},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				teamRepo.EXPECT().Exists(gomock.Any(), "team-1").Return(false, nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "lead-1").Return(
+					entity.NewUserFromRepository("lead-1", "Lead 1", "old-team", true, now, now), nil,
+				).Times(2)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				teamRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-2").Return(
+					entity.NewUserFromRepository("user-2", "User 2", "old-team", true, now, now), nil,
+				)
+				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - team lead is not a member of the new team",
+			req: dto.CreateTeamRequest{
+				TeamName:  "team-1",
+				ManagerID: "lead-1",
+				Members:   []dto.TeamMemberRequest{{UserID: "user-1", Username: "User 1", IsActive: true}},
+			},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				teamRepo.EXPECT().Exists(gomock.Any(), "team-1").Return(false, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrForbidden,
+		},
+		{
+			name: "error - team lead moves user of another team",
+			req: dto.CreateTeamRequest{
+				TeamName:  "team-1",
+				ManagerID: "lead-1",
+				Members: []dto.TeamMemberRequest{
+					{UserID: "user-2", Username: "User 2", IsActive: true},
+					{UserID: "lead-1", Username: "Lead 1", IsActive: true},
+				},
+			},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				teamRepo.EXPECT().Exists(gomock.Any(), "team-1").Return(false, nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "lead-1").Return(
+					entity.NewUserFromRepository("lead-1", "Lead 1", "old-team", true, now, now), nil,
+				)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				teamRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-2").Return(
+					entity.NewUserFromRepository("user-2", "User 2", "other-team", true, now, now), nil,
+				)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,7 +194,10 @@ func TestTeamUseCase_CreateTeam(t *testing.T) {
 			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
 
-			uc := NewTeamUseCase(txManager, teamRepo, userRepo, logger)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewTeamUseCase(txManager, teamRepo, userRepo, prRepo, newTestHistoryRepo(ctrl), selector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), logger)
 
 			tt.setupMocks(teamRepo, userRepo, txManager, logger)
 
@@ -138,7 +241,7 @@ func TestTeamUseCase_GetTeam(t *testing.T) {
 			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
 				now := time.Now()
 				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
-					entity.NewTeamFromRepository("team-1", now, now),
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
 					nil,
 				)
 				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
@@ -171,7 +274,10 @@ func TestTeamUseCase_GetTeam(t *testing.T) {
 			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
 
-			uc := NewTeamUseCase(txManager, teamRepo, userRepo, logger)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewTeamUseCase(txManager, teamRepo, userRepo, prRepo, newTestHistoryRepo(ctrl), selector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), logger)
 
 			tt.setupMocks(teamRepo, userRepo, logger)
 
@@ -201,21 +307,370 @@ func TestTeamUseCase_GetTeam(t *testing.T) {
 	}
 }
 
-func TestTeamUseCase_DeactivateTeamMembers(t *testing.T) {
+func TestTeamUseCase_UpdateTeam(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	strPtr := func(v string) *string { return &v }
+
 	tests := []struct {
 		name        string
-		teamName    string
-		setupMocks  func(*repositorymocks.MockTeamRepository, *repositorymocks.MockUserRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
+		req         dto.UpdateTeamRequest
+		setupMocks  func(*repositorymocks.MockTeamRepository, *repositorymocks.MockUserRepository)
 		expectErr   bool
 		expectedErr error
+		expectedMin int
+		expectedMax int
+	}{
+		{
+			name: "success - change limits and strategy",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", ReviewerStrategy: strPtr("random"), MinReviewers: intPtr(1), MaxReviewers: intPtr(3)},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				teamRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, team *entity.Team) error {
+					if team.ReviewerStrategy() != entity.ReviewerStrategyRandom {
+						t.Errorf("expected random strategy, got %q", team.ReviewerStrategy())
+					}
+					return nil
+				})
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectedMin: 1,
+			expectedMax: 3,
+		},
+		{
+			name: "success - partial update keeps other limit",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(1)},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				teamRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectedMin: entity.DefaultMinReviewers,
+			expectedMax: 1,
+		},
+		{
+			name: "success - nothing changed, no update",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(entity.DefaultMaxReviewers)},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectedMin: entity.DefaultMinReviewers,
+			expectedMax: entity.DefaultMaxReviewers,
+		},
+		{
+			name: "success - set fallback teams",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", FallbackTeams: &[]string{"team-2", "team-3"}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				teamRepo.EXPECT().Exists(gomock.Any(), "team-2").Return(true, nil)
+				teamRepo.EXPECT().Exists(gomock.Any(), "team-3").Return(true, nil)
+				teamRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, team *entity.Team) error {
+					if got := team.FallbackTeams(); len(got) != 2 || got[0] != "team-2" || got[1] != "team-3" {
+						t.Errorf("expected fallback teams [team-2 team-3], got %v", got)
+					}
+					return nil
+				})
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectedMin: entity.DefaultMinReviewers,
+			expectedMax: entity.DefaultMaxReviewers,
+		},
+		{
+			name: "success - set merge policy",
+			req: dto.UpdateTeamRequest{TeamName: "team-1", MergePolicy: &dto.MergePolicyRequest{
+				RequiredApprovals: 2, BlockOnChangesRequested: true, CodeOwners: []string{"owner-1"},
+			}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().Exists(gomock.Any(), "owner-1").Return(true, nil)
+				teamRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, team *entity.Team) error {
+					policy := team.MergePolicy()
+					if policy.RequiredApprovals() != 2 || !policy.BlockOnChangesRequested() || len(policy.CodeOwners()) != 1 {
+						t.Errorf("unexpected merge policy %+v", policy)
+					}
+					return nil
+				})
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectedMin: entity.DefaultMinReviewers,
+			expectedMax: entity.DefaultMaxReviewers,
+		},
+		{
+			name: "error - code owner not found",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MergePolicy: &dto.MergePolicyRequest{CodeOwners: []string{"ghost"}}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+				userRepo.EXPECT().Exists(gomock.Any(), "ghost").Return(false, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrCodeOwnerNotFound,
+		},
+		{
+			name: "error - invalid merge policy",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MergePolicy: &dto.MergePolicyRequest{RequiredApprovals: -1}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrInvalidMergePolicy,
+		},
+		{
+			name: "error - fallback team not found",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", FallbackTeams: &[]string{"ghost"}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+				teamRepo.EXPECT().Exists(gomock.Any(), "ghost").Return(false, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrFallbackTeamNotFound,
+		},
+		{
+			name: "error - team is its own fallback",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", FallbackTeams: &[]string{"team-1"}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrInvalidFallbackTeams,
+		},
+		{
+			name: "error - min above current max",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MinReviewers: intPtr(3)},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrInvalidReviewerLimits,
+		},
+		{
+			name: "error - team not found",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(1)},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(nil, repository.ErrNotFound)
+			},
+			expectErr:   true,
+			expectedErr: ErrTeamNotFound,
+		},
+		{
+			name: "success - team lead updates own team",
+			req:  dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(1), ManagerID: "lead-1"},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("lead-1", "Lead 1", "team-1", true, now, now),
+				}, nil)
+				teamRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedMin: entity.DefaultMinReviewers,
+			expectedMax: 1,
+		},
+		{
+			name: "error - team lead of another team",
+			req: dto.UpdateTeamRequest{TeamName: "team-1", ManagerID: "lead-2", MergePolicy: &dto.MergePolicyRequest{
+				CodeOwners: []string{"lead-2"},
+			}},
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now),
+				}, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
+
+			uc := NewTeamUseCase(txManager, teamRepo, userRepo, prRepo, newTestHistoryRepo(ctrl), selector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), logger)
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(teamRepo, userRepo)
+
+			result, err := uc.UpdateTeam(context.Background(), tt.req)
+
+			if tt.expectErr {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.MinReviewers != tt.expectedMin || result.MaxReviewers != tt.expectedMax {
+				t.Errorf("expected limits (%d, %d), got (%d, %d)", tt.expectedMin, tt.expectedMax, result.MinReviewers, result.MaxReviewers)
+			}
+		})
+	}
+}
+
+func TestTeamUseCase_UpdateTeamRecordsAudit(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name            string
+		req             dto.UpdateTeamRequest
+		expectedActions []entity.AuditAction
+	}{
+		{
+			name:            "changed settings recorded",
+			req:             dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(1)},
+			expectedActions: []entity.AuditAction{entity.AuditTeamUpdated},
+		},
+		{
+			name:            "unchanged settings not recorded",
+			req:             dto.UpdateTeamRequest{TeamName: "team-1", MaxReviewers: intPtr(entity.DefaultMaxReviewers)},
+			expectedActions: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			recorder := auditmocks.NewMockRecorder(ctrl)
+
+			now := time.Now()
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+				entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+				nil,
+			)
+			teamRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+				entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now),
+			}, nil)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var recorded []entity.AuditAction
+			recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+				for _, record := range records {
+					before, beforeOK := record.Before.(dto.TeamDTO)
+					after, afterOK := record.After.(dto.TeamDTO)
+					if !beforeOK || !afterOK {
+						t.Fatalf("expected team snapshots, got %+v / %+v", record.Before, record.After)
+					}
+					if before.MaxReviewers != entity.DefaultMaxReviewers || after.MaxReviewers != 1 {
+						t.Errorf("expected max_reviewers %d -> 1, got %d -> %d", entity.DefaultMaxReviewers, before.MaxReviewers, after.MaxReviewers)
+					}
+					if len(after.Members) != 1 {
+						t.Errorf("expected snapshot with members, got %+v", after.Members)
+					}
+					recorded = append(recorded, record.Action)
+				}
+				return nil
+			}).AnyTimes()
+
+			uc := NewTeamUseCase(txManager, teamRepo, userRepo, prRepo, newTestHistoryRepo(ctrl), newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), recorder, logger)
+
+			if _, err := uc.UpdateTeam(context.Background(), tt.req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(recorded, tt.expectedActions) {
+				t.Errorf("expected audit actions %v, got %v", tt.expectedActions, recorded)
+			}
+		})
+	}
+}
+
+func TestTeamUseCase_DeactivateTeamMembers(t *testing.T) {
+	tests := []struct {
+		name                  string
+		teamName              string
+		managerID             string
+		setupMocks            func(*repositorymocks.MockTeamRepository, *repositorymocks.MockUserRepository, *repositorymocks.MockPullRequestRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
+		expectErr             bool
+		expectedErr           error
+		expectedReassignments []dto.ReviewerReassignmentDTO
 	}{
 		{
-			name:     "success - deactivate team members",
+			name:     "success - deactivate team members without open reviews",
 			teamName: "team-1",
-			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				now := time.Now()
 				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
-					entity.NewTeamFromRepository("team-1", now, now),
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
 					nil,
 				)
 				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
@@ -225,14 +680,96 @@ func TestTeamUseCase_DeactivateTeamMembers(t *testing.T) {
 					return fn(ctx)
 				})
 				userRepo.EXPECT().BatchDeactivateByTeamName(gomock.Any(), "team-1").Return(nil)
+				prRepo.EXPECT().FindOpenByReviewerIDsForUpdate(gomock.Any(), []string{"user-1"}).Return([]*entity.PullRequest{}, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:             false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{},
+		},
+		{
+			name:     "success - open reviews moved to author team or released",
+			teamName: "team-1",
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now),
+					entity.NewUserFromRepository("user-2", "User 2", "team-1", true, now, now),
+				}, nil).Times(2)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().BatchDeactivateByTeamName(gomock.Any(), "team-1").Return(nil)
+				prRepo.EXPECT().FindOpenByReviewerIDsForUpdate(gomock.Any(), []string{"user-1", "user-2"}).Return([]*entity.PullRequest{
+					entity.NewPullRequestFromRepository("pr-1", "PR 1", "author-2", "team-1", entity.PRStatusOpen, []string{"user-1", "user-2"}, nil, nil, entity.DefaultReviewerLimits(), now, nil, false),
+					entity.NewPullRequestFromRepository("pr-2", "PR 2", "user-1", "team-1", entity.PRStatusOpen, []string{"user-2"}, nil, nil, entity.DefaultReviewerLimits(), now, nil, false),
+				}, nil)
+				userRepo.EXPECT().FindByIDs(gomock.Any(), []string{"user-1", "user-2", "author-2"}).Return([]*entity.User{
+					entity.NewUserFromRepository("author-2", "Author 2", "team-2", true, now, now),
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", false, now, now),
+					entity.NewUserFromRepository("user-2", "User 2", "team-1", false, now, now),
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-2").Return([]*entity.User{
+					entity.NewUserFromRepository("author-2", "Author 2", "team-2", true, now, now),
+					entity.NewUserFromRepository("user-3", "User 3", "team-2", true, now, now),
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), []string{"author-2", "user-3"}).Return(map[string]int{
+					"author-2": 0,
+					"user-3":   0,
+				}, nil)
+				prRepo.EXPECT().ReassignReviewers(gomock.Any(), []repository.ReviewerChange{
+					{PullRequestID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3", IsFallback: true, Reason: "selected from team team-2 by least_loaded strategy"},
+					{PullRequestID: "pr-1", OldReviewerID: "user-2", NewReviewerID: "", Reason: "no active candidates in teams team-1, team-2, slot released"},
+					{PullRequestID: "pr-2", OldReviewerID: "user-2", NewReviewerID: "", Reason: "no active candidates in teams team-1, slot released"},
+				}).Return(nil)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectErr: false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{
+				{PullRequestID: "pr-1", OldUserID: "user-1", NewUserID: stringPtr("user-3")},
+				{PullRequestID: "pr-1", OldUserID: "user-2"},
+				{PullRequestID: "pr-2", OldUserID: "user-2"},
+			},
+		},
+		{
+			name:     "error - reassign reviewers fails",
+			teamName: "team-1",
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now),
+				}, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().BatchDeactivateByTeamName(gomock.Any(), "team-1").Return(nil)
+				prRepo.EXPECT().FindOpenByReviewerIDsForUpdate(gomock.Any(), []string{"user-1"}).Return([]*entity.PullRequest{
+					entity.NewPullRequestFromRepository("pr-1", "PR 1", "author-1", "team-1", entity.PRStatusOpen, []string{"user-1"}, nil, nil, entity.DefaultReviewerLimits(), now, nil, false),
+				}, nil)
+				userRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return([]*entity.User{
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", false, now, now),
+					entity.NewUserFromRepository("author-1", "Author 1", "team-1", false, now, now),
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{}, nil)
+				prRepo.EXPECT().ReassignReviewers(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr: true,
 		},
 		{
 			name:     "error - team not found",
 			teamName: "team-1",
-			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(nil, repository.ErrNotFound)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
@@ -240,6 +777,47 @@ func TestTeamUseCase_DeactivateTeamMembers(t *testing.T) {
 			expectErr:   true,
 			expectedErr: ErrTeamNotFound,
 		},
+		{
+			name:      "success - team lead deactivates own team",
+			teamName:  "team-1",
+			managerID: "user-1",
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now),
+				}, nil).Times(2)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().BatchDeactivateByTeamName(gomock.Any(), "team-1").Return(nil)
+				prRepo.EXPECT().FindOpenByReviewerIDsForUpdate(gomock.Any(), []string{"user-1"}).Return([]*entity.PullRequest{}, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:             false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{},
+		},
+		{
+			name:      "error - team lead of another team",
+			teamName:  "team-1",
+			managerID: "lead-2",
+			setupMocks: func(teamRepo *repositorymocks.MockTeamRepository, userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				teamRepo.EXPECT().FindByName(gomock.Any(), "team-1").Return(
+					entity.NewTeamFromRepository("team-1", entity.ReviewerStrategyDefault, entity.DefaultReviewerLimits(), nil, entity.MergePolicy{}, now, now),
+					nil,
+				)
+				userRepo.EXPECT().FindByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now),
+				}, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -249,14 +827,17 @@ func TestTeamUseCase_DeactivateTeamMembers(t *testing.T) {
 
 			teamRepo := repositorymocks.NewMockTeamRepository(ctrl)
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
 			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
 
-			uc := NewTeamUseCase(txManager, teamRepo, userRepo, logger)
+			uc := NewTeamUseCase(txManager, teamRepo, userRepo, prRepo, newTestHistoryRepo(ctrl), selector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), logger)
 
-			tt.setupMocks(teamRepo, userRepo, txManager, logger)
+			tt.setupMocks(teamRepo, userRepo, prRepo, txManager, logger)
 
-			result, err := uc.DeactivateTeamMembers(context.Background(), tt.teamName)
+			req := dto.DeactivateTeamMembersRequest{TeamName: tt.teamName, ManagerID: tt.managerID}
+			result, reassignments, err := uc.DeactivateTeamMembers(context.Background(), req)
 
 			if tt.expectErr {
 				if err == nil {
@@ -277,7 +858,14 @@ func TestTeamUseCase_DeactivateTeamMembers(t *testing.T) {
 				if result.TeamName != tt.teamName {
 					t.Errorf("expected team name %s, got %s", tt.teamName, result.TeamName)
 				}
+				if !reflect.DeepEqual(reassignments, tt.expectedReassignments) {
+					t.Errorf("expected reassignments %+v, got %+v", tt.expectedReassignments, reassignments)
+				}
 			}
 		})
 	}
 }
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Атрибуты спанов use case
+const (
+	attrPullRequestID = attribute.Key("pr.id")
+	attrTeamName      = attribute.Key("team.name")
+	attrUserID        = attribute.Key("user.id")
+)
+
+// tracer спаны use case. Пока провайдер трассировки не настроен, спаны не записываются
+var tracer = otel.Tracer("github.com/exPriceD/pr-reviewer-service/internal/usecase")
+
+// startSpan открывает спан метода use case дочерним к спану из ctx (обычно HTTP запроса)
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan закрывает спан и отмечает в нем ошибку операции, если она была.
+// Вызывается отложенно с именованным результатом err
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
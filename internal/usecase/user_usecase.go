@@ -4,66 +4,157 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
 // UserUseCase Use Case для работы с пользователями
 type UserUseCase struct {
-	userRepo repository.UserRepository
-	prRepo   repository.PullRequestRepository
-	logger   logger.Logger
+	txManager        transaction.Manager
+	userRepo         repository.UserRepository
+	prRepo           repository.PullRequestRepository
+	historyRepo      repository.ReviewerHistoryRepository
+	reviewerSelector *ReviewerSelector
+	events           event.Emitter
+	audit            audit.Recorder
+	logger           logger.Logger
 }
 
 // NewUserUseCase создает новый UserUseCase
 func NewUserUseCase(
+	txManager transaction.Manager,
 	userRepo repository.UserRepository,
 	prRepo repository.PullRequestRepository,
+	historyRepo repository.ReviewerHistoryRepository,
+	reviewerSelector *ReviewerSelector,
+	events event.Emitter,
+	audit audit.Recorder,
 	logger logger.Logger,
 ) *UserUseCase {
 	return &UserUseCase{
-		userRepo: userRepo,
-		prRepo:   prRepo,
-		logger:   logger,
+		txManager:        txManager,
+		userRepo:         userRepo,
+		prRepo:           prRepo,
+		historyRepo:      historyRepo,
+		reviewerSelector: reviewerSelector,
+		events:           events,
+		audit:            audit,
+		logger:           logger,
 	}
 }
 
-// SetUserActive устанавливает флаг активности пользователя
+// SetUserActive устанавливает флаг активности пользователя.
+// При деактивации в той же транзакции передает его открытые ревью активным коллегам
+// (если замены нет, слот освобождается); отключается флагом SkipReassign.
+// Руководитель команды (req.ManagerID) может менять активность только пользователей своей команды
 // POST /users/setIsActive
-func (uc *UserUseCase) SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, error) {
+func (uc *UserUseCase) SetUserActive(ctx context.Context, req dto.SetUserActiveRequest) (*dto.UserDTO, []dto.ReviewerReassignmentDTO, error) {
 	uc.logger.Info("Setting user active status", "user_id", req.UserID, "is_active", req.IsActive)
 
 	user, err := uc.userRepo.FindByID(ctx, req.UserID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrUserNotFound
+			return nil, nil, ErrUserNotFound
 		}
 		uc.logger.Error("Failed to find user", "error", err, "user_id", req.UserID)
-		return nil, fmt.Errorf("failed to find user: %w", err)
+		return nil, nil, fmt.Errorf("failed to find user: %w", err)
 	}
 
+	if err := checkManagerScope(ctx, uc.userRepo, uc.logger, req.ManagerID, user.TeamName()); err != nil {
+		return nil, nil, err
+	}
+
+	before := dto.ToUserDTO(user)
+	deactivated := false
 	if req.IsActive && !user.IsActive() {
 		user.Activate()
 	} else if !req.IsActive && user.IsActive() {
 		user.Deactivate()
+		deactivated = true
 	}
 
-	if err := uc.userRepo.Update(ctx, user); err != nil {
-		uc.logger.Error("Failed to update user", "error", err, "user_id", req.UserID)
-		return nil, fmt.Errorf("failed to update user: %w", err)
+	var changes []repository.ReviewerChange
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		var events []event.Event
+		var records []audit.Record
+		if deactivated {
+			events = append(events, event.NewUserDeactivated(user.ID(), user.TeamName()))
+		}
+		if before.IsActive != user.IsActive() {
+			records = append(records, userActivityRecord(before, dto.ToUserDTO(user), ""))
+		}
+
+		if !req.IsActive && !req.SkipReassign {
+			changes, err = reassignOpenReviews(ctx, uc.prRepo, uc.historyRepo, uc.reviewerSelector, []string{user.ID()})
+			if err != nil {
+				return err
+			}
+			events = append(events, reviewerReassignedEvents(changes)...)
+			records = append(records, reviewerReassignedRecords(changes)...)
+		}
+
+		if err := emitEvents(ctx, uc.events, events...); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, records...)
+	})
+	if err != nil {
+		uc.logger.Error("Failed to set user active status", "error", err, "user_id", req.UserID)
+		return nil, nil, err
 	}
 
-	uc.logger.Info("User active status updated", "user_id", req.UserID, "is_active", req.IsActive)
+	uc.logger.Info("User active status updated",
+		"user_id", req.UserID,
+		"is_active", req.IsActive,
+		"reassigned_slots", len(changes),
+	)
 	result := dto.ToUserDTO(user)
-	return &result, nil
+	return &result, dto.ToReviewerReassignmentDTOs(changes), nil
 }
 
-// GetUserReviews получает PR'ы где пользователь назначен ревьювером
-// GET /users/getReview?user_id=
-func (uc *UserUseCase) GetUserReviews(ctx context.Context, userID string) ([]dto.PullRequestShortDTO, error) {
-	uc.logger.Info("Getting user reviews", "user_id", userID)
+// checkManagerScope проверяет, что руководитель managerID состоит в команде teamName.
+// Пустой managerID - действие администратора, оно не ограничено командой
+func checkManagerScope(ctx context.Context, userRepo repository.UserRepository, log logger.Logger, managerID, teamName string) error {
+	if managerID == "" {
+		return nil
+	}
+
+	manager, err := userRepo.FindByID(ctx, managerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrForbidden
+		}
+		return fmt.Errorf("failed to find manager: %w", err)
+	}
+	if manager.TeamName() != teamName {
+		log.Info("Team lead is not a member of the team", "manager_id", managerID, "team_name", teamName)
+		return ErrForbidden
+	}
+	return nil
+}
+
+// isTeamMember возвращает true, если пользователь userID входит в users
+func isTeamMember(users []*entity.User, userID string) bool {
+	return slices.ContainsFunc(users, func(user *entity.User) bool { return user.ID() == userID })
+}
+
+// GetUserReviews получает PR'ы где пользователь назначен ревьювером.
+// С awaitingOnly остаются только открытые PR, где пользователь еще не одобрил PR и не запросил изменения
+// GET /users/getReview?user_id=&awaiting=
+func (uc *UserUseCase) GetUserReviews(ctx context.Context, userID string, awaitingOnly bool) ([]dto.PullRequestShortDTO, error) {
+	uc.logger.Info("Getting user reviews", "user_id", userID, "awaiting_only", awaitingOnly)
 
 	exists, err := uc.userRepo.Exists(ctx, userID)
 	if err != nil {
@@ -80,6 +171,12 @@ func (uc *UserUseCase) GetUserReviews(ctx context.Context, userID string) ([]dto
 		return nil, fmt.Errorf("failed to find user PRs: %w", err)
 	}
 
+	if awaitingOnly {
+		prs = slices.DeleteFunc(prs, func(pr *entity.PullRequest) bool {
+			return !pr.IsOpen() || pr.ReviewOf(userID).State().IsDecisive()
+		})
+	}
+
 	uc.logger.Info("User reviews retrieved", "user_id", userID, "prs_count", len(prs))
 	return dto.ToPullRequestShortDTOs(prs), nil
 }
@@ -3,25 +3,32 @@ package usecase
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+	eventmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/event/mocks"
 	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
 	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
 func TestUserUseCase_SetUserActive(t *testing.T) {
 	tests := []struct {
-		name        string
-		req         dto.SetUserActiveRequest
-		setupMocks  func(*repositorymocks.MockUserRepository, *loggermocks.MockLogger)
-		expectErr   bool
-		expectedErr error
+		name                  string
+		req                   dto.SetUserActiveRequest
+		setupMocks            func(*repositorymocks.MockUserRepository, *repositorymocks.MockPullRequestRepository, *transactionmocks.MockManager, *loggermocks.MockLogger)
+		expectErr             bool
+		expectedErr           error
+		expectedReassignments []dto.ReviewerReassignmentDTO
 	}{
 		{
 			name: "success - activate user",
@@ -29,27 +36,95 @@ func TestUserUseCase_SetUserActive(t *testing.T) {
 				UserID:   "user-1",
 				IsActive: true,
 			},
-			setupMocks: func(userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				user := entity.NewUserFromRepository("user-1", "User 1", "team-1", false, time.Now(), time.Now())
 				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(user, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
 				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
+			expectErr:             false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{},
+		},
+		{
+			name: "success - deactivate user and reassign open reviews",
+			req: dto.SetUserActiveRequest{
+				UserID:   "user-1",
+				IsActive: false,
+			},
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				now := time.Now()
+				user := entity.NewUserFromRepository("user-1", "User 1", "team-1", true, now, now)
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(user, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				prRepo.EXPECT().FindOpenByReviewerIDsForUpdate(gomock.Any(), []string{"user-1"}).Return([]*entity.PullRequest{
+					entity.NewPullRequestFromRepository("pr-1", "PR 1", "author-1", "team-1", entity.PRStatusOpen, []string{"user-1", "user-2"}, nil, nil, entity.DefaultReviewerLimits(), now, nil, false),
+				}, nil)
+				userRepo.EXPECT().FindByIDs(gomock.Any(), []string{"user-1", "author-1"}).Return([]*entity.User{
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, now, now),
+					entity.NewUserFromRepository("user-1", "User 1", "team-1", false, now, now),
+				}, nil)
+				userRepo.EXPECT().FindActiveByTeamName(gomock.Any(), "team-1").Return([]*entity.User{
+					entity.NewUserFromRepository("author-1", "Author", "team-1", true, now, now),
+					entity.NewUserFromRepository("user-2", "User 2", "team-1", true, now, now),
+					entity.NewUserFromRepository("user-3", "User 3", "team-1", true, now, now),
+				}, nil)
+				prRepo.EXPECT().CountActiveReviewsByUserIDs(gomock.Any(), gomock.Any()).Return(map[string]int{
+					"user-2": 1,
+					"user-3": 0,
+				}, nil)
+				prRepo.EXPECT().ReassignReviewers(gomock.Any(), []repository.ReviewerChange{
+					{PullRequestID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3", Reason: "selected from team team-1 by least_loaded strategy"},
+				}).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
 			expectErr: false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{
+				{PullRequestID: "pr-1", OldUserID: "user-1", NewUserID: stringPtr("user-3")},
+			},
 		},
 		{
-			name: "success - deactivate user",
+			name: "success - deactivate user without reassignment",
+			req: dto.SetUserActiveRequest{
+				UserID:       "user-1",
+				IsActive:     false,
+				SkipReassign: true,
+			},
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				user := entity.NewUserFromRepository("user-1", "User 1", "team-1", true, time.Now(), time.Now())
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(user, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:             false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{},
+		},
+		{
+			name: "error - reassignment fails",
 			req: dto.SetUserActiveRequest{
 				UserID:   "user-1",
 				IsActive: false,
 			},
-			setupMocks: func(userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				user := entity.NewUserFromRepository("user-1", "User 1", "team-1", true, time.Now(), time.Now())
 				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(user, nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
 				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				prRepo.EXPECT().FindOpenByReviewerIDsForUpdate(gomock.Any(), []string{"user-1"}).Return(nil, errors.New("database error"))
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
 			},
-			expectErr: false,
+			expectErr: true,
 		},
 		{
 			name: "error - user not found",
@@ -57,7 +132,7 @@ func TestUserUseCase_SetUserActive(t *testing.T) {
 				UserID:   "user-1",
 				IsActive: true,
 			},
-			setupMocks: func(userRepo *repositorymocks.MockUserRepository, logger *loggermocks.MockLogger) {
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
 				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(nil, repository.ErrNotFound)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 				logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
@@ -65,6 +140,55 @@ func TestUserUseCase_SetUserActive(t *testing.T) {
 			expectErr:   true,
 			expectedErr: ErrUserNotFound,
 		},
+		{
+			name: "success - team lead changes own team member",
+			req: dto.SetUserActiveRequest{
+				UserID:    "user-1",
+				IsActive:  true,
+				ManagerID: "lead-1",
+			},
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(entity.NewUserFromRepository("user-1", "User 1", "team-1", false, time.Now(), time.Now()), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "lead-1").Return(entity.NewUserFromRepository("lead-1", "Lead 1", "team-1", true, time.Now(), time.Now()), nil)
+				txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+				userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:             false,
+			expectedReassignments: []dto.ReviewerReassignmentDTO{},
+		},
+		{
+			name: "error - team lead of another team",
+			req: dto.SetUserActiveRequest{
+				UserID:    "user-1",
+				IsActive:  false,
+				ManagerID: "lead-2",
+			},
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(entity.NewUserFromRepository("user-1", "User 1", "team-1", true, time.Now(), time.Now()), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "lead-2").Return(entity.NewUserFromRepository("lead-2", "Lead 2", "team-2", true, time.Now(), time.Now()), nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrForbidden,
+		},
+		{
+			name: "error - team lead without user",
+			req: dto.SetUserActiveRequest{
+				UserID:    "user-1",
+				IsActive:  false,
+				ManagerID: "service-lead",
+			},
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, txManager *transactionmocks.MockManager, logger *loggermocks.MockLogger) {
+				userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(entity.NewUserFromRepository("user-1", "User 1", "team-1", true, time.Now(), time.Now()), nil)
+				userRepo.EXPECT().FindByID(gomock.Any(), "service-lead").Return(nil, repository.ErrNotFound)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectErr:   true,
+			expectedErr: ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -74,13 +198,15 @@ func TestUserUseCase_SetUserActive(t *testing.T) {
 
 			userRepo := repositorymocks.NewMockUserRepository(ctrl)
 			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
+			selector := newTestReviewerSelector(ctrl, userRepo, prRepo)
 
-			uc := NewUserUseCase(userRepo, prRepo, logger)
+			uc := NewUserUseCase(txManager, userRepo, prRepo, newTestHistoryRepo(ctrl), selector, newTestEmitter(ctrl), newTestAuditRecorder(ctrl), logger)
 
-			tt.setupMocks(userRepo, logger)
+			tt.setupMocks(userRepo, prRepo, txManager, logger)
 
-			result, err := uc.SetUserActive(context.Background(), tt.req)
+			result, reassignments, err := uc.SetUserActive(context.Background(), tt.req)
 
 			if tt.expectErr {
 				if err == nil {
@@ -104,6 +230,146 @@ func TestUserUseCase_SetUserActive(t *testing.T) {
 				if result.IsActive != tt.req.IsActive {
 					t.Errorf("expected is_active %v, got %v", tt.req.IsActive, result.IsActive)
 				}
+				if !reflect.DeepEqual(reassignments, tt.expectedReassignments) {
+					t.Errorf("expected reassignments %+v, got %+v", tt.expectedReassignments, reassignments)
+				}
+			}
+		})
+	}
+}
+
+func TestUserUseCase_SetUserActiveEmitsEvents(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            dto.SetUserActiveRequest
+		wasActive      bool
+		expectedEvents []entity.EventType
+	}{
+		{
+			name:           "deactivation emits user.deactivated",
+			req:            dto.SetUserActiveRequest{UserID: "user-1", IsActive: false, SkipReassign: true},
+			wasActive:      true,
+			expectedEvents: []entity.EventType{entity.EventUserDeactivated},
+		},
+		{
+			name:           "repeated deactivation emits nothing",
+			req:            dto.SetUserActiveRequest{UserID: "user-1", IsActive: false, SkipReassign: true},
+			wasActive:      false,
+			expectedEvents: nil,
+		},
+		{
+			name:           "activation emits nothing",
+			req:            dto.SetUserActiveRequest{UserID: "user-1", IsActive: true},
+			wasActive:      false,
+			expectedEvents: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			emitter := eventmocks.NewMockEmitter(ctrl)
+
+			now := time.Now()
+			userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(
+				entity.NewUserFromRepository("user-1", "User 1", "team-1", tt.wasActive, now, now), nil)
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var emitted []entity.EventType
+			emitter.EXPECT().Emit(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events ...event.Event) error {
+				for _, e := range events {
+					emitted = append(emitted, e.Type)
+				}
+				return nil
+			}).AnyTimes()
+
+			uc := NewUserUseCase(txManager, userRepo, prRepo, newTestHistoryRepo(ctrl), newTestReviewerSelector(ctrl, userRepo, prRepo), emitter, newTestAuditRecorder(ctrl), logger)
+
+			if _, _, err := uc.SetUserActive(context.Background(), tt.req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(emitted, tt.expectedEvents) {
+				t.Errorf("expected events %v, got %v", tt.expectedEvents, emitted)
+			}
+		})
+	}
+}
+
+func TestUserUseCase_SetUserActiveRecordsAudit(t *testing.T) {
+	tests := []struct {
+		name            string
+		req             dto.SetUserActiveRequest
+		wasActive       bool
+		expectedActions []entity.AuditAction
+	}{
+		{
+			name:            "deactivation recorded",
+			req:             dto.SetUserActiveRequest{UserID: "user-1", IsActive: false, SkipReassign: true},
+			wasActive:       true,
+			expectedActions: []entity.AuditAction{entity.AuditUserDeactivated},
+		},
+		{
+			name:            "activation recorded",
+			req:             dto.SetUserActiveRequest{UserID: "user-1", IsActive: true},
+			wasActive:       false,
+			expectedActions: []entity.AuditAction{entity.AuditUserActivated},
+		},
+		{
+			name:            "unchanged status not recorded",
+			req:             dto.SetUserActiveRequest{UserID: "user-1", IsActive: true},
+			wasActive:       true,
+			expectedActions: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			recorder := auditmocks.NewMockRecorder(ctrl)
+
+			now := time.Now()
+			userRepo.EXPECT().FindByID(gomock.Any(), "user-1").Return(
+				entity.NewUserFromRepository("user-1", "User 1", "team-1", tt.wasActive, now, now), nil)
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			userRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+			var recorded []entity.AuditAction
+			recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+				for _, record := range records {
+					if record.EntityType != entity.AuditEntityUser || record.EntityID != "user-1" {
+						t.Errorf("unexpected audit entity %s/%s", record.EntityType, record.EntityID)
+					}
+					recorded = append(recorded, record.Action)
+				}
+				return nil
+			}).AnyTimes()
+
+			uc := NewUserUseCase(txManager, userRepo, prRepo, newTestHistoryRepo(ctrl), newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), recorder, logger)
+
+			if _, _, err := uc.SetUserActive(context.Background(), tt.req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(recorded, tt.expectedActions) {
+				t.Errorf("expected audit actions %v, got %v", tt.expectedActions, recorded)
 			}
 		})
 	}
@@ -111,12 +377,13 @@ func TestUserUseCase_SetUserActive(t *testing.T) {
 
 func TestUserUseCase_GetUserReviews(t *testing.T) {
 	tests := []struct {
-		name        string
-		userID      string
-		setupMocks  func(*repositorymocks.MockUserRepository, *repositorymocks.MockPullRequestRepository, *loggermocks.MockLogger)
-		expectErr   bool
-		expectedErr error
-		expectedLen int
+		name         string
+		userID       string
+		awaitingOnly bool
+		setupMocks   func(*repositorymocks.MockUserRepository, *repositorymocks.MockPullRequestRepository, *loggermocks.MockLogger)
+		expectErr    bool
+		expectedErr  error
+		expectedLen  int
 	}{
 		{
 			name:   "success - get user reviews",
@@ -124,13 +391,32 @@ func TestUserUseCase_GetUserReviews(t *testing.T) {
 			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, logger *loggermocks.MockLogger) {
 				userRepo.EXPECT().Exists(gomock.Any(), "user-1").Return(true, nil)
 				prRepo.EXPECT().FindByReviewerID(gomock.Any(), "user-1").Return([]*entity.PullRequest{
-					entity.NewPullRequestFromRepository("pr-1", "PR 1", "author-1", entity.PRStatusOpen, []string{"user-1"}, time.Now(), nil),
+					entity.NewPullRequestFromRepository("pr-1", "PR 1", "author-1", "team-1", entity.PRStatusOpen, []string{"user-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
 				}, nil)
 				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
 			},
 			expectErr:   false,
 			expectedLen: 1,
 		},
+		{
+			name:         "success - only awaiting reviews",
+			userID:       "user-1",
+			awaitingOnly: true,
+			setupMocks: func(userRepo *repositorymocks.MockUserRepository, prRepo *repositorymocks.MockPullRequestRepository, logger *loggermocks.MockLogger) {
+				reviewed := time.Now()
+				userRepo.EXPECT().Exists(gomock.Any(), "user-1").Return(true, nil)
+				prRepo.EXPECT().FindByReviewerID(gomock.Any(), "user-1").Return([]*entity.PullRequest{
+					entity.NewPullRequestFromRepository("pr-pending", "PR 1", "author-1", "team-1", entity.PRStatusOpen, []string{"user-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					entity.NewPullRequestFromRepository("pr-commented", "PR 2", "author-1", "team-1", entity.PRStatusOpen, []string{"user-1"}, nil,
+						[]entity.Review{entity.NewReviewFromRepository("user-1", entity.ReviewStateCommented, &reviewed)}, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					entity.NewPullRequestFromRepository("pr-approved", "PR 3", "author-1", "team-1", entity.PRStatusOpen, []string{"user-1"}, nil,
+						[]entity.Review{entity.NewReviewFromRepository("user-1", entity.ReviewStateApproved, &reviewed)}, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+					entity.NewPullRequestFromRepository("pr-merged", "PR 4", "author-1", "team-1", entity.PRStatusMerged, []string{"user-1"}, nil, nil, entity.DefaultReviewerLimits(), time.Now(), nil, false),
+				}, nil)
+				logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			},
+			expectedLen: 2,
+		},
 		{
 			name:   "error - user not found",
 			userID: "user-1",
@@ -163,11 +449,11 @@ func TestUserUseCase_GetUserReviews(t *testing.T) {
 			prRepo := repositorymocks.NewMockPullRequestRepository(ctrl)
 			logger := loggermocks.NewMockLogger(ctrl)
 
-			uc := NewUserUseCase(userRepo, prRepo, logger)
+			uc := NewUserUseCase(transactionmocks.NewMockManager(ctrl), userRepo, prRepo, newTestHistoryRepo(ctrl), newTestReviewerSelector(ctrl, userRepo, prRepo), newTestEmitter(ctrl), newTestAuditRecorder(ctrl), logger)
 
 			tt.setupMocks(userRepo, prRepo, logger)
 
-			result, err := uc.GetUserReviews(context.Background(), tt.userID)
+			result, err := uc.GetUserReviews(context.Background(), tt.userID, tt.awaitingOnly)
 
 			if tt.expectErr {
 				if err == nil {
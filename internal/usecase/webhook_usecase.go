@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// PullRequestLifecycle операции над PR, которые выполняют вебхуки git-хостингов
+type PullRequestLifecycle interface {
+	CreatePR(ctx context.Context, req dto.CreatePRRequest) (*dto.PullRequestDTO, error)
+	MergePR(ctx context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error)
+	MarkReady(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	ClosePR(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+	ReopenPR(ctx context.Context, prID string) (*dto.PullRequestDTO, error)
+}
+
+var _ PullRequestLifecycle = (*PullRequestUseCase)(nil)
+
+// webhookSkippableErrors ошибки, при которых событие не применимо к текущему состоянию.
+// Такая доставка отвечает 200 со статусом ignored, чтобы git-хостинг не повторял ее
+var webhookSkippableErrors = []error{
+	ErrGitLoginNotLinked,
+	ErrUnsupportedWebhookAction,
+	ErrUserNotFound,
+	ErrTeamNotFound,
+	ErrPRAlreadyExists,
+	ErrPRNotFound,
+	ErrPRClosed,
+	ErrPRAlreadyMerged,
+	ErrPRDraft,
+	ErrInvalidStatusTransition,
+	ErrNotEnoughReviewers,
+}
+
+// WebhookUseCase Use Case для вебхуков git-хостингов
+type WebhookUseCase struct {
+	txManager    transaction.Manager
+	webhookRepo  repository.WebhookRepository
+	userRepo     repository.UserRepository
+	pullRequests PullRequestLifecycle
+	audit        audit.Recorder
+	logger       logger.Logger
+}
+
+// NewWebhookUseCase создает новый WebhookUseCase
+func NewWebhookUseCase(
+	txManager transaction.Manager,
+	webhookRepo repository.WebhookRepository,
+	userRepo repository.UserRepository,
+	pullRequests PullRequestLifecycle,
+	audit audit.Recorder,
+	logger logger.Logger,
+) *WebhookUseCase {
+	return &WebhookUseCase{
+		txManager:    txManager,
+		webhookRepo:  webhookRepo,
+		userRepo:     userRepo,
+		pullRequests: pullRequests,
+		audit:        audit,
+		logger:       logger,
+	}
+}
+
+// HandlePullRequestEvent применяет событие pull request к PR сервиса.
+// Доставка регистрируется в одной транзакции с изменением PR: повтор уже принятой доставки
+// возвращает duplicate, а при ошибке регистрация откатывается и git-хостинг может повторить доставку.
+// Неприменимые события (PR уже существует, логин не сопоставлен и т.п.) возвращают ignored
+// POST /webhooks/github, POST /webhooks/gitlab
+func (uc *WebhookUseCase) HandlePullRequestEvent(ctx context.Context, event dto.PullRequestEvent) (*dto.WebhookResultDTO, error) {
+	provider, err := entity.ParseGitProvider(event.Provider)
+	if err != nil {
+		return nil, ErrInvalidGitProvider
+	}
+
+	prID := entity.ExternalPullRequestID(provider, event.Repository, event.Number)
+	uc.logger.Info("Handling webhook event",
+		"provider", provider,
+		"delivery_id", event.DeliveryID,
+		"action", event.Action,
+		"pr_id", prID,
+	)
+
+	result := &dto.WebhookResultDTO{
+		DeliveryID:    event.DeliveryID,
+		Status:        dto.WebhookStatusProcessed,
+		PullRequestID: prID,
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		registered, err := uc.webhookRepo.RegisterDelivery(ctx, provider, event.DeliveryID)
+		if err != nil {
+			return fmt.Errorf("failed to register webhook delivery: %w", err)
+		}
+		if !registered {
+			result.Status = dto.WebhookStatusDuplicate
+			return nil
+		}
+		return uc.applyEvent(ctx, provider, prID, event)
+	})
+	if err != nil {
+		if !isWebhookSkippable(err) {
+			uc.logger.Error("Failed to handle webhook event", "error", err, "delivery_id", event.DeliveryID)
+			return nil, err
+		}
+		result.Status = dto.WebhookStatusIgnored
+		result.Reason = err.Error()
+	}
+
+	uc.logger.Info("Webhook event handled",
+		"delivery_id", event.DeliveryID,
+		"pr_id", prID,
+		"status", result.Status,
+		"reason", result.Reason,
+	)
+	return result, nil
+}
+
+// applyEvent вызывает операцию над PR, соответствующую действию события.
+// merged применяется с Force: PR уже смержен на git-хостинге, политика мержа фиксирует лишь обход
+func (uc *WebhookUseCase) applyEvent(ctx context.Context, provider entity.GitProvider, prID string, event dto.PullRequestEvent) error {
+	var err error
+
+	switch event.Action {
+	case dto.PullRequestEventOpened:
+		authorID, resolveErr := uc.resolveLogin(ctx, provider, event.AuthorLogin)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		_, err = uc.pullRequests.CreatePR(ctx, dto.CreatePRRequest{
+			PullRequestID:   prID,
+			PullRequestName: entity.ExternalPullRequestName(event.Title, event.Number),
+			AuthorID:        authorID,
+			Draft:           event.Draft,
+		})
+	case dto.PullRequestEventReady:
+		_, err = uc.pullRequests.MarkReady(ctx, prID)
+	case dto.PullRequestEventClosed:
+		_, err = uc.pullRequests.ClosePR(ctx, prID)
+	case dto.PullRequestEventMerged:
+		_, err = uc.pullRequests.MergePR(ctx, dto.MergePRRequest{PullRequestID: prID, Force: true})
+	case dto.PullRequestEventReopened:
+		_, err = uc.pullRequests.ReopenPR(ctx, prID)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedWebhookAction, event.Action)
+	}
+
+	return err
+}
+
+// resolveLogin находит пользователя по логину git-хостинга. Логины сравниваются без учета регистра
+func (uc *WebhookUseCase) resolveLogin(ctx context.Context, provider entity.GitProvider, login string) (string, error) {
+	userID, err := uc.webhookRepo.FindUserIDByLogin(ctx, provider, strings.ToLower(login))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", fmt.Errorf("%w: %s", ErrGitLoginNotLinked, login)
+		}
+		return "", fmt.Errorf("failed to find git login: %w", err)
+	}
+	return userID, nil
+}
+
+// LinkGitLogin сопоставляет логин git-хостинга пользователю, прежнее сопоставление логина заменяется
+// POST /webhooks/linkLogin
+func (uc *WebhookUseCase) LinkGitLogin(ctx context.Context, req dto.LinkGitLoginRequest) (*dto.GitLoginDTO, error) {
+	uc.logger.Info("Linking git login", "provider", req.Provider, "login", req.Login, "user_id", req.UserID)
+
+	provider, err := entity.ParseGitProvider(req.Provider)
+	if err != nil {
+		return nil, ErrInvalidGitProvider
+	}
+
+	exists, err := uc.userRepo.Exists(ctx, req.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to check user existence", "error", err, "user_id", req.UserID)
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	login := strings.ToLower(strings.TrimSpace(req.Login))
+	result := dto.GitLoginDTO{
+		Provider: string(provider),
+		Login:    login,
+		UserID:   req.UserID,
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var before *dto.GitLoginDTO
+		previousUserID, err := uc.webhookRepo.FindUserIDByLogin(ctx, provider, login)
+		switch {
+		case err == nil:
+			before = &dto.GitLoginDTO{Provider: string(provider), Login: login, UserID: previousUserID}
+		case !errors.Is(err, repository.ErrNotFound):
+			return fmt.Errorf("failed to find git login: %w", err)
+		}
+
+		if err := uc.webhookRepo.SaveLogin(ctx, provider, login, req.UserID); err != nil {
+			return err
+		}
+		return recordAudit(ctx, uc.audit, gitLoginLinkedRecord(before, result))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to link git login", "error", err, "login", login)
+		return nil, err
+	}
+
+	uc.logger.Info("Git login linked", "provider", provider, "login", login, "user_id", req.UserID)
+	return &result, nil
+}
+
+func isWebhookSkippable(err error) bool {
+	for _, skippable := range webhookSkippableErrors {
+		if errors.Is(err, skippable) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,375 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/audit"
+	auditmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/audit/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	loggermocks "github.com/exPriceD/pr-reviewer-service/internal/domain/logger/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/repository"
+	repositorymocks "github.com/exPriceD/pr-reviewer-service/internal/domain/repository/mocks"
+	transactionmocks "github.com/exPriceD/pr-reviewer-service/internal/domain/transaction/mocks"
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
+)
+
+// fakePullRequestLifecycle записывает вызовы операций над PR и возвращает заданную ошибку
+type fakePullRequestLifecycle struct {
+	err   error
+	calls []string
+	req   any
+}
+
+func (f *fakePullRequestLifecycle) record(call string, req any) (*dto.PullRequestDTO, error) {
+	f.calls = append(f.calls, call)
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &dto.PullRequestDTO{}, nil
+}
+
+func (f *fakePullRequestLifecycle) CreatePR(_ context.Context, req dto.CreatePRRequest) (*dto.PullRequestDTO, error) {
+	return f.record("CreatePR", req)
+}
+
+func (f *fakePullRequestLifecycle) MergePR(_ context.Context, req dto.MergePRRequest) (*dto.PullRequestDTO, error) {
+	return f.record("MergePR", req)
+}
+
+func (f *fakePullRequestLifecycle) MarkReady(_ context.Context, prID string) (*dto.PullRequestDTO, error) {
+	return f.record("MarkReady", prID)
+}
+
+func (f *fakePullRequestLifecycle) ClosePR(_ context.Context, prID string) (*dto.PullRequestDTO, error) {
+	return f.record("ClosePR", prID)
+}
+
+func (f *fakePullRequestLifecycle) ReopenPR(_ context.Context, prID string) (*dto.PullRequestDTO, error) {
+	return f.record("ReopenPR", prID)
+}
+
+func TestWebhookUseCase_HandlePullRequestEvent(t *testing.T) {
+	const prID = "github-acme_backend-42"
+
+	openedEvent := dto.PullRequestEvent{
+		Provider:    "github",
+		DeliveryID:  "delivery-1",
+		Action:      dto.PullRequestEventOpened,
+		Repository:  "acme/backend",
+		Number:      42,
+		Title:       "Add webhooks (part 1)",
+		AuthorLogin: "Octocat",
+		Draft:       true,
+	}
+	eventWithAction := func(action dto.PullRequestEventAction) dto.PullRequestEvent {
+		event := openedEvent
+		event.Action = action
+		return event
+	}
+
+	tests := []struct {
+		name           string
+		event          dto.PullRequestEvent
+		lifecycleErr   error
+		setupMocks     func(*repositorymocks.MockWebhookRepository)
+		expectErr      bool
+		expectedErr    error
+		expectedStatus string
+		expectedCalls  []string
+		expectedReq    any
+	}{
+		{
+			name:  "opened creates PR with linked author",
+			event: openedEvent,
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+				webhookRepo.EXPECT().FindUserIDByLogin(gomock.Any(), entity.GitProviderGitHub, "octocat").Return("u1", nil)
+			},
+			expectedStatus: dto.WebhookStatusProcessed,
+			expectedCalls:  []string{"CreatePR"},
+			expectedReq: dto.CreatePRRequest{
+				PullRequestID:   prID,
+				PullRequestName: "Add webhooks part 1",
+				AuthorID:        "u1",
+				Draft:           true,
+			},
+		},
+		{
+			name:  "merged forces merge",
+			event: eventWithAction(dto.PullRequestEventMerged),
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectedStatus: dto.WebhookStatusProcessed,
+			expectedCalls:  []string{"MergePR"},
+			expectedReq:    dto.MergePRRequest{PullRequestID: prID, Force: true},
+		},
+		{
+			name:  "closed closes PR",
+			event: eventWithAction(dto.PullRequestEventClosed),
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectedStatus: dto.WebhookStatusProcessed,
+			expectedCalls:  []string{"ClosePR"},
+			expectedReq:    prID,
+		},
+		{
+			name:  "reopened reopens PR",
+			event: eventWithAction(dto.PullRequestEventReopened),
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectedStatus: dto.WebhookStatusProcessed,
+			expectedCalls:  []string{"ReopenPR"},
+			expectedReq:    prID,
+		},
+		{
+			name:  "ready for review marks PR ready",
+			event: eventWithAction(dto.PullRequestEventReady),
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectedStatus: dto.WebhookStatusProcessed,
+			expectedCalls:  []string{"MarkReady"},
+			expectedReq:    prID,
+		},
+		{
+			name:  "duplicate delivery is not applied",
+			event: openedEvent,
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(false, nil)
+			},
+			expectedStatus: dto.WebhookStatusDuplicate,
+		},
+		{
+			name:  "unlinked login is ignored",
+			event: openedEvent,
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+				webhookRepo.EXPECT().FindUserIDByLogin(gomock.Any(), entity.GitProviderGitHub, "octocat").Return("", repository.ErrNotFound)
+			},
+			expectedStatus: dto.WebhookStatusIgnored,
+		},
+		{
+			name:         "inapplicable transition is ignored",
+			event:        eventWithAction(dto.PullRequestEventClosed),
+			lifecycleErr: ErrInvalidStatusTransition,
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectedStatus: dto.WebhookStatusIgnored,
+			expectedCalls:  []string{"ClosePR"},
+			expectedReq:    prID,
+		},
+		{
+			name:         "closed on merged PR is ignored",
+			event:        eventWithAction(dto.PullRequestEventClosed),
+			lifecycleErr: ErrPRAlreadyMerged,
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectedStatus: dto.WebhookStatusIgnored,
+			expectedCalls:  []string{"ClosePR"},
+			expectedReq:    prID,
+		},
+		{
+			name:         "internal error fails delivery",
+			event:        eventWithAction(dto.PullRequestEventClosed),
+			lifecycleErr: errors.New("db error"),
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(true, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name:  "register delivery error",
+			event: openedEvent,
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository) {
+				webhookRepo.EXPECT().RegisterDelivery(gomock.Any(), entity.GitProviderGitHub, "delivery-1").Return(false, errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "unknown provider",
+			event: dto.PullRequestEvent{
+				Provider:   "bitbucket",
+				DeliveryID: "delivery-1",
+				Action:     dto.PullRequestEventOpened,
+			},
+			setupMocks:  func(webhookRepo *repositorymocks.MockWebhookRepository) {},
+			expectErr:   true,
+			expectedErr: ErrInvalidGitProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookRepo := repositorymocks.NewMockWebhookRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+			lifecycle := &fakePullRequestLifecycle{err: tt.lifecycleErr}
+
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setupMocks(webhookRepo)
+
+			uc := NewWebhookUseCase(txManager, webhookRepo, userRepo, lifecycle, newTestAuditRecorder(ctrl), logger)
+
+			result, err := uc.HandlePullRequestEvent(context.Background(), tt.event)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Status != tt.expectedStatus {
+				t.Errorf("expected status %s, got %s (reason: %s)", tt.expectedStatus, result.Status, result.Reason)
+			}
+			if result.PullRequestID != prID {
+				t.Errorf("expected pull request ID %s, got %s", prID, result.PullRequestID)
+			}
+			if tt.expectedStatus == dto.WebhookStatusIgnored && result.Reason == "" {
+				t.Error("expected reason for ignored delivery")
+			}
+			if !reflect.DeepEqual(lifecycle.calls, tt.expectedCalls) {
+				t.Errorf("expected calls %v, got %v", tt.expectedCalls, lifecycle.calls)
+			}
+			if tt.expectedReq != nil && !reflect.DeepEqual(lifecycle.req, tt.expectedReq) {
+				t.Errorf("expected request %+v, got %+v", tt.expectedReq, lifecycle.req)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_LinkGitLogin(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.LinkGitLoginRequest
+		setupMocks  func(*repositorymocks.MockWebhookRepository, *repositorymocks.MockUserRepository)
+		expectErr   bool
+		expectedErr error
+		expected    *dto.GitLoginDTO
+		// expectedBefore пользователь прежнего сопоставления в записи аудита, пусто - логин сопоставлен впервые
+		expectedBefore string
+	}{
+		{
+			name: "success - login is normalized",
+			req:  dto.LinkGitLoginRequest{Provider: "gitlab", Login: " Octocat ", UserID: "u1"},
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "u1").Return(true, nil)
+				webhookRepo.EXPECT().FindUserIDByLogin(gomock.Any(), entity.GitProviderGitLab, "octocat").Return("", repository.ErrNotFound)
+				webhookRepo.EXPECT().SaveLogin(gomock.Any(), entity.GitProviderGitLab, "octocat", "u1").Return(nil)
+			},
+			expected: &dto.GitLoginDTO{Provider: "gitlab", Login: "octocat", UserID: "u1"},
+		},
+		{
+			name: "success - previous link replaced",
+			req:  dto.LinkGitLoginRequest{Provider: "github", Login: "octocat", UserID: "u1"},
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "u1").Return(true, nil)
+				webhookRepo.EXPECT().FindUserIDByLogin(gomock.Any(), entity.GitProviderGitHub, "octocat").Return("u0", nil)
+				webhookRepo.EXPECT().SaveLogin(gomock.Any(), entity.GitProviderGitHub, "octocat", "u1").Return(nil)
+			},
+			expected:       &dto.GitLoginDTO{Provider: "github", Login: "octocat", UserID: "u1"},
+			expectedBefore: "u0",
+		},
+		{
+			name: "user not found",
+			req:  dto.LinkGitLoginRequest{Provider: "github", Login: "octocat", UserID: "ghost"},
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "ghost").Return(false, nil)
+			},
+			expectErr:   true,
+			expectedErr: ErrUserNotFound,
+		},
+		{
+			name: "invalid provider",
+			req:  dto.LinkGitLoginRequest{Provider: "svn", Login: "octocat", UserID: "u1"},
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository, userRepo *repositorymocks.MockUserRepository) {
+			},
+			expectErr:   true,
+			expectedErr: ErrInvalidGitProvider,
+		},
+		{
+			name: "save error",
+			req:  dto.LinkGitLoginRequest{Provider: "github", Login: "octocat", UserID: "u1"},
+			setupMocks: func(webhookRepo *repositorymocks.MockWebhookRepository, userRepo *repositorymocks.MockUserRepository) {
+				userRepo.EXPECT().Exists(gomock.Any(), "u1").Return(true, nil)
+				webhookRepo.EXPECT().FindUserIDByLogin(gomock.Any(), entity.GitProviderGitHub, "octocat").Return("", repository.ErrNotFound)
+				webhookRepo.EXPECT().SaveLogin(gomock.Any(), entity.GitProviderGitHub, "octocat", "u1").Return(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookRepo := repositorymocks.NewMockWebhookRepository(ctrl)
+			userRepo := repositorymocks.NewMockUserRepository(ctrl)
+			txManager := transactionmocks.NewMockManager(ctrl)
+			logger := loggermocks.NewMockLogger(ctrl)
+
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+			recorder := auditmocks.NewMockRecorder(ctrl)
+			recorder.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, records ...audit.Record) error {
+				if len(records) != 1 || records[0].Action != entity.AuditGitLoginLinked || records[0].EntityID != tt.expected.Provider+":"+tt.expected.Login {
+					t.Fatalf("expected single git_login.linked record, got %+v", records)
+				}
+				before, linked := records[0].Before.(dto.GitLoginDTO)
+				if linked != (tt.expectedBefore != "") || before.UserID != tt.expectedBefore {
+					t.Errorf("expected previous link to %q, got %+v", tt.expectedBefore, records[0].Before)
+				}
+				return nil
+			}).MaxTimes(1)
+			tt.setupMocks(webhookRepo, userRepo)
+
+			uc := NewWebhookUseCase(txManager, webhookRepo, userRepo, &fakePullRequestLifecycle{}, recorder, logger)
+
+			result, err := uc.LinkGitLogin(context.Background(), tt.req)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+					t.Errorf("expected error %v, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS team_reviewer_cursors;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS chk_teams_reviewer_strategy,
+    DROP COLUMN IF EXISTS reviewer_strategy;
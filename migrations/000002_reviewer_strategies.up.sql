@@ -0,0 +1,13 @@
+-- Стратегия выбора ревьюверов команды (пустая строка - глобальная стратегия из конфигурации)
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS reviewer_strategy VARCHAR(32) NOT NULL DEFAULT '',
+    ADD CONSTRAINT chk_teams_reviewer_strategy
+        CHECK (reviewer_strategy IN ('', 'least_loaded', 'random', 'weighted_random', 'round_robin'));
+
+-- Курсор стратегии round_robin: последний назначенный ревьювер команды
+CREATE TABLE IF NOT EXISTS team_reviewer_cursors (
+    team_name VARCHAR(255) PRIMARY KEY,
+    last_user_id VARCHAR(255) NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_team_reviewer_cursors_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_pr_team_name;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS fk_pr_team,
+    DROP COLUMN IF EXISTS max_reviewers,
+    DROP COLUMN IF EXISTS min_reviewers,
+    DROP COLUMN IF EXISTS team_name;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS chk_teams_reviewer_limits,
+    DROP COLUMN IF EXISTS max_reviewers,
+    DROP COLUMN IF EXISTS min_reviewers;
@@ -0,0 +1,23 @@
+-- Ограничения команды на число ревьюверов PR
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS min_reviewers INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_reviewers INTEGER NOT NULL DEFAULT 2,
+    ADD CONSTRAINT chk_teams_reviewer_limits
+        CHECK (min_reviewers >= 0 AND max_reviewers BETWEEN 1 AND 10 AND min_reviewers <= max_reviewers);
+
+-- PR запоминает команду автора и её ограничения на момент создания
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS team_name VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS min_reviewers INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_reviewers INTEGER NOT NULL DEFAULT 2;
+
+UPDATE pull_requests pr
+SET team_name = u.team_name
+FROM users u
+WHERE u.user_id = pr.author_id AND pr.team_name IS NULL;
+
+ALTER TABLE pull_requests
+    ALTER COLUMN team_name SET NOT NULL,
+    ADD CONSTRAINT fk_pr_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_pr_team_name ON pull_requests(team_name);
@@ -0,0 +1,15 @@
+-- Запасные команды: из них добираются ревьюверы, если в команде автора не хватает кандидатов
+CREATE TABLE IF NOT EXISTS team_fallback_teams (
+    team_name VARCHAR(255) NOT NULL,
+    fallback_team_name VARCHAR(255) NOT NULL,
+    priority INTEGER NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CONSTRAINT uq_team_fallback_priority UNIQUE (team_name, priority),
+    CONSTRAINT chk_team_fallback_not_self CHECK (team_name <> fallback_team_name),
+    CONSTRAINT fk_team_fallback_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_team_fallback_fallback FOREIGN KEY (fallback_team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Признак ревьювера, назначенного из запасной команды
+ALTER TABLE pr_reviewers
+    ADD COLUMN IF NOT EXISTS is_fallback BOOLEAN NOT NULL DEFAULT FALSE;
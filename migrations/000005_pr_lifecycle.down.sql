@@ -0,0 +1,4 @@
+-- Черновики и закрытые PR возвращаются в OPEN, иначе их статусы нельзя удалить из справочника
+UPDATE pull_requests SET status = 'OPEN' WHERE status IN ('DRAFT', 'CLOSED');
+
+DELETE FROM pr_statuses WHERE status IN ('DRAFT', 'CLOSED');
@@ -0,0 +1,2 @@
+-- Статусы жизненного цикла PR: черновик и закрытый без мержа
+INSERT INTO pr_statuses (status) VALUES ('DRAFT'), ('CLOSED') ON CONFLICT (status) DO NOTHING;
@@ -0,0 +1,4 @@
+ALTER TABLE pr_reviewers
+    DROP CONSTRAINT IF EXISTS chk_pr_reviewers_review_state,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS review_state;
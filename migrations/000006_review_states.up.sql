@@ -0,0 +1,6 @@
+-- Последнее ревью каждого назначенного ревьювера
+ALTER TABLE pr_reviewers
+    ADD COLUMN IF NOT EXISTS review_state VARCHAR(50) NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ,
+    ADD CONSTRAINT chk_pr_reviewers_review_state
+        CHECK (review_state IN ('PENDING', 'APPROVED', 'CHANGES_REQUESTED', 'COMMENTED'));
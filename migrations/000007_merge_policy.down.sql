@@ -0,0 +1,9 @@
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS merge_forced;
+
+DROP TABLE IF EXISTS team_code_owners;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS chk_teams_required_approvals,
+    DROP COLUMN IF EXISTS block_on_changes_requested,
+    DROP COLUMN IF EXISTS required_approvals;
@@ -0,0 +1,19 @@
+-- Политика мержа команды: сколько одобрений нужно и блокирует ли мерж запрос изменений
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS required_approvals INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS block_on_changes_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT chk_teams_required_approvals CHECK (required_approvals BETWEEN 0 AND 10);
+
+-- Владельцы кода команды: если список не пуст, PR должен одобрить хотя бы один из них
+CREATE TABLE IF NOT EXISTS team_code_owners (
+    team_name VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (team_name, user_id),
+    CONSTRAINT fk_team_code_owners_team FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_team_code_owners_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Признак мержа в обход политики (force)
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS merge_forced BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP INDEX IF EXISTS idx_git_user_logins_user_id;
+DROP TABLE IF EXISTS git_user_logins;
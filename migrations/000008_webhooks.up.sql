@@ -0,0 +1,21 @@
+-- Сопоставление логинов git-хостингов с пользователями сервиса
+CREATE TABLE IF NOT EXISTS git_user_logins (
+    provider VARCHAR(50) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, login),
+    CONSTRAINT fk_git_user_logins_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT chk_git_user_logins_provider CHECK (provider IN ('github', 'gitlab'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_git_user_logins_user_id ON git_user_logins(user_id);
+
+-- Принятые доставки вебхуков: повторная доставка с тем же ID не обрабатывается
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    provider VARCHAR(50) NOT NULL,
+    delivery_id VARCHAR(255) NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, delivery_id)
+);
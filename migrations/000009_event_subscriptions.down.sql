@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_event_dead_letters_subscription_id;
+DROP TABLE IF EXISTS event_dead_letters;
+
+DROP INDEX IF EXISTS idx_event_deliveries_next_attempt_at;
+DROP TABLE IF EXISTS event_deliveries;
+
+DROP INDEX IF EXISTS idx_event_subscription_types_event_type;
+DROP TABLE IF EXISTS event_subscription_types;
+DROP TABLE IF EXISTS event_subscriptions;
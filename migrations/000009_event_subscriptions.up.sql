@@ -0,0 +1,53 @@
+-- Подписки внешних сервисов на события об изменении назначений
+CREATE TABLE IF NOT EXISTS event_subscriptions (
+    subscription_id VARCHAR(255) PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Типы событий подписки
+CREATE TABLE IF NOT EXISTS event_subscription_types (
+    subscription_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (subscription_id, event_type),
+    CONSTRAINT fk_event_subscription_types_subscription FOREIGN KEY (subscription_id) REFERENCES event_subscriptions(subscription_id) ON DELETE CASCADE,
+    CONSTRAINT chk_event_subscription_types_event_type CHECK (event_type IN ('reviewer.assigned', 'reviewer.reassigned', 'pr.merged', 'user.deactivated'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_subscription_types_event_type ON event_subscription_types(event_type);
+
+-- Очередь доставок событий подписчикам
+CREATE TABLE IF NOT EXISTS event_deliveries (
+    delivery_id VARCHAR(255) PRIMARY KEY,
+    subscription_id VARCHAR(255) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload BYTEA NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_event_deliveries_subscription FOREIGN KEY (subscription_id) REFERENCES event_subscriptions(subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_deliveries_next_attempt_at ON event_deliveries(next_attempt_at);
+
+-- Доставки, исчерпавшие попытки
+CREATE TABLE IF NOT EXISTS event_dead_letters (
+    delivery_id VARCHAR(255) PRIMARY KEY,
+    subscription_id VARCHAR(255) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload BYTEA NOT NULL,
+    attempts INTEGER NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    failed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_event_dead_letters_subscription FOREIGN KEY (subscription_id) REFERENCES event_subscriptions(subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_dead_letters_subscription_id ON event_dead_letters(subscription_id, failed_at DESC);
@@ -0,0 +1,12 @@
+-- Outbox доменных событий: пишется в транзакции изменения, публикуется фоновым relay
+CREATE TABLE IF NOT EXISTS outbox (
+    outbox_id VARCHAR(255) PRIMARY KEY,
+    seq BIGSERIAL NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload BYTEA NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    sent_at TIMESTAMPTZ
+);
+
+-- Relay выбирает только неопубликованные сообщения в порядке записи
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(seq) WHERE sent_at IS NULL;
@@ -0,0 +1,11 @@
+-- Статические API токены. Хранится только SHA-256 токена, открытое значение показывается один раз
+CREATE TABLE IF NOT EXISTS api_tokens (
+    token_id VARCHAR(255) PRIMARY KEY,
+    subject VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMPTZ,
+    CONSTRAINT uq_api_tokens_token_hash UNIQUE (token_hash),
+    CONSTRAINT chk_api_tokens_role CHECK (role IN ('admin', 'team-lead', 'member', 'service'))
+);
@@ -0,0 +1,31 @@
+-- Журнал аудита изменений состояния. Только дополняется: UPDATE и DELETE запрещены триггером
+CREATE TABLE IF NOT EXISTS audit_log (
+    seq BIGSERIAL PRIMARY KEY,
+    entry_id VARCHAR(255) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    before_state JSONB,
+    after_state JSONB,
+    reason TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uq_audit_log_entry_id UNIQUE (entry_id),
+    CONSTRAINT chk_audit_log_entity_type CHECK (entity_type IN ('team', 'user', 'pull_request'))
+);
+
+-- Выборки по сущности и по автору идут страницами от новых записей к старым
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON audit_log(entity_type, entity_id, seq DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor ON audit_log(actor, seq DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log(occurred_at);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
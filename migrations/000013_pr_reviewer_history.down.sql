@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pr_reviewer_history_user;
+DROP INDEX IF EXISTS idx_pr_reviewer_history_pr;
+DROP TABLE IF EXISTS pr_reviewer_history;
@@ -0,0 +1,27 @@
+-- История назначений ревьюверов. pr_reviewers хранит только текущих ревьюверов,
+-- а история сохраняет каждое назначение и снятие с причиной
+CREATE TABLE IF NOT EXISTS pr_reviewer_history (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_pr_reviewer_history_pr FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_pr_reviewer_history_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    CONSTRAINT chk_pr_reviewer_history_action CHECK (action IN ('assigned', 'unassigned')),
+    CONSTRAINT chk_pr_reviewer_history_reason CHECK (reason IN ('auto', 'reassign', 'manual', 'deactivation'))
+);
+
+-- Для /pullRequest/history
+CREATE INDEX IF NOT EXISTS idx_pr_reviewer_history_pr ON pr_reviewer_history(pull_request_id, id);
+
+-- Для статистики назначений пользователей
+CREATE INDEX IF NOT EXISTS idx_pr_reviewer_history_user ON pr_reviewer_history(user_id)
+    WHERE action = 'assigned';
+
+-- Текущие назначения переносятся в историю; прежние снятия восстановить нельзя
+INSERT INTO pr_reviewer_history (pull_request_id, user_id, action, reason, occurred_at)
+SELECT pull_request_id, user_id, 'assigned', 'auto', assigned_at
+FROM pr_reviewers
+ORDER BY assigned_at;
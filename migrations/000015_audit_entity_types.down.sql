@@ -0,0 +1,5 @@
+-- Журнал только дополняется, поэтому уже записанные строки новых типов остаются: ограничение не проверяет их
+ALTER TABLE audit_log
+    DROP CONSTRAINT IF EXISTS chk_audit_log_entity_type,
+    ADD CONSTRAINT chk_audit_log_entity_type
+        CHECK (entity_type IN ('team', 'user', 'pull_request')) NOT VALID;
@@ -0,0 +1,5 @@
+-- Журнал аудита записывает изменения подписок, API токенов и сопоставлений логинов git-хостингов
+ALTER TABLE audit_log
+    DROP CONSTRAINT IF EXISTS chk_audit_log_entity_type,
+    ADD CONSTRAINT chk_audit_log_entity_type
+        CHECK (entity_type IN ('team', 'user', 'pull_request', 'subscription', 'api_token', 'git_login'));
@@ -0,0 +1,8 @@
+// Package migrations встраивает миграции схемы PostgreSQL в бинарник.
+// Те же файлы применяет контейнер migrate/migrate в docker-compose
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,251 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+type auditPageResponse struct {
+	Entries []struct {
+		EntryID    string          `json:"entry_id"`
+		Action     string          `json:"action"`
+		EntityType string          `json:"entity_type"`
+		EntityID   string          `json:"entity_id"`
+		Actor      string          `json:"actor"`
+		Before     json.RawMessage `json:"before"`
+		After      json.RawMessage `json:"after"`
+		Reason     string          `json:"reason"`
+	} `json:"entries"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+func getAuditPage(t *testing.T, query string) auditPageResponse {
+	t.Helper()
+
+	resp, err := http.Get(testBaseURL + "/audit?" + query)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		json.NewDecoder(resp.Body).Decode(&errResp)
+		t.Fatalf("Expected status 200 on audit list, got %d: %v", resp.StatusCode, errResp)
+	}
+
+	var page auditPageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return page
+}
+
+func TestAuditLog(t *testing.T) {
+	teamReq := map[string]interface{}{
+		"team_name": "team-audit-test",
+		"members": []map[string]interface{}{
+			{"user_id": "user-audit-1", "username": "User Audit 1", "is_active": true},
+			{"user_id": "user-audit-2", "username": "User Audit 2", "is_active": true},
+		},
+	}
+	teamBody, _ := json.Marshal(teamReq)
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	deactivateBody, _ := json.Marshal(map[string]interface{}{"user_id": "user-audit-2", "is_active": false})
+	deactivateResp, err := http.Post(testBaseURL+"/users/setIsActive", "application/json", bytes.NewReader(deactivateBody))
+	if err != nil {
+		t.Fatalf("Failed to deactivate user: %v", err)
+	}
+	deactivateResp.Body.Close()
+
+	teamPage := getAuditPage(t, "entity_type=team&entity_id=team-audit-test")
+	if len(teamPage.Entries) != 1 || teamPage.Entries[0].Action != "team.created" {
+		t.Fatalf("Expected single team.created entry, got %+v", teamPage.Entries)
+	}
+	if teamPage.Entries[0].Actor != "bootstrap" {
+		t.Errorf("Expected actor 'bootstrap', got %q", teamPage.Entries[0].Actor)
+	}
+
+	userPage := getAuditPage(t, "entity_type=user&entity_id=user-audit-2")
+	if len(userPage.Entries) != 1 || userPage.Entries[0].Action != "user.deactivated" {
+		t.Fatalf("Expected single user.deactivated entry, got %+v", userPage.Entries)
+	}
+
+	var before, after struct {
+		IsActive bool `json:"is_active"`
+	}
+	json.Unmarshal(userPage.Entries[0].Before, &before)
+	json.Unmarshal(userPage.Entries[0].After, &after)
+	if !before.IsActive || after.IsActive {
+		t.Errorf("Expected is_active true -> false, got %v -> %v", before.IsActive, after.IsActive)
+	}
+}
+
+func TestAuditLogPullRequestAndTeamChanges(t *testing.T) {
+	post := func(path string, body map[string]interface{}) {
+		t.Helper()
+		data, _ := json.Marshal(body)
+		resp, err := http.Post(testBaseURL+path, "application/json", bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("Failed to call %s: %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			t.Fatalf("Expected success on %s, got %d", path, resp.StatusCode)
+		}
+	}
+
+	post("/team/add", map[string]interface{}{
+		"team_name": "team-audit-changes",
+		"members": []map[string]interface{}{
+			{"user_id": "user-audit-changes-1", "username": "Author", "is_active": true},
+			{"user_id": "user-audit-changes-2", "username": "Reviewer", "is_active": true},
+			{"user_id": "user-audit-changes-3", "username": "Reviewer", "is_active": true},
+		},
+	})
+	post("/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   "pr-audit-changes",
+		"pull_request_name": "Audit changes",
+		"author_id":         "user-audit-changes-1",
+		"draft":             true,
+	})
+	post("/pullRequest/ready", map[string]interface{}{"pull_request_id": "pr-audit-changes"})
+	post("/pullRequest/removeReviewer", map[string]interface{}{"pull_request_id": "pr-audit-changes", "user_id": "user-audit-changes-2"})
+	post("/pullRequest/addReviewer", map[string]interface{}{"pull_request_id": "pr-audit-changes", "user_id": "user-audit-changes-2"})
+	post("/pullRequest/review", map[string]interface{}{"pull_request_id": "pr-audit-changes", "user_id": "user-audit-changes-2", "state": "APPROVED"})
+	post("/pullRequest/close", map[string]interface{}{"pull_request_id": "pr-audit-changes"})
+	post("/pullRequest/reopen", map[string]interface{}{"pull_request_id": "pr-audit-changes"})
+	post("/team/update", map[string]interface{}{"team_name": "team-audit-changes", "max_reviewers": 1})
+
+	teamPage := getAuditPage(t, "entity_type=team&entity_id=team-audit-changes")
+	if len(teamPage.Entries) != 2 || teamPage.Entries[0].Action != "team.updated" {
+		t.Fatalf("Expected team.updated after team.created, got %+v", teamPage.Entries)
+	}
+
+	prPage := getAuditPage(t, "entity_type=pull_request&entity_id=pr-audit-changes")
+	actions := make([]string, 0, len(prPage.Entries))
+	for _, entry := range prPage.Entries {
+		actions = append(actions, entry.Action)
+	}
+	expected := []string{"pr.reopened", "pr.closed", "pr.review_submitted", "pr.reviewer_added", "pr.reviewer_removed", "pr.ready", "pr.created"}
+	if !slices.Equal(actions, expected) {
+		t.Fatalf("Expected actions %v, got %v", expected, actions)
+	}
+
+	var before, after struct {
+		Status string `json:"status"`
+	}
+	json.Unmarshal(prPage.Entries[1].Before, &before)
+	json.Unmarshal(prPage.Entries[1].After, &after)
+	if before.Status != "OPEN" || after.Status != "CLOSED" {
+		t.Errorf("Expected pr.closed OPEN -> CLOSED, got %s -> %s", before.Status, after.Status)
+	}
+}
+
+func TestAuditLogAdministrativeChanges(t *testing.T) {
+	post := func(path string, body map[string]interface{}, result interface{}) {
+		t.Helper()
+		resp := doWithToken(t, http.MethodPost, path, testBootstrapToken, body)
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			t.Fatalf("Expected success on %s, got %d", path, resp.StatusCode)
+		}
+		if result != nil {
+			if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+				t.Fatalf("Failed to decode %s response: %v", path, err)
+			}
+		}
+	}
+	actions := func(query string) []string {
+		t.Helper()
+		page := getAuditPage(t, query)
+		result := make([]string, 0, len(page.Entries))
+		for _, entry := range page.Entries {
+			result = append(result, entry.Action)
+			if bytes.Contains(entry.Before, []byte(`"secret"`)) || bytes.Contains(entry.After, []byte(`"secret"`)) ||
+				bytes.Contains(entry.After, []byte(`"token":`)) {
+				t.Errorf("Expected audit snapshots without secrets, got %s / %s", entry.Before, entry.After)
+			}
+		}
+		return result
+	}
+
+	var subscription subscriptionResponse
+	post("/subscriptions/create", map[string]interface{}{"url": "https://example.com/audit-hook", "event_types": []string{"pr.merged"}}, &subscription)
+	subscriptionID := subscription.Subscription.SubscriptionID
+	post("/subscriptions/update", map[string]interface{}{"subscription_id": subscriptionID, "is_active": false}, nil)
+	post("/subscriptions/delete", map[string]interface{}{"subscription_id": subscriptionID}, nil)
+
+	expected := []string{"subscription.deleted", "subscription.updated", "subscription.created"}
+	if got := actions("entity_type=subscription&entity_id=" + subscriptionID); !slices.Equal(got, expected) {
+		t.Errorf("Expected actions %v, got %v", expected, got)
+	}
+
+	token := createAPIToken(t, "audit-token", "member")
+	post("/auth/tokens/revoke", map[string]interface{}{"token_id": token.Token.TokenID}, nil)
+
+	expected = []string{"api_token.revoked", "api_token.created"}
+	if got := actions("entity_type=api_token&entity_id=" + token.Token.TokenID); !slices.Equal(got, expected) {
+		t.Errorf("Expected actions %v, got %v", expected, got)
+	}
+
+	post("/team/add", map[string]interface{}{
+		"team_name": "team-audit-logins",
+		"members": []map[string]interface{}{
+			{"user_id": "user-audit-login-1", "username": "Login 1", "is_active": true},
+			{"user_id": "user-audit-login-2", "username": "Login 2", "is_active": true},
+		},
+	}, nil)
+	post("/webhooks/linkLogin", map[string]interface{}{"provider": "github", "login": "audit-login", "user_id": "user-audit-login-1"}, nil)
+	post("/webhooks/linkLogin", map[string]interface{}{"provider": "github", "login": "audit-login", "user_id": "user-audit-login-2"}, nil)
+
+	page := getAuditPage(t, "entity_type=git_login&entity_id=github:audit-login")
+	if len(page.Entries) != 2 || page.Entries[0].Action != "git_login.linked" {
+		t.Fatalf("Expected two git_login.linked entries, got %+v", page.Entries)
+	}
+	var before, after struct {
+		UserID string `json:"user_id"`
+	}
+	json.Unmarshal(page.Entries[0].Before, &before)
+	json.Unmarshal(page.Entries[0].After, &after)
+	if before.UserID != "user-audit-login-1" || after.UserID != "user-audit-login-2" {
+		t.Errorf("Expected relink user-audit-login-1 -> user-audit-login-2, got %s -> %s", before.UserID, after.UserID)
+	}
+}
+
+func TestAuditLogPagination(t *testing.T) {
+	first := getAuditPage(t, "limit=1")
+	if len(first.Entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(first.Entries))
+	}
+	if first.NextCursor == nil {
+		t.Fatal("Expected next_cursor on the first page")
+	}
+
+	second := getAuditPage(t, "limit=1&cursor="+*first.NextCursor)
+	if len(second.Entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(second.Entries))
+	}
+	if second.Entries[0].EntryID == first.Entries[0].EntryID {
+		t.Error("Expected the second page to continue after the first")
+	}
+}
+
+func TestAuditLogRequiresAdmin(t *testing.T) {
+	member := createAPIToken(t, "audit-member", "member")
+
+	resp := doWithToken(t, http.MethodGet, "/audit", member.Token.Token, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", resp.StatusCode)
+	}
+}
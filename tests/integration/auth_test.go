@@ -0,0 +1,327 @@
+package integration
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const (
+	testBootstrapToken = "integration-bootstrap-token"
+	testJWTSecret      = "integration-jwt-secret"
+)
+
+// bearerTransport добавляет Authorization: Bearer, если запрос не задал заголовок сам
+type bearerTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.next.RoundTrip(req)
+}
+
+type apiTokenResponse struct {
+	Token struct {
+		TokenID   string     `json:"token_id"`
+		Subject   string     `json:"subject"`
+		Role      string     `json:"role"`
+		Token     string     `json:"token"`
+		RevokedAt *time.Time `json:"revoked_at"`
+	} `json:"token"`
+}
+
+// doWithToken отправляет запрос с заданным Bearer токеном. Пустой token отправляет запрос без заголовка
+func doWithToken(t *testing.T, method, path, token string, body interface{}) *http.Response {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, _ := http.NewRequest(method, testBaseURL+path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	return resp
+}
+
+func createAPIToken(t *testing.T, subject, role string) apiTokenResponse {
+	t.Helper()
+
+	resp := doWithToken(t, http.MethodPost, "/auth/tokens/create", testBootstrapToken, map[string]string{
+		"subject": subject,
+		"role":    role,
+	})
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201 on token create, got %d", resp.StatusCode)
+	}
+
+	var result apiTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return result
+}
+
+func signTestJWT(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(testJWTSecret))
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthRequired(t *testing.T) {
+	resp := doWithToken(t, http.MethodGet, "/team/get?team_name=any", "", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Expected status 401 without token, got %d", resp.StatusCode)
+	}
+
+	var errResp ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if errResp.Error.Code != "UNAUTHORIZED" {
+		t.Errorf("Expected error code UNAUTHORIZED, got %s", errResp.Error.Code)
+	}
+
+	health := doWithToken(t, http.MethodGet, "/health", "", nil)
+	health.Body.Close()
+	if health.StatusCode != http.StatusOK {
+		t.Errorf("Expected /health to be public, got %d", health.StatusCode)
+	}
+}
+
+func TestAPITokenRoles(t *testing.T) {
+	member := createAPIToken(t, "auth-member", "member")
+	lead := createAPIToken(t, "auth-lead", "team-lead")
+
+	whoami := doWithToken(t, http.MethodGet, "/auth/whoami", member.Token.Token, nil)
+	var principal struct {
+		Principal struct {
+			ID   string `json:"id"`
+			Role string `json:"role"`
+		} `json:"principal"`
+	}
+	_ = json.NewDecoder(whoami.Body).Decode(&principal)
+	whoami.Body.Close()
+	if principal.Principal.ID != "auth-member" || principal.Principal.Role != "member" {
+		t.Errorf("Expected auth-member/member, got %+v", principal.Principal)
+	}
+
+	deactivate := map[string]interface{}{"user_id": "auth-nobody", "is_active": false}
+
+	resp := doWithToken(t, http.MethodPost, "/users/setIsActive", member.Token.Token, deactivate)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for member on /users/setIsActive, got %d", resp.StatusCode)
+	}
+
+	// Team lead проходит авторизацию, пользователя нет
+	resp = doWithToken(t, http.MethodPost, "/users/setIsActive", lead.Token.Token, deactivate)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404 for team lead on unknown user, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/merge", lead.Token.Token, map[string]interface{}{
+		"pull_request_id": "auth-missing-pr",
+		"force":           true,
+	})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for forced merge by team lead, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodGet, "/auth/tokens/list", lead.Token.Token, nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for team lead on token list, got %d", resp.StatusCode)
+	}
+
+	revoke := doWithToken(t, http.MethodPost, "/auth/tokens/revoke", testBootstrapToken, map[string]string{
+		"token_id": member.Token.TokenID,
+	})
+	var revoked apiTokenResponse
+	_ = json.NewDecoder(revoke.Body).Decode(&revoked)
+	revoke.Body.Close()
+	if revoke.StatusCode != http.StatusOK || revoked.Token.RevokedAt == nil {
+		t.Fatalf("Expected revoked token, got %d %+v", revoke.StatusCode, revoked)
+	}
+	if revoked.Token.Token != "" {
+		t.Error("Expected plain token to be returned only on create")
+	}
+
+	resp = doWithToken(t, http.MethodGet, "/auth/whoami", member.Token.Token, nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 for revoked token, got %d", resp.StatusCode)
+	}
+}
+
+func TestTeamLeadAndReviewerScope(t *testing.T) {
+	for _, team := range []map[string]interface{}{
+		{"team_name": "scope-a", "members": []map[string]interface{}{
+			{"user_id": "scope-lead", "username": "Lead", "is_active": true},
+			{"user_id": "scope-a1", "username": "Member A", "is_active": true},
+		}},
+		{"team_name": "scope-b", "members": []map[string]interface{}{
+			{"user_id": "scope-b1", "username": "Member B", "is_active": true},
+		}},
+	} {
+		resp := doWithToken(t, http.MethodPost, "/team/add", testBootstrapToken, team)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("Expected status 201 on team create, got %d", resp.StatusCode)
+		}
+	}
+
+	lead := createAPIToken(t, "scope-lead", "team-lead")
+	member := createAPIToken(t, "scope-a1", "member")
+
+	resp := doWithToken(t, http.MethodPost, "/users/setIsActive", lead.Token.Token, map[string]interface{}{"user_id": "scope-b1", "is_active": false})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for team lead on another team user, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/users/setIsActive", lead.Token.Token, map[string]interface{}{"user_id": "scope-a1", "is_active": true})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 for team lead on own team user, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/team/deactivateMembers", lead.Token.Token, map[string]interface{}{"team_name": "scope-b"})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for team lead on another team, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/team/update", lead.Token.Token, map[string]interface{}{"team_name": "scope-b", "max_reviewers": 1})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for team lead updating another team, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/team/update", lead.Token.Token, map[string]interface{}{"team_name": "scope-a", "max_reviewers": 1})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 for team lead updating own team, got %d", resp.StatusCode)
+	}
+
+	// Новую команду руководитель создает только из себя и своей команды
+	resp = doWithToken(t, http.MethodPost, "/team/add", lead.Token.Token, map[string]interface{}{
+		"team_name": "scope-c",
+		"members": []map[string]interface{}{
+			{"user_id": "scope-lead", "username": "Lead", "is_active": true},
+			{"user_id": "scope-b1", "username": "Member B", "is_active": true},
+		},
+	})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for team lead moving another team user, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/create", testBootstrapToken, map[string]interface{}{
+		"pull_request_id": "scope-b-pr", "pull_request_name": "Scope B", "author_id": "scope-b1",
+	})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201 on PR create, got %d", resp.StatusCode)
+	}
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/merge", lead.Token.Token, map[string]interface{}{"pull_request_id": "scope-b-pr"})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for team lead merging another team PR, got %d", resp.StatusCode)
+	}
+
+	// Ревью от чужого имени запрещено, свое проходит авторизацию и упирается в отсутствующий PR
+	review := map[string]interface{}{"pull_request_id": "scope-missing-pr", "user_id": "scope-b1", "state": "APPROVED"}
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/review", member.Token.Token, review)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for review on behalf of another user, got %d", resp.StatusCode)
+	}
+
+	review["user_id"] = "scope-a1"
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/review", member.Token.Token, review)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404 for own review on missing PR, got %d", resp.StatusCode)
+	}
+
+	// Снимать ревьюверов может только руководитель или администратор, автор не меняет ревьюверов своего PR
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/removeReviewer", member.Token.Token, map[string]interface{}{"pull_request_id": "scope-b-pr", "user_id": "scope-a1"})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for member removing reviewer, got %d", resp.StatusCode)
+	}
+
+	author := createAPIToken(t, "scope-b1", "member")
+	resp = doWithToken(t, http.MethodPost, "/pullRequest/reassign", author.Token.Token, map[string]interface{}{"pull_request_id": "scope-b-pr", "old_user_id": "scope-a1"})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for author reassigning reviewer of own PR, got %d", resp.StatusCode)
+	}
+}
+
+func TestJWTAuthentication(t *testing.T) {
+	valid := signTestJWT(map[string]interface{}{
+		"sub":  "ci-bot",
+		"role": "service",
+		"exp":  time.Now().Add(time.Hour).Unix(),
+	})
+
+	resp := doWithToken(t, http.MethodGet, "/auth/whoami", valid, nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 for valid jwt, got %d", resp.StatusCode)
+	}
+
+	resp = doWithToken(t, http.MethodPost, "/team/add", valid, map[string]interface{}{
+		"team_name": "auth-team",
+		"members":   []interface{}{},
+	})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for service on /team/add, got %d", resp.StatusCode)
+	}
+
+	expired := signTestJWT(map[string]interface{}{
+		"sub":  "ci-bot",
+		"role": "service",
+		"exp":  time.Now().Add(-time.Minute).Unix(),
+	})
+	resp = doWithToken(t, http.MethodGet, "/auth/whoami", expired, nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 for expired jwt, got %d", resp.StatusCode)
+	}
+}
@@ -1,8 +1,11 @@
 package integration
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/usecase/dto"
 )
 
 func TestHealthCheck(t *testing.T) {
@@ -16,3 +19,53 @@ func TestHealthCheck(t *testing.T) {
 		t.Errorf("Expected status 200, got %d", resp.StatusCode)
 	}
 }
+
+func TestLivenessEndpoint(t *testing.T) {
+	// Пробы публичные: балансировщик ходит без токена
+	resp := doWithToken(t, http.MethodGet, "/livez", "", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var body dto.HealthDTO
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if body.Status != dto.HealthStatusOK {
+		t.Errorf("Expected status %q, got %q", dto.HealthStatusOK, body.Status)
+	}
+}
+
+func TestReadinessEndpoint(t *testing.T) {
+	resp := doWithToken(t, http.MethodGet, "/readyz", "", nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var body dto.HealthDTO
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if body.Status != dto.HealthStatusOK {
+		t.Errorf("Expected status %q, got %q: %+v", dto.HealthStatusOK, body.Status, body.Checks)
+	}
+
+	for _, name := range []string{"shutdown", "database", "schema", "connection_pool"} {
+		check, ok := body.Checks[name]
+		if !ok {
+			t.Errorf("Expected check %s in response", name)
+			continue
+		}
+		if check.Status != dto.HealthStatusOK {
+			t.Errorf("Expected check %s to pass, got %q: %s", name, check.Status, check.Error)
+		}
+	}
+
+	if version := body.Checks["schema"].Details["version"]; version == nil || version == float64(0) {
+		t.Errorf("Expected applied schema version in details, got %v", version)
+	}
+}
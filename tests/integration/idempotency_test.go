@@ -0,0 +1,130 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+// postWithIdempotencyKey отправляет POST запрос от администратора с заголовком Idempotency-Key
+func postWithIdempotencyKey(t *testing.T, path, key string, body interface{}) (*http.Response, []byte) {
+	t.Helper()
+
+	data, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, testBaseURL+path, bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", key)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response: %v", err)
+	}
+	return resp, respBody
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	team := map[string]interface{}{
+		"team_name": "team-idempotency",
+		"members": []map[string]interface{}{
+			{"user_id": "user-idem-1", "username": "User Idem 1", "is_active": true},
+			{"user_id": "user-idem-2", "username": "User Idem 2", "is_active": true},
+		},
+	}
+	if resp, body := postWithIdempotencyKey(t, "/team/add", "idem-team-add", team); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d: %s", resp.StatusCode, body)
+	}
+
+	pr := map[string]interface{}{
+		"pull_request_id":   "pr-idempotency",
+		"pull_request_name": "Idempotent PR",
+		"author_id":         "user-idem-1",
+	}
+	first, firstBody := postWithIdempotencyKey(t, "/pullRequest/create", "idem-pr-create", pr)
+	if first.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d: %s", first.StatusCode, firstBody)
+	}
+	if first.Header.Get("Idempotent-Replayed") != "" {
+		t.Error("Expected first response not to be replayed")
+	}
+
+	// Повтор после таймаута получает первый ответ, а не PR_EXISTS
+	retry, retryBody := postWithIdempotencyKey(t, "/pullRequest/create", "idem-pr-create", pr)
+	if retry.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected replayed status 201, got %d: %s", retry.StatusCode, retryBody)
+	}
+	if retry.Header.Get("Idempotent-Replayed") != "true" {
+		t.Error("Expected Idempotent-Replayed: true on retry")
+	}
+	if !bytes.Equal(retryBody, firstBody) {
+		t.Errorf("Expected replayed body %s, got %s", firstBody, retryBody)
+	}
+	if retry.Header.Get("Content-Type") != first.Header.Get("Content-Type") {
+		t.Errorf("Expected Content-Type %q, got %q", first.Header.Get("Content-Type"), retry.Header.Get("Content-Type"))
+	}
+
+	// Тот же ключ с другим телом
+	pr["pull_request_name"] = "Another PR"
+	reused, reusedBody := postWithIdempotencyKey(t, "/pullRequest/create", "idem-pr-create", pr)
+	if reused.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected status 422, got %d: %s", reused.StatusCode, reusedBody)
+	}
+	var errResp ErrorResponse
+	if err := json.Unmarshal(reusedBody, &errResp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if errResp.Error.Code != "IDEMPOTENCY_KEY_REUSED" {
+		t.Errorf("Expected error code IDEMPOTENCY_KEY_REUSED, got %s", errResp.Error.Code)
+	}
+
+	// Без ключа запрос выполняется заново
+	resp, body := postWithIdempotencyKey(t, "/pullRequest/create", "", map[string]interface{}{
+		"pull_request_id":   "pr-idempotency",
+		"pull_request_name": "Idempotent PR",
+		"author_id":         "user-idem-1",
+	})
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Expected status 409 without key, got %d: %s", resp.StatusCode, body)
+	}
+}
+
+func TestIdempotencyKeyScopedByPrincipal(t *testing.T) {
+	first := createAPIToken(t, "idempotency-client-1", "admin")
+	second := createAPIToken(t, "idempotency-client-2", "admin")
+
+	send := func(token, teamName string) *http.Response {
+		data, _ := json.Marshal(map[string]interface{}{
+			"team_name": teamName,
+			"members": []map[string]interface{}{
+				{"user_id": teamName + "-user", "username": "Member", "is_active": true},
+			},
+		})
+		req, _ := http.NewRequest(http.MethodPost, testBaseURL+"/team/add", bytes.NewReader(data))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Idempotency-Key", "shared-key")
+
+		resp, err := http.DefaultTransport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("Failed to send request: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	// Ключ одного клиента не конфликтует с тем же ключом другого
+	for i, token := range []string{first.Token.Token, second.Token.Token} {
+		resp := send(token, fmt.Sprintf("team-idem-scope-%d", i+1))
+		if resp.StatusCode != http.StatusCreated || resp.Header.Get("Idempotent-Replayed") != "" {
+			t.Errorf("Expected independent key per principal, got status %d replayed=%q", resp.StatusCode, resp.Header.Get("Idempotent-Replayed"))
+		}
+	}
+}
@@ -3,33 +3,52 @@ package integration
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
 	"github.com/exPriceD/pr-reviewer-service/internal/app"
 	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/tracing"
 )
 
 var (
 	testApp     *app.App
 	testServer  *httptest.Server
 	testBaseURL string
+
+	// Спаны всех тестов пишутся в память
+	testSpans          *tracetest.InMemoryExporter
+	testTracerProvider *sdktrace.TracerProvider
 )
 
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
+	// TEST_STORAGE_DRIVER=sqlite прогоняет тесты на временном файле SQLite без сервера PostgreSQL
+	sqliteDir, err := os.MkdirTemp("", "pr-reviewer-integration-")
+	if err != nil {
+		fmt.Printf("Failed to create temp dir: %v\n", err)
+		os.Exit(1)
+	}
+
 	testCfg := &config.Config{
 		Server: config.ServerConfig{
-			Port:            "0",
-			Host:            "localhost",
-			ReadTimeout:     30,
-			WriteTimeout:    30,
-			IdleTimeout:     60,
-			MaxHeaderBytes:  1048576,
-			MaxBodySize:     10485760,
-			ShutdownTimeout: 10,
+			Port:             "0",
+			Host:             "localhost",
+			ReadTimeout:      30,
+			WriteTimeout:     30,
+			IdleTimeout:      60,
+			MaxHeaderBytes:   1048576,
+			MaxBodySize:      10485760,
+			ShutdownTimeout:  10,
+			ReadinessTimeout: 2,
 		},
 		Database: config.DatabaseConfig{
 			Host:            getEnv("TEST_DB_HOST", "localhost"),
@@ -43,13 +62,69 @@ func TestMain(m *testing.M) {
 			ConnMaxLifetime: 5,
 			PingTimeout:     5,
 		},
+		SQLite: config.SQLiteConfig{
+			Path:        filepath.Join(sqliteDir, "pr_reviewer_test.db"),
+			BusyTimeout: config.DefaultSQLiteBusyTimeout,
+		},
+		Storage: config.StorageConfig{
+			Driver:      getEnv("TEST_STORAGE_DRIVER", config.StorageDriverPostgres),
+			AutoMigrate: true,
+		},
 		Logger: config.LoggerConfig{
 			Level:  "info",
 			Format: "json",
 		},
+		Reviewer: config.ReviewerConfig{
+			Strategy: config.DefaultReviewerStrategy,
+		},
+		Webhooks: config.WebhooksConfig{
+			GitHubSecret: testGitHubSecret,
+			GitLabSecret: testGitLabSecret,
+		},
+		Notifications: config.NotificationsConfig{
+			PollInterval:   1,
+			MaxAttempts:    1,
+			InitialBackoff: 1,
+			MaxBackoff:     1,
+			RequestTimeout: 2,
+			BatchSize:      config.DefaultNotificationsBatchSize,
+		},
+		Outbox: config.OutboxConfig{
+			PollInterval: 1,
+			BatchSize:    config.DefaultOutboxBatchSize,
+		},
+		Auth: config.AuthConfig{
+			Enabled:        true,
+			BootstrapToken: testBootstrapToken,
+			JWTSecret:      testJWTSecret,
+		},
+		Metrics: config.MetricsConfig{
+			Enabled: true,
+		},
+		// Квота по умолчанию не ограничена, чтобы не мешать остальным тестам
+		RateLimit: config.RateLimitConfig{
+			Enabled: true,
+			Routes: map[string]config.RateLimit{
+				"/auth/whoami": {RPS: 1, Burst: testWhoamiBurst},
+			},
+			AuthFailures: config.RateLimit{RPS: 1, Burst: testAuthFailureBurst},
+		},
+		Idempotency: config.IdempotencyConfig{
+			Enabled:         true,
+			TTL:             3600,
+			Lease:           60,
+			CleanupInterval: 60,
+		},
 	}
 
-	var err error
+	// Запросы тестов через http.DefaultClient идут от администратора, если токен не задан явно
+	http.DefaultClient.Transport = &bearerTransport{token: testBootstrapToken, next: http.DefaultTransport}
+
+	testSpans = tracetest.NewInMemoryExporter()
+	testTracerProvider = tracing.NewProvider(testSpans, "pr-reviewer-integration", 1)
+	otel.SetTracerProvider(testTracerProvider)
+	otel.SetTextMapPropagator(tracing.Propagator())
+
 	testApp, err = buildTestApp(ctx, testCfg)
 	if err != nil {
 		fmt.Printf("Failed to build test app: %v\n", err)
@@ -65,6 +140,8 @@ func TestMain(m *testing.M) {
 	if testApp != nil {
 		testApp.Shutdown()
 	}
+	_ = testTracerProvider.Shutdown(ctx)
+	_ = os.RemoveAll(sqliteDir)
 
 	os.Exit(code)
 }
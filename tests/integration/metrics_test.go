@@ -0,0 +1,49 @@
+package integration
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	health, err := http.Get(testBaseURL + "/health")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	health.Body.Close()
+
+	// /metrics требует токен роли service или admin. Запрос без токена здесь не проверяется:
+	// ответ 401 расходует общую квоту неудачных попыток аутентификации
+	member := createAPIToken(t, "metrics-member", "member")
+	forbidden := doWithToken(t, http.MethodGet, "/metrics", member.Token.Token, nil)
+	forbidden.Body.Close()
+	if forbidden.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for member, got %d", forbidden.StatusCode)
+	}
+
+	scraper := createAPIToken(t, "prometheus", "service")
+	resp := doWithToken(t, http.MethodGet, "/metrics", scraper.Token.Token, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read metrics: %v", err)
+	}
+
+	for _, want := range []string{
+		`pr_reviewer_http_requests_total{method="GET",route="/health",status="200"}`,
+		`pr_reviewer_pull_requests{status="open"}`,
+		`pr_reviewer_transaction_duration_seconds_count{outcome="commit"}`,
+		`go_sql_open_connections`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Expected %s in metrics output", want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/event"
+)
+
+// relayOutbox публикует все накопленные в outbox события
+func relayOutbox(t *testing.T) {
+	t.Helper()
+
+	for {
+		published, err := testApp.OutboxRelay.RelayPending(context.Background())
+		if err != nil {
+			t.Fatalf("Failed to relay outbox: %v", err)
+		}
+		if published < testApp.Config.Outbox.BatchSize {
+			return
+		}
+	}
+}
+
+func TestOutboxRelay(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		merged []event.Event
+	)
+	testApp.EventPublisher.Subscribe(func(_ context.Context, e event.Event) error {
+		if e.Type == entity.EventPRMerged {
+			mu.Lock()
+			merged = append(merged, e)
+			mu.Unlock()
+		}
+		return nil
+	})
+
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-outbox",
+		"members": []map[string]interface{}{
+			{"user_id": "outbox-author", "username": "Author", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prBody, _ := json.Marshal(map[string]string{
+		"pull_request_id":   "pr-outbox-1",
+		"pull_request_name": "Outbox relay",
+		"author_id":         "outbox-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	prResp.Body.Close()
+
+	mergeBody, _ := json.Marshal(map[string]string{"pull_request_id": "pr-outbox-1"})
+	for range 2 {
+		mergeResp, err := http.Post(testBaseURL+"/pullRequest/merge", "application/json", bytes.NewReader(mergeBody))
+		if err != nil {
+			t.Fatalf("Failed to merge PR: %v", err)
+		}
+		mergeResp.Body.Close()
+	}
+
+	mu.Lock()
+	before := len(merged)
+	mu.Unlock()
+	if before != 0 {
+		t.Fatalf("Expected no events before relay, got %d", before)
+	}
+
+	relayOutbox(t)
+	relayOutbox(t)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	var events []event.Event
+	for _, e := range merged {
+		var data event.PRMerged
+		if err := json.Unmarshal(e.Data.(json.RawMessage), &data); err != nil {
+			t.Fatalf("Failed to decode event data: %v", err)
+		}
+		if data.PullRequestID == "pr-outbox-1" {
+			events = append(events, e)
+		}
+	}
+
+	// Повторный мерж идемпотентен и события не создает, а опубликованное событие не публикуется снова
+	if len(events) != 1 {
+		t.Fatalf("Expected exactly 1 pr.merged event, got %d", len(events))
+	}
+	if events[0].ID == "" {
+		t.Error("Expected published event to have an ID")
+	}
+}
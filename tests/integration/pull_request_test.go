@@ -327,3 +327,478 @@ func TestCreatePRWithOnlyInactiveUsers(t *testing.T) {
 		t.Errorf("Expected 0 reviewers when only inactive users in team (besides author), got %d", len(reviewers))
 	}
 }
+
+func TestAddAndRemoveReviewer(t *testing.T) {
+	teamReq := map[string]interface{}{
+		"team_name":     "team-manual-reviewers",
+		"max_reviewers": 1,
+		"members": []map[string]interface{}{
+			{"user_id": "manual-author", "username": "Author", "is_active": true},
+			{"user_id": "manual-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "manual-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	}
+	teamBody, _ := json.Marshal(teamReq)
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-manual-1",
+		"pull_request_name": "Manual reviewers",
+		"author_id":         "manual-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.NewDecoder(prResp.Body).Decode(&created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	prResp.Body.Close()
+	if len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("Expected 1 reviewer, got %v", created.PR.AssignedReviewers)
+	}
+	assigned := created.PR.AssignedReviewers[0]
+	other := "manual-r1"
+	if assigned == other {
+		other = "manual-r2"
+	}
+
+	post := func(path string, payload map[string]interface{}) (int, ErrorResponse) {
+		body, _ := json.Marshal(payload)
+		resp, err := http.Post(testBaseURL+path, "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var errResp ErrorResponse
+		if resp.StatusCode != http.StatusOK {
+			_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		}
+		return resp.StatusCode, errResp
+	}
+
+	status, errResp := post("/pullRequest/addReviewer", map[string]interface{}{"pull_request_id": "pr-manual-1", "user_id": other})
+	if status != http.StatusConflict || errResp.Error.Code != "TOO_MANY_REVIEWERS" {
+		t.Errorf("Expected 409 TOO_MANY_REVIEWERS, got %d %s", status, errResp.Error.Code)
+	}
+
+	status, errResp = post("/pullRequest/addReviewer", map[string]interface{}{"pull_request_id": "pr-manual-1", "user_id": "manual-author"})
+	if status != http.StatusConflict || errResp.Error.Code != "AUTHOR_CANNOT_REVIEW" {
+		t.Errorf("Expected 409 AUTHOR_CANNOT_REVIEW, got %d %s", status, errResp.Error.Code)
+	}
+
+	if status, _ = post("/pullRequest/removeReviewer", map[string]interface{}{"pull_request_id": "pr-manual-1", "user_id": assigned}); status != http.StatusOK {
+		t.Fatalf("Expected status 200 on remove, got %d", status)
+	}
+
+	if status, _ = post("/pullRequest/addReviewer", map[string]interface{}{"pull_request_id": "pr-manual-1", "user_id": other}); status != http.StatusOK {
+		t.Fatalf("Expected status 200 on add, got %d", status)
+	}
+
+	if status, _ = post("/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-manual-1"}); status != http.StatusOK {
+		t.Fatalf("Expected status 200 on merge, got %d", status)
+	}
+
+	status, errResp = post("/pullRequest/removeReviewer", map[string]interface{}{"pull_request_id": "pr-manual-1", "user_id": other})
+	if status != http.StatusConflict || errResp.Error.Code != "PR_MERGED" {
+		t.Errorf("Expected 409 PR_MERGED, got %d %s", status, errResp.Error.Code)
+	}
+}
+
+func TestReassignReviewerToUser(t *testing.T) {
+	for _, team := range []map[string]interface{}{
+		{
+			"team_name":     "team-explicit-reassign",
+			"max_reviewers": 1,
+			"members": []map[string]interface{}{
+				{"user_id": "explicit-author", "username": "Author", "is_active": true},
+				{"user_id": "explicit-r1", "username": "Reviewer 1", "is_active": true},
+				{"user_id": "explicit-r2", "username": "Reviewer 2", "is_active": true},
+				{"user_id": "explicit-idle", "username": "Idle", "is_active": false},
+			},
+		},
+		{
+			"team_name": "team-explicit-outsiders",
+			"members": []map[string]interface{}{
+				{"user_id": "explicit-outsider", "username": "Outsider", "is_active": true},
+			},
+		},
+	} {
+		body, _ := json.Marshal(team)
+		resp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create team: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	prBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-explicit-1",
+		"pull_request_name": "Explicit reassign",
+		"author_id":         "explicit-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.NewDecoder(prResp.Body).Decode(&created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	prResp.Body.Close()
+	if len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("Expected 1 reviewer, got %v", created.PR.AssignedReviewers)
+	}
+	assigned := created.PR.AssignedReviewers[0]
+	other := "explicit-r1"
+	if assigned == other {
+		other = "explicit-r2"
+	}
+
+	reassign := func(newUserID string) (int, ErrorResponse) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"pull_request_id": "pr-explicit-1",
+			"old_user_id":     assigned,
+			"new_user_id":     newUserID,
+		})
+		resp, err := http.Post(testBaseURL+"/pullRequest/reassign", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var errResp ErrorResponse
+		if resp.StatusCode != http.StatusOK {
+			_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		}
+		return resp.StatusCode, errResp
+	}
+
+	rejections := []struct {
+		newUserID string
+		status    int
+		code      string
+	}{
+		{"explicit-ghost", http.StatusNotFound, "NOT_FOUND"},
+		{"explicit-idle", http.StatusConflict, "USER_INACTIVE"},
+		{"explicit-author", http.StatusConflict, "AUTHOR_CANNOT_REVIEW"},
+		{"explicit-outsider", http.StatusConflict, "TEAM_NOT_ALLOWED"},
+	}
+	for _, r := range rejections {
+		status, errResp := reassign(r.newUserID)
+		if status != r.status || errResp.Error.Code != r.code {
+			t.Errorf("new_user_id=%s: expected %d %s, got %d %s", r.newUserID, r.status, r.code, status, errResp.Error.Code)
+		}
+	}
+
+	if status, _ := reassign(other); status != http.StatusOK {
+		t.Fatalf("Expected status 200 on explicit reassign, got %d", status)
+	}
+}
+
+func TestPullRequestLifecycle(t *testing.T) {
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-lifecycle",
+		"members": []map[string]interface{}{
+			{"user_id": "lifecycle-author", "username": "Author", "is_active": true},
+			{"user_id": "lifecycle-r1", "username": "Reviewer 1", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	type prResponse struct {
+		PR struct {
+			Status            string   `json:"status"`
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+
+	post := func(path string, payload map[string]interface{}) (int, prResponse, ErrorResponse) {
+		body, _ := json.Marshal(payload)
+		resp, err := http.Post(testBaseURL+path, "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var prResp prResponse
+		var errResp ErrorResponse
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+			_ = json.NewDecoder(resp.Body).Decode(&prResp)
+		} else {
+			_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		}
+		return resp.StatusCode, prResp, errResp
+	}
+	prID := map[string]interface{}{"pull_request_id": "pr-lifecycle-1"}
+
+	status, created, _ := post("/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   "pr-lifecycle-1",
+		"pull_request_name": "Lifecycle",
+		"author_id":         "lifecycle-author",
+		"draft":             true,
+	})
+	if status != http.StatusCreated || created.PR.Status != "DRAFT" || len(created.PR.AssignedReviewers) != 0 {
+		t.Fatalf("Expected 201 DRAFT without reviewers, got %d %+v", status, created.PR)
+	}
+
+	status, _, errResp := post("/pullRequest/addReviewer", map[string]interface{}{"pull_request_id": "pr-lifecycle-1", "user_id": "lifecycle-r1"})
+	if status != http.StatusConflict || errResp.Error.Code != "PR_DRAFT" {
+		t.Errorf("Expected 409 PR_DRAFT, got %d %s", status, errResp.Error.Code)
+	}
+
+	status, ready, _ := post("/pullRequest/ready", prID)
+	if status != http.StatusOK || ready.PR.Status != "OPEN" || len(ready.PR.AssignedReviewers) != 1 {
+		t.Fatalf("Expected 200 OPEN with 1 reviewer, got %d %+v", status, ready.PR)
+	}
+
+	status, closed, _ := post("/pullRequest/close", prID)
+	if status != http.StatusOK || closed.PR.Status != "CLOSED" || len(closed.PR.AssignedReviewers) != 1 {
+		t.Fatalf("Expected 200 CLOSED with frozen reviewer, got %d %+v", status, closed.PR)
+	}
+
+	status, _, errResp = post("/pullRequest/merge", prID)
+	if status != http.StatusConflict || errResp.Error.Code != "PR_CLOSED" {
+		t.Errorf("Expected 409 PR_CLOSED, got %d %s", status, errResp.Error.Code)
+	}
+
+	status, reopened, _ := post("/pullRequest/reopen", prID)
+	if status != http.StatusOK || reopened.PR.Status != "OPEN" {
+		t.Fatalf("Expected 200 OPEN after reopen, got %d %+v", status, reopened.PR)
+	}
+
+	if status, _, _ = post("/pullRequest/merge", prID); status != http.StatusOK {
+		t.Fatalf("Expected status 200 on merge, got %d", status)
+	}
+
+	status, _, errResp = post("/pullRequest/reopen", prID)
+	if status != http.StatusConflict || errResp.Error.Code != "PR_MERGED" {
+		t.Errorf("Expected 409 PR_MERGED, got %d %s", status, errResp.Error.Code)
+	}
+}
+
+func TestSubmitReview(t *testing.T) {
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-review-state",
+		"members": []map[string]interface{}{
+			{"user_id": "review-state-author", "username": "Author", "is_active": true},
+			{"user_id": "review-state-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "review-state-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-review-state-1",
+		"pull_request_name": "Review state",
+		"author_id":         "review-state-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	prResp.Body.Close()
+
+	type reviewResponse struct {
+		PR struct {
+			ReviewDecision string `json:"review_decision"`
+			Reviews        []struct {
+				UserID string `json:"user_id"`
+				State  string `json:"state"`
+			} `json:"reviews"`
+		} `json:"pr"`
+	}
+
+	submit := func(userID, state string) (int, reviewResponse, ErrorResponse) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"pull_request_id": "pr-review-state-1",
+			"user_id":         userID,
+			"state":           state,
+		})
+		resp, err := http.Post(testBaseURL+"/pullRequest/review", "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var result reviewResponse
+		var errResp ErrorResponse
+		if resp.StatusCode == http.StatusOK {
+			_ = json.NewDecoder(resp.Body).Decode(&result)
+		} else {
+			_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		}
+		return resp.StatusCode, result, errResp
+	}
+
+	awaiting := func(userID string) int {
+		resp, err := http.Get(testBaseURL + "/users/getReview?awaiting=true&user_id=" + userID)
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var result struct {
+			PullRequests []interface{} `json:"pull_requests"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&result)
+		return len(result.PullRequests)
+	}
+
+	status, _, errResp := submit("review-state-author", "APPROVED")
+	if status != http.StatusConflict || errResp.Error.Code != "NOT_ASSIGNED" {
+		t.Errorf("Expected 409 NOT_ASSIGNED for author, got %d %s", status, errResp.Error.Code)
+	}
+
+	status, result, _ := submit("review-state-r1", "COMMENTED")
+	if status != http.StatusOK || result.PR.ReviewDecision != "REVIEW_REQUIRED" {
+		t.Fatalf("Expected 200 REVIEW_REQUIRED, got %d %+v", status, result.PR)
+	}
+	if awaiting("review-state-r1") != 1 {
+		t.Error("Expected PR to still await review after COMMENTED")
+	}
+
+	status, result, _ = submit("review-state-r1", "APPROVED")
+	if status != http.StatusOK || result.PR.ReviewDecision != "REVIEW_REQUIRED" {
+		t.Fatalf("Expected 200 REVIEW_REQUIRED, got %d %+v", status, result.PR)
+	}
+	if awaiting("review-state-r1") != 0 {
+		t.Error("Expected approved PR to leave the awaiting list")
+	}
+
+	status, result, _ = submit("review-state-r2", "APPROVED")
+	if status != http.StatusOK || result.PR.ReviewDecision != "APPROVED" {
+		t.Fatalf("Expected 200 APPROVED, got %d %+v", status, result.PR)
+	}
+	for _, review := range result.PR.Reviews {
+		if review.State != "APPROVED" {
+			t.Errorf("Expected all reviews APPROVED, got %+v", result.PR.Reviews)
+		}
+	}
+}
+
+func TestMergeGate(t *testing.T) {
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-merge-gate",
+		"members": []map[string]interface{}{
+			{"user_id": "gate-author", "username": "Author", "is_active": true},
+			{"user_id": "gate-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "gate-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	updateBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-merge-gate",
+		"merge_policy": map[string]interface{}{
+			"required_approvals":         2,
+			"block_on_changes_requested": true,
+		},
+	})
+	updateResp, err := http.Post(testBaseURL+"/team/update", "application/json", bytes.NewReader(updateBody))
+	if err != nil {
+		t.Fatalf("Failed to update team: %v", err)
+	}
+	updateResp.Body.Close()
+	if updateResp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200 on team update, got %d", updateResp.StatusCode)
+	}
+
+	type prResponse struct {
+		PR struct {
+			Status      string `json:"status"`
+			MergeForced bool   `json:"merge_forced"`
+		} `json:"pr"`
+	}
+
+	post := func(path string, payload map[string]interface{}) (int, prResponse, ErrorResponse) {
+		body, _ := json.Marshal(payload)
+		resp, err := http.Post(testBaseURL+path, "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to make request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var prResp prResponse
+		var errResp ErrorResponse
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+			_ = json.NewDecoder(resp.Body).Decode(&prResp)
+		} else {
+			_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		}
+		return resp.StatusCode, prResp, errResp
+	}
+
+	for _, prID := range []string{"pr-gate-1", "pr-gate-2"} {
+		if status, _, _ := post("/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   prID,
+			"pull_request_name": "Merge gate",
+			"author_id":         "gate-author",
+		}); status != http.StatusCreated {
+			t.Fatalf("Failed to create PR %s: status %d", prID, status)
+		}
+	}
+
+	review := func(prID, userID, state string) {
+		if status, _, errResp := post("/pullRequest/review", map[string]interface{}{
+			"pull_request_id": prID,
+			"user_id":         userID,
+			"state":           state,
+		}); status != http.StatusOK {
+			t.Fatalf("Failed to submit review: %d %s", status, errResp.Error.Code)
+		}
+	}
+
+	review("pr-gate-1", "gate-r1", "APPROVED")
+	review("pr-gate-1", "gate-r2", "CHANGES_REQUESTED")
+
+	status, _, errResp := post("/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-gate-1"})
+	if status != http.StatusConflict || errResp.Error.Code != "MERGE_BLOCKED" {
+		t.Fatalf("Expected 409 MERGE_BLOCKED, got %d %s", status, errResp.Error.Code)
+	}
+	if len(errResp.Error.Details) != 2 {
+		t.Errorf("Expected 2 unmet conditions, got %v", errResp.Error.Details)
+	}
+
+	review("pr-gate-1", "gate-r2", "APPROVED")
+	status, merged, _ := post("/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-gate-1"})
+	if status != http.StatusOK || merged.PR.Status != "MERGED" || merged.PR.MergeForced {
+		t.Fatalf("Expected 200 MERGED without force, got %d %+v", status, merged.PR)
+	}
+
+	status, forced, _ := post("/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-gate-2", "force": true})
+	if status != http.StatusOK || forced.PR.Status != "MERGED" || !forced.PR.MergeForced {
+		t.Fatalf("Expected 200 MERGED with merge_forced, got %d %+v", status, forced.PR)
+	}
+
+	status, again, _ := post("/pullRequest/merge", map[string]interface{}{"pull_request_id": "pr-gate-2"})
+	if status != http.StatusOK || !again.PR.MergeForced {
+		t.Errorf("Expected idempotent merge to keep merge_forced, got %d %+v", status, again.PR)
+	}
+}
@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// testWhoamiBurst сколько запросов /auth/whoami субъект может сделать подряд
+const testWhoamiBurst = 3
+
+// testAuthFailureBurst сколько неудачных попыток аутентификации IP адрес может сделать подряд
+const testAuthFailureBurst = 5
+
+func TestRateLimit(t *testing.T) {
+	limited := createAPIToken(t, "rate-limited-client", "member")
+	other := createAPIToken(t, "rate-limit-neighbour", "member")
+
+	for i := 0; i < testWhoamiBurst; i++ {
+		resp := doWithToken(t, http.MethodGet, "/auth/whoami", limited.Token.Token, nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Request %d within burst: expected status 200, got %d", i+1, resp.StatusCode)
+		}
+	}
+
+	resp := doWithToken(t, http.MethodGet, "/auth/whoami", limited.Token.Token, nil)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429 over burst, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Retry-After") != "1" {
+		t.Errorf("Expected Retry-After 1, got %q", resp.Header.Get("Retry-After"))
+	}
+
+	var errResp ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if errResp.Error.Code != "RATE_LIMITED" {
+		t.Errorf("Expected error code RATE_LIMITED, got %s", errResp.Error.Code)
+	}
+
+	// Квота считается по субъекту: другой клиент не затронут
+	neighbour := doWithToken(t, http.MethodGet, "/auth/whoami", other.Token.Token, nil)
+	neighbour.Body.Close()
+	if neighbour.StatusCode != http.StatusOK {
+		t.Errorf("Expected another principal to pass, got %d", neighbour.StatusCode)
+	}
+
+	// Пробы не ограничиваются
+	for i := 0; i < testWhoamiBurst+1; i++ {
+		probe := doWithToken(t, http.MethodGet, "/livez", "", nil)
+		probe.Body.Close()
+		if probe.StatusCode != http.StatusOK {
+			t.Fatalf("Expected /livez to be exempt, got %d", probe.StatusCode)
+		}
+	}
+}
+
+// doFromIP отправляет запрос от имени адреса ip: сервер учитывает X-Forwarded-For
+func doFromIP(t *testing.T, ip, token string) *http.Response {
+	t.Helper()
+
+	req, _ := http.NewRequest(http.MethodGet, testBaseURL+"/auth/whoami", nil)
+	req.Header.Set("X-Forwarded-For", ip)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Failed to send request: %v", err)
+	}
+	return resp
+}
+
+func TestRateLimitAuthFailures(t *testing.T) {
+	const attacker = "203.0.113.7"
+
+	var limited *http.Response
+	// Корзина пополняется раз в секунду, поэтому попыток с запасом
+	for i := 0; i < testAuthFailureBurst*2; i++ {
+		resp := doFromIP(t, attacker, "forged-token")
+		if resp.StatusCode == http.StatusTooManyRequests && i >= testAuthFailureBurst {
+			limited = resp
+			break
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("Attempt %d with bad token: expected status 401, got %d", i+1, resp.StatusCode)
+		}
+	}
+	if limited == nil {
+		t.Fatalf("Expected status 429 after %d bad tokens", testAuthFailureBurst*2)
+	}
+	defer limited.Body.Close()
+
+	if limited.Header.Get("Retry-After") == "" {
+		t.Error("Expected Retry-After header")
+	}
+	var errResp ErrorResponse
+	if err := json.NewDecoder(limited.Body).Decode(&errResp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if errResp.Error.Code != "RATE_LIMITED" {
+		t.Errorf("Expected error code RATE_LIMITED, got %s", errResp.Error.Code)
+	}
+
+	// Запросы без токена тоже упираются в лимит адреса
+	missing := doFromIP(t, attacker, "")
+	missing.Body.Close()
+	if missing.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected request without token to be throttled, got %d", missing.StatusCode)
+	}
+
+	// Лимит считается по адресу: с другого адреса верный токен проходит
+	other := doFromIP(t, "203.0.113.8", testBootstrapToken)
+	other.Body.Close()
+	if other.StatusCode != http.StatusOK {
+		t.Errorf("Expected another address to pass, got %d", other.StatusCode)
+	}
+}
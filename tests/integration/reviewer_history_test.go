@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type reviewerHistoryResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	History       []struct {
+		UserID string `json:"user_id"`
+		Action string `json:"action"`
+		Reason string `json:"reason"`
+	} `json:"history"`
+}
+
+func TestReviewerHistory(t *testing.T) {
+	teamReq := map[string]interface{}{
+		"team_name": "team-history-test",
+		"members": []map[string]interface{}{
+			{"user_id": "user-history-1", "username": "User History 1", "is_active": true},
+			{"user_id": "user-history-2", "username": "User History 2", "is_active": true},
+			{"user_id": "user-history-3", "username": "User History 3", "is_active": true},
+			{"user_id": "user-history-4", "username": "User History 4", "is_active": true},
+		},
+	}
+	teamBody, _ := json.Marshal(teamReq)
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-history-1",
+		"pull_request_name": "Test PR History",
+		"author_id":         "user-history-1",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	defer prResp.Body.Close()
+	if prResp.StatusCode != http.StatusCreated {
+		t.Fatalf("Failed to create PR: status %d", prResp.StatusCode)
+	}
+
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.NewDecoder(prResp.Body).Decode(&created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(created.PR.AssignedReviewers) != 2 {
+		t.Fatalf("Expected 2 assigned reviewers, got %v", created.PR.AssignedReviewers)
+	}
+	replaced := created.PR.AssignedReviewers[0]
+
+	reassignBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id": "pr-history-1",
+		"old_user_id":     replaced,
+	})
+	reassignResp, err := http.Post(testBaseURL+"/pullRequest/reassign", "application/json", bytes.NewReader(reassignBody))
+	if err != nil {
+		t.Fatalf("Failed to reassign reviewer: %v", err)
+	}
+	reassignResp.Body.Close()
+	if reassignResp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200 on reassign, got %d", reassignResp.StatusCode)
+	}
+
+	resp, err := http.Get(testBaseURL + "/pullRequest/history?pull_request_id=pr-history-1")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var history reviewerHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&history); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	expected := []struct{ action, reason string }{
+		{"assigned", "auto"},
+		{"assigned", "auto"},
+		{"unassigned", "reassign"},
+		{"assigned", "reassign"},
+	}
+	if len(history.History) != len(expected) {
+		t.Fatalf("Expected %d history entries, got %+v", len(expected), history.History)
+	}
+	for i, want := range expected {
+		if history.History[i].Action != want.action || history.History[i].Reason != want.reason {
+			t.Errorf("Entry %d: expected %s/%s, got %+v", i, want.action, want.reason, history.History[i])
+		}
+	}
+	if history.History[2].UserID != replaced {
+		t.Errorf("Expected %s to be unassigned, got %s", replaced, history.History[2].UserID)
+	}
+
+	statsResp, err := http.Get(testBaseURL + "/statistics?team_name=team-history-test")
+	if err != nil {
+		t.Fatalf("Failed to get statistics: %v", err)
+	}
+	defer statsResp.Body.Close()
+
+	var stats struct {
+		UserStats []struct {
+			UserID        string `json:"user_id"`
+			TotalReviews  int    `json:"total_reviews"`
+			ActiveReviews int    `json:"active_reviews"`
+		} `json:"user_stats"`
+	}
+	if err := json.NewDecoder(statsResp.Body).Decode(&stats); err != nil {
+		t.Fatalf("Failed to decode statistics: %v", err)
+	}
+
+	for _, userStats := range stats.UserStats {
+		if userStats.UserID != replaced {
+			continue
+		}
+		if userStats.TotalReviews != 1 || userStats.ActiveReviews != 0 {
+			t.Errorf("Expected replaced reviewer to keep total_reviews 1 with no active reviews, got %+v", userStats)
+		}
+		return
+	}
+	t.Errorf("Expected statistics for %s", replaced)
+}
+
+func TestReviewerHistoryPRNotFound(t *testing.T) {
+	resp, err := http.Get(testBaseURL + "/pullRequest/history?pull_request_id=pr-history-missing")
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	}
+}
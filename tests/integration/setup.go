@@ -3,19 +3,21 @@ package integration
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/exPriceD/pr-reviewer-service/internal/app"
 	httpDelivery "github.com/exPriceD/pr-reviewer-service/internal/delivery/http"
 	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/handler"
+	"github.com/exPriceD/pr-reviewer-service/internal/delivery/http/middleware"
+	"github.com/exPriceD/pr-reviewer-service/internal/domain/entity"
 	"github.com/exPriceD/pr-reviewer-service/internal/domain/logger"
-	"github.com/exPriceD/pr-reviewer-service/internal/domain/transaction"
 	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/config"
 	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database"
-	prRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/pull_request"
-	teamRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/team"
-	userRepo "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/user"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/sqlite"
 	infraLogger "github.com/exPriceD/pr-reviewer-service/internal/infrastructure/logger"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/metrics"
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/notifier"
 	"github.com/exPriceD/pr-reviewer-service/internal/usecase"
 )
 
@@ -41,64 +43,135 @@ func createTestDB(ctx context.Context, cfg config.DatabaseConfig) (*database.Pos
 	return db, nil
 }
 
-func createTestTxManager(db *database.PostgresDB) transaction.Manager {
-	return database.NewTransactionManager(db)
-}
-
-type testRepositories struct {
-	UserRepo *userRepo.Repository
-	TeamRepo *teamRepo.Repository
-	PRRepo   *prRepo.Repository
-}
+// createTestStorage создает хранилище по storage.driver: PostgreSQL или SQLite
+func createTestStorage(ctx context.Context, cfg *config.Config) (*app.Storage, error) {
+	if cfg.Storage.Driver == config.StorageDriverSQLite {
+		db, err := sqlite.NewSQLiteDB(cfg.SQLite)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open test sqlite database: %w", err)
+		}
+		return app.NewSQLiteStorage(db), nil
+	}
 
-func createTestRepositories(db *database.PostgresDB) testRepositories {
-	return testRepositories{
-		UserRepo: userRepo.NewRepository(db.DB(), db.Getter()),
-		TeamRepo: teamRepo.NewRepository(db.DB(), db.Getter()),
-		PRRepo:   prRepo.NewRepository(db.DB(), db.Getter()),
+	db, err := createTestDB(ctx, cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to test database: %w", err)
 	}
+	return app.NewPostgresStorage(db), nil
 }
 
 type testUseCases struct {
-	UserUseCase        *usecase.UserUseCase
-	TeamUseCase        *usecase.TeamUseCase
-	PullRequestUseCase *usecase.PullRequestUseCase
-	StatisticsUseCase  *usecase.StatisticsUseCase
+	UserUseCase         *usecase.UserUseCase
+	TeamUseCase         *usecase.TeamUseCase
+	PullRequestUseCase  *usecase.PullRequestUseCase
+	StatisticsUseCase   *usecase.StatisticsUseCase
+	WebhookUseCase      *usecase.WebhookUseCase
+	SubscriptionUseCase *usecase.SubscriptionUseCase
+	AuthUseCase         *usecase.AuthUseCase
+	AuditUseCase        *usecase.AuditUseCase
+	HealthUseCase       *usecase.HealthUseCase
+	IdempotencyUseCase  *usecase.IdempotencyUseCase
+	EventPublisher      *notifier.InProcessPublisher
+	OutboxRelay         *usecase.OutboxRelay
+	EventDeliverer      *usecase.EventDeliverer
 }
 
-func createTestUseCases(txManager transaction.Manager, repos testRepositories, log logger.Logger) testUseCases {
-	reviewerSelector := usecase.NewReviewerSelector(repos.UserRepo, repos.PRRepo)
+func createTestUseCases(storage *app.Storage, cfg *config.Config, appMetrics *metrics.Metrics, log logger.Logger) (testUseCases, error) {
+	reviewerSelector := usecase.NewReviewerSelector(
+		storage.UserRepository,
+		storage.PullRequestRepository,
+		storage.TeamRepository,
+		usecase.NewReviewerStrategies(storage.ReviewerCursorRepository),
+		entity.ReviewerStrategyName(cfg.Reviewer.Strategy),
+	)
 
-	return testUseCases{
-		UserUseCase:        usecase.NewUserUseCase(repos.UserRepo, repos.PRRepo, log),
-		TeamUseCase:        usecase.NewTeamUseCase(txManager, repos.TeamRepo, repos.UserRepo, log),
-		PullRequestUseCase: usecase.NewPullRequestUseCase(txManager, repos.PRRepo, repos.UserRepo, reviewerSelector, log),
-		StatisticsUseCase:  usecase.NewStatisticsUseCase(repos.PRRepo, repos.UserRepo, log),
+	eventOutbox := usecase.NewEventOutbox(storage.OutboxRepository)
+	auditTrail := usecase.NewAuditTrail(storage.AuditRepository, app.AuditContext)
+	eventNotifier := usecase.NewEventNotifier(storage.SubscriptionRepository, storage.EventDeliveryRepository, log)
+	eventPublisher := notifier.NewInProcessPublisher(eventNotifier.Publish)
+	pullRequestUseCase := usecase.NewPullRequestUseCase(storage.TxManager, storage.PullRequestRepository, storage.ReviewerHistoryRepository, storage.UserRepository, storage.TeamRepository, reviewerSelector, eventOutbox, auditTrail, appMetrics, log)
+
+	tokenVerifier, err := app.NewTokenVerifier(cfg.Auth)
+	if err != nil {
+		return testUseCases{}, err
 	}
+
+	readinessChecks, err := app.NewReadinessChecks(storage)
+	if err != nil {
+		return testUseCases{}, err
+	}
+
+	return testUseCases{
+		UserUseCase:         usecase.NewUserUseCase(storage.TxManager, storage.UserRepository, storage.PullRequestRepository, storage.ReviewerHistoryRepository, reviewerSelector, eventOutbox, auditTrail, log),
+		TeamUseCase:         usecase.NewTeamUseCase(storage.TxManager, storage.TeamRepository, storage.UserRepository, storage.PullRequestRepository, storage.ReviewerHistoryRepository, reviewerSelector, eventOutbox, auditTrail, log),
+		PullRequestUseCase:  pullRequestUseCase,
+		StatisticsUseCase:   usecase.NewStatisticsUseCase(storage.PullRequestRepository, storage.ReviewerHistoryRepository, storage.UserRepository, log),
+		WebhookUseCase:      usecase.NewWebhookUseCase(storage.TxManager, storage.WebhookRepository, storage.UserRepository, pullRequestUseCase, auditTrail, log),
+		SubscriptionUseCase: usecase.NewSubscriptionUseCase(storage.TxManager, storage.SubscriptionRepository, storage.EventDeliveryRepository, auditTrail, log),
+		AuthUseCase: usecase.NewAuthUseCase(storage.TxManager, storage.APITokenRepository, tokenVerifier, usecase.AuthSettings{
+			BootstrapToken: cfg.Auth.BootstrapToken,
+		}, auditTrail, log),
+		AuditUseCase:       usecase.NewAuditUseCase(storage.AuditRepository, log),
+		HealthUseCase:      usecase.NewHealthUseCase(readinessChecks, time.Duration(cfg.Server.ReadinessTimeout)*time.Second, log),
+		IdempotencyUseCase: usecase.NewIdempotencyUseCase(storage.IdempotencyRepository, app.NewIdempotencySettings(cfg.Idempotency), log),
+		EventPublisher:     eventPublisher,
+		OutboxRelay:        usecase.NewOutboxRelay(storage.TxManager, storage.OutboxRepository, eventPublisher, app.NewOutboxRelaySettings(cfg.Outbox), log),
+		EventDeliverer: usecase.NewEventDeliverer(
+			storage.TxManager,
+			storage.SubscriptionRepository,
+			storage.EventDeliveryRepository,
+			notifier.NewHTTPSender(&http.Client{}),
+			app.NewEventDeliverySettings(cfg.Notifications),
+			log,
+		),
+	}, nil
 }
 
 type testHandlers struct {
-	TeamHandler        *handler.TeamHandler
-	UserHandler        *handler.UserHandler
-	PullRequestHandler *handler.PullRequestHandler
-	StatisticsHandler  *handler.StatisticsHandler
+	TeamHandler         *handler.TeamHandler
+	UserHandler         *handler.UserHandler
+	PullRequestHandler  *handler.PullRequestHandler
+	StatisticsHandler   *handler.StatisticsHandler
+	WebhookHandler      *handler.WebhookHandler
+	SubscriptionHandler *handler.SubscriptionHandler
+	AuthHandler         *handler.AuthHandler
+	AuditHandler        *handler.AuditHandler
+	HealthHandler       *handler.HealthHandler
 }
 
-func createTestHandlers(useCases testUseCases) testHandlers {
+func createTestHandlers(useCases testUseCases, webhooksCfg config.WebhooksConfig) testHandlers {
 	return testHandlers{
 		TeamHandler:        handler.NewTeamHandler(useCases.TeamUseCase),
 		UserHandler:        handler.NewUserHandler(useCases.UserUseCase),
 		PullRequestHandler: handler.NewPullRequestHandler(useCases.PullRequestUseCase),
 		StatisticsHandler:  handler.NewStatisticsHandler(useCases.StatisticsUseCase),
+		WebhookHandler: handler.NewWebhookHandler(useCases.WebhookUseCase, handler.WebhookSecrets{
+			GitHub: webhooksCfg.GitHubSecret,
+			GitLab: webhooksCfg.GitLabSecret,
+		}),
+		SubscriptionHandler: handler.NewSubscriptionHandler(useCases.SubscriptionUseCase),
+		AuthHandler:         handler.NewAuthHandler(useCases.AuthUseCase),
+		AuditHandler:        handler.NewAuditHandler(useCases.AuditUseCase),
+		HealthHandler:       handler.NewHealthHandler(useCases.HealthUseCase),
 	}
 }
 
-func createTestRouter(handlers testHandlers, log logger.Logger, maxBodySize int64) *httpDelivery.Router {
+func createTestRouter(handlers testHandlers, authenticator middleware.Authenticator, routerMetrics httpDelivery.Metrics, rateLimiter middleware.RateLimiter, authFailureLimiter middleware.AuthFailureLimiter, idempotency middleware.IdempotencyStore, log logger.Logger, maxBodySize int64) *httpDelivery.Router {
 	return httpDelivery.NewRouter(
 		handlers.TeamHandler,
 		handlers.UserHandler,
 		handlers.PullRequestHandler,
 		handlers.StatisticsHandler,
+		handlers.WebhookHandler,
+		handlers.SubscriptionHandler,
+		handlers.AuthHandler,
+		handlers.AuditHandler,
+		handlers.HealthHandler,
+		authenticator,
+		routerMetrics,
+		rateLimiter,
+		authFailureLimiter,
+		idempotency,
 		log,
 		maxBodySize,
 	)
@@ -114,30 +187,64 @@ func buildTestApp(ctx context.Context, cfg *config.Config) (*app.App, error) {
 		Format: cfg.Logger.Format,
 	})
 
-	db, err := createTestDB(ctx, cfg.Database)
+	storage, err := createTestStorage(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to test database: %w", err)
+		return nil, err
+	}
+	if err := app.PrepareSchema(storage, cfg.Storage, log); err != nil {
+		return nil, fmt.Errorf("failed to prepare test database schema: %w", err)
 	}
 
-	txManager := createTestTxManager(db)
-	repos := createTestRepositories(db)
-	useCases := createTestUseCases(txManager, repos, log)
-	handlers := createTestHandlers(useCases)
-	router := createTestRouter(handlers, log, int64(cfg.Server.MaxBodySize))
+	appMetrics := metrics.New()
+	var routerMetrics httpDelivery.Metrics
+	if cfg.Metrics.Enabled {
+		if err := app.InstrumentStorage(appMetrics, storage, cfg.Storage.Driver); err != nil {
+			return nil, fmt.Errorf("failed to register test metrics: %w", err)
+		}
+		routerMetrics = appMetrics
+	}
+
+	useCases, err := createTestUseCases(storage, cfg, appMetrics, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test use cases: %w", err)
+	}
+	handlers := createTestHandlers(useCases, cfg.Webhooks)
+
+	var authenticator middleware.Authenticator
+	if cfg.Auth.Enabled {
+		authenticator = useCases.AuthUseCase
+	}
+	var rateLimiter middleware.RateLimiter
+	var authFailureLimiter middleware.AuthFailureLimiter
+	if cfg.RateLimit.Enabled {
+		rateLimiter = app.NewRateLimiter(cfg.RateLimit)
+		authFailureLimiter = app.NewAuthFailureLimiter(cfg.RateLimit)
+	}
+	var idempotency middleware.IdempotencyStore
+	if cfg.Idempotency.Enabled {
+		idempotency = useCases.IdempotencyUseCase
+	}
+	router := createTestRouter(handlers, authenticator, routerMetrics, rateLimiter, authFailureLimiter, idempotency, log, int64(cfg.Server.MaxBodySize))
 	httpServer := createTestHTTPServer(cfg.Server, router)
 
 	return &app.App{
-		Config:                cfg,
-		Logger:                log,
-		DB:                    db,
-		TxManager:             txManager,
-		UserRepository:        repos.UserRepo,
-		TeamRepository:        repos.TeamRepo,
-		PullRequestRepository: repos.PRRepo,
-		UserUseCase:           useCases.UserUseCase,
-		TeamUseCase:           useCases.TeamUseCase,
-		PullRequestUseCase:    useCases.PullRequestUseCase,
-		StatisticsUseCase:     useCases.StatisticsUseCase,
-		HTTPServer:            httpServer,
+		Config:              cfg,
+		Logger:              log,
+		Storage:             storage,
+		Metrics:             appMetrics,
+		UserUseCase:         useCases.UserUseCase,
+		TeamUseCase:         useCases.TeamUseCase,
+		PullRequestUseCase:  useCases.PullRequestUseCase,
+		StatisticsUseCase:   useCases.StatisticsUseCase,
+		WebhookUseCase:      useCases.WebhookUseCase,
+		SubscriptionUseCase: useCases.SubscriptionUseCase,
+		AuthUseCase:         useCases.AuthUseCase,
+		AuditUseCase:        useCases.AuditUseCase,
+		HealthUseCase:       useCases.HealthUseCase,
+		IdempotencyUseCase:  useCases.IdempotencyUseCase,
+		EventPublisher:      useCases.EventPublisher,
+		OutboxRelay:         useCases.OutboxRelay,
+		EventDeliverer:      useCases.EventDeliverer,
+		HTTPServer:          httpServer,
 	}, nil
 }
@@ -0,0 +1,19 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/exPriceD/pr-reviewer-service/internal/infrastructure/database/storagetest"
+)
+
+func TestStorageContract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Backend {
+		return storagetest.Backend{
+			TxManager:    testApp.Storage.TxManager,
+			Users:        testApp.Storage.UserRepository,
+			Teams:        testApp.Storage.TeamRepository,
+			PullRequests: testApp.Storage.PullRequestRepository,
+			Idempotency:  testApp.Storage.IdempotencyRepository,
+		}
+	})
+}
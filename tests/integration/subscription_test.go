@@ -0,0 +1,226 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type subscriptionResponse struct {
+	Subscription struct {
+		SubscriptionID string   `json:"subscription_id"`
+		URL            string   `json:"url"`
+		EventTypes     []string `json:"event_types"`
+		Secret         string   `json:"secret"`
+	} `json:"subscription"`
+}
+
+type receivedEvent struct {
+	Event     string
+	Signature string
+	Body      []byte
+}
+
+// eventReceiver тестовый подписчик, запоминающий полученные доставки
+type eventReceiver struct {
+	mu     sync.Mutex
+	events []receivedEvent
+	status int
+}
+
+func (r *eventReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, receivedEvent{
+		Event:     req.Header.Get("X-PR-Reviewer-Event"),
+		Signature: req.Header.Get("X-PR-Reviewer-Signature"),
+		Body:      body,
+	})
+	w.WriteHeader(r.status)
+}
+
+func (r *eventReceiver) received() []receivedEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedEvent(nil), r.events...)
+}
+
+func createSubscription(t *testing.T, url string, eventTypes []string) subscriptionResponse {
+	t.Helper()
+
+	// События предыдущих тестов публикуются до создания подписки и до нее не доходят
+	relayOutbox(t)
+
+	body, _ := json.Marshal(map[string]interface{}{"url": url, "event_types": eventTypes})
+	resp, err := http.Post(testBaseURL+"/subscriptions/create", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create subscription: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", resp.StatusCode)
+	}
+
+	var result subscriptionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	t.Cleanup(func() {
+		deleteBody, _ := json.Marshal(map[string]string{"subscription_id": result.Subscription.SubscriptionID})
+		deleteResp, err := http.Post(testBaseURL+"/subscriptions/delete", "application/json", bytes.NewReader(deleteBody))
+		if err == nil {
+			deleteResp.Body.Close()
+		}
+	})
+
+	return result
+}
+
+func TestSubscriptionDelivery(t *testing.T) {
+	receiver := &eventReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription := createSubscription(t, server.URL, []string{"reviewer.assigned", "pr.merged"})
+	if len(subscription.Subscription.Secret) == 0 {
+		t.Fatal("Expected generated secret in create response")
+	}
+
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-subscriptions",
+		"members": []map[string]interface{}{
+			{"user_id": "sub-author", "username": "Author", "is_active": true},
+			{"user_id": "sub-r1", "username": "Reviewer", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prBody, _ := json.Marshal(map[string]string{
+		"pull_request_id":   "pr-subscriptions-1",
+		"pull_request_name": "Subscription delivery",
+		"author_id":         "sub-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	prResp.Body.Close()
+	if prResp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201 on PR create, got %d", prResp.StatusCode)
+	}
+
+	relayOutbox(t)
+	if _, err := testApp.EventDeliverer.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("Failed to deliver events: %v", err)
+	}
+
+	events := receiver.received()
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 delivered event, got %d", len(events))
+	}
+	if events[0].Event != "reviewer.assigned" {
+		t.Errorf("Expected reviewer.assigned event, got %s", events[0].Event)
+	}
+
+	mac := hmac.New(sha256.New, []byte(subscription.Subscription.Secret))
+	mac.Write(events[0].Body)
+	if expected := "sha256=" + hex.EncodeToString(mac.Sum(nil)); events[0].Signature != expected {
+		t.Errorf("Expected signature %s, got %s", expected, events[0].Signature)
+	}
+
+	var envelope struct {
+		Type string `json:"type"`
+		Data struct {
+			PullRequestID string   `json:"pull_request_id"`
+			ReviewerIDs   []string `json:"reviewer_ids"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(events[0].Body, &envelope); err != nil {
+		t.Fatalf("Failed to decode event body: %v", err)
+	}
+	if envelope.Data.PullRequestID != "pr-subscriptions-1" {
+		t.Errorf("Expected pull_request_id pr-subscriptions-1, got %s", envelope.Data.PullRequestID)
+	}
+
+	if _, err := testApp.EventDeliverer.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("Failed to deliver events: %v", err)
+	}
+	if len(receiver.received()) != 1 {
+		t.Error("Expected delivered event not to be sent again")
+	}
+}
+
+func TestSubscriptionDeadLetter(t *testing.T) {
+	receiver := &eventReceiver{status: http.StatusInternalServerError}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription := createSubscription(t, server.URL, []string{"user.deactivated"})
+
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-dead-letters",
+		"members": []map[string]interface{}{
+			{"user_id": "dl-user", "username": "User", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	userBody, _ := json.Marshal(map[string]interface{}{"user_id": "dl-user", "is_active": false})
+	userResp, err := http.Post(testBaseURL+"/users/setIsActive", "application/json", bytes.NewReader(userBody))
+	if err != nil {
+		t.Fatalf("Failed to deactivate user: %v", err)
+	}
+	userResp.Body.Close()
+
+	// Тестовая конфигурация допускает одну попытку: первая же ошибка переносит доставку в dead letter
+	relayOutbox(t)
+	if _, err := testApp.EventDeliverer.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("Failed to deliver events: %v", err)
+	}
+	if len(receiver.received()) != 1 {
+		t.Fatalf("Expected 1 delivery attempt, got %d", len(receiver.received()))
+	}
+
+	resp, err := http.Get(testBaseURL + "/subscriptions/deadLetters?subscription_id=" + subscription.Subscription.SubscriptionID)
+	if err != nil {
+		t.Fatalf("Failed to get dead letters: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		DeadLetters []struct {
+			EventType string `json:"event_type"`
+			Attempts  int    `json:"attempts"`
+			LastError string `json:"last_error"`
+		} `json:"dead_letters"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(result.DeadLetters) != 1 {
+		t.Fatalf("Expected 1 dead letter, got %d", len(result.DeadLetters))
+	}
+	if result.DeadLetters[0].EventType != "user.deactivated" || result.DeadLetters[0].Attempts != 1 {
+		t.Errorf("Unexpected dead letter: %+v", result.DeadLetters[0])
+	}
+}
@@ -125,3 +125,216 @@ func TestDeactivateTeamMembers(t *testing.T) {
 		t.Errorf("Expected status 200 or 404, got %d", resp.StatusCode)
 	}
 }
+
+func TestDeactivateTeamMembersReassignsOpenReviews(t *testing.T) {
+	teamA := map[string]interface{}{
+		"team_name": "team-deact-a",
+		"members": []map[string]interface{}{
+			{"user_id": "deact-author", "username": "Deact Author", "is_active": true},
+			{"user_id": "deact-a-rev1", "username": "Deact Rev 1", "is_active": true},
+			{"user_id": "deact-a-rev2", "username": "Deact Rev 2", "is_active": true},
+			{"user_id": "deact-z-backup", "username": "Deact Backup", "is_active": true},
+		},
+	}
+	teamABody, _ := json.Marshal(teamA)
+	teamAResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamABody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamAResp.Body.Close()
+
+	prReq := map[string]interface{}{
+		"pull_request_id":   "pr-deact-1",
+		"pull_request_name": "Deactivation PR",
+		"author_id":         "deact-author",
+	}
+	prBody, _ := json.Marshal(prReq)
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	prResp.Body.Close()
+
+	teamB := map[string]interface{}{
+		"team_name": "team-deact-b",
+		"members": []map[string]interface{}{
+			{"user_id": "deact-a-rev1", "username": "Deact Rev 1", "is_active": true},
+		},
+	}
+	teamBBody, _ := json.Marshal(teamB)
+	teamBResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamBResp.Body.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{"team_name": "team-deact-b"})
+	resp, err := http.Post(testBaseURL+"/team/deactivateMembers", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Reassignments []struct {
+			PullRequestID string  `json:"pull_request_id"`
+			OldUserID     string  `json:"old_user_id"`
+			NewUserID     *string `json:"new_user_id"`
+		} `json:"reassignments"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if len(result.Reassignments) != 1 {
+		t.Fatalf("Expected 1 reassignment, got %d", len(result.Reassignments))
+	}
+
+	moved := result.Reassignments[0]
+	if moved.PullRequestID != "pr-deact-1" || moved.OldUserID != "deact-a-rev1" {
+		t.Errorf("Unexpected reassignment: %+v", moved)
+	}
+	if moved.NewUserID == nil || *moved.NewUserID != "deact-z-backup" {
+		t.Errorf("Expected slot moved to 'deact-z-backup', got %v", moved.NewUserID)
+	}
+}
+
+func TestTeamReviewerLimits(t *testing.T) {
+	teamReq := map[string]interface{}{
+		"team_name":     "team-limits",
+		"max_reviewers": 1,
+		"members": []map[string]interface{}{
+			{"user_id": "limits-author", "username": "Author", "is_active": true},
+			{"user_id": "limits-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "limits-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	}
+	teamBody, _ := json.Marshal(teamReq)
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-limits-1",
+		"pull_request_name": "Single reviewer",
+		"author_id":         "limits-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	defer prResp.Body.Close()
+
+	var prResult struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.NewDecoder(prResp.Body).Decode(&prResult); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(prResult.PR.AssignedReviewers) != 1 {
+		t.Errorf("Expected 1 reviewer for max_reviewers=1, got %v", prResult.PR.AssignedReviewers)
+	}
+
+	updateBody, _ := json.Marshal(map[string]interface{}{
+		"team_name":     "team-limits",
+		"min_reviewers": 3,
+		"max_reviewers": 3,
+	})
+	updateResp, err := http.Post(testBaseURL+"/team/update", "application/json", bytes.NewReader(updateBody))
+	if err != nil {
+		t.Fatalf("Failed to update team: %v", err)
+	}
+	defer updateResp.Body.Close()
+
+	if updateResp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", updateResp.StatusCode)
+	}
+
+	prBody, _ = json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-limits-2",
+		"pull_request_name": "Needs three reviewers",
+		"author_id":         "limits-author",
+	})
+	resp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("Expected status 409, got %d", resp.StatusCode)
+	}
+
+	var errResp ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("Failed to decode error response: %v", err)
+	}
+	if errResp.Error.Code != "NOT_ENOUGH_REVIEWERS" {
+		t.Errorf("Expected error code NOT_ENOUGH_REVIEWERS, got %s", errResp.Error.Code)
+	}
+}
+
+func TestTeamFallbackReviewers(t *testing.T) {
+	teams := []map[string]interface{}{
+		{
+			"team_name": "team-fallback-helpers",
+			"members": []map[string]interface{}{
+				{"user_id": "fallback-helper", "username": "Helper", "is_active": true},
+			},
+		},
+		{
+			"team_name":      "team-fallback-home",
+			"fallback_teams": []string{"team-fallback-helpers"},
+			"members": []map[string]interface{}{
+				{"user_id": "fallback-author", "username": "Author", "is_active": true},
+				{"user_id": "fallback-r1", "username": "Reviewer 1", "is_active": true},
+			},
+		},
+	}
+	for _, teamReq := range teams {
+		teamBody, _ := json.Marshal(teamReq)
+		teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+		if err != nil {
+			t.Fatalf("Failed to create team: %v", err)
+		}
+		teamResp.Body.Close()
+		if teamResp.StatusCode != http.StatusCreated {
+			t.Fatalf("Expected status 201 for %v, got %d", teamReq["team_name"], teamResp.StatusCode)
+		}
+	}
+
+	prBody, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-fallback-1",
+		"pull_request_name": "Needs a helper",
+		"author_id":         "fallback-author",
+	})
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	defer prResp.Body.Close()
+
+	var prResult struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+			FallbackReviewers []string `json:"fallback_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.NewDecoder(prResp.Body).Decode(&prResult); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(prResult.PR.AssignedReviewers) != 2 {
+		t.Errorf("Expected 2 reviewers, got %v", prResult.PR.AssignedReviewers)
+	}
+	if len(prResult.PR.FallbackReviewers) != 1 || prResult.PR.FallbackReviewers[0] != "fallback-helper" {
+		t.Errorf("Expected fallback reviewers [fallback-helper], got %v", prResult.PR.FallbackReviewers)
+	}
+}
@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceSpans спаны трассировки traceID, записанные к этому моменту
+func traceSpans(t *testing.T, traceID trace.TraceID) []sdktrace.ReadOnlySpan {
+	t.Helper()
+
+	if err := testTracerProvider.ForceFlush(context.Background()); err != nil {
+		t.Fatalf("Failed to flush spans: %v", err)
+	}
+
+	var spans []sdktrace.ReadOnlySpan
+	for _, stub := range testSpans.GetSpans() {
+		if stub.SpanContext.TraceID() == traceID {
+			spans = append(spans, stub.Snapshot())
+		}
+	}
+	return spans
+}
+
+func TestTracingCreatePR(t *testing.T) {
+	teamReq := map[string]interface{}{
+		"team_name": "team-tracing",
+		"members": []map[string]interface{}{
+			{"user_id": "user-tracing-1", "username": "Tracing 1", "is_active": true},
+			{"user_id": "user-tracing-2", "username": "Tracing 2", "is_active": true},
+		},
+	}
+	teamBody, _ := json.Marshal(teamReq)
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"pull_request_id":   "pr-tracing-1",
+		"pull_request_name": "Traced PR",
+		"author_id":         "user-tracing-1",
+	})
+	req, _ := http.NewRequest(http.MethodPost, testBaseURL+"/pullRequest/create", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", resp.StatusCode)
+	}
+
+	traceID, _ := trace.TraceIDFromHex("0af7651916cd43dd8448eb211c80319c")
+	spans := traceSpans(t, traceID)
+
+	byName := make(map[string]sdktrace.ReadOnlySpan)
+	queries := 0
+	for _, span := range spans {
+		byName[span.Name()] = span
+		for _, attr := range span.Attributes() {
+			if attr.Key == semconv.DBSystemNameKey {
+				queries++
+			}
+		}
+	}
+
+	server, ok := byName["POST /pullRequest/create"]
+	if !ok {
+		t.Fatalf("Expected server span, got %d spans in trace", len(spans))
+	}
+	if server.Parent().SpanID().String() != "b7ad6b7169203331" {
+		t.Errorf("Expected server span to continue incoming traceparent, parent %s", server.Parent().SpanID())
+	}
+
+	useCase, ok := byName["PullRequestUseCase.CreatePR"]
+	if !ok {
+		t.Fatal("Expected use case span")
+	}
+	if useCase.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Error("Expected use case span to be child of server span")
+	}
+
+	if queries == 0 {
+		t.Error("Expected SQL query spans in trace")
+	}
+}
@@ -100,3 +100,63 @@ func TestGetUserReviews(t *testing.T) {
 		t.Errorf("Expected user_id 'user-reviews-2', got %v", result["user_id"])
 	}
 }
+
+func TestSetUserInactiveReassignsOpenReviews(t *testing.T) {
+	teamReq := map[string]interface{}{
+		"team_name": "team-user-deact",
+		"members": []map[string]interface{}{
+			{"user_id": "user-deact-author", "username": "User Deact Author", "is_active": true},
+			{"user_id": "user-deact-a", "username": "User Deact A", "is_active": true},
+			{"user_id": "user-deact-b", "username": "User Deact B", "is_active": true},
+			{"user_id": "user-deact-c", "username": "User Deact C", "is_active": true},
+		},
+	}
+	teamBody, _ := json.Marshal(teamReq)
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	prReq := map[string]interface{}{
+		"pull_request_id":   "pr-user-deact",
+		"pull_request_name": "User Deactivation PR",
+		"author_id":         "user-deact-author",
+	}
+	prBody, _ := json.Marshal(prReq)
+	prResp, err := http.Post(testBaseURL+"/pullRequest/create", "application/json", bytes.NewReader(prBody))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	prResp.Body.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{"user_id": "user-deact-a", "is_active": false})
+	resp, err := http.Post(testBaseURL+"/users/setIsActive", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to make request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Reassignments []struct {
+			PullRequestID string  `json:"pull_request_id"`
+			OldUserID     string  `json:"old_user_id"`
+			NewUserID     *string `json:"new_user_id"`
+		} `json:"reassignments"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if len(result.Reassignments) != 1 {
+		t.Fatalf("Expected 1 reassignment, got %d", len(result.Reassignments))
+	}
+
+	if result.Reassignments[0].NewUserID == nil || *result.Reassignments[0].NewUserID != "user-deact-c" {
+		t.Errorf("Expected slot moved to 'user-deact-c', got %v", result.Reassignments[0].NewUserID)
+	}
+}
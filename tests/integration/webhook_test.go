@@ -0,0 +1,166 @@
+package integration
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+const (
+	testGitHubSecret = "integration-github-secret"
+	testGitLabSecret = "integration-gitlab-secret"
+)
+
+type webhookResult struct {
+	DeliveryID    string `json:"delivery_id"`
+	Status        string `json:"status"`
+	PullRequestID string `json:"pull_request_id"`
+	Reason        string `json:"reason"`
+}
+
+func sendGitHubWebhook(t *testing.T, deliveryID string, payload map[string]interface{}) (int, webhookResult) {
+	t.Helper()
+
+	body, _ := json.Marshal(payload)
+	mac := hmac.New(sha256.New, []byte(testGitHubSecret))
+	mac.Write(body)
+
+	req, _ := http.NewRequest(http.MethodPost, testBaseURL+"/webhooks/github", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	req.Header.Set("X-GitHub-Delivery", deliveryID)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send webhook: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var result webhookResult
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func githubPullRequestPayload(action string, merged bool) map[string]interface{} {
+	return map[string]interface{}{
+		"action": action,
+		"number": 17,
+		"pull_request": map[string]interface{}{
+			"title":  "Webhook: add ingestion",
+			"draft":  false,
+			"merged": merged,
+			"user":   map[string]interface{}{"login": "Hook-Author"},
+		},
+		"repository": map[string]interface{}{"full_name": "acme/hooks"},
+	}
+}
+
+func TestGitHubWebhook(t *testing.T) {
+	teamBody, _ := json.Marshal(map[string]interface{}{
+		"team_name": "team-webhooks",
+		"members": []map[string]interface{}{
+			{"user_id": "hook-author", "username": "Author", "is_active": true},
+			{"user_id": "hook-r1", "username": "Reviewer", "is_active": true},
+		},
+	})
+	teamResp, err := http.Post(testBaseURL+"/team/add", "application/json", bytes.NewReader(teamBody))
+	if err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	teamResp.Body.Close()
+
+	status, result := sendGitHubWebhook(t, "hook-delivery-1", githubPullRequestPayload("opened", false))
+	if status != http.StatusOK || result.Status != "ignored" {
+		t.Fatalf("Expected ignored delivery for unlinked login, got %d %+v", status, result)
+	}
+
+	linkBody, _ := json.Marshal(map[string]interface{}{
+		"provider": "github",
+		"login":    "hook-author",
+		"user_id":  "hook-author",
+	})
+	linkResp, err := http.Post(testBaseURL+"/webhooks/linkLogin", "application/json", bytes.NewReader(linkBody))
+	if err != nil {
+		t.Fatalf("Failed to link login: %v", err)
+	}
+	linkResp.Body.Close()
+	if linkResp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200 on link login, got %d", linkResp.StatusCode)
+	}
+
+	// Повтор той же доставки после сопоставления логина обрабатывается: ignored не регистрирует доставку
+	status, result = sendGitHubWebhook(t, "hook-delivery-1", githubPullRequestPayload("opened", false))
+	if status != http.StatusOK || result.Status != "processed" {
+		t.Fatalf("Expected processed delivery, got %d %+v", status, result)
+	}
+	if result.PullRequestID != "github-acme_hooks-17" {
+		t.Errorf("Expected pull_request_id github-acme_hooks-17, got %s", result.PullRequestID)
+	}
+
+	status, result = sendGitHubWebhook(t, "hook-delivery-1", githubPullRequestPayload("opened", false))
+	if status != http.StatusOK || result.Status != "duplicate" {
+		t.Fatalf("Expected duplicate delivery, got %d %+v", status, result)
+	}
+
+	status, result = sendGitHubWebhook(t, "hook-delivery-2", githubPullRequestPayload("closed", true))
+	if status != http.StatusOK || result.Status != "processed" {
+		t.Fatalf("Expected processed merge delivery, got %d %+v", status, result)
+	}
+
+	mergeBody, _ := json.Marshal(map[string]interface{}{"pull_request_id": "github-acme_hooks-17"})
+	mergeResp, err := http.Post(testBaseURL+"/pullRequest/merge", "application/json", bytes.NewReader(mergeBody))
+	if err != nil {
+		t.Fatalf("Failed to merge PR: %v", err)
+	}
+	defer mergeResp.Body.Close()
+
+	var prResp struct {
+		PR struct {
+			Status string `json:"status"`
+		} `json:"pr"`
+	}
+	if err := json.NewDecoder(mergeResp.Body).Decode(&prResp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if prResp.PR.Status != "MERGED" {
+		t.Errorf("Expected PR to be MERGED by webhook, got %s", prResp.PR.Status)
+	}
+
+	// Закрытие уже слитого PR неприменимо: git-хостинг не должен повторять доставку
+	status, result = sendGitHubWebhook(t, "hook-delivery-3", githubPullRequestPayload("closed", false))
+	if status != http.StatusOK || result.Status != "ignored" {
+		t.Fatalf("Expected ignored close delivery for merged PR, got %d %+v", status, result)
+	}
+}
+
+func TestGitHubWebhookRejectsInvalidSignature(t *testing.T) {
+	body, _ := json.Marshal(githubPullRequestPayload("opened", false))
+
+	req, _ := http.NewRequest(http.MethodPost, testBaseURL+"/webhooks/github", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	req.Header.Set("X-GitHub-Delivery", "hook-delivery-forged")
+	req.Header.Set("X-Hub-Signature-256", "sha256=00")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to send webhook: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Expected status 401, got %d", resp.StatusCode)
+	}
+
+	var errResp ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if errResp.Error.Code != "INVALID_SIGNATURE" {
+		t.Errorf("Expected error code INVALID_SIGNATURE, got %s", errResp.Error.Code)
+	}
+}